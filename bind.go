@@ -0,0 +1,64 @@
+package validation
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+//
+// -----------------------------------------------------------------------------
+// Tip Güvenli Sonuç Bağlama (ValidateInto)
+// -----------------------------------------------------------------------------
+// Doğrulanmış ve dönüştürülmüş veriyi (ValidData) map[string]any içinden tek
+// tek tip dönüşümü yapmak yerine doğrudan bir struct'a yazar. Alan eşlemesi
+// encoding/json kurallarıyla yapılır; json etiketleri, gömülü struct'lar ve
+// iç içe tipler desteklenir.
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// ValidateInto
+// -----------------------------------------------------------------------------
+// data'yı schema ile doğrular ve başarılıysa ValidData'yı T tipine çözer.
+// Doğrulama başarısızsa T'nin sıfır değeri döner. Çözme sırasında oluşan
+// hatalar (örn. 3.5 değerinin int alana yazılması) sonuca "_payload" alanında
+// "decode" kuralıyla eklenir.
+//
+// Örnek:
+//
+//	type Signup struct {
+//	    Email string `json:"email"`
+//	    Age   int    `json:"age"`
+//	}
+//	signup, res := validation.ValidateInto[Signup](schema, data)
+//	if res.HasErrors() {
+//	    return res.Errors()
+//	}
+func ValidateInto[T any](schema Schema, data map[string]any) (T, *ValidationResult) {
+	var out T
+	result := schema.Validate(data)
+	if result.HasErrors() {
+		return out, result
+	}
+
+	if err := decodeInto(result.ValidData(), &out); err != nil {
+		result.AddErrorRule(payloadField, "decode", err.Error())
+	}
+	return out, result
+}
+
+// decodeInto, doğrulanmış veriyi JSON üzerinden hedef tipe çözer.
+func decodeInto(data map[string]any, target any) error {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("doğrulanmış veri kodlanamadı: %w", err)
+	}
+	if err := json.Unmarshal(raw, target); err != nil {
+		return fmt.Errorf("doğrulanmış veri %T tipine çözülemedi: %w", target, err)
+	}
+	return nil
+}
@@ -0,0 +1,139 @@
+// -----------------------------------------------------------------------------
+// fluentgen
+// -----------------------------------------------------------------------------
+// Şema dosyalarından TypeScript arayüzleri, Zod şemaları, Go struct'ları, CUE
+// tanımları veya protovalidate açıklamalı proto3 mesajları üreten küçük komut
+// satırı aracı. Girdi olarak şema anlık görüntüleri (schema.MarshalJSON
+// çıktısı) veya JSON Schema dokümanları kabul edilir; tür dosya içeriğinden
+// anlaşılır.
+//
+// Kullanım:
+//
+//	fluentgen -target zod -o web/src/schemas.ts signup.json Order=order.schema.json
+//	fluentgen -target go -package dto -o dto/schemas.go signup.json
+//	fluentgen -target proto -package acme.contracts.v1 -o contracts.proto signup.json
+//
+// Bildirim adı "Ad=dosya" biçiminde verilebilir; verilmezse dosya adından
+// türetilir (signup_request.json → SignupRequest).
+//
+// Metadata:
+// @author   Ahmet ALTUN
+// @github   github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email    ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode"
+
+	validation "github.com/biyonik/go-fluent-validator"
+	"github.com/biyonik/go-fluent-validator/codegen"
+)
+
+func main() {
+	target := flag.String("target", string(codegen.TypeScript), "üretilecek kod: typescript, zod, go, cue veya proto")
+	pkg := flag.String("package", "dto", "go, cue ve proto hedeflerinde üretilen dosyanın paket adı")
+	output := flag.String("o", "", "çıktı dosyası (boşsa standart çıktı)")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Kullanım: %s [-target typescript|zod|go|cue|proto] [-package ad] [-o dosya] [Ad=]şema.json...\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if err := run(*target, *pkg, *output, flag.Args()); err != nil {
+		fmt.Fprintln(os.Stderr, "fluentgen:", err)
+		os.Exit(1)
+	}
+}
+
+// run, args'taki şema dosyalarını yükler ve üretilen kodu output'a yazar.
+func run(target, pkg, output string, args []string) error {
+	schemas := make(map[string]*validation.ValidationSchema, len(args))
+	for _, arg := range args {
+		name, path, named := strings.Cut(arg, "=")
+		if !named {
+			name, path = declarationName(arg), arg
+		}
+		schema, err := loadSchema(path)
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		schemas[name] = schema
+	}
+
+	var out []byte
+	var err error
+	switch target {
+	case "go":
+		out, err = codegen.GenerateGo(pkg, schemas)
+	case "cue":
+		out, err = codegen.GenerateCUE(pkg, schemas)
+	case "proto":
+		out, err = codegen.GenerateProto(pkg, schemas)
+	default:
+		out, err = codegen.Generate(codegen.Target(target), schemas)
+	}
+	if err != nil {
+		return err
+	}
+	if output == "" {
+		_, err = os.Stdout.Write(out)
+		return err
+	}
+	return os.WriteFile(output, out, 0o644)
+}
+
+// loadSchema, bir anlık görüntü veya JSON Schema dosyasını şemaya yükler.
+func loadSchema(path string) (*validation.ValidationSchema, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var probe struct {
+		Version int             `json:"version"`
+		Schema  json.RawMessage `json:"schema"`
+	}
+	if err := json.Unmarshal(data, &probe); err != nil {
+		return nil, err
+	}
+	if probe.Version == 0 || probe.Schema == nil {
+		return validation.FromJSONSchema(data)
+	}
+
+	schema := validation.Make()
+	if err := json.Unmarshal(data, schema); err != nil {
+		return nil, err
+	}
+	return schema, nil
+}
+
+// declarationName, dosya adını PascalCase bildirim adına çevirir
+// (user-profile.schema.json → UserProfile).
+func declarationName(path string) string {
+	base := filepath.Base(path)
+	if i := strings.IndexByte(base, '.'); i > 0 {
+		base = base[:i]
+	}
+	var b strings.Builder
+	for _, part := range strings.FieldsFunc(base, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		runes := []rune(part)
+		b.WriteRune(unicode.ToUpper(runes[0]))
+		b.WriteString(string(runes[1:]))
+	}
+	return b.String()
+}
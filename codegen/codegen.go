@@ -0,0 +1,283 @@
+package codegen
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	validation "github.com/biyonik/go-fluent-validator"
+)
+
+//
+// -----------------------------------------------------------------------------
+// TypeScript / Zod Kod Üretimi
+// -----------------------------------------------------------------------------
+// Sunucudaki şemalardan frontend için TypeScript arayüzleri veya Zod şemaları
+// üretir; böylece istemci ve sunucu aynı kısıtları (min/max, enum, regex,
+// zorunluluk) paylaşır ve elle kopyalanan kurallar zamanla ayrışmaz:
+//
+//	out, _ := codegen.Generate(codegen.Zod, map[string]*validation.ValidationSchema{
+//	    "SignupRequest": signupSchema,
+//	})
+//	os.WriteFile("web/src/schemas.ts", out, 0o644)
+//
+// Üretim, şemanın ToJSONSchema() çıktısı üzerinden yapılır; bu yüzden hangi
+// kuralın neye eşlendiği JSON Schema dışa aktarımıyla aynıdır ve karşılığı
+// olmayan kurallar (checksum, national_id, özel doğrulayıcılar) atlanır.
+//
+//   - TypeScript: her şema bir "export interface" olur. Tipte ifade
+//     edilemeyen kısıtlar (minLength, pattern, minimum...) JSDoc etiketleri
+//     olarak yazılır.
+//   - Zod (v3 API): her şema "<Ad>Schema" sabiti ve z.infer ile türetilen
+//     aynı adlı tip olur. When(...) dallarındaki zorunluluklar ve
+//     RequireAnyOf, superRefine ile kontrol edilir.
+//
+// When(...), AllOf(...), AnyOf(...) ve OneOf(...) dallarında tanımlanan alanlar her iki
+// hedefte de isteğe bağlı (optional) alan olarak eklenir.
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// Target, üretilecek kodun türüdür.
+type Target string
+
+const (
+	// TypeScript, "export interface" bildirimleri üretir.
+	TypeScript Target = "typescript"
+
+	// Zod, Zod şemaları ve z.infer tipleri üretir.
+	Zod Target = "zod"
+)
+
+// header, üretilen dosyaların ilk satırıdır.
+const header = "// Code generated by go-fluent-validator/codegen. DO NOT EDIT.\n"
+
+// identifier, tırnaksız yazılabilen TypeScript adlarıdır.
+var identifier = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+
+// Generate
+// -----------------------------------------------------------------------------
+// schemas'taki her şema için target türünde bildirim üretir ve tek bir
+// TypeScript dosyası olarak döndürür. Bildirimler ada göre sıralıdır; aynı
+// girdi her zaman aynı çıktıyı üretir. Adlar geçerli TypeScript tanımlayıcısı
+// olmalıdır.
+func Generate(target Target, schemas map[string]*validation.ValidationSchema) ([]byte, error) {
+	var emit func(string, *validation.ValidationSchema) string
+	var buf bytes.Buffer
+	buf.WriteString(header)
+
+	switch target {
+	case TypeScript:
+		emit = TypeScriptInterface
+	case Zod:
+		emit = ZodSchema
+		buf.WriteString("\nimport { z } from \"zod\";\n")
+	default:
+		return nil, fmt.Errorf("codegen: bilinmeyen hedef %q", target)
+	}
+
+	names := make([]string, 0, len(schemas))
+	for name := range schemas {
+		if !identifier.MatchString(name) {
+			return nil, fmt.Errorf("codegen: %q geçerli bir TypeScript adı değil", name)
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		buf.WriteString("\n")
+		buf.WriteString(emit(name, schemas[name]))
+	}
+	return buf.Bytes(), nil
+}
+
+// mergeConditionals, When(...) dallarındaki (allOf içindeki "then") ve
+// AllOf/AnyOf/OneOf dallarındaki alanları, kök şemada bulunmuyorsa isteğe
+// bağlı alan olarak properties'e ekler.
+func mergeConditionals(doc map[string]any) map[string]any {
+	properties, _ := doc["properties"].(map[string]any)
+	if properties == nil {
+		properties = map[string]any{}
+	}
+	for _, branch := range list(doc["allOf"]) {
+		branches := []any{object(branch)["then"]}
+		if object(branch)["if"] == nil {
+			branches = append(append(list(object(branch)["anyOf"]), list(object(branch)["oneOf"])...), branch)
+		}
+		for _, sub := range branches {
+			for name, field := range mergeConditionals(object(sub)) {
+				if _, exists := properties[name]; !exists {
+					properties[name] = field
+				}
+			}
+		}
+	}
+	return properties
+}
+
+// object, v bir JSON Schema nesnesiyse onu, değilse boş map döndürür.
+func object(v any) map[string]any {
+	m, _ := v.(map[string]any)
+	return m
+}
+
+// alternatives, düğümün oneOf (ayrık birleşim) veya anyOf (Union) dallarını
+// döndürür.
+func alternatives(node map[string]any) []any {
+	if variants := list(node["oneOf"]); len(variants) > 0 {
+		return variants
+	}
+	return list(node["anyOf"])
+}
+
+// nullable, Nullable alanlar için üretilen {"type": ["T", "null"]} veya
+// {"anyOf": [X, {"type": "null"}]} düğümünün null olmayan halini döndürür.
+// anyOf biçiminde dış düğümdeki açıklamalar iç düğüme taşınır.
+func nullable(node map[string]any) (map[string]any, bool) {
+	if types := list(node["type"]); len(types) == 2 && slices.Contains(types, any("null")) {
+		inner := maps.Clone(node)
+		inner["type"] = types[0]
+		if types[0] == "null" {
+			inner["type"] = types[1]
+		}
+		return inner, true
+	}
+
+	variants := list(node["anyOf"])
+	if len(variants) != 2 || len(object(variants[1])) != 1 || object(variants[1])["type"] != "null" {
+		return nil, false
+	}
+	inner := maps.Clone(object(variants[0]))
+	for key, value := range node {
+		if key != "anyOf" {
+			inner[key] = value
+		}
+	}
+	return inner, true
+}
+
+// list, []any veya []string değeri []any olarak döndürür.
+func list(v any) []any {
+	switch v := v.(type) {
+	case []any:
+		return v
+	case []string:
+		out := make([]any, len(v))
+		for i, s := range v {
+			out[i] = s
+		}
+		return out
+	}
+	return nil
+}
+
+// stringList, []any veya []string değerdeki string'leri döndürür.
+func stringList(v any) []string {
+	var out []string
+	for _, item := range list(v) {
+		if s, ok := item.(string); ok {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+// literal, değeri JavaScript literal'i olarak (JSON biçiminde) yazar.
+func literal(v any) string {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return "undefined"
+	}
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+// propertyName, geçerli tanımlayıcı olmayan alan adlarını tırnaklar.
+func propertyName(name string) string {
+	if identifier.MatchString(name) {
+		return name
+	}
+	return literal(name)
+}
+
+// regexLiteral, bir pattern'i JavaScript regex literal'ine çevirir.
+func regexLiteral(pattern string) string {
+	var b strings.Builder
+	b.WriteByte('/')
+	escaped := false
+	for _, r := range pattern {
+		switch {
+		case escaped:
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == '/':
+			b.WriteByte('\\')
+		case r == '\n':
+			b.WriteString(`\n`)
+			continue
+		}
+		b.WriteRune(r)
+	}
+	b.WriteByte('/')
+	return b.String()
+}
+
+// patterns, bir string şemasının pattern'lerini (tekil veya allOf içinde)
+// döndürür.
+func patterns(node map[string]any) []string {
+	var out []string
+	if pattern, ok := node["pattern"].(string); ok {
+		out = append(out, pattern)
+	}
+	for _, item := range list(node["allOf"]) {
+		if pattern, ok := object(item)["pattern"].(string); ok {
+			out = append(out, pattern)
+		}
+	}
+	return out
+}
+
+// discriminator, oneOf dallarının hepsinde const ile sabitlenmiş ortak alanı
+// döndürür; yoksa boş string döner.
+func discriminator(variants []any) string {
+	if len(variants) == 0 {
+		return ""
+	}
+	first, _ := object(variants[0])["properties"].(map[string]any)
+	for _, name := range sortedNames(first) {
+		shared := true
+		for _, variant := range variants {
+			properties, _ := object(variant)["properties"].(map[string]any)
+			if _, ok := object(properties[name])["const"]; !ok {
+				shared = false
+				break
+			}
+		}
+		if shared {
+			return name
+		}
+	}
+	return ""
+}
+
+// sortedNames, map anahtarlarını sıralı döndürür.
+func sortedNames(m map[string]any) []string {
+	names := make([]string, 0, len(m))
+	for name := range m {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
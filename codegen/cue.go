@@ -0,0 +1,326 @@
+package codegen
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	validation "github.com/biyonik/go-fluent-validator"
+)
+
+//
+// -----------------------------------------------------------------------------
+// CUE Tanımları
+// -----------------------------------------------------------------------------
+// Sözleşmelerini CUE ile paylaşan ekipler için şemaları kapalı CUE
+// tanımlarına (#Ad) çevirir; kısıtlar bu pakette tanımlanmaya devam eder ve
+// CUE tarafı üretilen dosyadan beslenir:
+//
+//	#SignupRequest: {
+//	    email: string & =~"^[^@\\s]+@[^@\\s]+\\.[^@\\s]+$" & strings.MaxRunes(100)
+//	    age?:  int & >=18 & <=130
+//	    role?: *"editor" | "admin" | "editor"
+//	    tags?: [...string & strings.MinRunes(2)] & list.MaxItems(2)
+//	}
+//
+// Eşlemeler (JSON Schema dışa aktarımı üzerinden):
+//   - minLength/maxLength → strings.MinRunes/MaxRunes, pattern → =~, enum →
+//     literal ayrışımı, const → literal; email ve uuid düzenli ifadeyle,
+//     ipv4/ipv6 net.IPv4/IPv6 ile, date/date-time time.Format/time.Time ile
+//   - minimum/maximum → >=/<=, exclusiveMinimum/Maximum → >/<, integer → int,
+//     multipleOf → math.MultipleOf
+//   - minItems/maxItems → list.MinItems/MaxItems, uniqueItems →
+//     list.UniqueItems(), items → [...T]
+//   - zorunlu olmayan alanlar → "ad?:", varsayılan değer → *değer
+//
+// CUE'da karşılığı olmayan biçimler (uri, json-pointer) alan yorumuna
+// yazılır. When(...) ve AllOf/AnyOf/OneOf dallarındaki alanlar isteğe bağlı
+// alan olarak eklenir; koşullu zorunluluklar ve RequireAnyOf aktarılmaz.
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// cueIdentifier, tırnaksız yazılabilen CUE etiketleri ve paket adlarıdır.
+var cueIdentifier = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*$`)
+
+// cueKeywords, etiket olarak tırnaklanması gereken CUE anahtar kelimeleridir.
+var cueKeywords = map[string]bool{
+	"package": true, "import": true, "for": true, "in": true, "if": true,
+	"let": true, "true": true, "false": true, "null": true, "func": true,
+	"div": true, "mod": true, "quo": true, "rem": true,
+}
+
+// cuePatterns, CUE'da yerleşik doğrulayıcısı olmayan biçimlerin düzenli
+// ifadeleridir.
+var cuePatterns = map[string]string{
+	"email": `^[^@\s]+@[^@\s]+\.[^@\s]+$`,
+	"uuid":  `^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`,
+}
+
+// CUEDefinition
+// -----------------------------------------------------------------------------
+// Şemayı "#<name>: { ... }" tanımı olarak döndürür. Paket bildirimi ve
+// import'lar eklenmez (bkz. GenerateCUE).
+//
+// Örnek:
+//
+//	src := codegen.CUEDefinition("SignupRequest", schema)
+func CUEDefinition(name string, schema *validation.ValidationSchema) string {
+	return (&cueGenerator{}).definition(name, schema)
+}
+
+// GenerateCUE
+// -----------------------------------------------------------------------------
+// schemas'taki her şema için tanım üretir ve pkg paketinde tek bir CUE
+// dosyası olarak döndürür. Tanımlar ada göre sıralıdır; kullanılan standart
+// paketler (strings, list, math, net, time) import edilir.
+func GenerateCUE(pkg string, schemas map[string]*validation.ValidationSchema) ([]byte, error) {
+	if !cueIdentifier.MatchString(pkg) {
+		return nil, fmt.Errorf("codegen: %q geçerli bir CUE paket adı değil", pkg)
+	}
+	names := make([]string, 0, len(schemas))
+	for name := range schemas {
+		if !cueIdentifier.MatchString(name) {
+			return nil, fmt.Errorf("codegen: %q geçerli bir CUE tanım adı değil", name)
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	g := &cueGenerator{}
+	var body strings.Builder
+	for _, name := range names {
+		body.WriteString("\n" + g.definition(name, schemas[name]))
+	}
+
+	var buf strings.Builder
+	buf.WriteString(header)
+	buf.WriteString("\npackage " + pkg + "\n")
+	switch imports := g.importList(); len(imports) {
+	case 0:
+	case 1:
+		buf.WriteString("\nimport " + literal(imports[0]) + "\n")
+	default:
+		buf.WriteString("\nimport (\n")
+		for _, path := range imports {
+			buf.WriteString("\t" + literal(path) + "\n")
+		}
+		buf.WriteString(")\n")
+	}
+	buf.WriteString(body.String())
+	return []byte(buf.String()), nil
+}
+
+// cueGenerator, üretim sırasında kullanılan standart paketleri izler.
+type cueGenerator struct {
+	imports map[string]bool
+}
+
+// use, path paketini import listesine ekler ve ifadeyi olduğu gibi döndürür.
+func (g *cueGenerator) use(path, expr string) string {
+	if g.imports == nil {
+		g.imports = map[string]bool{}
+	}
+	g.imports[path] = true
+	return expr
+}
+
+// importList, kullanılan paketleri sıralı döndürür.
+func (g *cueGenerator) importList() []string {
+	out := make([]string, 0, len(g.imports))
+	for path := range g.imports {
+		out = append(out, path)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// definition, şemanın "#<name>" tanımını yazar.
+func (g *cueGenerator) definition(name string, schema *validation.ValidationSchema) string {
+	doc := schema.ToJSONSchema()
+	doc["properties"] = mergeConditionals(doc)
+	return "#" + name + ": " + g.object(doc, 0) + "\n"
+}
+
+// object, properties/required içeren düğümü çok satırlı CUE struct'ı olarak
+// yazar. depth, kapanış parantezinin girinti seviyesidir.
+func (g *cueGenerator) object(node map[string]any, depth int) string {
+	properties, _ := node["properties"].(map[string]any)
+	required := stringList(node["required"])
+	pad := strings.Repeat("\t", depth+1)
+
+	var b strings.Builder
+	b.WriteString("{\n")
+	for _, name := range sortedNames(properties) {
+		field := object(properties[name])
+		b.WriteString(cueComment(field, pad))
+		b.WriteString(pad + cueLabel(name))
+		if !slices.Contains(required, name) {
+			b.WriteString("?")
+		}
+		expr := g.value(field, depth+1)
+		if value, ok := field["default"]; ok {
+			expr = "*" + literal(value) + " | " + expr
+		}
+		b.WriteString(": " + expr + "\n")
+	}
+	b.WriteString(strings.Repeat("\t", depth) + "}")
+	return b.String()
+}
+
+// value, bir JSON Schema düğümünün CUE ifadesini döndürür.
+func (g *cueGenerator) value(node map[string]any, depth int) string {
+	if inner, ok := nullable(node); ok {
+		return g.value(inner, depth) + " | null"
+	}
+	if value, ok := node["const"]; ok {
+		return literal(value)
+	}
+	if values := list(node["enum"]); len(values) > 0 {
+		literals := make([]string, len(values))
+		for i, v := range values {
+			literals[i] = literal(v)
+		}
+		return strings.Join(literals, " | ")
+	}
+	if variants := alternatives(node); len(variants) > 0 {
+		items := make([]string, len(variants))
+		for i, variant := range variants {
+			items[i] = g.value(object(variant), depth)
+		}
+		return strings.Join(items, " | ")
+	}
+
+	switch node["type"] {
+	case "string":
+		return g.string(node)
+	case "number", "integer":
+		return g.number(node)
+	case "boolean":
+		return "bool"
+	case "array":
+		return g.array(node, depth)
+	case "object":
+		if values, ok := node["additionalProperties"].(map[string]any); ok {
+			return "{[string]: " + g.value(values, depth) + "}"
+		}
+		if len(object(node["properties"])) == 0 {
+			return "{...}"
+		}
+		return g.object(node, depth)
+	}
+	return "_"
+}
+
+// string, string düğümünü kısıtlarıyla yazar.
+func (g *cueGenerator) string(node map[string]any) string {
+	if node["contentEncoding"] == "binary" {
+		return "bytes"
+	}
+	parts := []string{"string"}
+	switch format, _ := node["format"].(string); format {
+	case "email", "uuid":
+		parts = append(parts, "=~"+literal(cuePatterns[format]))
+	case "ipv4":
+		parts = append(parts, g.use("net", "net.IPv4"))
+	case "ipv6":
+		parts = append(parts, g.use("net", "net.IPv6"))
+	case "date":
+		parts = append(parts, g.use("time", `time.Format("2006-01-02")`))
+	case "date-time":
+		parts = append(parts, g.use("time", "time.Time"))
+	}
+	if value, ok := node["minLength"]; ok {
+		parts = append(parts, g.use("strings", "strings.MinRunes("+literal(value)+")"))
+	}
+	if value, ok := node["maxLength"]; ok {
+		parts = append(parts, g.use("strings", "strings.MaxRunes("+literal(value)+")"))
+	}
+	for _, pattern := range patterns(node) {
+		parts = append(parts, "=~"+literal(pattern))
+	}
+	return strings.Join(parts, " & ")
+}
+
+// number, sayı düğümünü kısıtlarıyla yazar.
+func (g *cueGenerator) number(node map[string]any) string {
+	parts := []string{"number"}
+	if node["type"] == "integer" {
+		parts[0] = "int"
+	}
+	for _, c := range []struct{ key, op string }{
+		{"minimum", ">="}, {"exclusiveMinimum", ">"},
+		{"maximum", "<="}, {"exclusiveMaximum", "<"},
+	} {
+		if value, ok := node[c.key]; ok {
+			parts = append(parts, c.op+literal(value))
+		}
+	}
+	if value, ok := node["multipleOf"]; ok {
+		parts = append(parts, g.use("math", "math.MultipleOf("+literal(value)+")"))
+	}
+	return strings.Join(parts, " & ")
+}
+
+// array, dizi düğümünü eleman tipi ve kısıtlarıyla yazar.
+func (g *cueGenerator) array(node map[string]any, depth int) string {
+	expr := "[...]"
+	if items, ok := node["items"].(map[string]any); ok {
+		item := g.value(items, depth)
+		if strings.Contains(item, " | ") {
+			item = "(" + item + ")"
+		}
+		expr = "[..." + item + "]"
+	}
+	parts := []string{expr}
+	if value, ok := node["minItems"]; ok {
+		parts = append(parts, g.use("list", "list.MinItems("+literal(value)+")"))
+	}
+	if value, ok := node["maxItems"]; ok {
+		parts = append(parts, g.use("list", "list.MaxItems("+literal(value)+")"))
+	}
+	if node["uniqueItems"] == true {
+		parts = append(parts, g.use("list", "list.UniqueItems()"))
+	}
+	return strings.Join(parts, " & ")
+}
+
+// cueLabel, geçerli tanımlayıcı olmayan veya anahtar kelime olan alan
+// adlarını tırnaklar.
+func cueLabel(name string) string {
+	if cueIdentifier.MatchString(name) && !cueKeywords[name] {
+		return name
+	}
+	return literal(name)
+}
+
+// cueComment, alanın açıklamasını, CUE'da karşılığı olmayan biçimini ve
+// kullanımdan kaldırma notunu yorum satırları olarak döndürür.
+func cueComment(node map[string]any, pad string) string {
+	var lines []string
+	if text, ok := node["description"].(string); ok {
+		lines = append(lines, strings.Split(text, "\n")...)
+	} else if text, ok := node["title"].(string); ok {
+		lines = append(lines, text)
+	}
+	switch format, _ := node["format"].(string); format {
+	case "", "email", "uuid", "ipv4", "ipv6", "date", "date-time":
+	default:
+		lines = append(lines, "format: "+format)
+	}
+	if node["deprecated"] == true {
+		lines = append(lines, "Deprecated")
+	}
+
+	var b strings.Builder
+	for _, line := range lines {
+		b.WriteString(strings.TrimRight(pad+"// "+line, " ") + "\n")
+	}
+	return b.String()
+}
@@ -0,0 +1,331 @@
+package codegen
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"go/token"
+	"sort"
+	"strings"
+	"unicode"
+
+	validation "github.com/biyonik/go-fluent-validator"
+	"github.com/biyonik/go-fluent-validator/core"
+)
+
+//
+// -----------------------------------------------------------------------------
+// Go Struct Üretimi
+// -----------------------------------------------------------------------------
+// Şemayı önce tanımlayan ekiplerin eşleşen DTO'ları elle yazmaması için
+// şemanın Describe() çıktısından Go struct tanımları üretir:
+//
+//	type SignupRequest struct {
+//	    Address *SignupRequestAddress `json:"address,omitempty"`
+//	    Age     *int64                `json:"age,omitempty" validate:"min:18"`
+//	    Email   string                `json:"email" validate:"required|email"`
+//	}
+//
+// Eşlemeler:
+//   - string tabanlı tipler → string, number → float64 (integer kuralıyla
+//     int64), boolean → bool, date → time.Time
+//   - alt alanlı nesneler → <Üst><Alan> adlı ayrı struct, alansız nesneler ve
+//     ayrık birleşimler → map[string]any
+//   - diziler → eleman tipinin slice'ı; nesne elemanlar <Üst><Alan>Item olur
+//
+// Zorunlu olmayan skaler ve struct alanlar pointer olur ve json etiketine
+// omitempty eklenir; slice ve map alanlar pointer'sız omitempty alır.
+// validate ve label etiketleri validation.StructTag ile yazılır; böylece
+// üretilen struct validation.Struct ile doğrudan doğrulanabilir. When(...)
+// dallarındaki alanlar isteğe bağlı alan olarak eklenir.
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// goInitialisms, alan adlarında tamamı büyük harfle yazılan kısaltmalardır.
+var goInitialisms = map[string]bool{
+	"API": true, "HTML": true, "HTTP": true, "HTTPS": true, "IBAN": true, "ID": true,
+	"IP": true, "JSON": true, "SQL": true, "SKU": true, "URI": true, "URL": true,
+	"UUID": true, "XML": true,
+}
+
+// goStringTypes, Go'da string olarak temsil edilen şema tipleridir.
+var goStringTypes = map[string]bool{
+	"string": true, "email": true, "uuid": true, "iban": true,
+	"credit_card": true, "csrf_token": true, "captcha": true,
+}
+
+// GoStruct
+// -----------------------------------------------------------------------------
+// Şemayı name adlı struct ve iç içe nesneler için yardımcı struct'lar olarak
+// gofmt'lanmış Go kaynağı biçiminde döndürür. Paket bildirimi ve import'lar
+// eklenmez (bkz. GenerateGo).
+//
+// Örnek:
+//
+//	src := codegen.GoStruct("SignupRequest", schema)
+func GoStruct(name string, schema *validation.ValidationSchema) string {
+	g := &goGenerator{}
+	g.structType(name, mergeDescConditionals(schema.Describe()))
+	return g.source()
+}
+
+// GenerateGo
+// -----------------------------------------------------------------------------
+// schemas'taki her şema için struct üretir ve pkg paketinde tek bir Go dosyası
+// olarak döndürür. Struct'lar ada göre sıralıdır; gerekirse "time" import
+// edilir. Adlar dışa açık Go tanımlayıcıları olmalıdır.
+func GenerateGo(pkg string, schemas map[string]*validation.ValidationSchema) ([]byte, error) {
+	if !token.IsIdentifier(pkg) {
+		return nil, fmt.Errorf("codegen: %q geçerli bir paket adı değil", pkg)
+	}
+	names := make([]string, 0, len(schemas))
+	for name := range schemas {
+		if !token.IsIdentifier(name) || !token.IsExported(name) {
+			return nil, fmt.Errorf("codegen: %q dışa açık bir Go adı değil", name)
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	g := &goGenerator{}
+	for _, name := range names {
+		g.structType(name, mergeDescConditionals(schemas[name].Describe()))
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(header)
+	buf.WriteString("\npackage " + pkg + "\n")
+	if g.usesTime {
+		buf.WriteString("\nimport \"time\"\n")
+	}
+	buf.WriteString("\n" + g.source())
+	return format.Source(buf.Bytes())
+}
+
+// goGenerator, üretilen struct bildirimlerini biriktirir.
+type goGenerator struct {
+	decls    []string
+	usesTime bool
+}
+
+// source, biriktirilen bildirimleri gofmt'lanmış olarak döndürür.
+func (g *goGenerator) source() string {
+	src := strings.Join(g.decls, "\n")
+	if formatted, err := format.Source([]byte(src)); err == nil {
+		return string(formatted)
+	}
+	return src
+}
+
+// structType, fields için name adlı struct bildirimini (ve iç içe struct'ları)
+// ekler.
+func (g *goGenerator) structType(name string, fields map[string]*core.TypeDescription) {
+	index := len(g.decls)
+	g.decls = append(g.decls, "")
+
+	var b strings.Builder
+	b.WriteString("type " + name + " struct {\n")
+	for _, key := range sortedFields(fields) {
+		desc := fields[key]
+		if desc == nil {
+			continue
+		}
+		fieldName := goFieldName(key)
+		goType := g.fieldType(name+fieldName, desc)
+
+		jsonTag := key
+		if !desc.Required || desc.Optional {
+			jsonTag += ",omitempty"
+		}
+		if !desc.Required || desc.Optional || desc.Nullable {
+			if !strings.HasPrefix(goType, "[]") && !strings.HasPrefix(goType, "map[") && goType != "any" {
+				goType = "*" + goType
+			}
+		}
+		tags := fmt.Sprintf("json:%q", jsonTag)
+		if desc.Label != "" {
+			tags += fmt.Sprintf(" label:%q", desc.Label)
+		}
+		if tag := validation.StructTag(goTagDescription(desc)); tag != "" {
+			tags += fmt.Sprintf(" validate:%q", tag)
+		}
+
+		b.WriteString(goFieldDoc(desc))
+		b.WriteString("\t" + fieldName + " " + goType + " `" + tags + "`\n")
+	}
+	b.WriteString("}\n")
+	g.decls[index] = b.String()
+}
+
+// fieldType, bir alan tanımının Go tipini döndürür. typeName, alan iç içe
+// nesneyse üretilecek struct'ın adıdır.
+func (g *goGenerator) fieldType(typeName string, desc *core.TypeDescription) string {
+	switch {
+	case goStringTypes[desc.Type]:
+		return "string"
+	case desc.Type == "number":
+		if desc.HasRule("integer") {
+			return "int64"
+		}
+		return "float64"
+	case desc.Type == "boolean":
+		return "bool"
+	case desc.Type == "date":
+		g.usesTime = true
+		return "time.Time"
+	case desc.Type == "object":
+		if len(desc.Fields) == 0 || len(desc.Variants) > 0 {
+			return "map[string]any"
+		}
+		g.structType(typeName, desc.Fields)
+		return typeName
+	case desc.Type == "array":
+		if desc.Elements == nil {
+			return "[]any"
+		}
+		return "[]" + g.fieldType(typeName+"Item", desc.Elements)
+	case desc.Type == "map":
+		if desc.Values == nil {
+			return "map[string]any"
+		}
+		return "map[string]" + g.fieldType(typeName+"Value", desc.Values)
+	case desc.Type == "literal":
+		switch value := desc.Rule("literal").Params["value"].(type) {
+		case string:
+			return "string"
+		case bool:
+			return "bool"
+		case int, int64:
+			return "int64"
+		case float64:
+			if value == float64(int64(value)) {
+				return "int64"
+			}
+			return "float64"
+		}
+		return "any"
+	case desc.Type == "json_patch":
+		return "[]map[string]any"
+	}
+	return "any"
+}
+
+// goTagDescription, validate etiketinde Go tipinden zaten anlaşılan integer
+// kuralını çıkarır ve literal tanımlarını etiketle ifade edilebilen kurallara
+// çevirir.
+func goTagDescription(desc *core.TypeDescription) *core.TypeDescription {
+	if desc.Type == "literal" {
+		// Sabit string değerler one_of etiketiyle korunur; diğer sabitlerin
+		// etiket karşılığı yoktur.
+		clone := *desc
+		clone.Rules = nil
+		if value, ok := desc.Rule("literal").Params["value"].(string); ok {
+			clone.AddRule("one_of", map[string]any{"values": []any{value}})
+		}
+		return &clone
+	}
+	if desc.Type != "number" || !desc.HasRule("integer") {
+		return desc
+	}
+	clone := *desc
+	clone.Rules = nil
+	for _, rule := range desc.Rules {
+		if rule.Name != "integer" {
+			clone.Rules = append(clone.Rules, rule)
+		}
+	}
+	return &clone
+}
+
+// goFieldDoc, alanın açıklamasını ve kullanımdan kaldırma notunu yorum
+// satırları olarak döndürür.
+func goFieldDoc(desc *core.TypeDescription) string {
+	var lines []string
+	if desc.Description != "" {
+		lines = append(lines, strings.Split(desc.Description, "\n")...)
+	}
+	if desc.Deprecated {
+		if len(lines) > 0 {
+			lines = append(lines, "")
+		}
+		reason := desc.DeprecationReason
+		if reason == "" {
+			reason = "bu alan kullanımdan kaldırıldı."
+		}
+		lines = append(lines, "Deprecated: "+reason)
+	}
+
+	var b strings.Builder
+	for _, line := range lines {
+		b.WriteString(strings.TrimRight("\t// "+line, " ") + "\n")
+	}
+	return b.String()
+}
+
+// goFieldName, json alan adını dışa açık Go alan adına çevirir
+// (user_id → UserID, "2fa-code" → X2faCode).
+func goFieldName(key string) string {
+	var b strings.Builder
+	for _, part := range strings.FieldsFunc(key, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		if upper := strings.ToUpper(part); goInitialisms[upper] {
+			b.WriteString(upper)
+			continue
+		}
+		runes := []rune(part)
+		b.WriteRune(unicode.ToUpper(runes[0]))
+		b.WriteString(string(runes[1:]))
+	}
+	name := b.String()
+	if name == "" || !unicode.IsLetter([]rune(name)[0]) {
+		name = "X" + name
+	}
+	return name
+}
+
+// mergeDescConditionals, When(...) ve AllOf/AnyOf/OneOf dallarındaki
+// alanları kök alanlarda yoksa zorunluluğu kaldırılmış olarak ekler.
+func mergeDescConditionals(desc *core.SchemaDescription) map[string]*core.TypeDescription {
+	fields := make(map[string]*core.TypeDescription, len(desc.Fields))
+	for name, field := range desc.Fields {
+		fields[name] = field
+	}
+	var branches []*core.SchemaDescription
+	for _, cond := range desc.Conditionals {
+		branches = append(branches, cond.Schema)
+	}
+	for _, c := range desc.Compositions {
+		if c.Kind != "not" {
+			branches = append(branches, c.Schemas...)
+		}
+	}
+	for _, branch := range branches {
+		if branch == nil {
+			continue
+		}
+		for name, field := range mergeDescConditionals(branch) {
+			if _, exists := fields[name]; !exists && field != nil {
+				optional := *field
+				optional.Required = false
+				fields[name] = &optional
+			}
+		}
+	}
+	return fields
+}
+
+// sortedFields, alan adlarını sıralı döndürür.
+func sortedFields(fields map[string]*core.TypeDescription) []string {
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,346 @@
+package codegen
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"unicode"
+
+	validation "github.com/biyonik/go-fluent-validator"
+)
+
+//
+// -----------------------------------------------------------------------------
+// Protobuf / protovalidate Üretimi
+// -----------------------------------------------------------------------------
+// protovalidate (buf.validate) kullanan ekipler için şemaları, kısıtları
+// FieldOptions açıklamaları olarak taşıyan proto3 mesajlarına çevirir:
+//
+//	message SignupRequest {
+//	  optional int64 age = 1 [(buf.validate.field).int64 = {gte: 18, lte: 130}];
+//	  string email = 2 [(buf.validate.field).required = true, (buf.validate.field).string = {email: true, max_len: 100}];
+//	  repeated string tags = 3 [(buf.validate.field).repeated = {max_items: 2, items: {string: {min_len: 2}}}];
+//	}
+//
+// Eşlemeler (JSON Schema dışa aktarımı üzerinden):
+//   - string → string (min_len, max_len, pattern, email, uri, uuid, ipv4,
+//     ipv6, in, const); dosya alanları → bytes
+//   - number → double, integer → int64 (gte, lte, gt, lt, in, const)
+//   - diziler → repeated (min_items, max_items, unique, items)
+//   - alt alanlı nesneler → iç içe mesaj, alansız nesneler ve ayrık
+//     birleşimler → google.protobuf.Struct
+//   - zorunlu alanlar → required, zorunlu olmayan skalerler → optional
+//
+// Alan numaraları alan adlarının alfabetik sırasına göre verilir; şemaya
+// alan eklemek numaraları kaydırabileceğinden üretilen dosya sürümlenmiş bir
+// wire sözleşmesi değil, kısıtları paylaşmak için bir kaynaktır. JSON adı
+// protojson'un varsayılan camelCase adından farklı olan alanlara json_name
+// eklenir. protovalidate'te karşılığı olmayan kısıtlar (multipleOf, tarih
+// biçimleri, birden fazla pattern'in ilki dışındakiler) atlanır.
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// protoPackage, geçerli protobuf paket adlarıdır (acme.contracts.v1).
+var protoPackage = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*(\.[A-Za-z_][A-Za-z0-9_]*)*$`)
+
+// protoIdentifier, geçerli protobuf mesaj ve alan adlarıdır.
+var protoIdentifier = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// protoFormats, JSON Schema format değerlerinin protovalidate string
+// kurallarıdır.
+var protoFormats = map[string]string{
+	"email": "email",
+	"uri":   "uri",
+	"uuid":  "uuid",
+	"ipv4":  "ipv4",
+	"ipv6":  "ipv6",
+}
+
+// protoScalars, zorunlu değilse optional olarak işaretlenen skaler
+// tiplerdir.
+var protoScalars = map[string]bool{
+	"string": true, "bytes": true, "double": true, "int64": true, "bool": true,
+}
+
+// protoStruct, serbest nesnelerin protobuf tipidir.
+const protoStruct = "google.protobuf.Struct"
+
+// ProtoMessage
+// -----------------------------------------------------------------------------
+// Şemayı "message <name> { ... }" bildirimi olarak döndürür. syntax, package
+// ve import satırları eklenmez (bkz. GenerateProto).
+//
+// Örnek:
+//
+//	src := codegen.ProtoMessage("SignupRequest", schema)
+func ProtoMessage(name string, schema *validation.ValidationSchema) string {
+	return (&protoGenerator{}).message(name, schema)
+}
+
+// GenerateProto
+// -----------------------------------------------------------------------------
+// schemas'taki her şema için mesaj üretir ve pkg paketinde tek bir proto3
+// dosyası olarak döndürür. Mesajlar ada göre sıralıdır; buf/validate ve
+// gerekirse google/protobuf/struct.proto import edilir.
+func GenerateProto(pkg string, schemas map[string]*validation.ValidationSchema) ([]byte, error) {
+	if !protoPackage.MatchString(pkg) {
+		return nil, fmt.Errorf("codegen: %q geçerli bir protobuf paket adı değil", pkg)
+	}
+	names := make([]string, 0, len(schemas))
+	for name := range schemas {
+		if !protoIdentifier.MatchString(name) {
+			return nil, fmt.Errorf("codegen: %q geçerli bir protobuf mesaj adı değil", name)
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	g := &protoGenerator{}
+	var body strings.Builder
+	for _, name := range names {
+		body.WriteString("\n" + g.message(name, schemas[name]))
+	}
+
+	var buf strings.Builder
+	buf.WriteString(header)
+	buf.WriteString("\nsyntax = \"proto3\";\n")
+	buf.WriteString("\npackage " + pkg + ";\n")
+	buf.WriteString("\nimport \"buf/validate/validate.proto\";\n")
+	if g.usesStruct {
+		buf.WriteString("import \"google/protobuf/struct.proto\";\n")
+	}
+	buf.WriteString(body.String())
+	return []byte(buf.String()), nil
+}
+
+// protoGenerator, üretim sırasında google.protobuf.Struct kullanımını izler.
+type protoGenerator struct {
+	usesStruct bool
+}
+
+// message, şemanın kök mesajını yazar.
+func (g *protoGenerator) message(name string, schema *validation.ValidationSchema) string {
+	doc := schema.ToJSONSchema()
+	doc["properties"] = mergeConditionals(doc)
+	return g.messageBody(name, doc, 0)
+}
+
+// messageBody, properties/required içeren düğümü name adlı mesaj olarak
+// yazar. İç içe nesneler için üretilen mesajlar alanlardan sonra eklenir.
+func (g *protoGenerator) messageBody(name string, node map[string]any, depth int) string {
+	properties, _ := node["properties"].(map[string]any)
+	required := stringList(node["required"])
+	pad := strings.Repeat("  ", depth+1)
+
+	var b strings.Builder
+	var nested []string
+	b.WriteString(strings.Repeat("  ", depth) + "message " + name + " {\n")
+	for i, key := range sortedNames(properties) {
+		field := object(properties[key])
+		typeName := goFieldName(key)
+		label, protoType, rules := g.fieldType(typeName, field)
+
+		switch {
+		case protoType == typeName:
+			nested = append(nested, g.messageBody(typeName, field, depth+1))
+		case protoType == typeName+"Item":
+			nested = append(nested, g.messageBody(typeName+"Item", object(field["items"]), depth+1))
+		}
+
+		var options []string
+		if slices.Contains(required, key) {
+			options = append(options, "(buf.validate.field).required = true")
+		} else if label == "" && protoScalars[protoType] {
+			label = "optional "
+		}
+		options = append(options, rules...)
+		fieldName := protoFieldName(key)
+		if protoJSONName(fieldName) != key {
+			options = append(options, "json_name = "+literal(key))
+		}
+		if field["deprecated"] == true {
+			options = append(options, "deprecated = true")
+		}
+
+		b.WriteString(protoComment(field, pad))
+		b.WriteString(fmt.Sprintf("%s%s%s %s = %d", pad, label, protoType, fieldName, i+1))
+		if len(options) > 0 {
+			b.WriteString(" [" + strings.Join(options, ", ") + "]")
+		}
+		b.WriteString(";\n")
+	}
+	for _, decl := range nested {
+		b.WriteString("\n" + decl)
+	}
+	b.WriteString(strings.Repeat("  ", depth) + "}\n")
+	return b.String()
+}
+
+// fieldType, bir alanın etiketini ("repeated " veya boş), protobuf tipini ve
+// protovalidate seçeneklerini döndürür. typeName, alan iç içe nesneyse
+// üretilecek mesajın adıdır.
+func (g *protoGenerator) fieldType(typeName string, node map[string]any) (string, string, []string) {
+	if inner, ok := nullable(node); ok {
+		node = inner
+	}
+	if node["type"] == "array" {
+		items := object(node["items"])
+		itemType, itemKind, itemRules := g.scalar(typeName+"Item", items)
+
+		var rules []string
+		for _, c := range []struct{ key, rule string }{
+			{"minItems", "min_items"}, {"maxItems", "max_items"},
+		} {
+			if value, ok := node[c.key]; ok {
+				rules = append(rules, c.rule+": "+literal(value))
+			}
+		}
+		if node["uniqueItems"] == true && itemKind != "" {
+			rules = append(rules, "unique: true")
+		}
+		if len(itemRules) > 0 {
+			rules = append(rules, "items: {"+itemKind+": {"+strings.Join(itemRules, ", ")+"}}")
+		}
+		return "repeated ", itemType, protoOption("repeated", rules)
+	}
+
+	protoType, kind, rules := g.scalar(typeName, node)
+	return "", protoType, protoOption(kind, rules)
+}
+
+// scalar, dizi olmayan bir düğümün protobuf tipini, protovalidate kural
+// grubunu (string, int64, double...) ve kurallarını döndürür. Mesaj ve
+// Struct tiplerinde kural grubu boştur.
+func (g *protoGenerator) scalar(typeName string, node map[string]any) (string, string, []string) {
+	if len(alternatives(node)) > 0 || (node["type"] == "object" && len(object(node["properties"])) == 0) {
+		g.usesStruct = true
+		return protoStruct, "", nil
+	}
+
+	var rules []string
+	if value, ok := node["const"]; ok {
+		rules = append(rules, "const: "+literal(value))
+	}
+	if values := list(node["enum"]); len(values) > 0 {
+		literals := make([]string, len(values))
+		for i, v := range values {
+			literals[i] = literal(v)
+		}
+		rules = append(rules, "in: ["+strings.Join(literals, ", ")+"]")
+	}
+
+	switch node["type"] {
+	case "string":
+		if node["contentEncoding"] == "binary" {
+			return "bytes", "bytes", nil
+		}
+		format, _ := node["format"].(string)
+		if format, ok := protoFormats[format]; ok {
+			rules = append(rules, format+": true")
+		}
+		for _, c := range []struct{ key, rule string }{
+			{"minLength", "min_len"}, {"maxLength", "max_len"},
+		} {
+			if value, ok := node[c.key]; ok {
+				rules = append(rules, c.rule+": "+literal(value))
+			}
+		}
+		if all := patterns(node); len(all) > 0 {
+			rules = append(rules, "pattern: "+literal(all[0]))
+		}
+		return "string", "string", rules
+
+	case "number", "integer":
+		protoType := "double"
+		if node["type"] == "integer" {
+			protoType = "int64"
+		}
+		for _, c := range []struct{ key, rule string }{
+			{"minimum", "gte"}, {"exclusiveMinimum", "gt"},
+			{"maximum", "lte"}, {"exclusiveMaximum", "lt"},
+		} {
+			if value, ok := node[c.key]; ok {
+				rules = append(rules, c.rule+": "+literal(value))
+			}
+		}
+		return protoType, protoType, rules
+
+	case "boolean":
+		return "bool", "bool", rules
+
+	case "object":
+		return typeName, "", nil
+	}
+
+	if _, ok := node["const"]; ok || len(list(node["enum"])) > 0 {
+		return "string", "string", rules
+	}
+	g.usesStruct = true
+	return "google.protobuf.Value", "", nil
+}
+
+// protoOption, kind grubundaki kuralları tek bir FieldOptions açıklaması
+// olarak döndürür; kural yoksa nil döner.
+func protoOption(kind string, rules []string) []string {
+	if kind == "" || len(rules) == 0 {
+		return nil
+	}
+	return []string{"(buf.validate.field)." + kind + " = {" + strings.Join(rules, ", ") + "}"}
+}
+
+// protoFieldName, json alan adını geçerli bir protobuf alan adına çevirir
+// (user-id → user_id, "2fa" → f_2fa).
+func protoFieldName(key string) string {
+	name := strings.Map(func(r rune) rune {
+		if r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			return r
+		}
+		return '_'
+	}, key)
+	if !protoIdentifier.MatchString(name) {
+		name = "f_" + name
+	}
+	return name
+}
+
+// protoJSONName, protojson'un bir alan adı için varsayılan olarak kullandığı
+// camelCase JSON adını döndürür.
+func protoJSONName(name string) string {
+	var b strings.Builder
+	upper := false
+	for _, r := range name {
+		switch {
+		case r == '_':
+			upper = true
+		case upper:
+			b.WriteRune(unicode.ToUpper(r))
+			upper = false
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// protoComment, alanın açıklamasını yorum satırları olarak döndürür.
+func protoComment(node map[string]any, pad string) string {
+	var lines []string
+	if text, ok := node["description"].(string); ok {
+		lines = strings.Split(text, "\n")
+	} else if text, ok := node["title"].(string); ok {
+		lines = []string{text}
+	}
+
+	var b strings.Builder
+	for _, line := range lines {
+		b.WriteString(strings.TrimRight(pad+"// "+line, " ") + "\n")
+	}
+	return b.String()
+}
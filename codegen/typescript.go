@@ -0,0 +1,178 @@
+package codegen
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	validation "github.com/biyonik/go-fluent-validator"
+)
+
+//
+// -----------------------------------------------------------------------------
+// TypeScript Arayüzleri
+// -----------------------------------------------------------------------------
+// JSON Schema düğümlerini TypeScript tiplerine çevirir: string/number/boolean
+// temel tiplere, enum ve const string literal birleşimlerine, oneOf/anyOf
+// tip birleşimlerine, additionalProperties Record<string, T>'ye, dosya alanları
+// File'a dönüşür. Zorunlu olmayan alanlar "?" ile işaretlenir. Tipte ifade edilemeyen kısıtlar alanın JSDoc yorumuna
+// yazılır:
+//
+//	export interface SignupRequest {
+//	  /**
+//	   * E-posta
+//	   * @format email
+//	   */
+//	  email: string;
+//	  /** @minimum 18 */
+//	  age?: number;
+//	}
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// jsDocTags, JSDoc'a sırasıyla yazılan JSON Schema kısıtlarıdır.
+var jsDocTags = []string{
+	"format", "minLength", "maxLength", "pattern",
+	"minimum", "maximum", "exclusiveMinimum", "exclusiveMaximum", "multipleOf",
+	"minItems", "maxItems", "uniqueItems", "default",
+}
+
+// TypeScriptInterface
+// -----------------------------------------------------------------------------
+// Şemayı "export interface <name> { ... }" bildirimi olarak döndürür.
+//
+// Örnek:
+//
+//	src := codegen.TypeScriptInterface("SignupRequest", schema)
+func TypeScriptInterface(name string, schema *validation.ValidationSchema) string {
+	doc := schema.ToJSONSchema()
+	doc["properties"] = mergeConditionals(doc)
+	return "export interface " + name + " " + tsObject(doc, 0) + "\n"
+}
+
+// tsType, bir JSON Schema düğümünün TypeScript tipini döndürür.
+func tsType(node map[string]any, depth int) string {
+	if inner, ok := nullable(node); ok {
+		return tsType(inner, depth) + " | null"
+	}
+	if value, ok := node["const"]; ok {
+		return literal(value)
+	}
+	if values := list(node["enum"]); len(values) > 0 {
+		literals := make([]string, len(values))
+		for i, v := range values {
+			literals[i] = literal(v)
+		}
+		return strings.Join(literals, " | ")
+	}
+	if variants := alternatives(node); len(variants) > 0 {
+		types := make([]string, len(variants))
+		for i, variant := range variants {
+			types[i] = tsType(object(variant), depth)
+		}
+		return strings.Join(types, " | ")
+	}
+
+	switch node["type"] {
+	case "string":
+		if node["contentEncoding"] == "binary" {
+			return "File"
+		}
+		return "string"
+	case "number", "integer":
+		return "number"
+	case "boolean":
+		return "boolean"
+	case "array":
+		items, ok := node["items"].(map[string]any)
+		if !ok {
+			return "unknown[]"
+		}
+		item := tsType(items, depth)
+		if strings.Contains(item, " | ") {
+			item = "(" + item + ")"
+		}
+		return item + "[]"
+	case "object":
+		if values, ok := node["additionalProperties"].(map[string]any); ok {
+			return "Record<string, " + tsType(values, depth) + ">"
+		}
+		if len(object(node["properties"])) == 0 {
+			return "Record<string, unknown>"
+		}
+		return tsObject(node, depth)
+	}
+	return "unknown"
+}
+
+// tsObject, properties/required içeren bir düğümü çok satırlı nesne tipi
+// olarak yazar. depth, kapanış parantezinin girinti seviyesidir.
+func tsObject(node map[string]any, depth int) string {
+	properties, _ := node["properties"].(map[string]any)
+	required := stringList(node["required"])
+	pad := strings.Repeat("  ", depth+1)
+
+	var b strings.Builder
+	b.WriteString("{\n")
+	for _, name := range sortedNames(properties) {
+		field := object(properties[name])
+		b.WriteString(jsDoc(field, pad))
+		b.WriteString(pad + propertyName(name))
+		if !slices.Contains(required, name) {
+			b.WriteString("?")
+		}
+		b.WriteString(": " + tsType(field, depth+1) + ";\n")
+	}
+	b.WriteString(strings.Repeat("  ", depth) + "}")
+	return b.String()
+}
+
+// jsDoc, alanın açıklamasını ve kısıtlarını JSDoc yorumu olarak döndürür.
+// Yazılacak bir şey yoksa boş string döner.
+func jsDoc(node map[string]any, pad string) string {
+	var lines []string
+	if text, ok := node["description"].(string); ok {
+		lines = append(lines, text)
+	} else if text, ok := node["title"].(string); ok {
+		lines = append(lines, text)
+	}
+	if node["type"] == "integer" {
+		lines = append(lines, "@integer")
+	}
+	for _, tag := range jsDocTags {
+		value, ok := node[tag]
+		if !ok {
+			continue
+		}
+		switch v := value.(type) {
+		case string:
+			if tag == "default" {
+				v = literal(v)
+			}
+			lines = append(lines, fmt.Sprintf("@%s %s", tag, v))
+		case bool:
+			lines = append(lines, "@"+tag)
+		default:
+			lines = append(lines, fmt.Sprintf("@%s %s", tag, literal(v)))
+		}
+	}
+	if node["deprecated"] == true {
+		lines = append(lines, "@deprecated")
+	}
+
+	for i, line := range lines {
+		lines[i] = strings.ReplaceAll(line, "*/", "*\\/")
+	}
+	switch len(lines) {
+	case 0:
+		return ""
+	case 1:
+		return pad + "/** " + lines[0] + " */\n"
+	}
+	return pad + "/**\n" + pad + " * " + strings.Join(lines, "\n"+pad+" * ") + "\n" + pad + " */\n"
+}
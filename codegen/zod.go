@@ -0,0 +1,286 @@
+package codegen
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	validation "github.com/biyonik/go-fluent-validator"
+)
+
+//
+// -----------------------------------------------------------------------------
+// Zod Şemaları
+// -----------------------------------------------------------------------------
+// JSON Schema düğümlerini Zod (v3) zincirlerine çevirir:
+//
+//   - minLength/maxLength → .min/.max, pattern → .regex, format → .email,
+//     .url, .uuid, .ip, .date, .datetime; enum → z.enum
+//   - minimum/maximum → .min/.max, exclusiveMinimum/Maximum → .gt/.lt,
+//     integer → .int(), multipleOf → .multipleOf
+//   - minItems/maxItems → .min/.max, uniqueItems → .refine
+//   - propertyNames/additionalProperties → z.record(anahtar, değer)
+//   - ayırıcı alanlı oneOf → z.discriminatedUnion, diğer oneOf/anyOf → z.union
+//   - zorunlu olmayan alanlar → .optional(), varsayılan değer → .default(...),
+//     açıklama → .describe(...)
+//
+// When(...) dallarındaki zorunlu alanlar ve RequireAnyOf grupları kök nesneye
+// eklenen superRefine içinde kontrol edilir:
+//
+//	export const SignupRequestSchema = z.object({
+//	  email: z.string().email(),
+//	  age: z.number().int().min(18).optional(),
+//	});
+//	export type SignupRequest = z.infer<typeof SignupRequestSchema>;
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// zodFormats, JSON Schema format değerlerinin Zod string metotlarıdır.
+var zodFormats = map[string]string{
+	"email":     ".email()",
+	"uri":       ".url()",
+	"uuid":      ".uuid()",
+	"ipv4":      `.ip({ version: "v4" })`,
+	"ipv6":      `.ip({ version: "v6" })`,
+	"date":      ".date()",
+	"date-time": ".datetime({ offset: true })",
+}
+
+// ZodSchema
+// -----------------------------------------------------------------------------
+// Şemayı "export const <name>Schema = z.object(...)" ve
+// "export type <name> = z.infer<...>" bildirimleri olarak döndürür. Çıktı
+// "zod" paketinden z'nin içe aktarıldığını varsayar (bkz. Generate).
+//
+// Örnek:
+//
+//	src := codegen.ZodSchema("SignupRequest", schema)
+func ZodSchema(name string, schema *validation.ValidationSchema) string {
+	doc := schema.ToJSONSchema()
+	doc["properties"] = mergeConditionals(doc)
+
+	var b strings.Builder
+	b.WriteString("export const " + name + "Schema = " + zodObject(doc, 0))
+	b.WriteString(zodRefinements(doc))
+	b.WriteString(";\n")
+	b.WriteString("export type " + name + " = z.infer<typeof " + name + "Schema>;\n")
+	return b.String()
+}
+
+// zodType, bir JSON Schema düğümünün Zod ifadesini döndürür.
+func zodType(node map[string]any, depth int) string {
+	if inner, ok := nullable(node); ok {
+		return zodType(inner, depth) + ".nullable()"
+	}
+	var expr string
+	if value, ok := node["const"]; ok {
+		expr = "z.literal(" + literal(value) + ")"
+	} else if values := list(node["enum"]); len(values) > 0 {
+		expr = zodEnum(values)
+	} else if variants := alternatives(node); len(variants) > 0 {
+		expr = zodUnion(variants, depth)
+	} else {
+		switch node["type"] {
+		case "string":
+			expr = zodString(node)
+		case "number", "integer":
+			expr = zodNumber(node)
+		case "boolean":
+			expr = "z.boolean()"
+		case "array":
+			expr = zodArray(node, depth)
+		case "object":
+			if len(object(node["properties"])) > 0 {
+				expr = zodObject(node, depth)
+			} else {
+				expr = zodRecord(node, depth)
+			}
+		default:
+			expr = "z.unknown()"
+		}
+	}
+
+	if text, ok := node["description"].(string); ok {
+		expr += ".describe(" + literal(text) + ")"
+	} else if text, ok := node["title"].(string); ok {
+		expr += ".describe(" + literal(text) + ")"
+	}
+	return expr
+}
+
+// zodEnum, enum değerlerini z.enum (yalnızca string'ler) veya literal
+// birleşimi olarak yazar.
+func zodEnum(values []any) string {
+	literals := make([]string, len(values))
+	allStrings := true
+	for i, v := range values {
+		literals[i] = literal(v)
+		if _, ok := v.(string); !ok {
+			allStrings = false
+		}
+	}
+	if allStrings {
+		return "z.enum([" + strings.Join(literals, ", ") + "])"
+	}
+	for i, l := range literals {
+		literals[i] = "z.literal(" + l + ")"
+	}
+	return "z.union([" + strings.Join(literals, ", ") + "])"
+}
+
+// zodUnion, oneOf/anyOf dallarını z.discriminatedUnion veya z.union olarak yazar.
+func zodUnion(variants []any, depth int) string {
+	pad := strings.Repeat("  ", depth+1)
+	items := make([]string, len(variants))
+	for i, variant := range variants {
+		items[i] = pad + zodType(object(variant), depth+1) + ",\n"
+	}
+	body := "[\n" + strings.Join(items, "") + strings.Repeat("  ", depth) + "]"
+	if key := discriminator(variants); key != "" {
+		return "z.discriminatedUnion(" + literal(key) + ", " + body + ")"
+	}
+	return "z.union(" + body + ")"
+}
+
+// zodString, string düğümünü kısıtlarıyla yazar.
+func zodString(node map[string]any) string {
+	if node["contentEncoding"] == "binary" {
+		return "z.instanceof(File)"
+	}
+	expr := "z.string()"
+	if format, ok := node["format"].(string); ok {
+		expr += zodFormats[format]
+	}
+	if value, ok := node["minLength"]; ok {
+		expr += ".min(" + literal(value) + ")"
+	}
+	if value, ok := node["maxLength"]; ok {
+		expr += ".max(" + literal(value) + ")"
+	}
+	for _, pattern := range patterns(node) {
+		expr += ".regex(" + regexLiteral(pattern) + ")"
+	}
+	return expr
+}
+
+// zodNumber, sayı düğümünü kısıtlarıyla yazar.
+func zodNumber(node map[string]any) string {
+	expr := "z.number()"
+	if node["type"] == "integer" {
+		expr += ".int()"
+	}
+	for _, c := range []struct{ key, method string }{
+		{"minimum", "min"}, {"exclusiveMinimum", "gt"},
+		{"maximum", "max"}, {"exclusiveMaximum", "lt"},
+		{"multipleOf", "multipleOf"},
+	} {
+		if value, ok := node[c.key]; ok {
+			expr += "." + c.method + "(" + literal(value) + ")"
+		}
+	}
+	return expr
+}
+
+// zodArray, dizi düğümünü eleman şeması ve kısıtlarıyla yazar.
+func zodArray(node map[string]any, depth int) string {
+	item := "z.unknown()"
+	if items, ok := node["items"].(map[string]any); ok {
+		item = zodType(items, depth)
+	}
+	expr := "z.array(" + item + ")"
+	if value, ok := node["minItems"]; ok {
+		expr += ".min(" + literal(value) + ")"
+	}
+	if value, ok := node["maxItems"]; ok {
+		expr += ".max(" + literal(value) + ")"
+	}
+	if node["uniqueItems"] == true {
+		expr += `.refine((items) => new Set(items.map((item) => JSON.stringify(item))).size === items.length, { message: "items must be unique" })`
+	}
+	return expr
+}
+
+// zodRecord, properties içermeyen nesneyi anahtar (propertyNames) ve değer
+// (additionalProperties) şemalarıyla z.record olarak yazar.
+func zodRecord(node map[string]any, depth int) string {
+	key, value := "z.string()", "z.unknown()"
+	if keys, ok := node["propertyNames"].(map[string]any); ok {
+		key = zodString(keys)
+	}
+	if values, ok := node["additionalProperties"].(map[string]any); ok {
+		value = zodType(values, depth)
+	}
+	return "z.record(" + key + ", " + value + ")"
+}
+
+// zodObject, properties/required içeren düğümü z.object olarak yazar.
+func zodObject(node map[string]any, depth int) string {
+	properties, _ := node["properties"].(map[string]any)
+	required := stringList(node["required"])
+	pad := strings.Repeat("  ", depth+1)
+
+	var b strings.Builder
+	b.WriteString("z.object({\n")
+	for _, name := range sortedNames(properties) {
+		field := object(properties[name])
+		expr := zodType(field, depth+1)
+		if value, ok := field["default"]; ok {
+			expr += ".default(" + literal(value) + ")"
+		} else if !slices.Contains(required, name) {
+			expr += ".optional()"
+		}
+		b.WriteString(pad + propertyName(name) + ": " + expr + ",\n")
+	}
+	b.WriteString(strings.Repeat("  ", depth) + "})")
+	return b.String()
+}
+
+// zodRefinements, kök şemadaki When(...) zorunluluklarını ve RequireAnyOf
+// gruplarını superRefine olarak yazar; yoksa boş string döner.
+func zodRefinements(doc map[string]any) string {
+	var checks []string
+	for _, branch := range list(doc["allOf"]) {
+		branch := object(branch)
+		if anyOf := list(branch["anyOf"]); len(anyOf) > 0 {
+			var fields []string
+			for _, option := range anyOf {
+				fields = append(fields, stringList(object(option)["required"])...)
+			}
+			checks = append(checks, fmt.Sprintf(
+				"    if (%s.every((key) => fields[key] === undefined)) {\n"+
+					"      ctx.addIssue({ code: \"custom\", path: [], message: %s });\n"+
+					"    }\n",
+				literal(fields), literal("one of "+strings.Join(fields, ", ")+" is required")))
+			continue
+		}
+
+		when := object(object(branch["if"])["properties"])
+		then := stringList(object(branch["then"])["required"])
+		for _, field := range sortedNames(when) {
+			if len(then) == 0 {
+				continue
+			}
+			checks = append(checks, fmt.Sprintf(
+				"    if (fields[%s] === %s) {\n"+
+					"      for (const key of %s) {\n"+
+					"        if (fields[key] === undefined) {\n"+
+					"          ctx.addIssue({ code: \"custom\", path: [key], message: \"Required\" });\n"+
+					"        }\n"+
+					"      }\n"+
+					"    }\n",
+				literal(field), literal(object(when[field])["const"]), literal(then)))
+		}
+	}
+	if len(checks) == 0 {
+		return ""
+	}
+	return "\n  .superRefine((data, ctx) => {\n" +
+		"    const fields = data as Record<string, unknown>;\n" +
+		strings.Join(checks, "") +
+		"  })"
+}
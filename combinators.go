@@ -0,0 +1,194 @@
+package validation
+
+import (
+	"context"
+
+	"github.com/biyonik/go-fluent-validator/core"
+	"github.com/biyonik/go-fluent-validator/i18n"
+)
+
+//
+// -----------------------------------------------------------------------------
+// Şema Birleşimleri (AllOf / AnyOf / OneOf / Not)
+// -----------------------------------------------------------------------------
+// JSON Schema'daki allOf, anyOf, oneOf ve not anahtar kelimelerinin şema
+// seviyesindeki karşılıklarıdır. İçe aktarılan JSON Schema'lar ve karmaşık
+// politikalar elle tek bir şemaya indirgenmeden ifade edilebilir:
+//
+//	policy := validation.AllOf(
+//	    identitySchema,
+//	    validation.AnyOf(cardSchema, ibanSchema),
+//	    validation.Not(blockedCountrySchema),
+//	)
+//
+//   - AllOf: verinin tüm alt şemalara uyması gerekir; alt şemaların hataları
+//     birleştirilir.
+//   - AnyOf: en az bir alt şemaya uyması yeterlidir. Hiçbiri uymazsa _payload
+//     alanına any_of hatası ve en az hata üreten dalın hataları yazılır.
+//   - OneOf: tam olarak bir alt şemaya uyması gerekir. Hiçbiri uymazsa AnyOf
+//     gibi, birden fazlası uyarsa yalnızca one_of_schema hatası yazılır.
+//   - Not: alt şemaya uymaması gerekir; uyarsa _payload alanına not hatası
+//     yazılır.
+//
+// Alt şemalar When(...) dallarında olduğu gibi ham veriyle çalıştırılır;
+// başarılı dalların ValidData çıktısı sonuç verisine eklenir (Not dalı hariç).
+// Dönen şemaya Shape(...) ile ortak alanlar eklenebilir.
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// Birleşim türleri (Describe çıktısındaki adlarıyla).
+const (
+	compositionAllOf = "all_of"
+	compositionAnyOf = "any_of"
+	compositionOneOf = "one_of"
+	compositionNot   = "not"
+)
+
+// composition, AllOf/AnyOf/OneOf/Not ile eklenen bir şema birleşimidir.
+type composition struct {
+	kind    string
+	schemas []core.Schema
+}
+
+// AllOf
+// -----------------------------------------------------------------------------
+// Verinin schemas içindeki tüm şemalara uymasını gerektiren bir şema
+// oluşturur.
+//
+// Örnek:
+//
+//	schema := validation.AllOf(addressSchema, contactSchema)
+func AllOf(schemas ...core.Schema) *ValidationSchema {
+	return compose(compositionAllOf, schemas)
+}
+
+// AnyOf
+// -----------------------------------------------------------------------------
+// Verinin schemas içindeki şemalardan en az birine uymasını gerektiren bir
+// şema oluşturur. İlk uyan dalın ValidData çıktısı kullanılır.
+//
+// Örnek:
+//
+//	schema := validation.AnyOf(cardSchema, ibanSchema)
+func AnyOf(schemas ...core.Schema) *ValidationSchema {
+	return compose(compositionAnyOf, schemas)
+}
+
+// OneOf
+// -----------------------------------------------------------------------------
+// Verinin schemas içindeki şemalardan tam olarak birine uymasını gerektiren
+// bir şema oluşturur. Alan seviyesindeki izinli değer listesi için
+// String().OneOf kullanılır.
+//
+// Örnek:
+//
+//	schema := validation.OneOf(personSchema, companySchema)
+func OneOf(schemas ...core.Schema) *ValidationSchema {
+	return compose(compositionOneOf, schemas)
+}
+
+// Not
+// -----------------------------------------------------------------------------
+// Verinin schema'ya uymamasını gerektiren bir şema oluşturur.
+//
+// Örnek:
+//
+//	schema := validation.Not(validation.Make().Shape(map[string]validation.Type{
+//	    "country": validation.String().OneOf([]string{"XX", "YY"}).Required(),
+//	}))
+func Not(schema core.Schema) *ValidationSchema {
+	return compose(compositionNot, []core.Schema{schema})
+}
+
+// compose, tek birleşimli yeni bir şema oluşturur.
+func compose(kind string, schemas []core.Schema) *ValidationSchema {
+	vs := Make()
+	vs.compositions = append(vs.compositions, composition{kind: kind, schemas: schemas})
+	return vs
+}
+
+// validateCompositions, şemanın birleşimlerini sırayla çalıştırır.
+func (vs *ValidationSchema) validateCompositions(ctx context.Context, data, transformedData map[string]any, result *core.ValidationResult) {
+	for _, c := range vs.compositions {
+		switch c.kind {
+		case compositionAllOf:
+			for _, schema := range c.schemas {
+				mergeBranch(core.ValidateContext(ctx, schema, data), transformedData, result)
+			}
+
+		case compositionAnyOf, compositionOneOf:
+			validateAlternatives(ctx, c, data, transformedData, result)
+
+		case compositionNot:
+			if !core.ValidateContext(ctx, c.schemas[0], data).HasErrors() {
+				result.AddRuleError(payloadField, i18n.KeyNot)
+			}
+		}
+	}
+}
+
+// validateAlternatives, AnyOf/OneOf dallarını çalıştırır. İlk uyan dalın
+// verisi kullanılır; hiçbiri uymazsa en az hata üreten dalın hataları eklenir.
+func validateAlternatives(ctx context.Context, c composition, data, transformedData map[string]any, result *core.ValidationResult) {
+	key := i18n.KeyAnyOf
+	if c.kind == compositionOneOf {
+		key = i18n.KeyOneOfSchema
+	}
+
+	var matched, closest *core.ValidationResult
+	matches := 0
+	for _, schema := range c.schemas {
+		subResult := core.ValidateContext(ctx, schema, data)
+		if !subResult.HasErrors() {
+			if matches++; matched == nil {
+				matched = subResult
+			}
+			if c.kind == compositionAnyOf {
+				break
+			}
+			continue
+		}
+		if closest == nil || len(subResult.Failures()) < len(closest.Failures()) {
+			closest = subResult
+		}
+	}
+
+	if matches == 1 || (matches > 1 && c.kind == compositionAnyOf) {
+		mergeBranch(matched, transformedData, result)
+		return
+	}
+	result.AddRuleError(payloadField, key, len(c.schemas))
+	if matches == 0 && closest != nil {
+		result.Merge(closest)
+	}
+}
+
+// mergeBranch, başarılı bir dalın ValidData çıktısını sonuç verisine ekler;
+// dal hatalıysa hatalarını result'a aktarır.
+func mergeBranch(subResult *core.ValidationResult, transformedData map[string]any, result *core.ValidationResult) {
+	if subResult.HasErrors() {
+		result.Merge(subResult)
+		return
+	}
+	for k, v := range subResult.ValidData() {
+		transformedData[k] = v
+	}
+}
+
+// describeCompositions, birleşimlerin yapısal tanımlarını döndürür.
+func (vs *ValidationSchema) describeCompositions(stack []string) []core.CompositionDescription {
+	var out []core.CompositionDescription
+	for _, c := range vs.compositions {
+		desc := core.CompositionDescription{Kind: c.kind}
+		for _, schema := range c.schemas {
+			desc.Schemas = append(desc.Schemas, describeSchema(schema, stack))
+		}
+		out = append(out, desc)
+	}
+	return out
+}
@@ -0,0 +1,237 @@
+package validation
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/biyonik/go-fluent-validator/core"
+)
+
+//
+// -----------------------------------------------------------------------------
+// Konfigürasyondan Şema Oluşturma
+// -----------------------------------------------------------------------------
+// Dinamik form oluşturucular ve yönetim panelinden ayarlanabilen doğrulama
+// için, şemaların derleme gerektirmeden YAML veya JSON bildirimlerinden
+// kurulmasını sağlar:
+//
+//	email:
+//	  type: string
+//	  label: E-posta
+//	  rules: [required, email, "max:100"]
+//	age: {type: integer, rules: ["min:18"]}
+//	address:
+//	  type: object
+//	  fields:
+//	    city: {type: string, rules: [required]}
+//	tags:
+//	  type: array
+//	  rules: ["max:5"]
+//	  elements: {type: string, rules: ["min:2"]}
+//
+// Kurallar struct etiketleriyle aynı söz dizimini kullanır (bkz. Struct):
+// "ad" veya "ad:parametre"; one_of için "one_of:a,b,c", between için
+// "between:1,10". "required" ve "trim" gibi dönüşümler de kural listesinde
+// yazılabilir. Her alan şu anahtarları alabilir: type (zorunlu; Describe()
+// çıktısındaki tip adları ve kısaca "integer"), rules, required, label,
+// description, default, examples, fields (object), elements (array), keys ve
+// values (map).
+// Bilinmeyen anahtarlar hata olarak raporlanır.
+//
+// Bildirim FromDescription ile derlenir; bilinmeyen tip veya kural adları
+// şema yüklenirken hata döndürür.
+//
+// YAML desteği kütüphane harici bağımlılık kullanmadığı için bir alt kümeyle
+// sınırlıdır: blok map/listeler, satır içi [..] ve {..} koleksiyonları,
+// tırnaklı/tırnaksız skalerler ve # yorumları desteklenir; çok satırlı
+// skalerler (|, >), çapa/takma adlar (&, *) ve etiketler (!) desteklenmez.
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// configFieldKeys, alan bildiriminde kabul edilen anahtarlardır.
+var configFieldKeys = map[string]bool{
+	"type": true, "rules": true, "required": true, "label": true, "description": true,
+	"default": true, "examples": true, "fields": true, "elements": true,
+	"keys": true, "values": true,
+}
+
+// FromConfig
+// -----------------------------------------------------------------------------
+// data'daki YAML veya JSON alan bildirimlerinden bir ValidationSchema kurar.
+// "{" ile başlayan belgeler önce JSON olarak okunur; diğerleri YAML alt
+// kümesiyle çözülür.
+//
+// Örnek:
+//
+//	schema, err := validation.FromConfig([]byte(`
+//	email: {type: string, rules: [required, email, "max:100"]}
+//	`))
+func FromConfig(data []byte, opts ...SchemaOption) (*ValidationSchema, error) {
+	doc, err := decodeConfig(data)
+	if err != nil {
+		return nil, err
+	}
+	root, ok := doc.(map[string]any)
+	if !ok && doc != nil {
+		return nil, errors.New("validation.FromConfig: alan adı → bildirim map'i bekleniyordu")
+	}
+
+	fields, err := configFields("", root)
+	if err != nil {
+		return nil, fmt.Errorf("validation.FromConfig: %w", err)
+	}
+	return FromDescription(&core.SchemaDescription{Fields: fields}, opts...)
+}
+
+// decodeConfig, belgeyi JSON veya YAML olarak çözer.
+func decodeConfig(data []byte) (any, error) {
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '{' {
+		var doc any
+		if err := json.Unmarshal(trimmed, &doc); err == nil {
+			return doc, nil
+		}
+	}
+	return decodeYAML(data)
+}
+
+// configFields, alan adı → bildirim map'ini tip tanımlarına çevirir.
+func configFields(path string, spec map[string]any) (map[string]*core.TypeDescription, error) {
+	fields := make(map[string]*core.TypeDescription, len(spec))
+	for _, name := range sortedKeys(spec) {
+		desc, err := configType(path+name, spec[name])
+		if err != nil {
+			return nil, err
+		}
+		fields[name] = desc
+	}
+	return fields, nil
+}
+
+// configType, tek bir alan bildirimini tip tanımına çevirir. Bildirim
+// yalnızca tip adı olan bir string de olabilir ("name: string").
+func configType(path string, raw any) (*core.TypeDescription, error) {
+	spec, ok := raw.(map[string]any)
+	if !ok {
+		typeName, isName := raw.(string)
+		if !isName {
+			return nil, fmt.Errorf("%s: alan bildirimi map veya tip adı olmalı", path)
+		}
+		spec = map[string]any{"type": typeName}
+	}
+	for _, key := range sortedKeys(spec) {
+		if !configFieldKeys[key] {
+			return nil, fmt.Errorf("%s: bilinmeyen anahtar %q", path, key)
+		}
+	}
+
+	typeName, _ := spec["type"].(string)
+	if typeName == "" {
+		return nil, fmt.Errorf("%s: type belirtilmeli", path)
+	}
+	desc := &core.TypeDescription{Type: typeName}
+	if typeName == "integer" {
+		desc.Type = "number"
+		desc.AddRule("integer", nil)
+	}
+
+	if required, ok := spec["required"].(bool); ok {
+		desc.Required = required
+	}
+	desc.Label, _ = spec["label"].(string)
+	desc.Description, _ = spec["description"].(string)
+	desc.Default = spec["default"]
+	if examples, ok := spec["examples"].([]any); ok {
+		desc.Examples = examples
+	}
+
+	rules, err := configRules(spec["rules"])
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	for _, rule := range rules {
+		if err := applyStructRule(desc, rule); err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+	}
+
+	if raw, ok := spec["fields"]; ok {
+		fields, isMap := raw.(map[string]any)
+		if !isMap && raw != nil {
+			return nil, fmt.Errorf("%s: fields bir map olmalı", path)
+		}
+		if desc.Fields, err = configFields(path+".", fields); err != nil {
+			return nil, err
+		}
+	}
+	if raw, ok := spec["elements"]; ok {
+		if desc.Elements, err = configType(path+"[]", raw); err != nil {
+			return nil, err
+		}
+	}
+	if raw, ok := spec["keys"]; ok {
+		if desc.Keys, err = configType(path+"{}", raw); err != nil {
+			return nil, err
+		}
+	}
+	if raw, ok := spec["values"]; ok {
+		if desc.Values, err = configType(path+".*", raw); err != nil {
+			return nil, err
+		}
+	}
+	return desc, nil
+}
+
+// configRules, rules değerini kural dizgelerine çevirir. Liste yerine
+// "required|email" biçiminde tek bir dizge de kabul edilir.
+func configRules(raw any) ([]string, error) {
+	switch v := raw.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return strings.Split(v, "|"), nil
+	case []any:
+		rules := make([]string, 0, len(v))
+		for _, item := range v {
+			switch item := item.(type) {
+			case string:
+				rules = append(rules, item)
+			case map[string]any:
+				// YAML'da tırnaksız "max: 100" tek anahtarlı map olarak okunur.
+				if len(item) != 1 {
+					return nil, fmt.Errorf("geçersiz kural %v", item)
+				}
+				for name, arg := range item {
+					rules = append(rules, name+":"+configScalar(arg))
+				}
+			default:
+				return nil, fmt.Errorf("geçersiz kural %v", item)
+			}
+		}
+		return rules, nil
+	}
+	return nil, errors.New("rules bir liste olmalı")
+}
+
+// configScalar, kural parametresi olarak yazılmış skaleri dizgeye çevirir.
+// Listeler virgülle birleştirilir ("one_of: [a, b]").
+func configScalar(v any) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case []any:
+		parts := make([]string, len(v))
+		for i, item := range v {
+			parts[i] = configScalar(item)
+		}
+		return strings.Join(parts, ",")
+	}
+	return fmt.Sprint(v)
+}
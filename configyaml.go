@@ -0,0 +1,428 @@
+package validation
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+//
+// -----------------------------------------------------------------------------
+// YAML Alt Kümesi
+// -----------------------------------------------------------------------------
+// FromConfig bildirimleri için harici bağımlılık gerektirmeyen küçük bir YAML
+// çözücüsü. Girintiye dayalı blok map ve listeler, satır içi [..] ve {..}
+// koleksiyonları, tek/çift tırnaklı ve tırnaksız skalerler ile # yorumları
+// desteklenir. Tırnaksız skalerler null/~ → nil, true/false → bool, sayılar →
+// float64, diğerleri → string olarak çözülür (encoding/json ile aynı tipler).
+//
+// Çok satırlı skalerler (|, >), çapa/takma adlar (&, *), etiketler (!), sekme
+// ile girinti ve birden fazla satıra yayılan satır içi koleksiyonlar
+// desteklenmez; bunlar satır numarasıyla birlikte hata olarak raporlanır.
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// yamlLine, yorumlardan arındırılmış boş olmayan bir satırdır.
+type yamlLine struct {
+	indent int
+	text   string
+	num    int
+}
+
+// yamlParser, blok yapıyı satır satır çözer.
+type yamlParser struct {
+	lines []yamlLine
+	pos   int
+}
+
+// decodeYAML, data'daki YAML alt kümesini map[string]any, []any ve skalerlere
+// çözer. Boş belge nil döndürür.
+func decodeYAML(data []byte) (any, error) {
+	p := &yamlParser{}
+	for i, raw := range strings.Split(string(data), "\n") {
+		raw = strings.TrimRight(yamlStripComment(strings.TrimSuffix(raw, "\r")), " \t")
+		text := strings.TrimLeft(raw, " ")
+		if text == "" || text == "---" {
+			continue
+		}
+		if text[0] == '\t' {
+			return nil, yamlError(i+1, "girinti için sekme kullanılamaz")
+		}
+		p.lines = append(p.lines, yamlLine{indent: len(raw) - len(text), text: text, num: i + 1})
+	}
+	if len(p.lines) == 0 {
+		return nil, nil
+	}
+
+	doc, err := p.node(p.lines[0].indent)
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.lines) {
+		return nil, yamlError(p.lines[p.pos].num, "beklenmeyen girinti")
+	}
+	return doc, nil
+}
+
+// node, indent girintisindeki satırdan başlayan map, liste veya skaleri çözer.
+func (p *yamlParser) node(indent int) (any, error) {
+	line := p.lines[p.pos]
+	if yamlIsItem(line.text) {
+		return p.sequence(indent)
+	}
+	if _, _, ok := yamlSplitKey(line.text); ok {
+		return p.mapping(indent)
+	}
+	p.pos++
+	return yamlInline(line.text, line.num)
+}
+
+// mapping, aynı girintideki "anahtar: değer" satırlarını map olarak çözer.
+func (p *yamlParser) mapping(indent int) (map[string]any, error) {
+	m := make(map[string]any)
+	for p.pos < len(p.lines) && p.lines[p.pos].indent == indent {
+		line := p.lines[p.pos]
+		key, rest, ok := yamlSplitKey(line.text)
+		if !ok {
+			return nil, yamlError(line.num, "\"anahtar: değer\" bekleniyordu")
+		}
+		if _, dup := m[key]; dup {
+			return nil, yamlError(line.num, fmt.Sprintf("%q anahtarı tekrarlandı", key))
+		}
+		p.pos++
+
+		value, err := p.value(indent, rest, line.num)
+		if err != nil {
+			return nil, err
+		}
+		m[key] = value
+	}
+	return m, nil
+}
+
+// sequence, aynı girintideki "- öğe" satırlarını liste olarak çözer.
+func (p *yamlParser) sequence(indent int) ([]any, error) {
+	items := []any{}
+	for p.pos < len(p.lines) && p.lines[p.pos].indent == indent && yamlIsItem(p.lines[p.pos].text) {
+		line := p.lines[p.pos]
+		rest := strings.TrimLeft(line.text[1:], " ")
+
+		var item any
+		var err error
+		switch _, _, isKey := yamlSplitKey(rest); {
+		case rest == "":
+			p.pos++
+			item, err = p.value(indent, "", line.num)
+		case isKey || yamlIsItem(rest):
+			// "- ad: x" veya "- - x": öğe, tire sonrasındaki sütunda
+			// başlayan bir blok olarak çözülür.
+			itemIndent := indent + len(line.text) - len(rest)
+			p.lines[p.pos] = yamlLine{indent: itemIndent, text: rest, num: line.num}
+			item, err = p.node(itemIndent)
+		default:
+			p.pos++
+			item, err = yamlInline(rest, line.num)
+		}
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// value, bir anahtarın veya tiresiz öğenin değerini çözer. Satır içi değer
+// yoksa değer, daha derin girintili bloktan (veya map anahtarı için aynı
+// girintideki listeden) okunur; o da yoksa nil'dir.
+func (p *yamlParser) value(indent int, rest string, num int) (any, error) {
+	if rest != "" {
+		return yamlInline(rest, num)
+	}
+	if p.pos < len(p.lines) {
+		next := p.lines[p.pos]
+		if next.indent > indent {
+			return p.node(next.indent)
+		}
+		if next.indent == indent && yamlIsItem(next.text) {
+			return p.sequence(indent)
+		}
+	}
+	return nil, nil
+}
+
+// yamlIsItem, satırın bir liste öğesi olup olmadığını döndürür.
+func yamlIsItem(text string) bool {
+	return text == "-" || strings.HasPrefix(text, "- ")
+}
+
+// yamlSplitKey, "anahtar: değer" satırını böler. Anahtar tırnaklı olabilir;
+// ayırıcı, ardından boşluk veya satır sonu gelen ilk ':' karakteridir.
+func yamlSplitKey(text string) (key, rest string, ok bool) {
+	if text == "" || text[0] == '[' || text[0] == '{' || yamlIsItem(text) {
+		return "", "", false
+	}
+
+	if text[0] == '"' || text[0] == '\'' {
+		end := yamlQuoteEnd(text, 0)
+		if end < 0 {
+			return "", "", false
+		}
+		unquoted, err := yamlUnquote(text[:end+1])
+		if err != nil {
+			return "", "", false
+		}
+		after := strings.TrimLeft(text[end+1:], " ")
+		if after == "" || after[0] != ':' || (len(after) > 1 && after[1] != ' ') {
+			return "", "", false
+		}
+		return unquoted, strings.TrimSpace(after[1:]), true
+	}
+	colon := -1
+	for i := 0; i < len(text); i++ {
+		if text[i] == ':' && (i == len(text)-1 || text[i+1] == ' ') {
+			colon = i
+			break
+		}
+	}
+	if colon <= 0 {
+		return "", "", false
+	}
+	return strings.TrimSpace(text[:colon]), strings.TrimSpace(text[colon+1:]), true
+}
+
+// yamlStripComment, tırnak dışındaki # yorumunu satırdan çıkarır.
+func yamlStripComment(line string) string {
+	for i := 0; i < len(line); i++ {
+		switch line[i] {
+		case '"', '\'':
+			if end := yamlQuoteEnd(line, i); end >= 0 {
+				i = end
+			}
+		case '#':
+			if i == 0 || line[i-1] == ' ' || line[i-1] == '\t' {
+				return line[:i]
+			}
+		}
+	}
+	return line
+}
+
+// yamlQuoteEnd, start'taki tırnağı kapatan tırnağın konumunu döndürür;
+// kapanmıyorsa -1 döner. Çift tırnakta ters bölü kaçışları, tek tırnakta
+// iki kez yazılan tırnak atlanır.
+func yamlQuoteEnd(s string, start int) int {
+	quote := s[start]
+	for i := start + 1; i < len(s); i++ {
+		switch {
+		case quote == '"' && s[i] == '\\':
+			i++
+		case s[i] == quote && quote == '\'' && i+1 < len(s) && s[i+1] == '\'':
+			i++
+		case s[i] == quote:
+			return i
+		}
+	}
+	return -1
+}
+
+// yamlUnquote, tırnaklı bir skaleri çözer.
+func yamlUnquote(s string) (string, error) {
+	if s[0] == '\'' {
+		return strings.ReplaceAll(s[1:len(s)-1], "''", "'"), nil
+	}
+	return strconv.Unquote(s)
+}
+
+// yamlError, satır numaralı bir çözümleme hatası döndürür.
+func yamlError(num int, msg string) error {
+	return fmt.Errorf("validation.FromConfig: YAML satır %d: %s", num, msg)
+}
+
+// yamlFlow, satır içi bir değeri ([..], {..} veya skaler) çözer.
+type yamlFlow struct {
+	s   string
+	i   int
+	num int
+}
+
+// yamlInline, satırdaki değerin tamamını çözer.
+func yamlInline(text string, num int) (any, error) {
+	if strings.ContainsRune("|>&*!", rune(text[0])) {
+		return nil, yamlError(num, fmt.Sprintf("desteklenmeyen YAML söz dizimi %q", text[:1]))
+	}
+	if text[0] != '[' && text[0] != '{' && text[0] != '"' && text[0] != '\'' {
+		return yamlResolve(text), nil
+	}
+
+	f := &yamlFlow{s: text, num: num}
+	value, err := f.value()
+	if err != nil {
+		return nil, err
+	}
+	f.skipSpace()
+	if f.i < len(f.s) {
+		return nil, yamlError(num, fmt.Sprintf("beklenmeyen %q", f.s[f.i:]))
+	}
+	return value, nil
+}
+
+// value, geçerli konumdaki satır içi değeri çözer.
+func (f *yamlFlow) value() (any, error) {
+	f.skipSpace()
+	if f.i >= len(f.s) {
+		return nil, yamlError(f.num, "değer bekleniyordu")
+	}
+	switch c := f.s[f.i]; {
+	case c == '[':
+		return f.sequence()
+	case c == '{':
+		return f.mapping()
+	case strings.IndexByte("|>&*!", c) >= 0:
+		return nil, yamlError(f.num, fmt.Sprintf("desteklenmeyen YAML söz dizimi %q", string(c)))
+	}
+	raw, quoted, err := f.scalar()
+	if err != nil || quoted {
+		return raw, err
+	}
+	return yamlResolve(raw), nil
+}
+
+// sequence, [a, b, ...] koleksiyonunu çözer.
+func (f *yamlFlow) sequence() ([]any, error) {
+	f.i++
+	items := []any{}
+	for {
+		f.skipSpace()
+		if f.i < len(f.s) && f.s[f.i] == ']' {
+			f.i++
+			return items, nil
+		}
+		item, err := f.value()
+		if err != nil {
+			return nil, err
+		}
+		if f.skipSpace(); f.i < len(f.s) && f.s[f.i] == ':' {
+			// [max: 2] gibi tek çiftli satır içi map.
+			f.i++
+			value, err := f.value()
+			if err != nil {
+				return nil, err
+			}
+			item = map[string]any{fmt.Sprint(item): value}
+		}
+		items = append(items, item)
+		if err := f.separator(']'); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// mapping, {a: b, ...} koleksiyonunu çözer.
+func (f *yamlFlow) mapping() (map[string]any, error) {
+	f.i++
+	m := make(map[string]any)
+	for {
+		f.skipSpace()
+		if f.i < len(f.s) && f.s[f.i] == '}' {
+			f.i++
+			return m, nil
+		}
+		key, _, err := f.scalar()
+		if err != nil {
+			return nil, err
+		}
+		f.skipSpace()
+		if f.i >= len(f.s) || f.s[f.i] != ':' {
+			return nil, yamlError(f.num, fmt.Sprintf("%q anahtarından sonra ':' bekleniyordu", key))
+		}
+		f.i++
+		if _, dup := m[key]; dup {
+			return nil, yamlError(f.num, fmt.Sprintf("%q anahtarı tekrarlandı", key))
+		}
+		if m[key], err = f.value(); err != nil {
+			return nil, err
+		}
+		if err := f.separator('}'); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// separator, öğeden sonraki ',' karakterini atlar; kapanış karakterini
+// tüketmeden bırakır.
+func (f *yamlFlow) separator(closing byte) error {
+	f.skipSpace()
+	if f.i >= len(f.s) {
+		return yamlError(f.num, fmt.Sprintf("kapanmamış koleksiyon, %q bekleniyordu", string(closing)))
+	}
+	switch f.s[f.i] {
+	case ',':
+		f.i++
+		return nil
+	case closing:
+		return nil
+	}
+	return yamlError(f.num, fmt.Sprintf("beklenmeyen %q", string(f.s[f.i])))
+}
+
+// scalar, tırnaklı veya tırnaksız bir skalerin metnini döndürür. Tırnaksız
+// skaler ',', ']', '}' ya da ardından boşluk veya bu karakterlerden biri gelen
+// ':' ile biter; böylece "max:100" tek bir skaler olarak okunur.
+func (f *yamlFlow) scalar() (string, bool, error) {
+	f.skipSpace()
+	if f.i < len(f.s) && (f.s[f.i] == '"' || f.s[f.i] == '\'') {
+		end := yamlQuoteEnd(f.s, f.i)
+		if end < 0 {
+			return "", false, yamlError(f.num, "kapanmamış tırnak")
+		}
+		text, err := yamlUnquote(f.s[f.i : end+1])
+		if err != nil {
+			return "", false, yamlError(f.num, "geçersiz tırnaklı dizge "+f.s[f.i:end+1])
+		}
+		f.i = end + 1
+		return text, true, nil
+	}
+
+	start := f.i
+	for ; f.i < len(f.s); f.i++ {
+		c := f.s[f.i]
+		if c == ',' || c == ']' || c == '}' {
+			break
+		}
+		if c == ':' && (f.i+1 == len(f.s) || strings.IndexByte(" ,]}", f.s[f.i+1]) >= 0) {
+			break
+		}
+	}
+	return strings.TrimSpace(f.s[start:f.i]), false, nil
+}
+
+// skipSpace, boşlukları atlar.
+func (f *yamlFlow) skipSpace() {
+	for f.i < len(f.s) && f.s[f.i] == ' ' {
+		f.i++
+	}
+}
+
+// yamlResolve, tırnaksız bir skaleri null, bool, sayı veya string olarak çözer.
+func yamlResolve(text string) any {
+	switch text {
+	case "", "~", "null", "Null", "NULL":
+		return nil
+	case "true", "True", "TRUE":
+		return true
+	case "false", "False", "FALSE":
+		return false
+	}
+	digits := strings.TrimLeft(text, "+-")
+	if digits != "" && (digits[0] >= '0' && digits[0] <= '9' || digits[0] == '.') && !strings.ContainsAny(text, "_xXoObB") {
+		if n, err := strconv.ParseFloat(text, 64); err == nil {
+			return n
+		}
+	}
+	return text
+}
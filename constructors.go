@@ -1,6 +1,10 @@
 package validation
 
-import "github.com/biyonik/go-fluent-validator/types"
+import (
+	"github.com/biyonik/go-fluent-validator/core"
+	"github.com/biyonik/go-fluent-validator/rules"
+	"github.com/biyonik/go-fluent-validator/types"
+)
 
 //
 // -----------------------------------------------------------------------------
@@ -23,90 +27,283 @@ import "github.com/biyonik/go-fluent-validator/types"
 // -----------------------------------------------------------------------------
 // Yeni bir StringType nesnesi oluşturur.
 //
+// Parametreler:
+//   - opts: WithRequired gibi seçenekler (bkz. StringOption)
+//
 // Dönüş:
 //   - *types.StringType → string tipli doğrulama nesnesi
-func String() *types.StringType {
-	return &types.StringType{}
+func String(opts ...StringOption) *types.StringType {
+	s := &types.StringType{}
+	for _, opt := range opts {
+		opt.applyString(s)
+	}
+	return s
 }
 
 // Number
 // -----------------------------------------------------------------------------
 // Yeni bir NumberType nesnesi oluşturur.
 //
+// Parametreler:
+//   - opts: WithRequired gibi seçenekler (bkz. NumberOption)
+//
 // Dönüş:
 //   - *types.NumberType → sayısal doğrulama nesnesi
-func Number() *types.NumberType {
-	return &types.NumberType{}
+func Number(opts ...NumberOption) *types.NumberType {
+	n := &types.NumberType{}
+	for _, opt := range opts {
+		opt.applyNumber(n)
+	}
+	return n
 }
 
 // Boolean
 // -----------------------------------------------------------------------------
 // Yeni bir BooleanType nesnesi oluşturur.
 //
+// Parametreler:
+//   - opts: WithRequired gibi seçenekler (bkz. BooleanOption)
+//
 // Dönüş:
 //   - *types.BooleanType → boolean doğrulama nesnesi
-func Boolean() *types.BooleanType {
-	return &types.BooleanType{}
+func Boolean(opts ...BooleanOption) *types.BooleanType {
+	b := &types.BooleanType{}
+	for _, opt := range opts {
+		opt.applyBoolean(b)
+	}
+	return b
 }
 
 // Array
 // -----------------------------------------------------------------------------
 // Yeni bir ArrayType nesnesi oluşturur.
 //
+// Parametreler:
+//   - opts: WithRequired gibi seçenekler (bkz. ArrayOption)
+//
 // Dönüş:
 //   - *types.ArrayType → dizi doğrulama nesnesi
-func Array() *types.ArrayType {
-	return &types.ArrayType{}
+func Array(opts ...ArrayOption) *types.ArrayType {
+	a := &types.ArrayType{}
+	for _, opt := range opts {
+		opt.applyArray(a)
+	}
+	return a
 }
 
 // Object
 // -----------------------------------------------------------------------------
 // Yeni bir ObjectType nesnesi oluşturur.
 //
+// Parametreler:
+//   - opts: WithRequired gibi seçenekler (bkz. ObjectOption)
+//
 // Dönüş:
 //   - *types.ObjectType → nesne doğrulama nesnesi
-func Object() *types.ObjectType {
-	return &types.ObjectType{}
+func Object(opts ...ObjectOption) *types.ObjectType {
+	o := &types.ObjectType{}
+	for _, opt := range opts {
+		opt.applyObject(o)
+	}
+	return o
+}
+
+// Map
+// -----------------------------------------------------------------------------
+// Anahtarları önceden bilinmeyen nesneler için yeni bir MapType nesnesi
+// oluşturur; anahtarlar Keys, değerler Values ile doğrulanır.
+//
+// Parametreler:
+//   - opts: WithRequired gibi seçenekler (bkz. MapOption)
+//
+// Dönüş:
+//   - *types.MapType → anahtar/değer doğrulama nesnesi
+func Map(opts ...MapOption) *types.MapType {
+	m := &types.MapType{}
+	for _, opt := range opts {
+		opt.applyMap(m)
+	}
+	return m
+}
+
+// Any
+// -----------------------------------------------------------------------------
+// Her değeri kabul eden yeni bir AnyType nesnesi oluşturur. Şemanın olduğu
+// gibi iletmesi gereken bölümler için kullanılır.
+//
+// Parametreler:
+//   - opts: WithRequired gibi seçenekler (bkz. AnyOption)
+//
+// Dönüş:
+//   - *types.AnyType → kısıtsız (passthrough) doğrulama nesnesi
+func Any(opts ...AnyOption) *types.AnyType {
+	a := &types.AnyType{}
+	for _, opt := range opts {
+		opt.applyAny(a)
+	}
+	return a
+}
+
+// Literal
+// -----------------------------------------------------------------------------
+// Değerin value sabitine eşit olmasını gerektiren yeni bir LiteralType nesnesi
+// oluşturur.
+//
+// Parametreler:
+//   - value: alanın eşit olması gereken sabit
+//   - opts: WithRequired gibi seçenekler (bkz. LiteralOption)
+//
+// Dönüş:
+//   - *types.LiteralType → sabit değer doğrulama nesnesi
+func Literal(value any, opts ...LiteralOption) *types.LiteralType {
+	l := (&types.LiteralType{}).Value(value)
+	for _, opt := range opts {
+		opt.applyLiteral(l)
+	}
+	return l
 }
 
 // Date
 // -----------------------------------------------------------------------------
 // Yeni bir DateType nesnesi oluşturur.
 //
+// Parametreler:
+//   - opts: WithRequired gibi seçenekler (bkz. DateOption)
+//
 // Dönüş:
 //   - *types.DateType → tarih doğrulama nesnesi
-func Date() *types.DateType {
-	return &types.DateType{}
+func Date(opts ...DateOption) *types.DateType {
+	d := &types.DateType{}
+	for _, opt := range opts {
+		opt.applyDate(d)
+	}
+	return d
+}
+
+// Email
+// -----------------------------------------------------------------------------
+// Yeni bir EmailType nesnesi oluşturur. Adres normalize edilir (alan adı küçük
+// harf) ve alan adı listeleriyle kısıtlanabilir.
+//
+// Parametreler:
+//   - opts: WithRequired gibi ortak seçenekler veya types.RejectFreeProviders,
+//     types.RejectRoleAccounts gibi e-postaya özel seçenekler (bkz. EmailOption)
+//
+// Dönüş:
+//   - *types.EmailType → e-posta doğrulama nesnesi
+func Email(opts ...EmailOption) *types.EmailType {
+	e := &types.EmailType{}
+	for _, opt := range opts {
+		opt.ApplyEmail(e)
+	}
+	return e
 }
 
 // Uuid
 // -----------------------------------------------------------------------------
 // Yeni bir UuidType nesnesi oluşturur.
 //
+// Parametreler:
+//   - opts: WithRequired gibi seçenekler (bkz. UuidOption)
+//
 // Dönüş:
 //   - *types.UuidType → UUID doğrulama nesnesi
-func Uuid() *types.UuidType {
-	return &types.UuidType{}
+func Uuid(opts ...UuidOption) *types.UuidType {
+	u := &types.UuidType{}
+	for _, opt := range opts {
+		opt.applyUuid(u)
+	}
+	return u
 }
 
 // Iban
 // -----------------------------------------------------------------------------
 // Yeni bir IbanType nesnesi oluşturur.
 //
+// Parametreler:
+//   - opts: WithRequired gibi seçenekler (bkz. IbanOption)
+//
 // Dönüş:
 //   - *types.IbanType → IBAN doğrulama nesnesi
-func Iban() *types.IbanType {
-	return &types.IbanType{}
+func Iban(opts ...IbanOption) *types.IbanType {
+	i := &types.IbanType{}
+	for _, opt := range opts {
+		opt.applyIban(i)
+	}
+	return i
 }
 
 // CreditCard
 // -----------------------------------------------------------------------------
 // Yeni bir CreditCardType nesnesi oluşturur.
 //
+// Parametreler:
+//   - opts: WithRequired gibi seçenekler (bkz. CreditCardOption)
+//
 // Dönüş:
 //   - *types.CreditCardType → kredi kartı doğrulama nesnesi
-func CreditCard() *types.CreditCardType {
-	return &types.CreditCardType{}
+func CreditCard(opts ...CreditCardOption) *types.CreditCardType {
+	c := &types.CreditCardType{}
+	for _, opt := range opts {
+		opt.applyCreditCard(c)
+	}
+	return c
+}
+
+// CSRFToken
+// -----------------------------------------------------------------------------
+// verify ile doğrulanan yeni bir CSRFTokenType nesnesi oluşturur. Token
+// ValidateCtx'in dış kaynaklı kontrol adımında doğrulanır.
+//
+// Dönüş:
+//   - *types.CSRFTokenType → CSRF token doğrulama nesnesi
+func CSRFToken(verify types.CSRFVerifier) *types.CSRFTokenType {
+	return (&types.CSRFTokenType{}).Verifier(verify)
+}
+
+// Captcha
+// -----------------------------------------------------------------------------
+// verify sağlayıcısıyla doğrulanan yeni bir CaptchaType nesnesi oluşturur.
+// Token ValidateCtx'in dış kaynaklı kontrol adımında doğrulanır.
+//
+// Dönüş:
+//   - *types.CaptchaType → captcha doğrulama nesnesi
+func Captcha(verify rules.CaptchaVerifier) *types.CaptchaType {
+	return (&types.CaptchaType{}).Verifier(verify)
+}
+
+// File
+// -----------------------------------------------------------------------------
+// Multipart formlarla yüklenen dosyaları (*multipart.FileHeader) doğrulayan
+// yeni bir FileType nesnesi oluşturur.
+//
+// Dönüş:
+//   - *types.FileType → dosya yükleme doğrulama nesnesi
+func File() *types.FileType {
+	return &types.FileType{}
+}
+
+// Image
+// -----------------------------------------------------------------------------
+// Yüklenen görselleri doğrulayan yeni bir ImageType nesnesi oluşturur. Dosya
+// kurallarına ek olarak biçim, piksel boyutları ve en-boy oranı denetlenir.
+//
+// Dönüş:
+//   - *types.ImageType → görsel doğrulama nesnesi
+func Image() *types.ImageType {
+	return &types.ImageType{}
+}
+
+// JSONPatch
+// -----------------------------------------------------------------------------
+// RFC 6902 JSON Patch dokümanlarını doğrulayan yeni bir JSONPatchType nesnesi
+// oluşturur. target verilirse her işlemin yolu ve değeri bu şemaya göre
+// denetlenir; nil ise yalnızca doküman yapısı doğrulanır.
+//
+// Dönüş:
+//   - *types.JSONPatchType → JSON Patch doğrulama nesnesi
+func JSONPatch(target core.Schema) *types.JSONPatchType {
+	return (&types.JSONPatchType{}).Target(target)
 }
 
 // AdvancedString
@@ -1,6 +1,8 @@
 package core
 
 import (
+	"time"
+
 	"github.com/biyonik/go-fluent-validator/i18n"
 )
 
@@ -35,6 +37,24 @@ type BaseType struct {
 	label           string
 	defaultValue    any
 	transformations []func(any) (any, error)
+	transformNames  []string
+	noTrim          bool
+	sensitive       bool
+	changeRules     []namedChangeRule
+	severity        Severity
+	description     string
+	examples        []any
+	deprecated      *string
+	optional        bool
+	nullable        bool
+}
+
+// namedChangeRule, BaseType içinde saklanan değişiklik kuralıdır. Hata
+// mesajlarında etiket kullanılabilmesi için alanın okunabilir adını da alır.
+type namedChangeRule struct {
+	name   string
+	params map[string]any
+	fn     func(fieldName string, oldValue, newValue any) error
 }
 
 // SetRequired
@@ -56,6 +76,113 @@ func (b *BaseType) SetLabel(label string) {
 	b.label = label
 }
 
+// SetSeverity
+// -----------------------------------------------------------------------------
+// Alanın kurallarının önem seviyesini belirler. SeverityError dışındaki
+// seviyelerdeki ihlaller, şemanın SeverityPolicy'sine göre hata yerine
+// uyarı/bilgi olarak raporlanabilir.
+func (b *BaseType) SetSeverity(severity Severity) {
+	b.severity = severity
+}
+
+// GetSeverity
+// -----------------------------------------------------------------------------
+// Alanın önem seviyesini döndürür. Tanımlanmamışsa SeverityError döner.
+func (b *BaseType) GetSeverity() Severity {
+	if b.severity == "" {
+		return SeverityError
+	}
+	return b.severity
+}
+
+// SetDescription
+// -----------------------------------------------------------------------------
+// Alan için dokümantasyon amaçlı bir açıklama tanımlar. Doğrulamayı
+// etkilemez; Describe() çıktısında ve şemadan üretilen dokümanlarda görünür.
+func (b *BaseType) SetDescription(text string) {
+	b.description = text
+}
+
+// AddExample
+// -----------------------------------------------------------------------------
+// Alan için dokümantasyonda gösterilecek örnek bir değer ekler. Birden fazla
+// kez çağrılarak birden çok örnek tanımlanabilir.
+func (b *BaseType) AddExample(value any) {
+	b.examples = append(b.examples, value)
+}
+
+// SetDeprecated
+// -----------------------------------------------------------------------------
+// Alanı kullanımdan kaldırılmış (deprecated) olarak işaretler. reason boş
+// bırakılabilir. Doğrulamayı etkilemez; API dokümanlarında ve istemci
+// kodu üretiminde alanın kaldırılacağı bilgisini taşır.
+func (b *BaseType) SetDeprecated(reason string) {
+	b.deprecated = &reason
+}
+
+// SetNoTrim
+// -----------------------------------------------------------------------------
+// Şema seviyesinde otomatik trim (WithAutoTrim) açık olsa bile bu alanın değerine
+// dokunulmamasını sağlar. Şifre gibi baştaki/sondaki boşlukların anlamlı olduğu
+// alanlar için kullanılır.
+func (b *BaseType) SetNoTrim() {
+	b.noTrim = true
+}
+
+// IsTrimDisabled
+// -----------------------------------------------------------------------------
+// Alanın otomatik trim işleminden muaf tutulup tutulmadığını döndürür.
+// Şema, otomatik trim uygulamadan önce bu bilgiyi kontrol eder.
+func (b *BaseType) IsTrimDisabled() bool {
+	return b.noTrim
+}
+
+// SetSensitive
+// -----------------------------------------------------------------------------
+// Alanın değerinin hassas olduğunu işaretler. Hassas alanlar, başarısız
+// doğrulama sonrası formu yeniden doldurmak için saklanan eski girdiye
+// (ValidationResult.OldInput) eklenmez.
+func (b *BaseType) SetSensitive() {
+	b.sensitive = true
+}
+
+// IsSensitive
+// -----------------------------------------------------------------------------
+// Alanın hassas olarak işaretlenip işaretlenmediğini döndürür.
+func (b *BaseType) IsSensitive() bool {
+	return b.sensitive
+}
+
+// SetOptional
+// -----------------------------------------------------------------------------
+// Alanın anahtarının payload'da hiç bulunmayabileceğini işaretler. Eksik
+// anahtar Required olsa bile geçerlidir (bkz. presence.go).
+func (b *BaseType) SetOptional() {
+	b.optional = true
+}
+
+// IsOptional
+// -----------------------------------------------------------------------------
+// Alanın eksik anahtara izin verip vermediğini döndürür.
+func (b *BaseType) IsOptional() bool {
+	return b.optional
+}
+
+// SetNullable
+// -----------------------------------------------------------------------------
+// Alanın açıkça null gönderilebileceğini işaretler. Null değer Required olsa
+// bile geçerlidir ve sonuç verisinde korunur (bkz. presence.go).
+func (b *BaseType) SetNullable() {
+	b.nullable = true
+}
+
+// IsNullable
+// -----------------------------------------------------------------------------
+// Alanın açık null değere izin verip vermediğini döndürür.
+func (b *BaseType) IsNullable() bool {
+	return b.nullable
+}
+
 // GetLabel
 // -----------------------------------------------------------------------------
 // Bu fonksiyon, alan için özel olarak atanmış bir etiket varsa onu döndürür,
@@ -85,7 +212,171 @@ func (b *BaseType) SetDefault(value any) {
 // Örnek: trim, lower-case, sayıya dönüştürme, format temizleme vb.
 // Her dönüşüm bir fonksiyon olarak eklenir ve Transform sırasında sırayla uygulanır.
 func (b *BaseType) AddTransform(fn func(any) (any, error)) {
+	b.AddNamedTransform("custom", fn)
+}
+
+// AddNamedTransform
+// -----------------------------------------------------------------------------
+// AddTransform ile aynı işi yapar; ek olarak dönüşüme bir isim verir. Bu isim
+// şema tanımlarında (Describe) dönüşümün ne yaptığını göstermek için kullanılır.
+// Örnek: "trim", "strip_tags".
+func (b *BaseType) AddNamedTransform(name string, fn func(any) (any, error)) {
 	b.transformations = append(b.transformations, fn)
+	b.transformNames = append(b.transformNames, name)
+}
+
+// AddChangeValidator
+// -----------------------------------------------------------------------------
+// Alanın eski ve yeni değerini karşılaştıran bir değişiklik kuralı ekler.
+// Bu kurallar yalnızca şemanın ValidateChanges metodu ile çalıştırılır; normal
+// Validate akışını etkilemez.
+func (b *BaseType) AddChangeValidator(fn ChangeValidator) {
+	b.changeRules = append(b.changeRules, namedChangeRule{
+		name: "on_change",
+		fn: func(_ string, oldValue, newValue any) error {
+			return fn(oldValue, newValue)
+		},
+	})
+}
+
+// AddNamedChangeValidator
+// -----------------------------------------------------------------------------
+// Tiplerin kendi yerleşik değişiklik kurallarını tanımlaması için kullanılır.
+// fn, hata mesajında kullanılabilmesi için alanın okunabilir adını da alır.
+// name ve params, şema tanımlarında (Describe) kuralı göstermek için kullanılır.
+func (b *BaseType) AddNamedChangeValidator(name string, params map[string]any, fn func(fieldName string, oldValue, newValue any) error) {
+	b.changeRules = append(b.changeRules, namedChangeRule{name: name, params: params, fn: fn})
+}
+
+// SetImmutable
+// -----------------------------------------------------------------------------
+// Alanın güncelleme sırasında değiştirilemeyeceğini işaretler. Eski ve yeni
+// değer ValuesEqual ile karşılaştırılır; farklıysa hata üretilir.
+func (b *BaseType) SetImmutable() {
+	b.changeRules = append(b.changeRules, namedChangeRule{
+		name: "immutable",
+		fn: func(fieldName string, oldValue, newValue any) error {
+			if !ValuesEqual(oldValue, newValue) {
+				return NewMessageError(i18n.KeyImmutable, fieldName)
+			}
+			return nil
+		},
+	})
+}
+
+// SetOnlyIncrease
+// -----------------------------------------------------------------------------
+// Sayısal veya tarih alanlarının güncelleme sırasında yalnızca artabileceğini
+// (veya aynı kalabileceğini) işaretler.
+func (b *BaseType) SetOnlyIncrease() {
+	b.changeRules = append(b.changeRules, namedChangeRule{
+		name: "only_increase",
+		fn: func(fieldName string, oldValue, newValue any) error {
+			if cmp, ok := compareOrdered(newValue, oldValue); ok && cmp < 0 {
+				return NewMessageError(i18n.KeyOnlyIncrease, fieldName, oldValue)
+			}
+			return nil
+		},
+	})
+}
+
+// SetOnlyDecrease
+// -----------------------------------------------------------------------------
+// Sayısal veya tarih alanlarının güncelleme sırasında yalnızca azalabileceğini
+// (veya aynı kalabileceğini) işaretler.
+func (b *BaseType) SetOnlyDecrease() {
+	b.changeRules = append(b.changeRules, namedChangeRule{
+		name: "only_decrease",
+		fn: func(fieldName string, oldValue, newValue any) error {
+			if cmp, ok := compareOrdered(newValue, oldValue); ok && cmp > 0 {
+				return NewMessageError(i18n.KeyOnlyDecrease, fieldName, oldValue)
+			}
+			return nil
+		},
+	})
+}
+
+// HasChangeValidators
+// -----------------------------------------------------------------------------
+// Alana en az bir değişiklik kuralı eklenip eklenmediğini döndürür.
+func (b *BaseType) HasChangeValidators() bool {
+	return len(b.changeRules) > 0
+}
+
+// ValidateChange
+// -----------------------------------------------------------------------------
+// Alana eklenmiş tüm değişiklik kurallarını eski ve yeni (dönüştürülmüş)
+// değerlerle çalıştırır ve hataları result'a ekler.
+func (b *BaseType) ValidateChange(field string, oldValue, newValue any, result *ValidationResult) {
+	fieldName := b.GetLabel(field)
+	for _, rule := range b.changeRules {
+		if err := rule.fn(fieldName, oldValue, newValue); err != nil {
+			result.AddErrorFrom(field, rule.name, err)
+		}
+	}
+}
+
+// compareOrdered, sayısal veya time.Time değerleri karşılaştırır.
+// a < b ise -1, eşitse 0, a > b ise 1 döner. Değerler karşılaştırılamıyorsa
+// ikinci dönüş değeri false olur.
+func compareOrdered(a, b any) (int, bool) {
+	if af, ok := ToFloat64(a); ok {
+		bf, ok := ToFloat64(b)
+		if !ok {
+			return 0, false
+		}
+		switch {
+		case af < bf:
+			return -1, true
+		case af > bf:
+			return 1, true
+		}
+		return 0, true
+	}
+	if at, ok := a.(time.Time); ok {
+		bt, ok := b.(time.Time)
+		if !ok {
+			return 0, false
+		}
+		return at.Compare(bt), true
+	}
+	return 0, false
+}
+
+// DescribeBase
+// -----------------------------------------------------------------------------
+// Tiplerin Introspect metodları için ortak tanım iskeletini üretir: tip adı,
+// etiket, zorunluluk, varsayılan değer ve dönüşüm adları doldurulur. Her tip
+// bu iskeletin üzerine kendi kurallarını ekler.
+func (b *BaseType) DescribeBase(typeName string) *TypeDescription {
+	desc := &TypeDescription{
+		Type:     typeName,
+		Label:    b.label,
+		Required: b.isRequired,
+		Default:  b.defaultValue,
+	}
+	if b.severity != "" && b.severity != SeverityError {
+		desc.Severity = string(b.severity)
+	}
+	desc.Description = b.description
+	if len(b.examples) > 0 {
+		desc.Examples = append([]any(nil), b.examples...)
+	}
+	if b.deprecated != nil {
+		desc.Deprecated = true
+		desc.DeprecationReason = *b.deprecated
+	}
+	desc.NoTrim = b.noTrim
+	desc.Sensitive = b.sensitive
+	desc.Optional = b.optional
+	desc.Nullable = b.nullable
+	if len(b.transformNames) > 0 {
+		desc.Transforms = append([]string(nil), b.transformNames...)
+	}
+	for _, rule := range b.changeRules {
+		desc.AddRule(rule.name, rule.params)
+	}
+	return desc
 }
 
 // Transform
@@ -132,11 +423,11 @@ func (b *BaseType) Validate(field string, value any, result *ValidationResult) {
 	fieldName := b.GetLabel(field)
 	if b.isRequired {
 		if value == nil {
-			result.AddError(field, i18n.Get(i18n.KeyRequired, fieldName))
+			result.AddRuleError(field, i18n.KeyRequired, fieldName)
 			return
 		}
 		if str, ok := value.(string); ok && str == "" {
-			result.AddError(field, i18n.Get(i18n.KeyRequired, fieldName))
+			result.AddRuleError(field, i18n.KeyRequired, fieldName)
 			return
 		}
 	}
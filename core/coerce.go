@@ -0,0 +1,88 @@
+package core
+
+import (
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+//
+// -----------------------------------------------------------------------------
+// Query String ve Form Değerlerinin Tip Çevrimi
+// -----------------------------------------------------------------------------
+// Query string, form ve başlık değerleri her zaman string olarak gelir.
+// NumberType ve BooleanType string kabul etmediği için bu değerler şema
+// tanımındaki tipe göre çevrilir:
+//
+//	number  → json.Number (NumberType Transform'da sayıya çevirir)
+//	boolean → bool (strconv.ParseBool: 1/0, true/false, t/f)
+//	array   → []any; tekrarlanan parametreler (?id=1&id=2) veya virgülle
+//	          ayrılmış tek değer (?id=1,2) elemanlara ayrılır ve elemanlar
+//	          dizi tanımına göre çevrilir
+//
+// Tarihler DateType tarafından string'den ayrıştırıldığı için olduğu gibi
+// bırakılır. Çevrilemeyen değerler değiştirilmez ve şema tarafından
+// reddedilir; böylece hata mesajları alanın kendi kuralından gelir.
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// CoerceValues, url.Values'u fields tanımlarına göre çevrilmiş veriye
+// dönüştürür. Tanımı olmayan parametreler tek değerse string, çok değerse
+// []any olarak kalır.
+func CoerceValues(values url.Values, fields map[string]*TypeDescription) map[string]any {
+	data := make(map[string]any, len(values))
+	for name, vs := range values {
+		data[name] = CoerceStrings(vs, fields[name])
+	}
+	return data
+}
+
+// CoerceStrings, bir parametrenin değerlerini desc'e göre çevirir.
+func CoerceStrings(values []string, desc *TypeDescription) any {
+	if desc == nil || desc.Type != "array" {
+		if len(values) == 1 {
+			return coerceString(values[0], desc)
+		}
+		items := make([]any, len(values))
+		for i, v := range values {
+			items[i] = coerceString(v, desc)
+		}
+		return items
+	}
+
+	if len(values) == 1 {
+		if values[0] == "" {
+			return []any{}
+		}
+		values = strings.Split(values[0], ",")
+	}
+	items := make([]any, len(values))
+	for i, v := range values {
+		items[i] = coerceString(strings.TrimSpace(v), desc.Elements)
+	}
+	return items
+}
+
+// coerceString, tek bir string değeri number veya boolean tipine çevirir.
+func coerceString(value string, desc *TypeDescription) any {
+	if desc == nil {
+		return value
+	}
+	switch desc.Type {
+	case "number":
+		if _, err := strconv.ParseFloat(value, 64); err == nil {
+			return json.Number(value)
+		}
+	case "boolean":
+		if b, err := strconv.ParseBool(value); err == nil {
+			return b
+		}
+	}
+	return value
+}
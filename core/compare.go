@@ -0,0 +1,111 @@
+package core
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"time"
+)
+
+//
+// -----------------------------------------------------------------------------
+// Değer Karşılaştırma Yardımcıları
+// -----------------------------------------------------------------------------
+// Bu dosya, farklı kaynaklardan gelen (JSON decode, veritabanı, Go literal)
+// değerlerin anlamsal olarak karşılaştırılması için yardımcı fonksiyonlar
+// içerir. Örneğin JSON'dan gelen float64(5) ile Go kodundaki int(5) aynı
+// değer kabul edilir.
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// ToFloat64
+// -----------------------------------------------------------------------------
+// Go'nun yerleşik sayısal tiplerinden birini veya json.Number değerini
+// float64'e çevirir.
+//
+// Dönüş:
+//   - float64: Dönüştürülmüş değer
+//   - bool: Değer sayısal değilse false
+func ToFloat64(value any) (float64, bool) {
+	switch v := value.(type) {
+	case int:
+		return float64(v), true
+	case int8:
+		return float64(v), true
+	case int16:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case uint:
+		return float64(v), true
+	case uint8:
+		return float64(v), true
+	case uint16:
+		return float64(v), true
+	case uint32:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	case float32:
+		return float64(v), true
+	case float64:
+		return v, true
+	case json.Number:
+		f, err := v.Float64()
+		return f, err == nil
+	}
+	return 0, false
+}
+
+// ValuesEqual
+// -----------------------------------------------------------------------------
+// İki değerin anlamsal olarak eşit olup olmadığını kontrol eder.
+//   - Sayısal değerler tipten bağımsız olarak float64 üzerinden karşılaştırılır
+//   - time.Time değerleri Equal ile karşılaştırılır
+//   - Diğer tüm değerler reflect.DeepEqual ile karşılaştırılır
+func ValuesEqual(a, b any) bool {
+	if af, ok := ToFloat64(a); ok {
+		if bf, ok := ToFloat64(b); ok {
+			return af == bf
+		}
+		return false
+	}
+	if at, ok := a.(time.Time); ok {
+		if bt, ok := b.(time.Time); ok {
+			return at.Equal(bt)
+		}
+		return false
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// CompareValues
+// -----------------------------------------------------------------------------
+// İki değeri sıralama amacıyla karşılaştırır: sayılar sayısal, time.Time
+// değerleri zamansal, string'ler sözlük sırasıyla (ISO 8601 tarih/saatler
+// için doğru sırayı verir) karşılaştırılır.
+//
+// Dönüş:
+//   - int: a < b ise -1, eşitse 0, a > b ise 1
+//   - bool: Değerler karşılaştırılamıyorsa false
+func CompareValues(a, b any) (int, bool) {
+	if cmp, ok := compareOrdered(a, b); ok {
+		return cmp, true
+	}
+	as, ok := a.(string)
+	if !ok {
+		return 0, false
+	}
+	bs, ok := b.(string)
+	if !ok {
+		return 0, false
+	}
+	return strings.Compare(as, bs), true
+}
@@ -0,0 +1,55 @@
+package core
+
+import "context"
+
+//
+// -----------------------------------------------------------------------------
+// İstek Kimliği (Correlation ID)
+// -----------------------------------------------------------------------------
+// Kullanıcının bildirdiği bir hatayı sunucudaki doğrulama kaydıyla
+// eşleştirebilmek için istek kimliği context üzerinden taşınır. ValidateCtx,
+// context'teki kimliği sonuca yazar; sonuç serileştiricileri (JSON, XML, düz
+// metin) kimliği yanıta ekler:
+//
+//	ctx := core.ContextWithRequestID(r.Context(), r.Header.Get("X-Request-ID"))
+//	res := schema.ValidateCtx(ctx, data)
+//	// {"valid":false,"request_id":"9f2c...","errors":{...}}
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// requestIDKey, context içinde istek kimliğini taşıyan anahtardır.
+type requestIDKey struct{}
+
+// ContextWithRequestID, istek kimliğini context'e ekler. Boş kimlik eklenmez.
+func ContextWithRequestID(ctx context.Context, id string) context.Context {
+	if id == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestIDFromContext, ContextWithRequestID ile eklenmiş kimliği döndürür;
+// yoksa boş string döner.
+func RequestIDFromContext(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// SetRequestID, sonucun ait olduğu isteğin kimliğini atar.
+func (r *ValidationResult) SetRequestID(id string) {
+	r.requestID = id
+}
+
+// RequestID, sonucun ait olduğu isteğin kimliğini döndürür; atanmamışsa boş
+// string döner.
+func (r *ValidationResult) RequestID() string {
+	return r.requestID
+}
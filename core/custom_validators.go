@@ -72,6 +72,18 @@ type AsyncValidator func(ctx context.Context, value any) error
 //   - error: Doğrulama başarısızsa hata, başarılıysa nil
 type ContextValidator func(value any, data map[string]any) error
 
+// ChangeValidator, bir alanın eski ve yeni değerini birlikte gören doğrulama
+// fonksiyonu tipidir. PUT/PATCH gibi güncelleme uç noktalarında geçiş
+// kurallarını ("status sadece draft→published olabilir") uygulamak için kullanılır.
+//
+// Parametreler:
+//   - oldValue: Alanın mevcut (kayıtlı) değeri
+//   - newValue: Alanın gönderilen yeni değeri
+//
+// Dönüş:
+//   - error: Değişiklik geçersizse hata, geçerliyse nil
+type ChangeValidator func(oldValue, newValue any) error
+
 // Rule, yeniden kullanılabilir özel doğrulama kuralı interface'i
 // Laravel'in Rule interface'ine benzer
 type Rule interface {
@@ -235,6 +247,18 @@ func (cv *CustomValidation) HasValidators() bool {
 		len(cv.rules) > 0
 }
 
+// Count, tanımlı toplam custom validator ve kural sayısını döndürür.
+// Şema tanımlarında (Describe) kullanılır.
+func (cv *CustomValidation) Count() int {
+	if cv == nil {
+		return 0
+	}
+	return len(cv.syncValidators) +
+		len(cv.asyncValidators) +
+		len(cv.contextValidators) +
+		len(cv.rules)
+}
+
 // -----------------------------------------------------------------------------
 // Yaygın Kullanılan Custom Rules (Built-in Utilities)
 // -----------------------------------------------------------------------------
@@ -0,0 +1,76 @@
+package core
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+//
+// -----------------------------------------------------------------------------
+// Doğrulama Süresi (Deadline / Budget)
+// -----------------------------------------------------------------------------
+// Senkron kurallar context almadığından, ValidateCtx'in context'i sonuca
+// bağlanır ve uzun döngüler (dizi elemanları, CSV satırları) belirli
+// aralıklarla Expired ile kontrol eder. Süre dolduğunda döngü kalan
+// elemanları atlar ve bunu sonuca işler (Interrupted); şema da kalan
+// adımları atlayarak _payload alanına deadline hatası ekler:
+//
+//	for i, item := range slice {
+//	    if i%core.ExpiryCheckInterval == 0 && result.Expired() {
+//	        break
+//	    }
+//	    ...
+//	}
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// ExpiryCheckInterval, döngülerde Expired kontrolünün kaç elemanda bir
+// yapılacağıdır.
+const ExpiryCheckInterval = 64
+
+// deadline, bir doğrulama çalışmasının context'i ve süre dolduğu için
+// atlanan iş olup olmadığıdır. Child ile oluşturulan sonuçlar aynı değeri
+// paylaşır.
+type deadline struct {
+	ctx context.Context
+	hit atomic.Bool
+}
+
+// BindContext, sonucu ctx'e bağlar; ctx iptal edildiğinde veya süresi
+// dolduğunda Expired true döner.
+func (r *ValidationResult) BindContext(ctx context.Context) {
+	r.deadline = &deadline{ctx: ctx}
+}
+
+// Expired, sonucun bağlı olduğu context'in sona erip ermediğini döndürür.
+// true dönerse çağıranın kalan işi atlayacağı varsayılır ve çalışma
+// kesilmiş olarak işaretlenir (bkz. Interrupted). Bağlı bir context yoksa
+// her zaman false döner.
+func (r *ValidationResult) Expired() bool {
+	d := r.deadline
+	if d == nil || d.ctx.Err() == nil {
+		return false
+	}
+	d.hit.Store(true)
+	return true
+}
+
+// Interrupted, bu çalışmada (Child sonuçlar dahil) Expired'ın true dönüp
+// dönmediğini, yani süre dolduğu için atlanan iş olup olmadığını bildirir.
+func (r *ValidationResult) Interrupted() bool {
+	return r.deadline != nil && r.deadline.hit.Load()
+}
+
+// Child, aynı context'e bağlı boş bir sonuç oluşturur. Eleman veya alan
+// bazında ayrı toplanan sonuçlar için NewResult yerine kullanılır; böylece
+// iç içe döngüler de süre sınırını görür.
+func (r *ValidationResult) Child() *ValidationResult {
+	child := NewResult()
+	child.deadline = r.deadline
+	return child
+}
@@ -0,0 +1,217 @@
+package core
+
+//
+// -----------------------------------------------------------------------------
+// Şema Tanımlama (Describe / Introspection) Modelleri
+// -----------------------------------------------------------------------------
+// Bu dosya, bir şemanın ve içindeki tiplerin hangi kurallarla, hangi
+// parametrelerle çalıştığını yapısal olarak dışarıya açan veri modellerini
+// içerir. Doğrulama çalıştırılmadan ("dry-run") şemanın ne yapacağının
+// görülebilmesini sağlar.
+//
+// Kullanım alanları:
+//   - Otomatik API dokümantasyonu üretimi
+//   - Yönetim panellerinde "kurallar" ekranları
+//   - Kontrat (contract) testleri
+//
+// Bu modeller JSON'a doğrudan serileştirilebilecek şekilde tasarlanmıştır.
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// RuleDescription, bir alana uygulanan tek bir kuralı ve parametrelerini tanımlar.
+type RuleDescription struct {
+	// Name, kuralın adıdır (örn: "min", "email", "one_of").
+	Name string `json:"name"`
+
+	// Params, kuralın parametreleridir (örn: {"value": 3}).
+	Params map[string]any `json:"params,omitempty"`
+}
+
+// TypeDescription, tek bir tipin (alanın) yapısal tanımıdır.
+type TypeDescription struct {
+	// Type, tipin adıdır (örn: "string", "number", "object").
+	Type string `json:"type"`
+
+	// Label, alan için tanımlanmış okunabilir etikettir.
+	Label string `json:"label,omitempty"`
+
+	// Required, alanın zorunlu olup olmadığını belirtir.
+	Required bool `json:"required"`
+
+	// Optional, alanın anahtarının eksik olabileceğini belirtir.
+	Optional bool `json:"optional,omitempty"`
+
+	// Nullable, alanın açıkça null gönderilebileceğini belirtir.
+	Nullable bool `json:"nullable,omitempty"`
+
+	// Default, alan için tanımlanmış varsayılan değerdir.
+	Default any `json:"default,omitempty"`
+
+	// Severity, alanın önem seviyesidir; varsayılan "error" seviyesinde boş bırakılır.
+	Severity string `json:"severity,omitempty"`
+
+	// Description, alan için dokümantasyon amaçlı açıklamadır.
+	Description string `json:"description,omitempty"`
+
+	// Examples, dokümantasyonda gösterilecek örnek değerlerdir.
+	Examples []any `json:"examples,omitempty"`
+
+	// Deprecated, alanın kullanımdan kaldırılmış olarak işaretlenip işaretlenmediğidir.
+	Deprecated bool `json:"deprecated,omitempty"`
+
+	// DeprecationReason, kullanımdan kaldırma gerekçesi veya yerine kullanılacak alandır.
+	DeprecationReason string `json:"deprecation_reason,omitempty"`
+
+	// NoTrim, alanın şema seviyesindeki otomatik trim işleminden muaf olduğunu belirtir.
+	NoTrim bool `json:"no_trim,omitempty"`
+
+	// Sensitive, alanın değerinin hassas olduğunu (şifre, kart numarası vb.)
+	// ve kullanıcıya geri gösterilmemesi gerektiğini belirtir.
+	Sensitive bool `json:"sensitive,omitempty"`
+
+	// Transforms, doğrulama öncesi uygulanan dönüşümlerin adlarıdır.
+	Transforms []string `json:"transforms,omitempty"`
+
+	// Rules, alana uygulanan doğrulama kurallarıdır.
+	Rules []RuleDescription `json:"rules,omitempty"`
+
+	// CustomRules, içeriği dışarıdan görülemeyen özel doğrulayıcı sayısıdır.
+	CustomRules int `json:"custom_rules,omitempty"`
+
+	// Fields, nesne tipleri için alt alanların tanımlarıdır.
+	Fields map[string]*TypeDescription `json:"fields,omitempty"`
+
+	// Elements, dizi tipleri için eleman şemasının tanımıdır.
+	Elements *TypeDescription `json:"elements,omitempty"`
+
+	// Discriminator, ayrık birleşim (discriminated union) tiplerinde şemayı
+	// seçen alanın adıdır.
+	Discriminator string `json:"discriminator,omitempty"`
+
+	// Variants, ayrık birleşim tiplerinde ayırıcı değerine göre seçilen
+	// şemaların tanımlarıdır.
+	Variants map[string]*TypeDescription `json:"variants,omitempty"`
+
+	// Keys, map tiplerinde anahtarların uyacağı şemanın tanımıdır.
+	Keys *TypeDescription `json:"keys,omitempty"`
+
+	// Values, map tiplerinde değerlerin uyacağı şemanın tanımıdır.
+	Values *TypeDescription `json:"values,omitempty"`
+
+	// Union, Union(...) tiplerinde sırayla denenen alternatif tiplerin
+	// tanımlarıdır.
+	Union []*TypeDescription `json:"union,omitempty"`
+
+	// Ref, Ref(...) ile başvurulan kayıtlı şemanın adıdır. Başvuru kendi
+	// içinde döngü oluşturuyorsa Fields boş bırakılır.
+	Ref string `json:"ref,omitempty"`
+}
+
+// AddRule, tanıma yeni bir kural ekler. params nil verilebilir.
+func (d *TypeDescription) AddRule(name string, params map[string]any) {
+	d.Rules = append(d.Rules, RuleDescription{Name: name, Params: params})
+}
+
+// HasRule, verilen isimde bir kuralın tanımda olup olmadığını kontrol eder.
+func (d *TypeDescription) HasRule(name string) bool {
+	return d.Rule(name) != nil
+}
+
+// Rule, verilen isimdeki ilk kuralı döndürür; yoksa nil döner.
+func (d *TypeDescription) Rule(name string) *RuleDescription {
+	for i := range d.Rules {
+		if d.Rules[i].Name == name {
+			return &d.Rules[i]
+		}
+	}
+	return nil
+}
+
+// ConditionalDescription, When(...) ile eklenen koşullu bir dalı tanımlar.
+type ConditionalDescription struct {
+	// Field, koşulun kontrol ettiği alandır.
+	Field string `json:"field"`
+
+	// Equals, koşulun tetiklenmesi için beklenen değerdir.
+	Equals any `json:"equals"`
+
+	// Schema, koşul sağlandığında çalışacak alt şemanın tanımıdır.
+	Schema *SchemaDescription `json:"schema,omitempty"`
+}
+
+// FeatureDescription, WithFeature(...) ile eklenen feature flag'e bağlı bir
+// kural grubunu tanımlar.
+type FeatureDescription struct {
+	// Feature, grubun bağlı olduğu feature flag adıdır.
+	Feature string `json:"feature"`
+
+	// Schema, feature açıkken çalışacak alt şemanın tanımıdır.
+	Schema *SchemaDescription `json:"schema,omitempty"`
+}
+
+// CompositionDescription, AllOf/AnyOf/Not ile kurulmuş bir şema
+// birleşimini tanımlar.
+type CompositionDescription struct {
+	// Kind, birleşim türüdür: "all_of", "any_of" veya "not".
+	Kind string `json:"kind"`
+
+	// Schemas, birleşimdeki alt şemaların tanımlarıdır ("not" için tek şema).
+	Schemas []*SchemaDescription `json:"schemas"`
+}
+
+// SchemaDescription, bir şemanın tamamının yapısal tanımıdır.
+type SchemaDescription struct {
+	// Fields, şemadaki alanların tanımlarıdır.
+	Fields map[string]*TypeDescription `json:"fields"`
+
+	// Conditionals, When(...) ile eklenmiş koşullu dallardır.
+	Conditionals []ConditionalDescription `json:"conditionals,omitempty"`
+
+	// Features, WithFeature(...) ile eklenmiş feature flag gruplarıdır.
+	Features []FeatureDescription `json:"features,omitempty"`
+
+	// Compositions, AllOf/AnyOf/Not ile eklenmiş şema birleşimleridir.
+	Compositions []CompositionDescription `json:"compositions,omitempty"`
+
+	// Rules, şema seviyesinde tanımlı isimli kurallardır (örn: "transition").
+	// Kuralın uygulandığı alan(lar) Params içinde belirtilir.
+	Rules []RuleDescription `json:"rules,omitempty"`
+
+	// CrossValidators, tanımlı çapraz alan doğrulayıcı sayısıdır.
+	CrossValidators int `json:"cross_validators,omitempty"`
+
+	// Options, şema seviyesinde açılmış seçeneklerdir (örn: "auto_trim",
+	// "strict", "coerce"). Değer taşıyan seçenekler "ad:değer" biçimindedir
+	// (örn: "locale:tr", "max_errors:20").
+	Options []string `json:"options,omitempty"`
+}
+
+// Introspector, kendisini yapısal olarak tanımlayabilen tiplerin uyguladığı
+// arayüzdür. Kütüphanedeki tüm yerleşik tipler bu arayüzü sağlar.
+type Introspector interface {
+	Introspect() *TypeDescription
+}
+
+// SchemaDescriber, kendisini yapısal olarak tanımlayabilen şemaların
+// uyguladığı arayüzdür. Schema arayüzünün parçası değildir; kullanıcı tanımlı
+// şemalar bunu sağlamak zorunda değildir.
+type SchemaDescriber interface {
+	Describe() *SchemaDescription
+}
+
+// DescribeType, verilen tipin tanımını döndürür. Tip Introspector arayüzünü
+// sağlamıyorsa (kullanıcı tanımlı tipler) yalnızca "custom" tipi raporlanır.
+func DescribeType(t Type) *TypeDescription {
+	if t == nil {
+		return nil
+	}
+	if in, ok := t.(Introspector); ok {
+		return in.Introspect()
+	}
+	return &TypeDescription{Type: "custom"}
+}
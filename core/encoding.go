@@ -0,0 +1,132 @@
+package core
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"sort"
+	"strings"
+)
+
+//
+// -----------------------------------------------------------------------------
+// ValidationResult Serileştiricileri (JSON, XML, düz metin)
+// -----------------------------------------------------------------------------
+// Doğrulama sonucunu API yanıtlarında doğrudan kullanılabilecek biçimlere
+// çevirir. JSON modern istemciler, XML eski SOAP tarzı istemciler, düz metin
+// ise CLI araçları ve loglar için tasarlanmıştır. Üç biçim de alan adına göre
+// sıralı ve deterministiktir; geçerli veri (ValidData) yanıta eklenmez.
+// Sonuca istek kimliği atanmışsa (bkz. ContextWithRequestID) üç biçim de
+// kimliği içerir.
+//
+// JSON:
+//
+//	{"valid":false,"errors":{"email":["email must be a valid email address"]}}
+//
+// XML:
+//
+//	<validation valid="false">
+//	  <error field="email" rule="email">email must be a valid email address</error>
+//	</validation>
+//
+// Düz metin:
+//
+//	email: email must be a valid email address
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// MarshalJSON, sonucu geçerlilik bilgisi, hatalar ve (varsa) uyarı/bilgi
+// mesajlarıyla JSON nesnesine çevirir.
+func (r *ValidationResult) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Valid     bool                `json:"valid"`
+		RequestID string              `json:"request_id,omitempty"`
+		Errors    map[string][]string `json:"errors"`
+		Warnings  map[string][]string `json:"warnings,omitempty"`
+		Infos     map[string][]string `json:"infos,omitempty"`
+	}{
+		Valid:     !r.HasErrors(),
+		RequestID: r.requestID,
+		Errors:    r.errors,
+		Warnings:  r.warnings,
+		Infos:     r.infos,
+	})
+}
+
+// xmlIssue, XML çıktısındaki tek bir hata/uyarı/bilgi öğesidir.
+type xmlIssue struct {
+	XMLName xml.Name
+	Field   string `xml:"field,attr"`
+	Rule    string `xml:"rule,attr,omitempty"`
+	Message string `xml:",chardata"`
+}
+
+// MarshalXML, sonucu <validation> kök öğesi altında <error>, <warning> ve
+// <info> öğeleri olarak yazar. Kök öğe adı, sonucu içeren yapının etiketinden
+// bağımsız olarak her zaman "validation"dır.
+func (r *ValidationResult) MarshalXML(e *xml.Encoder, _ xml.StartElement) error {
+	var issues []xmlIssue
+	for _, f := range r.Failures() {
+		issues = append(issues, xmlIssue{XMLName: xml.Name{Local: "error"}, Field: f.Field, Rule: f.Rule, Message: f.Message})
+	}
+	for _, field := range sortedFields(r.warnings) {
+		for _, msg := range r.warnings[field] {
+			issues = append(issues, xmlIssue{XMLName: xml.Name{Local: "warning"}, Field: field, Message: msg})
+		}
+	}
+	for _, field := range sortedFields(r.infos) {
+		for _, msg := range r.infos[field] {
+			issues = append(issues, xmlIssue{XMLName: xml.Name{Local: "info"}, Field: field, Message: msg})
+		}
+	}
+
+	valid := "true"
+	if r.HasErrors() {
+		valid = "false"
+	}
+	return e.Encode(struct {
+		XMLName   xml.Name   `xml:"validation"`
+		Valid     string     `xml:"valid,attr"`
+		RequestID string     `xml:"request_id,attr,omitempty"`
+		Issues    []xmlIssue `xml:",any"`
+	}{Valid: valid, RequestID: r.requestID, Issues: issues})
+}
+
+// MarshalText, sonucu her satırda bir mesaj olacak şekilde düz metne çevirir.
+// Hatalar "alan: mesaj", uyarı ve bilgiler "alan (warning): mesaj" biçimindedir.
+// İstek kimliği varsa mesajlardan önce "request_id: kimlik" satırı yazılır.
+// Hatasız ve uyarısız bir sonuç boş metin üretir.
+func (r *ValidationResult) MarshalText() ([]byte, error) {
+	var sb strings.Builder
+	if r.requestID != "" && (r.HasErrors() || len(r.warnings) > 0 || len(r.infos) > 0) {
+		sb.WriteString("request_id: " + r.requestID + "\n")
+	}
+	for _, f := range r.Failures() {
+		sb.WriteString(f.Field + ": " + f.Message + "\n")
+	}
+	for _, group := range []struct {
+		label  string
+		issues map[string][]string
+	}{{"warning", r.warnings}, {"info", r.infos}} {
+		for _, field := range sortedFields(group.issues) {
+			for _, msg := range group.issues[field] {
+				sb.WriteString(field + " (" + group.label + "): " + msg + "\n")
+			}
+		}
+	}
+	return []byte(sb.String()), nil
+}
+
+// sortedFields, mesaj haritasının alan adlarını sıralı döndürür.
+func sortedFields(issues map[string][]string) []string {
+	fields := make([]string, 0, len(issues))
+	for field := range issues {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	return fields
+}
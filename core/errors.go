@@ -1,6 +1,10 @@
 package core
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/biyonik/go-fluent-validator/i18n"
+)
 
 // -----------------------------------------------------------------------------
 // FieldError
@@ -59,3 +63,33 @@ func (e *FieldError) Error() string {
 func NewFieldError(field, message string) error {
 	return &FieldError{Field: field, Message: message}
 }
+
+// MessageError
+// -----------------------------------------------------------------------------
+// Çeviri anahtarını ve argümanlarını taşıyan hata türüdür. error döndüren
+// yerleşik kurallar (değişiklik kuralları, durum geçişleri) mesajı bununla
+// üretir; hata AddErrorFrom ile eklendiğinde anahtar saklandığı için
+// ValidationResult.Localize mesajı istek dilinde yeniden üretebilir.
+type MessageError struct {
+	// Key, mesajın çeviri anahtarıdır.
+	Key i18n.MessageKey
+
+	// Args, mesajın biçimlendirme argümanlarıdır.
+	Args []any
+}
+
+// Error, mesajı aktif dilde üretir.
+func (e *MessageError) Error() string {
+	return i18n.Get(e.Key, e.Args...)
+}
+
+// NewMessageError
+// -----------------------------------------------------------------------------
+// key ve args ile yeni bir MessageError oluşturur.
+//
+// Örnek:
+//
+//	return core.NewMessageError(i18n.KeyImmutable, fieldName)
+func NewMessageError(key i18n.MessageKey, args ...any) error {
+	return &MessageError{Key: key, Args: args}
+}
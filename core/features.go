@@ -0,0 +1,74 @@
+package core
+
+import "context"
+
+//
+// -----------------------------------------------------------------------------
+// Feature Flag Desteği
+// -----------------------------------------------------------------------------
+// Bu dosya, doğrulama kurallarının kiracıya (tenant) veya kademeli dağıtım
+// (rollout) bayraklarına göre açılıp kapatılabilmesi için gereken arayüzü ve
+// context yardımcılarını içerir. Şemalar, WithFeature(...) ile tanımlanan kural
+// gruplarını yalnızca context'teki FeatureChecker ilgili özelliği açık
+// bildirdiğinde çalıştırır.
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// FeatureChecker, bir özelliğin (feature flag) açık olup olmadığını bildirir.
+type FeatureChecker interface {
+	IsEnabled(feature string) bool
+}
+
+// FeatureSet, sabit bir özellik listesinden oluşan FeatureChecker'dır.
+//
+// Örnek:
+//
+//	core.FeatureSet{"strict_kyc": true}
+type FeatureSet map[string]bool
+
+// IsEnabled, FeatureChecker implementasyonu.
+func (f FeatureSet) IsEnabled(feature string) bool {
+	return f[feature]
+}
+
+// FeatureFunc, bir fonksiyonu FeatureChecker olarak kullanmayı sağlar.
+// Harici bir feature flag servisine bağlanmak için kullanılabilir.
+type FeatureFunc func(feature string) bool
+
+// IsEnabled, FeatureChecker implementasyonu.
+func (f FeatureFunc) IsEnabled(feature string) bool {
+	return f(feature)
+}
+
+// featureCheckerKey, context içinde FeatureChecker'ı saklamak için kullanılan anahtardır.
+type featureCheckerKey struct{}
+
+// ContextWithFeatures
+// -----------------------------------------------------------------------------
+// FeatureChecker'ı context'e ekler. Dönen context ValidateCtx'e verildiğinde
+// şemadaki WithFeature(...) grupları bu checker'a göre çalıştırılır.
+func ContextWithFeatures(ctx context.Context, checker FeatureChecker) context.Context {
+	return context.WithValue(ctx, featureCheckerKey{}, checker)
+}
+
+// FeaturesFromContext
+// -----------------------------------------------------------------------------
+// Context'teki FeatureChecker'ı döndürür. Tanımlı değilse ikinci dönüş false olur.
+func FeaturesFromContext(ctx context.Context) (FeatureChecker, bool) {
+	checker, ok := ctx.Value(featureCheckerKey{}).(FeatureChecker)
+	return checker, ok && checker != nil
+}
+
+// FeatureEnabled
+// -----------------------------------------------------------------------------
+// Context'teki FeatureChecker'a göre feature'ın açık olup olmadığını döndürür.
+// Context'te checker yoksa tüm özellikler kapalı kabul edilir.
+func FeatureEnabled(ctx context.Context, feature string) bool {
+	checker, ok := FeaturesFromContext(ctx)
+	return ok && checker.IsEnabled(feature)
+}
@@ -1,5 +1,7 @@
 package core
 
+import "context"
+
 //
 // -----------------------------------------------------------------------------
 // Type & Schema Arayüzleri
@@ -67,3 +69,103 @@ type Schema interface {
 	// Laravel'in "sometimes" veya "required_if" kurallarına benzer bir mantık sunar.
 	When(field string, expectedValue any, callback func() Schema) Schema
 }
+
+// ContextSchema, doğrulamayı bir context ile çalıştırabilen şemaların
+// uyguladığı arayüzdür. Schema arayüzünü genişletmek yerine ayrı tutulur;
+// context'e bağlı adımlar (feature flag'ler, dış kaynaklı kontroller, süre
+// sınırı, istek dili) yalnızca bu arayüzü sağlayan şemalarda çalışır.
+type ContextSchema interface {
+	Schema
+	ValidateCtx(ctx context.Context, data map[string]any) *ValidationResult
+}
+
+// ValidateContext, schema ContextSchema sağlıyorsa ValidateCtx'i, aksi halde
+// Validate'i çağırır.
+func ValidateContext(ctx context.Context, schema Schema, data map[string]any) *ValidationResult {
+	if cs, ok := schema.(ContextSchema); ok {
+		return cs.ValidateCtx(ctx, data)
+	}
+	return schema.Validate(data)
+}
+
+// Sanitizer, doğrulama kurallarını çalıştırmadan yalnızca dönüşüm zincirini
+// uygulayabilen şemaların uyguladığı arayüzdür.
+type Sanitizer interface {
+	Sanitize(data map[string]any) (map[string]any, *ValidationResult)
+}
+
+// TrimOptOut, şema seviyesindeki otomatik trim işleminden muaf tutulabilen
+// tiplerin uyguladığı arayüzdür. BaseType'ı embed eden tüm tipler bu arayüzü
+// otomatik olarak sağlar.
+type TrimOptOut interface {
+	IsTrimDisabled() bool
+}
+
+// SensitiveType, değeri kullanıcıya veya loglara geri gösterilmemesi gereken
+// (şifre, kart numarası vb.) tiplerin uyguladığı arayüzdür. BaseType'ı embed
+// eden tüm tipler bu arayüzü otomatik olarak sağlar.
+type SensitiveType interface {
+	IsSensitive() bool
+}
+
+// ShapeProvider, alt alanlara sahip tiplerin (ObjectType gibi) alan–tip
+// eşlemesini dışarıya açtığı arayüzdür. Şema seviyesindeki işlemlerin
+// iç içe yapılara inebilmesi için kullanılır.
+type ShapeProvider interface {
+	GetShape() map[string]Type
+}
+
+// ElementProvider, eleman şemasına sahip tiplerin (ArrayType gibi) bu şemayı
+// dışarıya açtığı arayüzdür.
+type ElementProvider interface {
+	GetElementSchema() Type
+}
+
+// ChangeAware, eski ve yeni değeri birlikte doğrulayabilen tiplerin uyguladığı
+// arayüzdür. BaseType'ı embed eden tüm tipler bu arayüzü otomatik olarak sağlar.
+type ChangeAware interface {
+	ValidateChange(field string, oldValue, newValue any, result *ValidationResult)
+}
+
+// AsyncValidatable, doğrulaması dış bir kaynağa (BIN veritabanı, harici API vb.)
+// başvuran tiplerin uyguladığı arayüzdür. Şema, alan seviyesi kuralları geçen
+// alanlar için ValidateAsync'i ValidateCtx'e verilen context ile eşzamanlı
+// çalıştırır.
+type AsyncValidatable interface {
+	ValidateAsync(ctx context.Context, field string, value any, result *ValidationResult)
+}
+
+// AsyncOptional, AsyncValidatable uygulayan ancak dış kaynaklı kuralı yalnızca
+// yapılandırıldığında olan tiplerin (örn. StringType.TOTP) uyguladığı
+// arayüzdür. HasAsyncRules false dönerse şema alan için ValidateAsync'i
+// çağırmaz.
+type AsyncOptional interface {
+	HasAsyncRules() bool
+}
+
+// Session, artımlı (incremental) doğrulama oturumunu tanımlar.
+// ValidationSchema.Session(...) ile oluşturulur.
+type Session interface {
+	// Update, bir alanı günceller, yalnızca etkilenen kuralları yeniden çalıştırır
+	// ve güncel sonucu döndürür.
+	Update(field string, value any) *ValidationResult
+
+	// Result, önbellekteki güncel doğrulama sonucunu döndürür.
+	Result() *ValidationResult
+
+	// Data, oturumdaki ham verinin bir kopyasını döndürür.
+	Data() map[string]any
+}
+
+// DataSource, doğrulanacak veriyi somut gösteriminden (map, form, header,
+// struct, protobuf vb.) bağımsız olarak okuyan arayüzdür.
+type DataSource interface {
+	// Get, verilen yoldaki değeri döndürür. Değer yoksa ikinci dönüş false olur.
+	Get(path string) (any, bool)
+}
+
+// XMLMapping, Schema.ValidateXML için alan adlarını XML yollarına eşler.
+// Anahtar şemadaki alan yoludur ("customer.name"); değer kök elemana göre
+// "/" ile ayrılmış eleman yoludur, son parça "@" ile başlıyorsa nitelik
+// okunur ("Customer/Name", "Order/@id").
+type XMLMapping map[string]string
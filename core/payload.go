@@ -0,0 +1,56 @@
+package core
+
+import (
+	"context"
+	"strings"
+)
+
+//
+// -----------------------------------------------------------------------------
+// Dış Kaynaklı Kontrollerde Kardeş Alanlara Erişim
+// -----------------------------------------------------------------------------
+// Alan tipleri yalnızca kendi değerlerini görür. Şema, AsyncValidatable
+// kontrollerini çalıştırırken dönüştürülmüş tüm veriyi context'e ekler; böylece
+// "şifre kullanıcı adına benzemesin" gibi kardeş alanlara bakan kurallar
+// ValidateAsync içinde PayloadFromContext ile bu veriye erişebilir.
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// payloadKey, context içinde doğrulanan veriyi taşıyan anahtardır.
+type payloadKey struct{}
+
+// ContextWithPayload, doğrulanan veriyi context'e ekler.
+func ContextWithPayload(ctx context.Context, data map[string]any) context.Context {
+	return context.WithValue(ctx, payloadKey{}, data)
+}
+
+// PayloadFromContext, ContextWithPayload ile eklenmiş veriyi döndürür. Veri
+// salt okunur kabul edilmelidir.
+func PayloadFromContext(ctx context.Context) (map[string]any, bool) {
+	if ctx == nil {
+		return nil, false
+	}
+	data, ok := ctx.Value(payloadKey{}).(map[string]any)
+	return data, ok
+}
+
+// PayloadValue, data içinde nokta yoluyla ("address.city") verilen alanın
+// değerini döndürür. Yol bulunamazsa ok false olur.
+func PayloadValue(data map[string]any, path string) (value any, ok bool) {
+	value = data
+	for _, part := range strings.Split(path, ".") {
+		m, isMap := value.(map[string]any)
+		if !isMap {
+			return nil, false
+		}
+		if value, ok = m[part]; !ok {
+			return nil, false
+		}
+	}
+	return value, true
+}
@@ -0,0 +1,67 @@
+package core
+
+//
+// -----------------------------------------------------------------------------
+// Alan Varlığı: Optional ve Nullable
+// -----------------------------------------------------------------------------
+// Varsayılan olarak gönderilmeyen bir alan ile açıkça null gönderilen alan
+// aynı şekilde (nil değer olarak) doğrulanır. PATCH gibi uç noktalarda bu iki
+// durumun ayrılması gerekir: "alanı değiştirme" ile "alanı temizle" farklı
+// isteklerdir. Bunun için tiplere iki işaret eklenebilir:
+//
+//   - Optional: anahtar payload'da hiç bulunmayabilir. Eksik anahtar Required
+//     olsa bile geçerlidir, doğrulanmaz ve ValidData'ya yazılmaz (varsayılan
+//     değer tanımlıysa o yazılır).
+//   - Nullable: değer açıkça null olabilir. Null değer Required olsa bile
+//     geçerlidir, tipin kurallarından geçmez ve ValidData'da nil olarak
+//     korunur. Anahtar eksikse ValidData'ya yazılmaz.
+//
+// PATCH şemalarında "gönderildiyse boş olamaz" için Required().Optional(),
+// "gönderilmeli ama temizlenebilir" için Required().Nullable() kullanılır.
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// PresenceAware, Optional/Nullable işaretlenebilen tiplerin uyguladığı
+// arayüzdür. BaseType'ı embed eden tüm tipler bu arayüzü otomatik olarak sağlar.
+type PresenceAware interface {
+	IsOptional() bool
+	IsNullable() bool
+}
+
+// OptionalOf, tipin eksik anahtara izin verip vermediğini döndürür.
+func OptionalOf(t Type) bool {
+	aware, ok := t.(PresenceAware)
+	return ok && aware.IsOptional()
+}
+
+// NullableOf, tipin açık null değere izin verip vermediğini döndürür.
+func NullableOf(t Type) bool {
+	aware, ok := t.(PresenceAware)
+	return ok && aware.IsNullable()
+}
+
+// OmitValue, field anahtarı data'da yoksa ve dönüştürülmüş değer nil ise
+// alanın sonuç verisine yazılmaması gerekip gerekmediğini bildirir. Yalnızca
+// Optional ve Nullable tipler için eksik anahtar nil'den ayrılır.
+func OmitValue(t Type, data map[string]any, field string, transformed any) bool {
+	if _, sent := data[field]; sent || transformed != nil {
+		return false
+	}
+	return OptionalOf(t) || NullableOf(t)
+}
+
+// SkipValue, dönüştürülmüş data içindeki field alanının doğrulanmadan
+// geçilmesi gerekip gerekmediğini bildirir: Optional alanların eksik
+// anahtarları ve Nullable alanlara gönderilen null değerler.
+func SkipValue(t Type, data map[string]any, field string) bool {
+	value, present := data[field]
+	if !present {
+		return OptionalOf(t)
+	}
+	return value == nil && NullableOf(t)
+}
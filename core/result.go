@@ -1,5 +1,14 @@
 package core
 
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/biyonik/go-fluent-validator/i18n"
+)
+
 //
 // -----------------------------------------------------------------------------
 // ValidationResult
@@ -35,8 +44,56 @@ type ValidationResult struct {
 	// errors, alanlara göre gruplanmış hata mesajlarını tutar.
 	errors map[string][]string
 
+	// rules, errors ile aynı sırada her hatayı üreten kuralın adını tutar
+	// (kural bilinmiyorsa boş string).
+	rules map[string][]string
+
+	// messages, errors ile aynı sırada her hatanın çeviri anahtarını ve
+	// argümanlarını tutar (anahtarsız eklenen hatalar için nil).
+	// Localize, mesajları bu bilgiyle başka bir dilde yeniden üretir.
+	messages map[string][]*messageRef
+
 	// validData, doğrulamadan başarıyla geçen temiz veri setidir.
 	validData map[string]any
+
+	// warnings ve infos, doğrulamayı engellemeyen düşük seviyeli bildirimlerdir.
+	warnings map[string][]string
+	infos    map[string][]string
+
+	// transformedData, doğrulama sonucundan bağımsız olarak dönüşüm sonrası veridir.
+	transformedData map[string]any
+
+	// elements, eleman şemasına sahip dizi alanlarının indeks bazlı sonuçlarıdır.
+	elements map[string][]ElementResult
+
+	// oldInput, başarısız doğrulamada gönderilen ham değerlerdir (hassas
+	// alanlar hariç).
+	oldInput map[string]any
+
+	// requestID, sonucun ait olduğu isteğin kimliğidir (bkz. ContextWithRequestID).
+	requestID string
+
+	// deadline, doğrulamanın süre sınırını taşıyan context'tir (bkz. BindContext).
+	deadline *deadline
+}
+
+// ElementResult, bir dizi elemanının bağımsız doğrulama sonucunu temsil eder.
+// Toplu içe aktarma (import) senaryolarında geçerli satırları kabul edip yalnızca
+// hatalı satırları reddetmek için kullanılır.
+type ElementResult struct {
+	// Index, elemanın dizideki sırasıdır.
+	Index int
+
+	// Value, elemanın dönüştürülmüş değeridir.
+	Value any
+
+	// Errors, yalnızca bu elemana ait hatalardır (örn: "users[2].email").
+	Errors map[string][]string
+}
+
+// Valid, elemanın hatasız doğrulanıp doğrulanmadığını döndürür.
+func (e ElementResult) Valid() bool {
+	return len(e.Errors) == 0
 }
 
 // NewResult
@@ -47,8 +104,14 @@ type ValidationResult struct {
 // ile temiz bir doğrulama sonucu üretmek için kullanılır.
 func NewResult() *ValidationResult {
 	return &ValidationResult{
-		errors:    make(map[string][]string),
-		validData: make(map[string]any),
+		errors:          make(map[string][]string),
+		rules:           make(map[string][]string),
+		messages:        make(map[string][]*messageRef),
+		validData:       make(map[string]any),
+		warnings:        make(map[string][]string),
+		infos:           make(map[string][]string),
+		transformedData: make(map[string]any),
+		elements:        make(map[string][]ElementResult),
 	}
 }
 
@@ -57,7 +120,122 @@ func NewResult() *ValidationResult {
 // Belirtilen alana (field) bir hata mesajı ekler.
 // Aynı alan için birden fazla hata oluşabilir; bu nedenle alan bazında liste tutulur.
 func (r *ValidationResult) AddError(field, message string) {
+	r.AddErrorRule(field, "", message)
+}
+
+// AddErrorRule
+// -----------------------------------------------------------------------------
+// Hatayı, onu üreten kuralın adıyla birlikte ekler. Kural adı Failures()
+// çıktısında ve hata istatistiklerinde kullanılır.
+func (r *ValidationResult) AddErrorRule(field, rule, message string) {
+	r.addError(field, rule, message, nil)
+}
+
+// AddRuleError
+// -----------------------------------------------------------------------------
+// key ile çevrilen mesajı, kural adı anahtardan türetilerek (örn:
+// i18n.KeyEmail → "email") ekler. Yerleşik tipler hata eklerken bunu kullanır.
+func (r *ValidationResult) AddRuleError(field string, key i18n.MessageKey, args ...any) {
+	r.addError(field, key.Rule(), i18n.Get(key, args...), &messageRef{key: key, args: args})
+}
+
+// AddRuleErrorAs
+// -----------------------------------------------------------------------------
+// AddRuleError ile aynıdır; ancak kural adı anahtardan türetilmez, rule olarak
+// kaydedilir. Mesajı başka bir kuralın anahtarıyla üretilen hatalar (örn.
+// card_expiry kuralının KeyCardExpired mesajı) için kullanılır.
+func (r *ValidationResult) AddRuleErrorAs(field, rule string, key i18n.MessageKey, args ...any) {
+	r.addError(field, rule, i18n.Get(key, args...), &messageRef{key: key, args: args})
+}
+
+// AddErrorFrom
+// -----------------------------------------------------------------------------
+// err'i rule kuralının hatası olarak ekler. err bir MessageError ise (veya onu
+// sarıyorsa) çeviri anahtarı saklanır ve mesaj Localize ile yeniden
+// çevrilebilir; diğer hatalar AddErrorRule gibi düz mesaj olarak eklenir.
+func (r *ValidationResult) AddErrorFrom(field, rule string, err error) {
+	var msg *MessageError
+	if errors.As(err, &msg) {
+		r.AddRuleErrorAs(field, rule, msg.Key, msg.Args...)
+		return
+	}
+	r.AddErrorRule(field, rule, err.Error())
+}
+
+// messageRef, bir hata mesajının yeniden çevrilebilmesi için anahtarı ve
+// argümanlarıdır.
+type messageRef struct {
+	key  i18n.MessageKey
+	args []any
+}
+
+// params, mesaj argümanlarındaki i18n.Attrs değerlerini tek bir haritada
+// toplar. i18n.ValueList değerleri düz listeye çevrilir.
+func (m *messageRef) params() map[string]any {
+	var out map[string]any
+	for _, arg := range m.args {
+		attrs, ok := arg.(i18n.Attrs)
+		if !ok {
+			continue
+		}
+		if out == nil {
+			out = make(map[string]any, len(attrs))
+		}
+		for name, value := range attrs {
+			if list, ok := value.(i18n.ValueList); ok {
+				value = []any(list)
+			}
+			out[name] = value
+		}
+	}
+	return out
+}
+
+// addError, hatayı kuralı ve (varsa) çeviri bilgisiyle birlikte ekler.
+func (r *ValidationResult) addError(field, rule, message string, ref *messageRef) {
 	r.errors[field] = append(r.errors[field], message)
+	r.rules[field] = append(r.rules[field], rule)
+	r.messages[field] = append(r.messages[field], ref)
+}
+
+// Localize
+// -----------------------------------------------------------------------------
+// Yerleşik kuralların ürettiği hata mesajlarını (alan, çapraz alan, grup,
+// geçiş ve değişiklik kuralları) locale dilinde yeniden üretir. AddError ile
+// eklenen özel mesajlar olduğu gibi kalır. ValidateCtx, context'te
+// i18n.ContextWithLocale ile bir dil taşındığında bunu otomatik çağırır; böylece
+// global aktif dil değiştirilmeden istek bazında çeviri yapılabilir.
+//
+// Örnek:
+//
+//	res := schema.Validate(data)
+//	res.Localize("de")
+func (r *ValidationResult) Localize(locale string) {
+	for field, refs := range r.messages {
+		for i, ref := range refs {
+			if ref != nil && i < len(r.errors[field]) {
+				r.errors[field][i] = i18n.GetIn(locale, ref.key, ref.args...)
+			}
+		}
+	}
+}
+
+// Failure, tek bir hatanın alanını, kuralını ve mesajını temsil eder.
+type Failure struct {
+	// Field, hatanın oluştuğu alan yoludur.
+	Field string
+
+	// Rule, hatayı üreten kuralın adıdır (örn: "email", "min_length").
+	// Özel doğrulayıcılar için "custom" olur.
+	Rule string
+
+	// Message, kullanıcıya gösterilen hata mesajıdır.
+	Message string
+
+	// Params, mesajın adlandırılmış parametreleridir (örn: one_of için
+	// "values" izin verilen değerlerin listesi). Mesajı yeniden biçimlendirmek
+	// isteyen istemciler için; parametresi olmayan hatalarda nil'dir.
+	Params map[string]any
 }
 
 // HasErrors
@@ -70,15 +248,146 @@ func (r *ValidationResult) HasErrors() bool {
 	return len(r.errors) > 0
 }
 
+// HasFieldErrors
+// -----------------------------------------------------------------------------
+// Belirtilen alan için en az bir hata eklenmiş olup olmadığını kontrol eder.
+// Tipler, kendi alanları hatalıyken ek kuralları atlamak için bunu kullanır;
+// böylece şemadaki başka bir alanın hatası bu alanın doğrulanmasını engellemez.
+func (r *ValidationResult) HasFieldErrors(field string) bool {
+	return len(r.errors[field]) > 0
+}
+
 // Errors
 // -----------------------------------------------------------------------------
 // Tüm hataları olduğu gibi döndürür.
 // Genellikle API yanıtlarında, debug ekranlarında veya kullanıcıya
-// gösterilecek hata çıktılarında kullanılır.
+// gösterilecek hata çıktılarında kullanılır. Map dolaşım sırası
+// deterministik değildir; sıralı liste için ErrorsSorted kullanılır.
 func (r *ValidationResult) Errors() map[string][]string {
 	return r.errors
 }
 
+// FieldMessages, bir alanın mesajlarını sıralı listelerde taşır
+// (bkz. ErrorsSorted).
+type FieldMessages struct {
+	// Field, mesajların ait olduğu alan yoludur.
+	Field string `json:"field"`
+
+	// Messages, alanın mesajlarıdır (eklendikleri sırayla).
+	Messages []string `json:"messages"`
+}
+
+// ErrorsSorted
+// -----------------------------------------------------------------------------
+// Hataları deterministik sırada bir liste olarak döndürür. Errors() bir map
+// olduğundan dolaşım sırası her çalıştırmada değişebilir; API yanıtları,
+// loglar ve golden testler için bu metot kullanılmalıdır.
+//
+// order verilmezse alanlar alfabetik sıralanır. order verilirse (örn. şemanın
+// tanımlandığı sıra) alanlar bu sırayla yazılır: iç içe yollar ("address.city",
+// "items[2]") kök alanın konumunu alır, order'da bulunmayan alanlar sona
+// alfabetik olarak eklenir. Go map literal'leri tanım sırasını korumadığı için
+// şema sırası order ile açıkça verilmelidir.
+//
+// Örnek:
+//
+//	for _, e := range result.ErrorsSorted("name", "email", "address") {
+//	    fmt.Println(e.Field, e.Messages)
+//	}
+func (r *ValidationResult) ErrorsSorted(order ...string) []FieldMessages {
+	fields := sortedFields(r.errors)
+	if len(order) > 0 {
+		rank := make(map[string]int, len(order))
+		for i, field := range order {
+			if _, ok := rank[field]; !ok {
+				rank[field] = i
+			}
+		}
+		position := func(field string) int {
+			if i, ok := rank[rootField(field)]; ok {
+				return i
+			}
+			return len(order)
+		}
+		sort.SliceStable(fields, func(i, j int) bool {
+			return position(fields[i]) < position(fields[j])
+		})
+	}
+
+	sorted := make([]FieldMessages, 0, len(fields))
+	for _, field := range fields {
+		sorted = append(sorted, FieldMessages{Field: field, Messages: r.errors[field]})
+	}
+	return sorted
+}
+
+// rootField, "address.city" veya "items[2]" gibi bir yolun kök alan adını
+// döndürür.
+func rootField(path string) string {
+	if i := strings.IndexAny(path, ".["); i > 0 {
+		return path[:i]
+	}
+	return path
+}
+
+// Failures
+// -----------------------------------------------------------------------------
+// Tüm hataları alan, kural, mesaj ve (varsa) parametre bilgisiyle birlikte
+// düz bir liste olarak döndürür. Kuralı bilinmeyen hatalar "custom" olarak
+// raporlanır. Sıralama alan adına göredir.
+func (r *ValidationResult) Failures() []Failure {
+	fields := make([]string, 0, len(r.errors))
+	for field := range r.errors {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	var failures []Failure
+	for _, field := range fields {
+		for i, msg := range r.errors[field] {
+			rule := "custom"
+			if i < len(r.rules[field]) && r.rules[field][i] != "" {
+				rule = r.rules[field][i]
+			}
+			var params map[string]any
+			if i < len(r.messages[field]) && r.messages[field][i] != nil {
+				params = r.messages[field][i].params()
+			}
+			failures = append(failures, Failure{Field: field, Rule: rule, Message: msg, Params: params})
+		}
+	}
+	return failures
+}
+
+// LimitErrors
+// -----------------------------------------------------------------------------
+// Sonuçta n'den fazla hata varsa Failures sırasıyla ilk n hatayı tutar ve
+// kalanları atar. n 0 veya negatifse sonuç değişmez. Hata atıldıysa true
+// döner.
+func (r *ValidationResult) LimitErrors(n int) bool {
+	if n <= 0 {
+		return false
+	}
+	kept, dropped := 0, false
+	for _, field := range sortedFields(r.errors) {
+		keep := min(len(r.errors[field]), n-kept)
+		if keep < len(r.errors[field]) {
+			dropped = true
+		}
+		if keep == 0 {
+			delete(r.errors, field)
+			delete(r.rules, field)
+			delete(r.messages, field)
+			continue
+		}
+		r.errors[field] = r.errors[field][:keep]
+		r.rules[field] = r.rules[field][:min(keep, len(r.rules[field]))]
+		r.messages[field] = r.messages[field][:min(keep, len(r.messages[field]))]
+		kept += keep
+	}
+	return dropped
+}
+
 // ValidData
 // -----------------------------------------------------------------------------
 // Geçerli (doğrulanmış ve dönüştürülmüş) veri setini döndürür.
@@ -95,3 +404,211 @@ func (r *ValidationResult) ValidData() map[string]any {
 func (r *ValidationResult) SetValidData(data map[string]any) {
 	r.validData = data
 }
+
+// AddWarning
+// -----------------------------------------------------------------------------
+// Alana doğrulamayı engellemeyen bir uyarı ekler. SeverityWarning seviyesindeki
+// alanların ihlalleri, politika engellemediği sürece buraya yazılır.
+func (r *ValidationResult) AddWarning(field, message string) {
+	r.warnings[field] = append(r.warnings[field], message)
+}
+
+// Warnings
+// -----------------------------------------------------------------------------
+// Alanlara göre gruplanmış uyarıları döndürür.
+func (r *ValidationResult) Warnings() map[string][]string {
+	return r.warnings
+}
+
+// HasWarnings
+// -----------------------------------------------------------------------------
+// En az bir uyarı olup olmadığını kontrol eder.
+func (r *ValidationResult) HasWarnings() bool {
+	return len(r.warnings) > 0
+}
+
+// AddInfo
+// -----------------------------------------------------------------------------
+// Alana bilgilendirme amaçlı bir bildirim ekler.
+func (r *ValidationResult) AddInfo(field, message string) {
+	r.infos[field] = append(r.infos[field], message)
+}
+
+// Infos
+// -----------------------------------------------------------------------------
+// Alanlara göre gruplanmış bilgilendirme bildirimlerini döndürür.
+func (r *ValidationResult) Infos() map[string][]string {
+	return r.infos
+}
+
+// AddIssue
+// -----------------------------------------------------------------------------
+// Mesajı seviyesine göre hata, uyarı veya bilgi olarak ekler.
+func (r *ValidationResult) AddIssue(severity Severity, field, message string) {
+	switch severity {
+	case SeverityInfo:
+		r.AddInfo(field, message)
+	case SeverityWarning:
+		r.AddWarning(field, message)
+	default:
+		r.AddError(field, message)
+	}
+}
+
+// TransformedData
+// -----------------------------------------------------------------------------
+// Dönüşüm zincirinden (trim, varsayılan değerler, tip dönüşümleri vb.) geçmiş
+// girdiyi, hata olup olmadığından bağımsız olarak döndürür. ValidData'dan
+// farklı olarak doğrulama başarısız olduğunda da doludur; hata ekranlarında
+// normalize edilmiş değerleri kullanıcıya geri göstermek için kullanılır.
+//
+// Dönüşümü başarısız olan alanlar bu veride yer almaz.
+func (r *ValidationResult) TransformedData() map[string]any {
+	return r.transformedData
+}
+
+// SetTransformedData
+// -----------------------------------------------------------------------------
+// Dönüşüm sonrası veriyi atar. Şema doğrulaması sırasında çağrılır.
+func (r *ValidationResult) SetTransformedData(data map[string]any) {
+	r.transformedData = data
+}
+
+// SetElements
+// -----------------------------------------------------------------------------
+// Bir dizi alanının indeks bazlı eleman sonuçlarını kaydeder. ArrayType
+// tarafından eleman şeması doğrulanırken çağrılır.
+func (r *ValidationResult) SetElements(field string, elements []ElementResult) {
+	r.elements[field] = elements
+}
+
+// Elements
+// -----------------------------------------------------------------------------
+// Bir dizi alanının indeks bazlı eleman sonuçlarını döndürür. Alan bir dizi
+// değilse veya eleman şeması tanımlı değilse nil döner.
+func (r *ValidationResult) Elements(field string) []ElementResult {
+	return r.elements[field]
+}
+
+// ValidDataAt
+// -----------------------------------------------------------------------------
+// Dizi alanındaki index numaralı elemanın dönüştürülmüş değerini, yalnızca
+// eleman hatasız doğrulandıysa döndürür. Dizinin geri kalanındaki hatalar bu
+// elemanı etkilemez.
+//
+// Örnek:
+//
+//	for i := range rows {
+//	    if user, ok := result.ValidDataAt("users", i); ok {
+//	        importUser(user)
+//	    }
+//	}
+func (r *ValidationResult) ValidDataAt(field string, index int) (any, bool) {
+	elements := r.elements[field]
+	if index < 0 || index >= len(elements) || !elements[index].Valid() {
+		return nil, false
+	}
+	return elements[index].Value, true
+}
+
+// ValidElements
+// -----------------------------------------------------------------------------
+// Dizi alanındaki hatasız elemanların dönüştürülmüş değerlerini sırasıyla
+// döndürür.
+func (r *ValidationResult) ValidElements(field string) []any {
+	var valid []any
+	for _, element := range r.elements[field] {
+		if element.Valid() {
+			valid = append(valid, element.Value)
+		}
+	}
+	return valid
+}
+
+// SetOldInput
+// -----------------------------------------------------------------------------
+// Formu yeniden doldurmak için saklanacak ham girdiyi atar. Şema, başarısız
+// doğrulamalarda hassas alanları çıkararak bunu otomatik çağırır.
+func (r *ValidationResult) SetOldInput(data map[string]any) {
+	r.oldInput = data
+}
+
+// OldInput
+// -----------------------------------------------------------------------------
+// Başarısız bir doğrulamada gönderilen ham (dönüştürülmemiş) değerleri
+// döndürür. Sunucu tarafında render edilen HTML formlarını, Laravel'in old()
+// yardımcısına benzer şekilde kullanıcının girdiği değerlerle yeniden doldurmak
+// için kullanılır. Şifre gibi hassas alanlar eklenmez. Doğrulama başarılıysa nil
+// döner.
+func (r *ValidationResult) OldInput() map[string]any {
+	return r.oldInput
+}
+
+// OldValue
+// -----------------------------------------------------------------------------
+// Alanın eski girdisini şablonlarda doğrudan kullanılabilecek string olarak
+// döndürür. Alan yoksa, nil ise veya hassassa boş string döner. İç içe alanlar
+// nokta ile belirtilir (örn: "address.city").
+//
+// Örnek:
+//
+//	<input name="email" value="{{.Result.OldValue "email"}}">
+func (r *ValidationResult) OldValue(field string) string {
+	var value any = r.oldInput
+	for _, part := range strings.Split(field, ".") {
+		m, ok := value.(map[string]any)
+		if !ok {
+			return ""
+		}
+		value = m[part]
+	}
+	if value == nil {
+		return ""
+	}
+	if _, nested := value.(map[string]any); nested {
+		return ""
+	}
+	return fmt.Sprint(value)
+}
+
+// Merge
+// -----------------------------------------------------------------------------
+// other içindeki hataları, uyarıları ve eleman sonuçlarını bu sonuca ekler. ValidData
+// birleştirilmez; geçerli verinin belirlenmesi çağırana bırakılır.
+func (r *ValidationResult) Merge(other *ValidationResult) {
+	for field, msgs := range other.errors {
+		r.errors[field] = append(r.errors[field], msgs...)
+		r.rules[field] = append(r.rules[field], other.rules[field]...)
+		r.messages[field] = append(r.messages[field], other.messages[field]...)
+	}
+	for field, msgs := range other.warnings {
+		r.warnings[field] = append(r.warnings[field], msgs...)
+	}
+	for field, msgs := range other.infos {
+		r.infos[field] = append(r.infos[field], msgs...)
+	}
+	for field, elements := range other.elements {
+		r.elements[field] = elements
+	}
+}
+
+// MergePrefixed, Merge gibi çalışır ancak other'daki alan adlarının başına
+// "prefix." ekler (örn. "email" → "body.email"). Birden fazla kaynaktan
+// gelen sonuçları tek sonuçta toplamak için kullanılır.
+func (r *ValidationResult) MergePrefixed(prefix string, other *ValidationResult) {
+	key := func(field string) string { return prefix + "." + field }
+	for field, msgs := range other.errors {
+		r.errors[key(field)] = append(r.errors[key(field)], msgs...)
+		r.rules[key(field)] = append(r.rules[key(field)], other.rules[field]...)
+		r.messages[key(field)] = append(r.messages[key(field)], other.messages[field]...)
+	}
+	for field, msgs := range other.warnings {
+		r.warnings[key(field)] = append(r.warnings[key(field)], msgs...)
+	}
+	for field, msgs := range other.infos {
+		r.infos[key(field)] = append(r.infos[key(field)], msgs...)
+	}
+	for field, elements := range other.elements {
+		r.elements[key(field)] = elements
+	}
+}
@@ -0,0 +1,87 @@
+package core
+
+//
+// -----------------------------------------------------------------------------
+// Hata Önem Seviyeleri (Severity)
+// -----------------------------------------------------------------------------
+// Bu dosya, alan kurallarının "error", "warning" veya "info" seviyesiyle
+// işaretlenebilmesi için Severity tipini ve hangi seviyenin doğrulamayı
+// engelleyeceğine karar veren SeverityPolicy kancasını içerir.
+//
+// Yeni bir kural önce "warning" seviyesinde yayına alınıp etkisi izlenebilir;
+// ortam bazlı politika ile (örn: prod'da katı, staging'de esnek) aynı şema
+// farklı davranabilir.
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// Severity, bir alan kuralının ihlal edildiğinde taşıdığı önem seviyesidir.
+type Severity string
+
+const (
+	// SeverityError, doğrulamayı başarısız kılan varsayılan seviyedir.
+	SeverityError Severity = "error"
+
+	// SeverityWarning, varsayılan politikada doğrulamayı engellemeyen uyarıdır.
+	SeverityWarning Severity = "warning"
+
+	// SeverityInfo, yalnızca bilgilendirme amaçlı bildirimdir.
+	SeverityInfo Severity = "info"
+)
+
+// rank, seviyeleri karşılaştırmak için sayısal sıra döndürür.
+func (s Severity) rank() int {
+	switch s {
+	case SeverityInfo:
+		return 0
+	case SeverityWarning:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// SeverityPolicy, verilen seviyedeki ihlallerin doğrulamayı engelleyip
+// engellemeyeceğine (hata olarak raporlanıp raporlanmayacağına) karar verir.
+type SeverityPolicy func(severity Severity) bool
+
+// DefaultSeverityPolicy, yalnızca SeverityError seviyesinin engellediği
+// varsayılan politikadır.
+func DefaultSeverityPolicy(severity Severity) bool {
+	return severity.rank() >= SeverityError.rank()
+}
+
+// BlockAtOrAbove
+// -----------------------------------------------------------------------------
+// min seviyesi ve üzerindeki ihlallerin engellediği bir politika döndürür.
+//
+// Örnek:
+//
+//	policy := core.DefaultSeverityPolicy
+//	if env == "production" {
+//	    policy = core.BlockAtOrAbove(core.SeverityWarning)
+//	}
+func BlockAtOrAbove(min Severity) SeverityPolicy {
+	return func(severity Severity) bool {
+		return severity.rank() >= min.rank()
+	}
+}
+
+// SeverityAware, önem seviyesi tanımlanabilen tiplerin uyguladığı arayüzdür.
+// BaseType'ı embed eden tüm tipler bu arayüzü otomatik olarak sağlar.
+type SeverityAware interface {
+	GetSeverity() Severity
+}
+
+// SeverityOf, tipin önem seviyesini döndürür. Tip seviye bildirmiyorsa
+// SeverityError kabul edilir.
+func SeverityOf(t Type) Severity {
+	if aware, ok := t.(SeverityAware); ok {
+		return aware.GetSeverity()
+	}
+	return SeverityError
+}
@@ -0,0 +1,372 @@
+package validation
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/biyonik/go-fluent-validator/core"
+)
+
+//
+// -----------------------------------------------------------------------------
+// Veri Kaynağı (DataSource) Adaptörleri
+// -----------------------------------------------------------------------------
+// Bu dosya, doğrulama mantığını somut map[string]any gösteriminden ayırmak
+// için core.DataSource arayüzünün hazır adaptörlerini içerir:
+//
+//   - MapSource:    map[string]any (noktalı yol desteğiyle: "address.city")
+//   - FormSource:   url.Values (form / query string)
+//   - HeaderSource: http.Header (büyük/küçük harf duyarsız)
+//   - StructSource: Herhangi bir struct (json etiketleri veya alan adlarıyla)
+//   - ProtoSource:  protoc ile üretilmiş mesajlar (protobuf etiketleriyle)
+//
+// Struct ve protobuf adaptörleri iç içe struct'ları map[string]any'ye,
+// slice'ları []any'ye çevirir; böylece Object() ve Array() tipleri bu
+// verileri doğrudan doğrulayabilir. Protobuf desteği herhangi bir harici
+// bağımlılık gerektirmez; üretilmiş kodun struct etiketleri okunur.
+//
+// Metadata:
+// @author    Ahmet ALTUN
+// @github    github.com/biyonik
+// @linkedin  linkedin.com/in/biyonik
+// @email     ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// ValidateSource
+// -----------------------------------------------------------------------------
+// Şemayı bir core.DataSource üzerinden doğrular. Şemadaki (ve When(...)
+// alt şemalarındaki) alanlar kaynaktan okunur, ardından normal Validate
+// akışı çalıştırılır.
+//
+// Örnek:
+//
+//	res := schema.ValidateSource(validation.FormSource(r.PostForm))
+func (vs *ValidationSchema) ValidateSource(src core.DataSource) *core.ValidationResult {
+	return vs.Validate(vs.collect(src))
+}
+
+// ValidateValues
+// -----------------------------------------------------------------------------
+// Query string veya form değerlerini şemadaki tiplere çevirerek doğrular
+// (bkz. core.CoerceValues): number alanlara "42", boolean alanlara "true",
+// dizi alanlara "?id=1&id=2" veya "?id=1,2" verilebilir; tarihler alanın
+// biçimiyle ayrıştırılır. FormSource'tan farklı olarak değerler string
+// olarak bırakılmaz.
+//
+// Örnek:
+//
+//	res := schema.ValidateValues(r.URL.Query())
+func (vs *ValidationSchema) ValidateValues(values url.Values) *core.ValidationResult {
+	return vs.Validate(core.CoerceValues(values, vs.Describe().Fields))
+}
+
+// collect, şemanın ihtiyaç duyduğu alanları kaynaktan okuyarak bir map'e toplar.
+func (vs *ValidationSchema) collect(src core.DataSource) map[string]any {
+	data := make(map[string]any, len(vs.shape))
+	fetch := func(field string) {
+		if _, done := data[field]; done {
+			return
+		}
+		if value, ok := src.Get(field); ok {
+			data[field] = value
+		}
+	}
+
+	for field := range vs.shape {
+		fetch(field)
+	}
+	for _, rule := range vs.conditionalRules {
+		fetch(rule.field)
+		if provider, ok := rule.callback().(core.ShapeProvider); ok {
+			for field := range provider.GetShape() {
+				fetch(field)
+			}
+		}
+	}
+
+	return data
+}
+
+// -----------------------------------------------------------------------------
+// MapSource
+// -----------------------------------------------------------------------------
+
+// mapSource, map[string]any için DataSource adaptörüdür.
+type mapSource map[string]any
+
+// MapSource
+// -----------------------------------------------------------------------------
+// map[string]any verisini DataSource olarak sarmalar. Anahtar doğrudan
+// bulunamazsa yol noktalarla bölünerek iç içe map'lerde aranır.
+func MapSource(data map[string]any) core.DataSource {
+	return mapSource(data)
+}
+
+// Get, core.DataSource implementasyonu.
+func (m mapSource) Get(path string) (any, bool) {
+	if value, ok := m[path]; ok {
+		return value, true
+	}
+
+	parts := strings.Split(path, ".")
+	var current any = map[string]any(m)
+	for _, part := range parts {
+		node, ok := current.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		if current, ok = node[part]; !ok {
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+// -----------------------------------------------------------------------------
+// FormSource / HeaderSource
+// -----------------------------------------------------------------------------
+
+// formSource, url.Values için DataSource adaptörüdür.
+type formSource url.Values
+
+// FormSource
+// -----------------------------------------------------------------------------
+// url.Values (form gövdesi veya query string) verisini DataSource olarak
+// sarmalar. Tek değerli alanlar string, çok değerli alanlar []any döner.
+func FormSource(values url.Values) core.DataSource {
+	return formSource(values)
+}
+
+// Get, core.DataSource implementasyonu.
+func (f formSource) Get(path string) (any, bool) {
+	values, ok := f[path]
+	if !ok {
+		return nil, false
+	}
+	return stringsValue(values), true
+}
+
+// headerSource, http.Header için DataSource adaptörüdür.
+type headerSource http.Header
+
+// HeaderSource
+// -----------------------------------------------------------------------------
+// http.Header verisini DataSource olarak sarmalar. Anahtarlar büyük/küçük
+// harf duyarsızdır ("x-request-id" == "X-Request-Id").
+func HeaderSource(header http.Header) core.DataSource {
+	return headerSource(header)
+}
+
+// Get, core.DataSource implementasyonu.
+func (h headerSource) Get(path string) (any, bool) {
+	values, ok := h[http.CanonicalHeaderKey(path)]
+	if !ok {
+		return nil, false
+	}
+	return stringsValue(values), true
+}
+
+// stringsValue, tek elemanlı listeyi string'e, diğerlerini []any'ye çevirir.
+func stringsValue(values []string) any {
+	if len(values) == 1 {
+		return values[0]
+	}
+	items := make([]any, len(values))
+	for i, v := range values {
+		items[i] = v
+	}
+	return items
+}
+
+// -----------------------------------------------------------------------------
+// StructSource / ProtoSource
+// -----------------------------------------------------------------------------
+
+// structSource, reflection ile struct alanlarını okuyan DataSource adaptörüdür.
+type structSource struct {
+	data map[string]any
+}
+
+// StructSource
+// -----------------------------------------------------------------------------
+// Bir struct'ı (veya struct pointer'ını) DataSource olarak sarmalar. Alan
+// adları json etiketlerinden, etiket yoksa Go alan adından alınır;
+// json:"-" ile işaretlenen ve dışa açık olmayan alanlar atlanır.
+//
+// Örnek:
+//
+//	type SignupRequest struct {
+//	    Email string `json:"email"`
+//	    Age   int    `json:"age"`
+//	}
+//	res := schema.ValidateSource(validation.StructSource(req))
+func StructSource(v any) core.DataSource {
+	data, _ := normalizeValue(reflect.ValueOf(v), jsonFieldName).(map[string]any)
+	return structSource{data: data}
+}
+
+// ProtoSource
+// -----------------------------------------------------------------------------
+// protoc-gen-go ile üretilmiş bir mesajı DataSource olarak sarmalar. Alan
+// adları protobuf etiketindeki name= değerinden (örn: "user_name") alınır;
+// Get ayrıca json= (lowerCamelCase) adını da kabul eder. oneof alanlarında
+// seçili alanın değeri kendi adıyla okunabilir. Protobuf kütüphanesine
+// bağımlılık yoktur; üretilmiş kodun struct etiketleri kullanılır.
+func ProtoSource(msg any) core.DataSource {
+	data, _ := normalizeValue(reflect.ValueOf(msg), protoFieldName).(map[string]any)
+	return structSource{data: data}
+}
+
+// Get, core.DataSource implementasyonu. Noktalı yollar desteklenir.
+func (s structSource) Get(path string) (any, bool) {
+	return mapSource(s.data).Get(path)
+}
+
+// fieldNamer, bir struct alanının DataSource içindeki adlarını döndürür.
+// İlk ad birincil addır; sonraki adlar takma ad olarak eklenir. Boş liste
+// alanın atlanacağını belirtir.
+type fieldNamer func(field reflect.StructField) []string
+
+// jsonFieldName, json etiketine göre alan adını belirler.
+func jsonFieldName(field reflect.StructField) []string {
+	tag := field.Tag.Get("json")
+	if tag == "-" {
+		return nil
+	}
+	if name, _, _ := strings.Cut(tag, ","); name != "" {
+		return []string{name}
+	}
+	return []string{field.Name}
+}
+
+// protoFieldName, protobuf etiketindeki name= ve json= değerlerini döndürür.
+// Protobuf etiketi olmayan dışa açık alanlar json kuralına göre adlandırılır.
+func protoFieldName(field reflect.StructField) []string {
+	tag, ok := field.Tag.Lookup("protobuf")
+	if !ok {
+		return jsonFieldName(field)
+	}
+
+	var names []string
+	for _, part := range strings.Split(tag, ",") {
+		if name, found := strings.CutPrefix(part, "name="); found {
+			names = append([]string{name}, names...)
+		} else if name, found := strings.CutPrefix(part, "json="); found {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return []string{field.Name}
+	}
+	return names
+}
+
+// normalizeValue
+// -----------------------------------------------------------------------------
+// Reflection ile okunan değeri doğrulama tiplerinin beklediği biçime çevirir:
+// struct → map[string]any, slice/array → []any, map → map[string]any,
+// pointer/interface → gösterilen değer, işaretsiz tamsayı → float64.
+// time.Time ve []byte olduğu gibi bırakılır.
+func normalizeValue(rv reflect.Value, namer fieldNamer) any {
+	for rv.Kind() == reflect.Pointer || rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			return nil
+		}
+		rv = rv.Elem()
+	}
+	if !rv.IsValid() {
+		return nil
+	}
+
+	if t, ok := rv.Interface().(time.Time); ok {
+		return t
+	}
+
+	switch rv.Kind() {
+	case reflect.Struct:
+		out := make(map[string]any, rv.NumField())
+		normalizeStruct(rv, namer, out)
+		return out
+
+	case reflect.Slice, reflect.Array:
+		if rv.Kind() == reflect.Slice && rv.IsNil() {
+			return nil
+		}
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			return rv.Bytes()
+		}
+		items := make([]any, rv.Len())
+		for i := range items {
+			items[i] = normalizeValue(rv.Index(i), namer)
+		}
+		return items
+
+	case reflect.Map:
+		if rv.IsNil() {
+			return nil
+		}
+		out := make(map[string]any, rv.Len())
+		iter := rv.MapRange()
+		for iter.Next() {
+			out[fmt.Sprint(iter.Key().Interface())] = normalizeValue(iter.Value(), namer)
+		}
+		return out
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(rv.Uint())
+	}
+
+	return rv.Interface()
+}
+
+// normalizeStruct, struct alanlarını namer ile belirlenen adlarla out'a yazar.
+// Gömülü (embedded) struct alanları üst seviyeye açılır; protobuf oneof
+// sarmalayıcılarında seçili alan kendi adıyla eklenir.
+func normalizeStruct(rv reflect.Value, namer fieldNamer, out map[string]any) {
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		value := rv.Field(i)
+
+		if _, isOneof := field.Tag.Lookup("protobuf_oneof"); isOneof {
+			if value.IsNil() {
+				continue
+			}
+			wrapper := value.Elem()
+			for wrapper.Kind() == reflect.Pointer {
+				wrapper = wrapper.Elem()
+			}
+			if wrapper.Kind() == reflect.Struct {
+				normalizeStruct(wrapper, namer, out)
+			}
+			continue
+		}
+
+		if field.Anonymous && field.Tag.Get("json") == "" {
+			embedded := value
+			for embedded.Kind() == reflect.Pointer && !embedded.IsNil() {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				normalizeStruct(embedded, namer, out)
+				continue
+			}
+		}
+
+		names := namer(field)
+		if len(names) == 0 {
+			continue
+		}
+		normalized := normalizeValue(value, namer)
+		for _, name := range names {
+			out[name] = normalized
+		}
+	}
+}
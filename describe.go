@@ -0,0 +1,134 @@
+package validation
+
+import (
+	"strconv"
+
+	"github.com/biyonik/go-fluent-validator/core"
+)
+
+//
+// -----------------------------------------------------------------------------
+// Şema Tanımı (Describe / Explain)
+// -----------------------------------------------------------------------------
+// Bu dosya, ValidationSchema'nın doğrulama çalıştırmadan kendi yapısını
+// (alanlar, kurallar, parametreler, koşullu dallar) raporlamasını sağlar.
+// Üretilen tanım JSON'a serileştirilebilir ve otomatik API dokümantasyonu,
+// yönetim ekranları veya kontrat testleri için kullanılabilir.
+//
+// Metadata:
+// @author    Ahmet ALTUN
+// @github    github.com/biyonik
+// @linkedin  linkedin.com/in/biyonik
+// @email     ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// Describe
+// -----------------------------------------------------------------------------
+// Şemadaki her alanın tipini, kurallarını ve parametrelerini, ayrıca When(...)
+// ile eklenen koşullu dalları yapısal olarak döndürür. Doğrulama çalıştırılmaz.
+//
+// Dönüş:
+//   - *core.SchemaDescription
+//
+// Örnek:
+//
+//	desc := schema.Describe()
+//	out, _ := json.MarshalIndent(desc, "", "  ")
+//	fmt.Println(string(out))
+func (vs *ValidationSchema) Describe() *core.SchemaDescription {
+	return vs.describe(nil)
+}
+
+// describe, Describe'ın Ref açma yığınını taşıyan hâlidir; stack, açılmakta
+// olan kayıtlı şemaların adlarıdır.
+func (vs *ValidationSchema) describe(stack []string) *core.SchemaDescription {
+	desc := &core.SchemaDescription{
+		Fields: make(map[string]*core.TypeDescription, len(vs.shape)),
+	}
+
+	for field, typ := range vs.shape {
+		desc.Fields[field] = core.DescribeType(typ)
+		expandLazy(typ, desc.Fields[field], nil)
+		expandRefs(desc.Fields[field], stack)
+	}
+
+	for _, rule := range vs.conditionalRules {
+		cond := core.ConditionalDescription{
+			Field:  rule.field,
+			Equals: rule.expectedValue,
+		}
+		if sub := rule.callback(); sub != nil {
+			cond.Schema = describeSchema(sub, stack)
+		}
+		desc.Conditionals = append(desc.Conditionals, cond)
+	}
+
+	for _, rule := range vs.featureRules {
+		feature := core.FeatureDescription{Feature: rule.feature}
+		if sub := rule.callback(); sub != nil {
+			feature.Schema = describeSchema(sub, stack)
+		}
+		desc.Features = append(desc.Features, feature)
+	}
+
+	desc.Compositions = vs.describeCompositions(stack)
+
+	for _, rule := range vs.transitionRules {
+		desc.Rules = append(desc.Rules, core.RuleDescription{
+			Name: "transition",
+			Params: map[string]any{
+				"field":       rule.field,
+				"transitions": rule.transitions,
+			},
+		})
+	}
+
+	for _, cv := range vs.crossValidators {
+		if cv.rule == "" {
+			desc.CrossValidators++
+			continue
+		}
+		var params map[string]any
+		switch cv.rule {
+		case "require_any_of", "require_all_or_none":
+			params = map[string]any{"fields": append([]string(nil), cv.fields...)}
+		default:
+			params = map[string]any{"field": cv.fields[0]}
+			if len(cv.fields) > 1 {
+				params["other"] = cv.fields[1]
+			}
+		}
+		desc.Rules = append(desc.Rules, core.RuleDescription{Name: cv.rule, Params: params})
+	}
+
+	if vs.autoTrim {
+		desc.Options = append(desc.Options, "auto_trim")
+	}
+	if vs.strict {
+		desc.Options = append(desc.Options, "strict")
+	}
+	if vs.coerce {
+		desc.Options = append(desc.Options, "coerce")
+	}
+	if vs.locale != "" {
+		desc.Options = append(desc.Options, "locale:"+vs.locale)
+	}
+	if vs.maxErrors > 0 {
+		desc.Options = append(desc.Options, "max_errors:"+strconv.Itoa(vs.maxErrors))
+	}
+
+	return desc
+}
+
+// describeSchema, alt şemayı tanımlar; alt şema bir ValidationSchema ise Ref
+// açma yığını korunur. Kendini tanımlayamayan (core.SchemaDescriber
+// sağlamayan) şemalar için boş tanım döner.
+func describeSchema(schema core.Schema, stack []string) *core.SchemaDescription {
+	switch s := schema.(type) {
+	case *ValidationSchema:
+		return s.describe(stack)
+	case core.SchemaDescriber:
+		return s.Describe()
+	}
+	return &core.SchemaDescription{}
+}
@@ -0,0 +1,174 @@
+package validation
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/biyonik/go-fluent-validator/core"
+	"github.com/biyonik/go-fluent-validator/i18n"
+)
+
+//
+// -----------------------------------------------------------------------------
+// Ayrık Birleşim (Discriminated Union) Şemaları
+// -----------------------------------------------------------------------------
+// Webhook olay tipleri, ödeme aracı türleri gibi çok biçimli (polymorphic)
+// payload'lar için, bir ayırıcı alanın (discriminator) değerine göre doğru alt
+// şemayı seçen Discriminated yapıcısını içerir.
+//
+// Her varyant, ayırıcı alan üzerinde bir When(...) dalı olarak eklenir; bu
+// sayede Session, ValidateSource ve Describe gibi şema özellikleri varyantlarla
+// da çalışır. Ayırıcı alan eksikse zorunluluk hatası, tanımsız bir değer
+// içeriyorsa izin verilen değerleri listeleyen açık bir hata üretilir.
+// DiscriminatedUnion, string dışındaki (sayı, bool) ayırıcı değerlerini de
+// kabul eder.
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// Discriminated
+// -----------------------------------------------------------------------------
+// field alanının değerine göre variants içinden seçilen şemayla doğrulama
+// yapan bir şema oluşturur. Tüm varyantlarda ortak olan alanlar dönen şemaya
+// Shape(...) ile eklenebilir; ayırıcı alan korunur.
+//
+// Parametreler:
+//   - field: Ayırıcı alan adı (örn: "kind", "type")
+//   - variants: Ayırıcı değeri → alt şema eşlemesi
+//   - opts: Make ile aynı şema seçenekleri
+//
+// Örnek:
+//
+//	schema := validation.Discriminated("kind", map[string]validation.Schema{
+//	    "card": validation.Make().Shape(map[string]validation.Type{
+//	        "number": validation.CreditCard().Required(),
+//	    }),
+//	    "iban": validation.Make().Shape(map[string]validation.Type{
+//	        "iban": validation.Iban().Required(),
+//	    }),
+//	})
+//	schema.Shape(map[string]validation.Type{
+//	    "amount": validation.Number().Positive().Required(),
+//	})
+func Discriminated(field string, variants map[string]core.Schema, opts ...SchemaOption) *ValidationSchema {
+	byValue := make(map[any]core.Schema, len(variants))
+	for kind, variant := range variants {
+		byValue[kind] = variant
+	}
+	return DiscriminatedUnion(field, byValue, opts...)
+}
+
+// DiscriminatedUnion
+// -----------------------------------------------------------------------------
+// Discriminated gibidir; ayırıcı değerleri string dışında sayı veya bool da
+// olabilir ("version": 2 gibi zarf alanları için). Sayılar tipten bağımsız
+// karşılaştırılır (JSON'dan gelen float64(2), int(2) anahtarıyla eşleşir) ve
+// ValidData'ya anahtardaki haliyle yazılır.
+//
+// Örnek:
+//
+//	schema := validation.DiscriminatedUnion("version", map[any]validation.Schema{
+//	    1: v1Schema,
+//	    2: v2Schema,
+//	})
+func DiscriminatedUnion(field string, variants map[any]core.Schema, opts ...SchemaOption) *ValidationSchema {
+	vs := Make(opts...)
+
+	kinds := make([]any, 0, len(variants))
+	for kind := range variants {
+		kinds = append(kinds, kind)
+	}
+	sort.Slice(kinds, func(i, j int) bool {
+		if cmp, ok := core.CompareValues(kinds[i], kinds[j]); ok {
+			return cmp < 0
+		}
+		return fmt.Sprint(kinds[i]) < fmt.Sprint(kinds[j])
+	})
+
+	vs.discriminator = field
+	vs.shape[field] = &discriminatorType{kinds: kinds}
+	for _, kind := range kinds {
+		variant := variants[kind]
+		vs.When(field, kind, func() core.Schema { return variant })
+	}
+	return vs
+}
+
+// discriminatorType, Discriminated şemalarında ayırıcı alanı doğrulayan tiptir.
+// Değerin zorunlu olmasını ve tanımlı varyantlardan biri olmasını sağlar.
+type discriminatorType struct {
+	core.BaseType
+	kinds []any
+}
+
+// kind, value'ya anlamsal olarak eşit olan ayırıcı değerini döndürür.
+func (d *discriminatorType) kind(value any) (any, bool) {
+	for _, k := range d.kinds {
+		if core.ValuesEqual(k, value) {
+			return k, true
+		}
+	}
+	return nil, false
+}
+
+// Transform, ayırıcı değeri tanımlı anahtarın kendisine çevirir; böylece
+// When dalları JSON'dan gelen sayılarla da eşleşir.
+func (d *discriminatorType) Transform(value any) (any, error) {
+	value, err := d.BaseType.Transform(value)
+	if err != nil || value == nil {
+		return value, err
+	}
+	if kind, ok := d.kind(value); ok {
+		return kind, nil
+	}
+	return value, nil
+}
+
+// Validate, ayırıcı değerin varlığını ve tanımlı olup olmadığını doğrular.
+func (d *discriminatorType) Validate(field string, value any, result *core.ValidationResult) {
+	if value == nil || value == "" {
+		result.AddRuleError(field, i18n.KeyRequired, field)
+		return
+	}
+	if _, ok := d.kind(value); ok {
+		return
+	}
+	values := i18n.ValueList(d.kinds)
+	result.AddRuleError(field, i18n.KeyDiscriminator, field, values, value, i18n.Attrs{"values": values})
+}
+
+// Introspect, ayırıcı alanı izin verilen değerleriyle birlikte tanımlar.
+// String olmayan ayırıcılar ilk değerin tipiyle ve değer listesi olmadan
+// tanımlanır; değerler When dallarının tanımlarında yer alır.
+func (d *discriminatorType) Introspect() *core.TypeDescription {
+	values := make([]string, 0, len(d.kinds))
+	for _, k := range d.kinds {
+		if s, ok := k.(string); ok {
+			values = append(values, s)
+		}
+	}
+	if len(values) < len(d.kinds) {
+		desc := d.DescribeBase(discriminatorTypeName(d.kinds[0]))
+		desc.Required = true
+		return desc
+	}
+	desc := d.DescribeBase("string")
+	desc.Required = true
+	desc.AddRule("one_of", map[string]any{"values": values})
+	return desc
+}
+
+// discriminatorTypeName, string olmayan bir ayırıcı değerin tip adıdır.
+func discriminatorTypeName(kind any) string {
+	if _, ok := kind.(bool); ok {
+		return "boolean"
+	}
+	if _, ok := core.ToFloat64(kind); ok {
+		return "number"
+	}
+	return "string"
+}
@@ -0,0 +1,89 @@
+// Package echovalidate, fluent şemaları Echo (github.com/labstack/echo/v4)
+// handler'larında kullanmak için adaptörler sağlar.
+//
+// Middleware, isteği httpvalidate.Validate ile doğrular; hata varsa zinciri
+// keserek 422 yanıtını httpvalidate.WriteErrors ile (Accept başlığına göre
+// JSON/XML/metin) yazar. Locale, Accept-Language başlığına göre hata
+// mesajlarının dilini seçer:
+//
+//	e := echo.New()
+//	e.Use(echovalidate.Locale(httpvalidate.SupportedLocales("en", "tr")))
+//	e.POST("/users", createUser, echovalidate.Middleware(createUserSchema))
+//
+//	func createUser(c echo.Context) error {
+//	    data := echovalidate.ValidData(c)
+//	    ...
+//	}
+//
+// Veri kaynağı seçenekleri (FromQuery, FromForm) httpvalidate ile aynıdır. Bu
+// paket, ana modülün standart kütüphane dışında bağımlılık almaması için ayrı
+// bir Go modülüdür.
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+package echovalidate
+
+import (
+	"github.com/biyonik/go-fluent-validator/core"
+	"github.com/biyonik/go-fluent-validator/httpvalidate"
+	"github.com/labstack/echo/v4"
+)
+
+// ValidDataKey, Middleware'in doğrulanmış veriyi echo.Context'e yazdığı
+// anahtardır.
+const ValidDataKey = "echovalidate.valid_data"
+
+// Bind
+// -----------------------------------------------------------------------------
+// İsteği schema ile doğrular. Hata varsa 422 yanıtını yazar ve false döner;
+// handler bu durumda nil döndürerek çıkmalıdır.
+//
+// Örnek:
+//
+//	data, ok := echovalidate.Bind(c, schema)
+//	if !ok {
+//	    return nil
+//	}
+func Bind(c echo.Context, schema core.Schema, opts ...httpvalidate.Option) (map[string]any, bool) {
+	result := httpvalidate.Validate(c.Request(), schema, opts...)
+	if result.HasErrors() {
+		_ = httpvalidate.WriteErrors(c.Response(), c.Request(), result)
+		return nil, false
+	}
+	return result.ValidData(), true
+}
+
+// Middleware
+// -----------------------------------------------------------------------------
+// Bind'ı route middleware'i olarak çalıştırır. Başarılı isteklerde doğrulanmış
+// veri ValidData ile (ayrıca httpvalidate.ValidData(c.Request()) ile)
+// okunabilir.
+func Middleware(schema core.Schema, opts ...httpvalidate.Option) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			data, ok := Bind(c, schema, opts...)
+			if !ok {
+				return nil
+			}
+			c.Set(ValidDataKey, data)
+			c.SetRequest(c.Request().WithContext(httpvalidate.ContextWithValidData(c.Request().Context(), data)))
+			return next(c)
+		}
+	}
+}
+
+// ValidData, Middleware'in doğruladığı veriyi döndürür. Middleware'den
+// geçmemiş isteklerde nil döner.
+func ValidData(c echo.Context) map[string]any {
+	data, _ := c.Get(ValidDataKey).(map[string]any)
+	return data
+}
+
+// Locale, httpvalidate.LocaleMiddleware'i Echo middleware'ine çevirir; seçilen
+// dil istek context'ine eklenir ve Bind/Middleware hataları bu dilde üretilir.
+func Locale(opts ...httpvalidate.LocaleOption) echo.MiddlewareFunc {
+	return echo.WrapMiddleware(httpvalidate.LocaleMiddleware(opts...))
+}
@@ -0,0 +1,75 @@
+// -----------------------------------------------------------------------------
+// Echo Adapter Tests
+// -----------------------------------------------------------------------------
+// Bu dosya, echovalidate paketindeki Bind, Middleware ve Locale adaptörlerini
+// test eder.
+//
+// Metadata:
+// @author   Ahmet ALTUN
+// @github   github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email    ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+package echovalidate_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	validation "github.com/biyonik/go-fluent-validator"
+	"github.com/biyonik/go-fluent-validator/echovalidate"
+	"github.com/biyonik/go-fluent-validator/httpvalidate"
+	"github.com/labstack/echo/v4"
+)
+
+// TestEcho_MiddlewareAndLocale tests schema validation, 422 responses and localization
+func TestEcho_MiddlewareAndLocale(t *testing.T) {
+	schema := validation.Make().Shape(map[string]validation.Type{
+		"email": validation.String().Required().Email(),
+		"page":  validation.Number().Integer().Min(1),
+	})
+
+	e := echo.New()
+	e.Use(echovalidate.Locale(httpvalidate.SupportedLocales("en", "tr"), httpvalidate.FallbackLocale("en")))
+	e.POST("/users", func(c echo.Context) error {
+		return c.String(http.StatusOK, echovalidate.ValidData(c)["email"].(string))
+	}, echovalidate.Middleware(schema))
+	e.GET("/users", func(c echo.Context) error {
+		data, ok := echovalidate.Bind(c, schema, httpvalidate.FromQuery())
+		if !ok {
+			return nil
+		}
+		if data["page"] != int64(2) {
+			return c.NoContent(http.StatusInternalServerError)
+		}
+		return c.NoContent(http.StatusNoContent)
+	})
+
+	do := func(req *http.Request) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/users", strings.NewReader(`{"email":"ada@example.com"}`))
+	if rec := do(req); rec.Code != http.StatusOK || rec.Body.String() != "ada@example.com" {
+		t.Errorf("valid body: code=%d body=%s", rec.Code, rec.Body.String())
+	}
+
+	req = httptest.NewRequest(http.MethodPost, "/users", strings.NewReader(`{"email":"nope"}`))
+	req.Header.Set("Accept-Language", "tr")
+	rec := do(req)
+	if rec.Code != http.StatusUnprocessableEntity || rec.Header().Get("Content-Language") != "tr" || !strings.Contains(rec.Body.String(), `"email"`) {
+		t.Errorf("invalid body: code=%d lang=%q body=%s", rec.Code, rec.Header().Get("Content-Language"), rec.Body.String())
+	}
+
+	if rec := do(httptest.NewRequest(http.MethodGet, "/users?email=ada@example.com&page=2", nil)); rec.Code != http.StatusNoContent {
+		t.Errorf("query source: code=%d body=%s", rec.Code, rec.Body.String())
+	}
+	if rec := do(httptest.NewRequest(http.MethodGet, "/users?page=0", nil)); rec.Code != http.StatusUnprocessableEntity {
+		t.Errorf("invalid query should return 422, got %d", rec.Code)
+	}
+}
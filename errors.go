@@ -1,6 +1,12 @@
 package validation
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+
+	"github.com/biyonik/go-fluent-validator/core"
+	"github.com/biyonik/go-fluent-validator/i18n"
+)
 
 //
 // -----------------------------------------------------------------------------
@@ -29,6 +35,26 @@ import "fmt"
 type FieldError struct {
 	Field   string
 	Message string
+
+	// key ve args, yerleşik kuralların mesajı yeniden çevrilebilsin diye
+	// taşıdığı çeviri bilgisidir (bkz. newKeyedFieldError).
+	key  i18n.MessageKey
+	args []any
+}
+
+// newKeyedFieldError, mesajı key ile üretilen ve Localize ile yeniden
+// çevrilebilen bir FieldError oluşturur.
+func newKeyedFieldError(field string, key i18n.MessageKey, args ...any) *FieldError {
+	return &FieldError{Field: field, Message: i18n.Get(key, args...), key: key, args: args}
+}
+
+// addTo, hatayı rule kuralıyla result'a ekler; çeviri bilgisi varsa saklanır.
+func (e *FieldError) addTo(result *core.ValidationResult, rule string) {
+	if e.key != "" {
+		result.AddRuleErrorAs(e.Field, rule, e.key, e.args...)
+		return
+	}
+	result.AddErrorRule(e.Field, rule, e.Message)
 }
 
 // Error
@@ -62,6 +88,28 @@ func NewFieldError(field, message string) error {
 	}
 }
 
+// FieldErrors
+// -----------------------------------------------------------------------------
+// Birden fazla alana ait hataları tek bir error olarak taşır. CrossValidateFields
+// fonksiyonları bu tipi döndürerek hatayı ilgili tüm alanlara yazdırabilir.
+//
+// Örnek Kullanım:
+//
+//	return validation.FieldErrors{
+//	    {Field: "start", Message: "aralık geçersiz"},
+//	    {Field: "end", Message: "aralık geçersiz"},
+//	}
+type FieldErrors []*FieldError
+
+// Error, error interface implementasyonu; hataları "; " ile birleştirir.
+func (e FieldErrors) Error() string {
+	parts := make([]string, len(e))
+	for i, err := range e {
+		parts[i] = err.Error()
+	}
+	return strings.Join(parts, "; ")
+}
+
 // Custom validator fonksiyonlarında kullanılmak üzere basit bir validation
 // error tipidir. FieldError'dan farklı olarak sadece mesaj içerir, field bilgisi
 // validasyon sistemi tarafından otomatik olarak eklenir.
@@ -0,0 +1,116 @@
+package validation
+
+import (
+	"context"
+
+	"github.com/biyonik/go-fluent-validator/core"
+)
+
+//
+// -----------------------------------------------------------------------------
+// Feature Flag ile Açılıp Kapanan Kural Grupları
+// -----------------------------------------------------------------------------
+// Bu dosya, çok kiracılı (multi-tenant) SaaS uygulamalarında doğrulama
+// kurallarını şemayı çoğaltmadan kiracıya veya kademeli dağıtım bayrağına
+// göre değiştirebilmek için WithFeature ve ValidateCtx metodlarını içerir.
+//
+// Metadata:
+// @author    Ahmet ALTUN
+// @github    github.com/biyonik
+// @linkedin  linkedin.com/in/biyonik
+// @email     ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// Alias'lar (kullanıcı dostu API için)
+type FeatureChecker = core.FeatureChecker
+type FeatureSet = core.FeatureSet
+type FeatureFunc = core.FeatureFunc
+
+// featureRule
+// -----------------------------------------------------------------------------
+// WithFeature(...) ile eklenen, bir feature flag'e bağlı kural grubunu temsil eder.
+type featureRule struct {
+	feature  string             // Feature flag adı
+	callback func() core.Schema // Feature açıkken çalıştırılacak alt şema
+}
+
+// ContextWithFeatures
+// -----------------------------------------------------------------------------
+// FeatureChecker'ı context'e ekler; core.ContextWithFeatures için kısayoldur.
+//
+// Örnek:
+//
+//	ctx := validation.ContextWithFeatures(r.Context(), validation.FeatureSet{
+//	    "strict_kyc": tenant.StrictKYC,
+//	})
+//	res := schema.ValidateCtx(ctx, data)
+func ContextWithFeatures(ctx context.Context, checker FeatureChecker) context.Context {
+	return core.ContextWithFeatures(ctx, checker)
+}
+
+// WithFeature
+// -----------------------------------------------------------------------------
+// Yalnızca context'teki FeatureChecker feature'ı açık bildirdiğinde
+// çalıştırılan bir kural grubu ekler. Alt şema, When(...) dallarında olduğu
+// gibi aynı veri üzerinde çalışır ve hataları ana sonuca eklenir.
+//
+// Validate ile (context olmadan) yapılan doğrulamalarda feature grupları
+// çalıştırılmaz; grupları etkinleştirmek için ValidateCtx kullanılmalıdır.
+//
+// Parametreler:
+//   - feature: Feature flag adı
+//   - callback: Alt şema döndüren fonksiyon
+//
+// Örnek:
+//
+//	schema.WithFeature("strict_kyc", func() validation.Schema {
+//	    return validation.Make().Shape(map[string]validation.Type{
+//	        "national_id": validation.String().Required(),
+//	    })
+//	})
+func (vs *ValidationSchema) WithFeature(feature string, callback func() core.Schema) *ValidationSchema {
+	vs.featureRules = append(vs.featureRules, featureRule{
+		feature:  feature,
+		callback: callback,
+	})
+	return vs
+}
+
+// ValidateCtx
+// -----------------------------------------------------------------------------
+// Validate ile aynı akışı çalıştırır; ek olarak context'teki FeatureChecker'a
+// göre açık olan WithFeature(...) gruplarını da doğrular. Context'te
+// i18n.ContextWithLocale ile bir dil taşınıyorsa hata mesajları o dilde üretilir.
+//
+// Parametreler:
+//   - ctx: FeatureChecker ve/veya istek dili taşıyabilen context
+//   - data: map[string]any
+//
+// Dönüş:
+//   - *core.ValidationResult
+func (vs *ValidationSchema) ValidateCtx(ctx context.Context, data map[string]any) *core.ValidationResult {
+	result, transformedData := vs.validate(ctx, data)
+
+	if !result.HasErrors() {
+		result.SetValidData(transformedData)
+	}
+
+	return result
+}
+
+// validateFeatures, açık feature gruplarını çalıştırır ve sonuçlarını birleştirir.
+func (vs *ValidationSchema) validateFeatures(ctx context.Context, data, transformedData map[string]any, result *core.ValidationResult) {
+	for _, rule := range vs.featureRules {
+		if !core.FeatureEnabled(ctx, rule.feature) {
+			continue
+		}
+		subResult := core.ValidateContext(ctx, rule.callback(), data)
+		if subResult.HasErrors() {
+			result.Merge(subResult)
+			continue
+		}
+		for k, v := range subResult.ValidData() {
+			transformedData[k] = v
+		}
+	}
+}
@@ -0,0 +1,203 @@
+// Package fibervalidate, fluent şemaları Fiber (github.com/gofiber/fiber/v2)
+// handler'larında kullanmak için adaptörler sağlar.
+//
+// Fiber net/http yerine fasthttp kullandığı için istek verisi doğrudan
+// fiber.Ctx üzerinden okunur; sayı/boolean çevrimi, dil seçimi ve hata yanıtı
+// biçimi httpvalidate ile aynıdır (httpvalidate.DecodeJSON,
+// httpvalidate.CoerceValues, httpvalidate.SelectLocale,
+// httpvalidate.MarshalResult):
+//
+//	app := fiber.New()
+//	app.Use(fibervalidate.Locale(httpvalidate.SupportedLocales("en", "tr")))
+//	app.Post("/users", fibervalidate.Middleware(createUserSchema), createUser)
+//
+//	func createUser(c *fiber.Ctx) error {
+//	    data := fibervalidate.ValidData(c)
+//	    ...
+//	}
+//
+// Doğrulama c.UserContext() ile yapılır; Locale'in seçtiği dil bu context'e
+// eklenir. Bu paket, ana modülün standart kütüphane dışında bağımlılık
+// almaması için ayrı bir Go modülüdür.
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+package fibervalidate
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/url"
+
+	"github.com/biyonik/go-fluent-validator/core"
+	"github.com/biyonik/go-fluent-validator/httpvalidate"
+	"github.com/biyonik/go-fluent-validator/i18n"
+	"github.com/gofiber/fiber/v2"
+)
+
+// ValidDataKey, Middleware'in doğrulanmış veriyi c.Locals'a yazdığı
+// anahtardır.
+const ValidDataKey = "fibervalidate.valid_data"
+
+// payloadField, alan dışı (istek geneli) hataların raporlandığı alan adıdır.
+const payloadField = "_payload"
+
+// Option, Bind ve Middleware davranışını değiştiren seçeneklerdir.
+type Option func(*config)
+
+// config, seçeneklerin toplandığı yapıdır.
+type config struct {
+	source  func(c *fiber.Ctx, schema core.Schema) (map[string]any, error)
+	onError func(c *fiber.Ctx, result *core.ValidationResult) error
+}
+
+// FromQuery, doğrulanacak veriyi query string'den okur.
+func FromQuery() Option {
+	return func(cfg *config) {
+		cfg.source = func(c *fiber.Ctx, schema core.Schema) (map[string]any, error) {
+			values, err := url.ParseQuery(string(c.Request().URI().QueryString()))
+			if err != nil {
+				return nil, err
+			}
+			return httpvalidate.CoerceValues(values, schema), nil
+		}
+	}
+}
+
+// FromForm, doğrulanacak veriyi form gövdesinden (urlencoded veya multipart)
+// okur.
+func FromForm() Option {
+	return func(cfg *config) {
+		cfg.source = func(c *fiber.Ctx, schema core.Schema) (map[string]any, error) {
+			if form, err := c.MultipartForm(); err == nil {
+				return httpvalidate.CoerceForm(form, schema), nil
+			}
+			values, err := url.ParseQuery(string(c.Body()))
+			if err != nil {
+				return nil, err
+			}
+			return httpvalidate.CoerceValues(values, schema), nil
+		}
+	}
+}
+
+// OnError, doğrulama hatalarında varsayılan 422 yanıtı yerine çağrılacak
+// fonksiyonu belirler.
+func OnError(fn func(c *fiber.Ctx, result *core.ValidationResult) error) Option {
+	return func(cfg *config) {
+		cfg.onError = fn
+	}
+}
+
+// newConfig, varsayılanları (JSON gövdesi, WriteErrors) seçeneklerle birleştirir.
+func newConfig(opts []Option) config {
+	cfg := config{
+		source: func(c *fiber.Ctx, _ core.Schema) (map[string]any, error) {
+			return httpvalidate.DecodeJSON(bytes.NewReader(c.Body()))
+		},
+		onError: WriteErrors,
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return cfg
+}
+
+// Validate, isteği schema ile doğrular ancak yanıt yazmaz. Okuma hatası
+// ve httpvalidate.DefaultMaxBodyBytes'ı aşan JSON gövdeleri "_payload"
+// alanında raporlanır.
+func Validate(c *fiber.Ctx, schema core.Schema, opts ...Option) *core.ValidationResult {
+	return newConfig(opts).validate(c, schema)
+}
+
+// validate, veriyi seçilen kaynaktan okuyup c.UserContext() ile doğrular.
+func (cfg config) validate(c *fiber.Ctx, schema core.Schema) *core.ValidationResult {
+	data, err := cfg.source(c, schema)
+	if err != nil {
+		result := core.NewResult()
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			result.AddRuleError(payloadField, i18n.KeyPayloadTooLarge, tooLarge.Limit)
+		} else {
+			result.AddRuleError(payloadField, i18n.KeyInvalidJSON)
+		}
+		return result
+	}
+	return core.ValidateContext(c.UserContext(), schema, data)
+}
+
+// Bind
+// -----------------------------------------------------------------------------
+// İsteği schema ile doğrular. Hata varsa yanıtı yazar ve false ile birlikte
+// yanıt yazma hatasını döndürür; handler bu hatayı döndürerek çıkmalıdır.
+//
+// Örnek:
+//
+//	data, ok, err := fibervalidate.Bind(c, schema)
+//	if !ok {
+//	    return err
+//	}
+func Bind(c *fiber.Ctx, schema core.Schema, opts ...Option) (map[string]any, bool, error) {
+	cfg := newConfig(opts)
+	result := cfg.validate(c, schema)
+	if result.HasErrors() {
+		return nil, false, cfg.onError(c, result)
+	}
+	return result.ValidData(), true, nil
+}
+
+// Middleware
+// -----------------------------------------------------------------------------
+// Bind'ı route middleware'i olarak çalıştırır; hata varsa zinciri keser.
+// Başarılı isteklerde doğrulanmış veri ValidData ile okunabilir.
+func Middleware(schema core.Schema, opts ...Option) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		data, ok, err := Bind(c, schema, opts...)
+		if !ok {
+			return err
+		}
+		c.Locals(ValidDataKey, data)
+		c.SetUserContext(httpvalidate.ContextWithValidData(c.UserContext(), data))
+		return c.Next()
+	}
+}
+
+// ValidData, Middleware'in doğruladığı veriyi döndürür. Middleware'den
+// geçmemiş isteklerde nil döner.
+func ValidData(c *fiber.Ctx) map[string]any {
+	data, _ := c.Locals(ValidDataKey).(map[string]any)
+	return data
+}
+
+// WriteErrors, sonucu Accept başlığına göre seçilen biçimde 422 durum koduyla
+// yazar (httpvalidate.WriteErrors'ın Fiber karşılığı).
+func WriteErrors(c *fiber.Ctx, result *core.ValidationResult) error {
+	body, contentType, err := httpvalidate.MarshalResult(c.Get(fiber.HeaderAccept), result)
+	if err != nil {
+		return err
+	}
+	c.Vary(fiber.HeaderAccept)
+	c.Set(fiber.HeaderContentType, contentType+"; charset=utf-8")
+	return c.Status(fiber.StatusUnprocessableEntity).Send(body)
+}
+
+// Locale, Accept-Language başlığına göre hata mesajı dilini seçip
+// c.UserContext()'e ekleyen middleware döndürür. Seçenekler ve yanıt
+// başlıkları httpvalidate.LocaleMiddleware ile aynıdır.
+func Locale(opts ...httpvalidate.LocaleOption) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		locale, negotiated := httpvalidate.SelectLocale(c.Get(fiber.HeaderAcceptLanguage), opts...)
+		if negotiated {
+			c.Vary(fiber.HeaderAcceptLanguage)
+		}
+		if locale != "" {
+			c.Set(fiber.HeaderContentLanguage, locale)
+			c.SetUserContext(i18n.ContextWithLocale(c.UserContext(), locale))
+		}
+		return c.Next()
+	}
+}
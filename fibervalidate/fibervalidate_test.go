@@ -0,0 +1,85 @@
+// -----------------------------------------------------------------------------
+// Fiber Adapter Tests
+// -----------------------------------------------------------------------------
+// Bu dosya, fibervalidate paketindeki Bind, Middleware ve Locale adaptörlerini
+// test eder.
+//
+// Metadata:
+// @author   Ahmet ALTUN
+// @github   github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email    ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+package fibervalidate_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	validation "github.com/biyonik/go-fluent-validator"
+	"github.com/biyonik/go-fluent-validator/fibervalidate"
+	"github.com/biyonik/go-fluent-validator/httpvalidate"
+	"github.com/gofiber/fiber/v2"
+)
+
+// TestFiber_MiddlewareAndLocale tests schema validation, 422 responses and localization
+func TestFiber_MiddlewareAndLocale(t *testing.T) {
+	schema := validation.Make().Shape(map[string]validation.Type{
+		"email": validation.String().Required().Email(),
+		"page":  validation.Number().Integer().Min(1),
+	})
+
+	app := fiber.New()
+	app.Use(fibervalidate.Locale(httpvalidate.SupportedLocales("en", "tr"), httpvalidate.FallbackLocale("en")))
+	app.Post("/users", fibervalidate.Middleware(schema), func(c *fiber.Ctx) error {
+		return c.SendString(fibervalidate.ValidData(c)["email"].(string))
+	})
+	app.Get("/users", fibervalidate.Middleware(schema, fibervalidate.FromQuery()), func(c *fiber.Ctx) error {
+		if fibervalidate.ValidData(c)["page"] != int64(2) {
+			return c.SendStatus(http.StatusInternalServerError)
+		}
+		return c.SendStatus(http.StatusNoContent)
+	})
+
+	do := func(req *http.Request) (*http.Response, string) {
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatalf("app.Test: %v", err)
+		}
+		body, _ := io.ReadAll(resp.Body)
+		return resp, string(body)
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/users", strings.NewReader(`{"email":"ada@example.com"}`))
+	if resp, body := do(req); resp.StatusCode != http.StatusOK || body != "ada@example.com" {
+		t.Errorf("valid body: code=%d body=%s", resp.StatusCode, body)
+	}
+
+	req = httptest.NewRequest(http.MethodPost, "/users", strings.NewReader(`{"email":"nope","page":0}`))
+	req.Header.Set("Accept-Language", "tr")
+	resp, body := do(req)
+	if resp.StatusCode != http.StatusUnprocessableEntity || resp.Header.Get("Content-Language") != "tr" {
+		t.Fatalf("invalid body: code=%d lang=%q", resp.StatusCode, resp.Header.Get("Content-Language"))
+	}
+	var decoded struct {
+		Errors map[string][]string `json:"errors"`
+	}
+	if err := json.Unmarshal([]byte(body), &decoded); err != nil || len(decoded.Errors["email"]) == 0 || len(decoded.Errors["page"]) == 0 {
+		t.Errorf("error response = %s", body)
+	}
+
+	req = httptest.NewRequest(http.MethodPost, "/users", strings.NewReader(`{"email"`))
+	req.Header.Set("Accept", "text/plain")
+	if resp, body := do(req); resp.StatusCode != http.StatusUnprocessableEntity || !strings.HasPrefix(resp.Header.Get("Content-Type"), "text/plain") || !strings.Contains(body, "_payload") {
+		t.Errorf("malformed JSON: code=%d type=%s body=%s", resp.StatusCode, resp.Header.Get("Content-Type"), body)
+	}
+
+	if resp, body := do(httptest.NewRequest(http.MethodGet, "/users?email=ada@example.com&page=2", nil)); resp.StatusCode != http.StatusNoContent {
+		t.Errorf("query source: code=%d body=%s", resp.StatusCode, body)
+	}
+}
@@ -0,0 +1,23 @@
+module github.com/biyonik/go-fluent-validator/fibervalidate
+
+go 1.25.3
+
+require (
+	github.com/biyonik/go-fluent-validator v0.0.0
+	github.com/gofiber/fiber/v2 v2.52.9
+)
+
+require (
+	github.com/andybalholm/brotli v1.2.0 // indirect
+	github.com/google/uuid v1.6.0 // indirect
+	github.com/klauspost/compress v1.18.0 // indirect
+	github.com/mattn/go-colorable v0.1.13 // indirect
+	github.com/mattn/go-isatty v0.0.20 // indirect
+	github.com/mattn/go-runewidth v0.0.16 // indirect
+	github.com/rivo/uniseg v0.2.0 // indirect
+	github.com/valyala/bytebufferpool v1.0.0 // indirect
+	github.com/valyala/fasthttp v1.65.0 // indirect
+	golang.org/x/sys v0.35.0 // indirect
+)
+
+replace github.com/biyonik/go-fluent-validator => ../
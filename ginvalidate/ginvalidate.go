@@ -0,0 +1,93 @@
+// Package ginvalidate, fluent şemaları Gin (github.com/gin-gonic/gin)
+// handler'larında kullanmak için adaptörler sağlar.
+//
+// ShouldBindJSON + elle yapılan kontroller yerine Bind veya Middleware
+// kullanılır; doğrulama httpvalidate.Validate ile yapılır ve hatalar
+// httpvalidate.WriteErrors ile (422, Accept başlığına göre JSON/XML/metin,
+// istek diline göre yerelleştirilmiş) yazılır:
+//
+//	r := gin.New()
+//	r.Use(ginvalidate.Locale(httpvalidate.SupportedLocales("en", "tr")))
+//
+//	r.POST("/users", func(c *gin.Context) {
+//	    data, ok := ginvalidate.Bind(c, createUserSchema)
+//	    if !ok {
+//	        return // 422 yanıtı yazıldı
+//	    }
+//	    ...
+//	})
+//
+//	r.GET("/users", ginvalidate.Middleware(listSchema, httpvalidate.FromQuery()), listUsers)
+//
+// Bu paket, ana modülün standart kütüphane dışında bağımlılık almaması için
+// ayrı bir Go modülüdür.
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+package ginvalidate
+
+import (
+	"net/http"
+
+	"github.com/biyonik/go-fluent-validator/core"
+	"github.com/biyonik/go-fluent-validator/httpvalidate"
+	"github.com/gin-gonic/gin"
+)
+
+// ValidDataKey, Middleware'in doğrulanmış veriyi gin.Context'e yazdığı
+// anahtardır.
+const ValidDataKey = "ginvalidate.valid_data"
+
+// Bind
+// -----------------------------------------------------------------------------
+// İsteği schema ile doğrular (varsayılan olarak JSON gövdesi; FromQuery ve
+// FromForm seçenekleri httpvalidate ile aynıdır). Hata varsa 422 yanıtını
+// yazar, zinciri durdurur (c.Abort) ve false döner.
+func Bind(c *gin.Context, schema core.Schema, opts ...httpvalidate.Option) (map[string]any, bool) {
+	result := httpvalidate.Validate(c.Request, schema, opts...)
+	if result.HasErrors() {
+		_ = httpvalidate.WriteErrors(c.Writer, c.Request, result)
+		c.Abort()
+		return nil, false
+	}
+	return result.ValidData(), true
+}
+
+// Middleware
+// -----------------------------------------------------------------------------
+// Bind'ı route middleware'i olarak çalıştırır. Başarılı isteklerde doğrulanmış
+// veri ValidData ile (ayrıca httpvalidate.ValidData(c.Request) ile) okunabilir.
+func Middleware(schema core.Schema, opts ...httpvalidate.Option) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		data, ok := Bind(c, schema, opts...)
+		if !ok {
+			return
+		}
+		c.Set(ValidDataKey, data)
+		c.Request = c.Request.WithContext(httpvalidate.ContextWithValidData(c.Request.Context(), data))
+		c.Next()
+	}
+}
+
+// ValidData, Middleware'in doğruladığı veriyi döndürür. Middleware'den
+// geçmemiş isteklerde nil döner.
+func ValidData(c *gin.Context) map[string]any {
+	data, _ := c.Get(ValidDataKey)
+	m, _ := data.(map[string]any)
+	return m
+}
+
+// Locale, httpvalidate.LocaleMiddleware'i Gin middleware'ine çevirir; seçilen
+// dil istek context'ine eklenir ve Bind/Middleware hataları bu dilde üretilir.
+func Locale(opts ...httpvalidate.LocaleOption) gin.HandlerFunc {
+	mw := httpvalidate.LocaleMiddleware(opts...)
+	return func(c *gin.Context) {
+		mw(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+			c.Request = r
+		})).ServeHTTP(c.Writer, c.Request)
+		c.Next()
+	}
+}
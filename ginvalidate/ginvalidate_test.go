@@ -0,0 +1,79 @@
+// -----------------------------------------------------------------------------
+// Gin Adapter Tests
+// -----------------------------------------------------------------------------
+// Bu dosya, ginvalidate paketindeki Bind, Middleware ve Locale adaptörlerini
+// test eder.
+//
+// Metadata:
+// @author   Ahmet ALTUN
+// @github   github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email    ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+package ginvalidate_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	validation "github.com/biyonik/go-fluent-validator"
+	"github.com/biyonik/go-fluent-validator/ginvalidate"
+	"github.com/biyonik/go-fluent-validator/httpvalidate"
+	"github.com/gin-gonic/gin"
+)
+
+// TestGin_BindAndMiddleware tests schema binding, 422 responses and localization
+func TestGin_BindAndMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	schema := validation.Make().Shape(map[string]validation.Type{
+		"email": validation.String().Required().Email(),
+		"page":  validation.Number().Integer().Min(1),
+	})
+
+	r := gin.New()
+	r.Use(ginvalidate.Locale(httpvalidate.SupportedLocales("en", "tr"), httpvalidate.FallbackLocale("en")))
+	r.POST("/bind", func(c *gin.Context) {
+		data, ok := ginvalidate.Bind(c, schema)
+		if !ok {
+			return
+		}
+		c.String(http.StatusOK, data["email"].(string))
+	})
+	r.GET("/list", ginvalidate.Middleware(schema, httpvalidate.FromQuery()), func(c *gin.Context) {
+		if ginvalidate.ValidData(c)["page"] != int64(3) || httpvalidate.ValidData(c.Request) == nil {
+			c.Status(http.StatusInternalServerError)
+			return
+		}
+		c.Status(http.StatusNoContent)
+	})
+
+	do := func(req *http.Request) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, req)
+		return rec
+	}
+
+	if rec := do(httptest.NewRequest(http.MethodPost, "/bind", strings.NewReader(`{"email":"ada@example.com"}`))); rec.Code != http.StatusOK || rec.Body.String() != "ada@example.com" {
+		t.Errorf("valid bind: code=%d body=%s", rec.Code, rec.Body.String())
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/bind", strings.NewReader(`{"email":"nope"}`))
+	req.Header.Set("Accept-Language", "tr")
+	rec := do(req)
+	if rec.Code != http.StatusUnprocessableEntity || !strings.Contains(rec.Body.String(), `"email"`) {
+		t.Errorf("invalid bind: code=%d body=%s", rec.Code, rec.Body.String())
+	}
+	if rec.Header().Get("Content-Language") != "tr" {
+		t.Errorf("Content-Language = %q, want tr", rec.Header().Get("Content-Language"))
+	}
+
+	if rec := do(httptest.NewRequest(http.MethodGet, "/list?email=ada@example.com&page=3", nil)); rec.Code != http.StatusNoContent {
+		t.Errorf("middleware: code=%d body=%s", rec.Code, rec.Body.String())
+	}
+	if rec := do(httptest.NewRequest(http.MethodGet, "/list?page=0", nil)); rec.Code != http.StatusUnprocessableEntity {
+		t.Errorf("middleware should abort with 422, got %d", rec.Code)
+	}
+}
@@ -0,0 +1,122 @@
+package validation
+
+import (
+	"strings"
+
+	"github.com/biyonik/go-fluent-validator/i18n"
+)
+
+//
+// -----------------------------------------------------------------------------
+// Alan Grubu Kuralları
+// -----------------------------------------------------------------------------
+// Birden fazla alanın birlikte değerlendirildiği en yaygın iki gereksinimi
+// CrossValidate kapanışı yazmadan tanımlar:
+//
+//   - RequireAnyOf("email", "phone"): en az bir iletişim yolu girilmelidir.
+//     Hiçbiri girilmemişse hata grubun her alanına yazılır.
+//   - RequireAllOrNone("street", "city", "zip"): adres ya eksiksiz girilmeli
+//     ya da tamamen boş bırakılmalıdır. Hata yalnızca eksik alanlara yazılır.
+//
+// Bir alan; nil, boş string, boş dizi veya boş nesne değilse dolu kabul
+// edilir. Gruptaki alanlardan biri alan seviyesinde zaten hatalıysa kural
+// atlanır.
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// RequireAnyOf
+// -----------------------------------------------------------------------------
+// Verilen alanlardan en az birinin dolu olmasını zorunlu kılar.
+//
+// Örnek:
+//
+//	schema.RequireAnyOf("email", "phone")
+func (vs *ValidationSchema) RequireAnyOf(fields ...string) *ValidationSchema {
+	vs.crossValidators = append(vs.crossValidators, crossValidator{
+		fields: fields,
+		rule:   "require_any_of",
+		fn: func(data map[string]any) error {
+			for _, field := range fields {
+				if isPresent(data[field]) {
+					return nil
+				}
+			}
+			errs := make(FieldErrors, 0, len(fields))
+			for _, field := range fields {
+				errs = append(errs, vs.groupError(i18n.KeyRequireAnyOf, field, fields))
+			}
+			return errs
+		},
+	})
+	return vs
+}
+
+// RequireAllOrNone
+// -----------------------------------------------------------------------------
+// Verilen alanların ya hepsinin dolu ya da hepsinin boş olmasını zorunlu
+// kılar. En az biri doluysa boş kalan her alana hata yazılır.
+//
+// Örnek:
+//
+//	schema.RequireAllOrNone("street", "city", "zip")
+func (vs *ValidationSchema) RequireAllOrNone(fields ...string) *ValidationSchema {
+	vs.crossValidators = append(vs.crossValidators, crossValidator{
+		fields: fields,
+		rule:   "require_all_or_none",
+		fn: func(data map[string]any) error {
+			var present, missing []string
+			for _, field := range fields {
+				if isPresent(data[field]) {
+					present = append(present, field)
+				} else {
+					missing = append(missing, field)
+				}
+			}
+			if len(present) == 0 || len(missing) == 0 {
+				return nil
+			}
+			errs := make(FieldErrors, 0, len(missing))
+			for _, field := range missing {
+				errs = append(errs, vs.groupError(i18n.KeyRequireAllOrNone, field, present))
+			}
+			return errs
+		},
+	})
+	return vs
+}
+
+// groupError, field için key mesajlı hatayı üretir; {values} yer tutucusu
+// others içindeki (field hariç) alanların etiketleriyle doldurulur.
+func (vs *ValidationSchema) groupError(key i18n.MessageKey, field string, others []string) *FieldError {
+	labels := make([]string, 0, len(others))
+	for _, other := range others {
+		if other != field {
+			labels = append(labels, vs.labelOf(other))
+		}
+	}
+	label := vs.labelOf(field)
+	return newKeyedFieldError(field, key, label, i18n.Attrs{
+		"attribute": label,
+		"values":    strings.Join(labels, ", "),
+	})
+}
+
+// isPresent, değerin grup kuralları açısından dolu olup olmadığını döndürür.
+func isPresent(value any) bool {
+	switch v := value.(type) {
+	case nil:
+		return false
+	case string:
+		return strings.TrimSpace(v) != ""
+	case []any:
+		return len(v) > 0
+	case map[string]any:
+		return len(v) > 0
+	}
+	return true
+}
@@ -0,0 +1,208 @@
+// Package grpcvalidate, gRPC sunucularına gelen protobuf mesajlarını fluent
+// şemalarla doğrulayan bir unary interceptor sağlar.
+//
+// Mesaj protojson ile JSON'a, ardından map[string]any'ye çevrilir ve tam
+// metot adına ("/paket.Servis/Metot") göre kayıtlı şemayla ValidateCtx ile
+// doğrulanır. Alan adları varsayılan olarak .proto dosyasındaki adlardır
+// (snake_case); JSONNames seçeneğiyle lowerCamelCase kullanılabilir. protojson
+// 64 bit tamsayıları string olarak ürettiği için şemada number tanımlı
+// alanlar tekrar sayıya çevrilir.
+//
+// Doğrulama başarısız olursa handler çağrılmaz; istemciye InvalidArgument
+// kodu ve her hata için bir google.rpc.BadRequest.FieldViolation içeren
+// status döner. Şeması kayıtlı olmayan metotlar olduğu gibi geçer:
+//
+//	srv := grpc.NewServer(grpc.UnaryInterceptor(grpcvalidate.UnaryServerInterceptor(
+//	    map[string]core.Schema{
+//	        "/users.v1.UserService/CreateUser": createUserSchema,
+//	    },
+//	    grpcvalidate.Locale(httpvalidate.SupportedLocales("en", "tr")),
+//	)))
+//
+// Bu paket, ana modülün standart kütüphane dışında bağımlılık almaması için
+// ayrı bir Go modülüdür.
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+package grpcvalidate
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/biyonik/go-fluent-validator/core"
+	"github.com/biyonik/go-fluent-validator/httpvalidate"
+	"github.com/biyonik/go-fluent-validator/i18n"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// StatusMessage, doğrulama hatalarında döndürülen status mesajıdır. Alan
+// bazlı (yerelleştirilmiş) mesajlar BadRequest detaylarında taşınır.
+const StatusMessage = "validation failed"
+
+// localeMetadataKeys, dil seçimi için bakılan metadata anahtarlarıdır
+// (grpc-gateway, HTTP başlıklarını "grpcgateway-" önekiyle iletir).
+var localeMetadataKeys = []string{"accept-language", "grpcgateway-accept-language"}
+
+// Option, interceptor davranışını değiştiren seçeneklerdir.
+type Option func(*config)
+
+// config, seçeneklerin toplandığı yapıdır.
+type config struct {
+	jsonNames     bool
+	locale        bool
+	localeOptions []httpvalidate.LocaleOption
+}
+
+// JSONNames, şema alan adları olarak .proto adları yerine protojson'ın
+// lowerCamelCase adlarını kullanır.
+func JSONNames() Option {
+	return func(c *config) {
+		c.jsonNames = true
+	}
+}
+
+// Locale, hata mesajı dilini "accept-language" metadata değerine göre
+// seçer. Seçenekler httpvalidate.LocaleMiddleware ile aynıdır.
+func Locale(opts ...httpvalidate.LocaleOption) Option {
+	return func(c *config) {
+		c.locale = true
+		c.localeOptions = opts
+	}
+}
+
+// UnaryServerInterceptor
+// -----------------------------------------------------------------------------
+// schemas'taki tam metot adlarına gelen istekleri doğrulayan interceptor
+// döndürür. schemas interceptor oluşturulduktan sonra değiştirilmemelidir.
+func UnaryServerInterceptor(schemas map[string]core.Schema, opts ...Option) grpc.UnaryServerInterceptor {
+	var cfg config
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		schema, ok := schemas[info.FullMethod]
+		if !ok {
+			return handler(ctx, req)
+		}
+		msg, ok := req.(proto.Message)
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		locale := ""
+		if cfg.locale {
+			locale = incomingLocale(ctx, cfg.localeOptions)
+			if locale != "" {
+				ctx = i18n.ContextWithLocale(ctx, locale)
+			}
+		}
+
+		data, err := messageData(msg, schema, cfg.jsonNames)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "grpcvalidate: %v", err)
+		}
+		if result := core.ValidateContext(ctx, schema, data); result.HasErrors() {
+			return nil, Status(result, locale).Err()
+		}
+		return handler(ctx, req)
+	}
+}
+
+// Status
+// -----------------------------------------------------------------------------
+// Doğrulama sonucunu InvalidArgument kodlu ve BadRequest detaylı bir
+// status'a çevirir. locale boş değilse her ihlale LocalizedMessage eklenir.
+// Interceptor dışında, handler içinde yapılan doğrulamalar için de
+// kullanılabilir.
+func Status(result *core.ValidationResult, locale string) *status.Status {
+	st := status.New(codes.InvalidArgument, StatusMessage)
+	details := &errdetails.BadRequest{}
+	for _, f := range result.Failures() {
+		violation := &errdetails.BadRequest_FieldViolation{
+			Field:       f.Field,
+			Description: f.Message,
+			Reason:      strings.ToUpper(f.Rule),
+		}
+		if locale != "" {
+			violation.LocalizedMessage = &errdetails.LocalizedMessage{Locale: locale, Message: f.Message}
+		}
+		details.FieldViolations = append(details.FieldViolations, violation)
+	}
+	if withDetails, err := st.WithDetails(details); err == nil {
+		return withDetails
+	}
+	return st
+}
+
+// incomingLocale, gelen metadata'daki Accept-Language değerine göre dili seçer.
+func incomingLocale(ctx context.Context, opts []httpvalidate.LocaleOption) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	accept := ""
+	for _, key := range localeMetadataKeys {
+		if values := md.Get(key); len(values) > 0 {
+			accept = strings.Join(values, ",")
+			break
+		}
+	}
+	locale, _ := httpvalidate.SelectLocale(accept, opts...)
+	return locale
+}
+
+// messageData, mesajı protojson üzerinden şemanın beklediği veriye çevirir.
+func messageData(msg proto.Message, schema core.Schema, jsonNames bool) (map[string]any, error) {
+	raw, err := protojson.MarshalOptions{UseProtoNames: !jsonNames}.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	// Mesaj boyutu gRPC'nin alım sınırıyla zaten kısıtlıdır.
+	data, err := httpvalidate.DecodeJSONLimit(bytes.NewReader(raw), 0)
+	if err != nil {
+		return nil, err
+	}
+	describer, ok := schema.(core.SchemaDescriber)
+	if !ok {
+		return data, nil
+	}
+	fields := describer.Describe().Fields
+	for name, value := range data {
+		data[name] = coerceNumbers(value, fields[name])
+	}
+	return data, nil
+}
+
+// coerceNumbers, protojson'ın string olarak ürettiği 64 bit tamsayıları
+// şemada number tanımlı alanlarda json.Number'a çevirir; nesne ve dizilere
+// tanım boyunca iner.
+func coerceNumbers(value any, desc *core.TypeDescription) any {
+	if desc == nil {
+		return value
+	}
+	switch v := value.(type) {
+	case string:
+		if desc.Type == "number" {
+			return json.Number(v)
+		}
+	case map[string]any:
+		for name, item := range v {
+			v[name] = coerceNumbers(item, desc.Fields[name])
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = coerceNumbers(item, desc.Elements)
+		}
+	}
+	return value
+}
@@ -0,0 +1,87 @@
+// -----------------------------------------------------------------------------
+// gRPC Interceptor Tests
+// -----------------------------------------------------------------------------
+// Bu dosya, grpcvalidate paketindeki UnaryServerInterceptor'ı ve BadRequest
+// detaylı status üretimini test eder.
+//
+// Metadata:
+// @author   Ahmet ALTUN
+// @github   github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email    ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+package grpcvalidate_test
+
+import (
+	"context"
+	"testing"
+
+	validation "github.com/biyonik/go-fluent-validator"
+	"github.com/biyonik/go-fluent-validator/core"
+	"github.com/biyonik/go-fluent-validator/grpcvalidate"
+	"github.com/biyonik/go-fluent-validator/httpvalidate"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// TestGRPC_UnaryServerInterceptor tests message validation and InvalidArgument details
+func TestGRPC_UnaryServerInterceptor(t *testing.T) {
+	schema := validation.Make().Shape(map[string]validation.Type{
+		"email":   validation.String().Required().Email(),
+		"user_id": validation.Number().Integer().Min(1),
+	})
+	interceptor := grpcvalidate.UnaryServerInterceptor(
+		map[string]core.Schema{"/users.v1.UserService/CreateUser": schema},
+		grpcvalidate.Locale(httpvalidate.SupportedLocales("en", "tr"), httpvalidate.FallbackLocale("en")),
+	)
+
+	called := false
+	handler := func(ctx context.Context, req any) (any, error) {
+		called = true
+		return "ok", nil
+	}
+	call := func(ctx context.Context, method string, fields map[string]any) error {
+		called = false
+		req, err := structpb.NewStruct(fields)
+		if err != nil {
+			t.Fatalf("NewStruct: %v", err)
+		}
+		_, err = interceptor(ctx, req, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		return err
+	}
+
+	ctx := context.Background()
+	if err := call(ctx, "/users.v1.UserService/CreateUser", map[string]any{"email": "ada@example.com", "user_id": "42"}); err != nil || !called {
+		t.Fatalf("valid request: err=%v called=%v", err, called)
+	}
+	if err := call(ctx, "/users.v1.UserService/Other", map[string]any{}); err != nil || !called {
+		t.Errorf("unregistered methods should pass through, err=%v", err)
+	}
+
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("accept-language", "tr-TR"))
+	err := call(ctx, "/users.v1.UserService/CreateUser", map[string]any{"email": "nope", "user_id": 0})
+	if called {
+		t.Fatal("handler should not run for invalid requests")
+	}
+	st, _ := status.FromError(err)
+	if st.Code() != codes.InvalidArgument {
+		t.Fatalf("code = %v, want InvalidArgument", st.Code())
+	}
+	var violations []*errdetails.BadRequest_FieldViolation
+	for _, d := range st.Details() {
+		if br, ok := d.(*errdetails.BadRequest); ok {
+			violations = br.GetFieldViolations()
+		}
+	}
+	if len(violations) != 2 || violations[0].GetField() != "email" || violations[1].GetField() != "user_id" {
+		t.Fatalf("violations = %v", violations)
+	}
+	if violations[0].GetReason() != "EMAIL" || violations[0].GetLocalizedMessage().GetLocale() != "tr" {
+		t.Errorf("violation details = %v", violations[0])
+	}
+}
@@ -0,0 +1,180 @@
+package validation
+
+import (
+	"fmt"
+	"time"
+	"unicode/utf8"
+
+	"github.com/biyonik/go-fluent-validator/core"
+	"github.com/biyonik/go-fluent-validator/i18n"
+)
+
+//
+// -----------------------------------------------------------------------------
+// Payload Boyutu ve Global String Uzunluğu Sınırları
+// -----------------------------------------------------------------------------
+// Bu dosya, alan kurallarından önce uygulanan ve patolojik girdilere (10MB'lık
+// bir "name" alanı gibi) karşı koruma sağlayan şema seçeneklerini içerir.
+// Sınırı aşan bir değer, birden fazla doğrulayıcı tarafından (regex, e-posta,
+// OneOf vb.) taranmadan önce reddedilir.
+//
+//   - WithMaxTotalBytes(m): Tüm payload'ın tahmini boyutu m baytı aşarsa
+//     hiçbir alan doğrulanmaz; hata "_payload" alanına eklenir.
+//   - WithGlobalStringMax(n): Herhangi bir string değer (iç içe nesne ve
+//     dizi elemanları dahil) n karakteri aşarsa ilgili üst seviye alanın
+//     kuralları çalıştırılmaz; hata değerin yoluna eklenir.
+//   - WithBudget(d): Doğrulamanın tamamı (iç içe dizi elemanları dahil) d
+//     süresini aşarsa çalışma yarıda kesilir; hata "_payload" alanına
+//     eklenir. ValidateCtx'e verilen context'in süresi ve iptali de aynı
+//     şekilde uygulanır.
+//
+// Örnek:
+//
+//	schema := validation.Make(
+//	    validation.WithGlobalStringMax(10_000),
+//	    validation.WithMaxTotalBytes(1 << 20),
+//	    validation.WithBudget(50 * time.Millisecond),
+//	)
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// payloadField, payload boyutu hatalarının eklendiği alan adıdır.
+const payloadField = "_payload"
+
+// WithGlobalStringMax
+// -----------------------------------------------------------------------------
+// Şemadaki hiçbir string değerin n karakteri aşamayacağını belirtir. Kontrol
+// alan kurallarından önce yapılır; sınırı aşan alanlar için diğer kurallar
+// çalıştırılmaz. 0 veya negatif değer sınırı kapatır.
+func WithGlobalStringMax(n int) SchemaOption {
+	return func(vs *ValidationSchema) {
+		vs.globalStringMax = n
+	}
+}
+
+// WithMaxTotalBytes
+// -----------------------------------------------------------------------------
+// Doğrulanan verinin toplam tahmini boyutunu (anahtarlar ve string değerler
+// bayt olarak, diğer skaler değerler 8 bayt) m bayt ile sınırlar. Sınır
+// aşılırsa doğrulama alan kurallarına geçmeden sonlanır. 0 veya negatif
+// değer sınırı kapatır.
+func WithMaxTotalBytes(m int) SchemaOption {
+	return func(vs *ValidationSchema) {
+		vs.maxTotalBytes = m
+	}
+}
+
+// WithBudget
+// -----------------------------------------------------------------------------
+// Tek bir doğrulama çalışmasının en fazla d sürmesine izin verir. Süre alan
+// adımları arasında ve dizi elemanları doğrulanırken belirli aralıklarla
+// (core.ExpiryCheckInterval) kontrol edilir; dolduğunda kalan kurallar
+// atlanır, "_payload" alanına deadline hatası eklenir ve ValidData boş kalır.
+// Tek bir kuralın kendisi kesilmez. 0 veya negatif değer sınırı kapatır;
+// ValidateCtx'e verilen context'in daha erken bir süresi varsa o geçerlidir.
+func WithBudget(d time.Duration) SchemaOption {
+	return func(vs *ValidationSchema) {
+		vs.budget = d
+	}
+}
+
+// applyGuards
+// -----------------------------------------------------------------------------
+// Payload sınırlarını uygular. Payload toplam boyut sınırını aşarsa ok false
+// döner. Aksi halde string sınırını aşan alanlar çıkarılmış veri ve bu
+// alanların kümesi döner.
+func (vs *ValidationSchema) applyGuards(data map[string]any, result *core.ValidationResult) (guarded map[string]any, blocked map[string]bool, ok bool) {
+	if vs.maxTotalBytes > 0 {
+		if _, exceeded := payloadSize(data, vs.maxTotalBytes); exceeded {
+			result.AddRuleError(payloadField, i18n.KeyPayloadTooLarge, vs.maxTotalBytes)
+			return nil, nil, false
+		}
+	}
+
+	if vs.globalStringMax <= 0 {
+		return data, nil, true
+	}
+
+	for field, value := range data {
+		if path, found := findLongString(field, value, vs.globalStringMax); found {
+			if blocked == nil {
+				blocked = make(map[string]bool)
+			}
+			blocked[field] = true
+			result.AddRuleError(path, i18n.KeyMaxLength, vs.labelOf(path), vs.globalStringMax)
+		}
+	}
+	if blocked == nil {
+		return data, nil, true
+	}
+
+	guarded = make(map[string]any, len(data))
+	for field, value := range data {
+		if !blocked[field] {
+			guarded[field] = value
+		}
+	}
+	return guarded, blocked, true
+}
+
+// payloadSize, değerin tahmini boyutunu hesaplar. Boyut budget'ı aştığı anda
+// dolaşmayı bırakır ve exceeded true döner.
+func payloadSize(value any, budget int) (size int, exceeded bool) {
+	switch v := value.(type) {
+	case nil:
+		return 0, false
+	case string:
+		size = len(v)
+	case []byte:
+		size = len(v)
+	case map[string]any:
+		for key, item := range v {
+			itemSize, over := payloadSize(item, budget-size-len(key))
+			size += len(key) + itemSize
+			if over || size > budget {
+				return size, true
+			}
+		}
+	case []any:
+		for _, item := range v {
+			itemSize, over := payloadSize(item, budget-size)
+			size += itemSize
+			if over || size > budget {
+				return size, true
+			}
+		}
+	default:
+		size = 8
+	}
+	return size, size > budget
+}
+
+// findLongString, value içinde limit karakterden uzun ilk string'in yolunu
+// döndürür. Yollar hata anahtarlarıyla aynı biçimdedir (field.sub, field[0]).
+func findLongString(path string, value any, limit int) (string, bool) {
+	switch v := value.(type) {
+	case string:
+		// Bayt sayısı sınırın altındaysa karakter sayısı da altındadır.
+		if len(v) > limit && utf8.RuneCountInString(v) > limit {
+			return path, true
+		}
+	case map[string]any:
+		for key, item := range v {
+			if found, ok := findLongString(path+"."+key, item, limit); ok {
+				return found, true
+			}
+		}
+	case []any:
+		for i, item := range v {
+			if found, ok := findLongString(fmt.Sprintf("%s[%d]", path, i), item, limit); ok {
+				return found, true
+			}
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,42 @@
+package httpvalidate
+
+import (
+	"context"
+	"net/http"
+)
+
+//
+// -----------------------------------------------------------------------------
+// İstek Context Yardımcıları
+// -----------------------------------------------------------------------------
+// Doğrulama sırasında isteğe (çerezler, başlıklar, oturum) erişmesi gereken
+// kuralların (örn. CookieCSRF) *http.Request'i ValidateCtx'e verilen context
+// üzerinden almasını sağlar.
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// requestContextKey, context içinde *http.Request'i taşıyan anahtardır.
+type requestContextKey struct{}
+
+// ContextWithRequest, isteği context'e ekler.
+//
+// Örnek:
+//
+//	res := schema.ValidateCtx(httpvalidate.ContextWithRequest(r.Context(), r), form)
+func ContextWithRequest(ctx context.Context, r *http.Request) context.Context {
+	return context.WithValue(ctx, requestContextKey{}, r)
+}
+
+// RequestFromContext, ContextWithRequest ile eklenmiş isteği döndürür.
+func RequestFromContext(ctx context.Context) (*http.Request, bool) {
+	if ctx == nil {
+		return nil, false
+	}
+	r, ok := ctx.Value(requestContextKey{}).(*http.Request)
+	return r, ok && r != nil
+}
@@ -0,0 +1,47 @@
+package httpvalidate
+
+import (
+	"context"
+	"crypto/subtle"
+
+	"github.com/biyonik/go-fluent-validator/types"
+)
+
+//
+// -----------------------------------------------------------------------------
+// CSRF Token Doğrulayıcıları
+// -----------------------------------------------------------------------------
+// validation.CSRFToken alanı için hazır types.CSRFVerifier gerçekleştirimleri
+// içerir. Oturum tabanlı saklama gibi uygulamaya özgü yöntemler için kullanıcı
+// kendi CSRFVerifier fonksiyonunu yazabilir.
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// CookieCSRF
+// -----------------------------------------------------------------------------
+// "Double submit cookie" yöntemiyle çalışan bir doğrulayıcı döndürür: formla
+// gönderilen token, isteğin cookieName adlı çerezindeki değerle sabit zamanlı
+// karşılaştırılır. İstek context'e ContextWithRequest ile eklenmiş olmalıdır;
+// istek veya çerez yoksa token reddedilir.
+//
+// Örnek:
+//
+//	"_token": validation.CSRFToken(httpvalidate.CookieCSRF("csrf_token")),
+func CookieCSRF(cookieName string) types.CSRFVerifier {
+	return func(ctx context.Context, token string) bool {
+		r, ok := RequestFromContext(ctx)
+		if !ok || token == "" {
+			return false
+		}
+		cookie, err := r.Cookie(cookieName)
+		if err != nil || cookie.Value == "" {
+			return false
+		}
+		return subtle.ConstantTimeCompare([]byte(cookie.Value), []byte(token)) == 1
+	}
+}
@@ -0,0 +1,126 @@
+package httpvalidate
+
+import (
+	"net/http"
+
+	"github.com/biyonik/go-fluent-validator/i18n"
+)
+
+//
+// -----------------------------------------------------------------------------
+// İstek Diline Göre Hata Mesajı Yerelleştirme
+// -----------------------------------------------------------------------------
+// LocaleMiddleware, isteğin Accept-Language başlığını i18n.MatchLocale ile
+// yüklü dillerle eşleştirir ve seçilen dili request context'ine ekler. Bu
+// context ile yapılan ValidateCtx çağrıları hata mesajlarını istemcinin dilinde
+// döndürür; handler içinde dil seçimi için kod yazmak gerekmez. Global aktif
+// dil (i18n.SetLocale) değiştirilmediği için eşzamanlı isteklerde güvenlidir.
+//
+// Rota bazında davranış, aynı middleware'in rotaya özel seçeneklerle tekrar
+// sarmalanmasıyla değiştirilir; içteki middleware dıştakinin seçimini ezer.
+//
+// Örnek:
+//
+//	mux := http.NewServeMux()
+//	mux.Handle("/api/", handler)
+//	mux.Handle("/tr/", httpvalidate.LocaleMiddleware(httpvalidate.ForceLocale("tr"))(trHandler))
+//	http.ListenAndServe(":8080", httpvalidate.LocaleMiddleware(
+//	    httpvalidate.SupportedLocales("en", "tr", "de"),
+//	    httpvalidate.FallbackLocale("en"),
+//	)(mux))
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// LocaleOption, LocaleMiddleware davranışını değiştiren seçeneklerdir.
+type LocaleOption func(*localeConfig)
+
+// localeConfig, dil seçimi seçeneklerinin toplandığı yapıdır.
+type localeConfig struct {
+	supported []string
+	fallback  string
+	force     string
+}
+
+// SupportedLocales, Accept-Language eşleştirmesinin yapılacağı dilleri
+// sınırlar. Verilmezse i18n'e yüklü tüm diller kullanılır.
+func SupportedLocales(locales ...string) LocaleOption {
+	return func(c *localeConfig) {
+		c.supported = append(c.supported, locales...)
+	}
+}
+
+// FallbackLocale, başlık yoksa veya hiçbir dil eşleşmezse kullanılacak dili
+// belirler. Verilmezse context'e dil eklenmez ve global aktif dil kullanılır.
+func FallbackLocale(locale string) LocaleOption {
+	return func(c *localeConfig) {
+		c.fallback = locale
+	}
+}
+
+// ForceLocale, başlığı yok sayarak her zaman verilen dili kullanır. Dile özel
+// rotalar ("/tr/...") veya kullanıcı tercihini başka kaynaktan alan uç
+// noktalar için rota bazında geçersiz kılma sağlar.
+func ForceLocale(locale string) LocaleOption {
+	return func(c *localeConfig) {
+		c.force = locale
+	}
+}
+
+// LocaleMiddleware
+// -----------------------------------------------------------------------------
+// Her istek için hata mesajı dilini seçip request context'ine ekleyen
+// middleware döndürür. Dil başlıktan seçildiğinde yanıta
+// "Vary: Accept-Language", her durumda da "Content-Language" başlığı eklenir.
+func LocaleMiddleware(opts ...LocaleOption) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			locale, negotiated := SelectLocale(r.Header.Get("Accept-Language"), opts...)
+			if negotiated {
+				w.Header().Add("Vary", "Accept-Language")
+			}
+			if locale == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			w.Header().Set("Content-Language", locale)
+			next.ServeHTTP(w, r.WithContext(i18n.ContextWithLocale(r.Context(), locale)))
+		})
+	}
+}
+
+// SelectLocale
+// -----------------------------------------------------------------------------
+// Accept-Language başlık değerine ve seçeneklere göre LocaleMiddleware'in
+// seçeceği dili döndürür. negotiated, seçimin başlığa bağlı olduğunu (yanıta
+// "Vary: Accept-Language" eklenmesi gerektiğini) belirtir; ForceLocale ile
+// false olur. Dil seçilemezse boş string döner. net/http dışındaki
+// framework adaptörleri (örn. fasthttp tabanlı olanlar) için dışa açıktır.
+func SelectLocale(acceptLanguage string, opts ...LocaleOption) (locale string, negotiated bool) {
+	var cfg localeConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	if cfg.force != "" {
+		return cfg.force, false
+	}
+	matched, ok := i18n.MatchLocale(acceptLanguage, cfg.supported...)
+	if !ok {
+		matched = cfg.fallback
+	}
+	return matched, true
+}
+
+// RequestLocale, LocaleMiddleware'in istek için seçtiği dili döndürür. Dil
+// seçilmemişse global aktif dil döndürülür.
+func RequestLocale(r *http.Request) string {
+	if locale, ok := i18n.LocaleFromContext(r.Context()); ok {
+		return locale
+	}
+	return i18n.GetLocale()
+}
@@ -0,0 +1,323 @@
+package httpvalidate
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"mime"
+	"mime/multipart"
+	"net/http"
+
+	"github.com/biyonik/go-fluent-validator/core"
+	"github.com/biyonik/go-fluent-validator/i18n"
+	"github.com/biyonik/go-fluent-validator/rules"
+)
+
+//
+// -----------------------------------------------------------------------------
+// Doğrulama Middleware'i
+// -----------------------------------------------------------------------------
+// Bir şemayı standart net/http middleware'ine çevirir: istek verisi şemayla
+// doğrulanır, hata varsa 422 yanıtı yazılır ve handler çağrılmaz; hata yoksa
+// ValidData() request context'ine eklenir ve handler'a geçilir:
+//
+//	mux.Handle("POST /users", httpvalidate.Middleware(createUserSchema)(createUser))
+//
+//	func createUser(w http.ResponseWriter, r *http.Request) {
+//	    data := httpvalidate.ValidData(r)
+//	    ...
+//	}
+//
+// Veri varsayılan olarak JSON gövdesinden (UseNumber ile) okunur;
+// multipart/form-data gövdeler ise FromForm'daki gibi değer alanları ve
+// dosyalarla birlikte okunur. FromQuery ve FromForm seçenekleriyle query
+// string veya form gövdesi zorlanabilir. Bu kaynaklardaki string değerler
+// ValidateRequest'teki gibi şemadaki tipe göre çevrilir; dosyalar
+// validation.File() ile doğrulanır ve handler'a ValidFile/ValidFiles ile
+// geçer.
+//
+// Şema, çöp gövdelere karşı hiç çalıştırılmaz: RequireContentType ile kabul
+// edilen içerik türleri, MaxBodyBytes ile gövdenin toplam boyutu (varsayılan
+// DefaultMaxBodyBytes, 1 MiB) sınırlanır.
+// Uygun olmayan içerik türü, sınırı aşan gövde ve bozuk JSON (söz dizimi
+// hatalarında satır ve sütunla) "_payload" alanında raporlanır. Doğrulama
+// ContextWithRequest ile isteği taşıyan context üzerinden yapılır; böylece
+// CookieCSRF gibi isteğe bağlı kurallar da çalışır.
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// payloadField, alan dışı (istek geneli) hataların raporlandığı alan adıdır.
+const payloadField = "_payload"
+
+// Option, Middleware'in davranışını değiştiren seçeneklerdir.
+type Option func(*middlewareConfig)
+
+// middlewareConfig, middleware seçeneklerinin toplandığı yapıdır.
+type middlewareConfig struct {
+	source       func(r *http.Request, schema core.Schema) (map[string]any, error)
+	onError      func(w http.ResponseWriter, r *http.Request, result *core.ValidationResult)
+	maxBodyBytes int64
+	contentTypes []string
+
+	requestIDHeader string
+	logger          *slog.Logger
+}
+
+// FromQuery, doğrulanacak veriyi query string'den okur.
+func FromQuery() Option {
+	return func(c *middlewareConfig) {
+		c.source = func(r *http.Request, schema core.Schema) (map[string]any, error) {
+			return CoerceValues(r.URL.Query(), schema), nil
+		}
+	}
+}
+
+// MultipartMaxMemory, FromForm'un multipart gövdelerde belleğe aldığı en
+// fazla bayt sayısıdır; aşan dosya içerikleri geçici dosyalara yazılır.
+const MultipartMaxMemory = 32 << 20
+
+// FromForm, doğrulanacak veriyi form gövdesinden (application/x-www-form-urlencoded
+// veya multipart) okur. Gövdesiz isteklerde query string kullanılır.
+// Multipart gövdelerde yüklenen dosyalar CoerceForm ile veriye eklenir.
+func FromForm() Option {
+	return func(c *middlewareConfig) {
+		c.source = formSource
+	}
+}
+
+// formSource, form gövdesini (multipart ise dosyalarla birlikte) okur.
+func formSource(r *http.Request, schema core.Schema) (map[string]any, error) {
+	err := r.ParseMultipartForm(MultipartMaxMemory)
+	switch {
+	case err == nil:
+		return CoerceForm(&multipart.Form{Value: r.Form, File: r.MultipartForm.File}, schema), nil
+	case !errors.Is(err, http.ErrNotMultipart):
+		return nil, err
+	}
+	if err := r.ParseForm(); err != nil {
+		return nil, err
+	}
+	return CoerceValues(r.Form, schema), nil
+}
+
+// isMultipart, isteğin multipart/form-data gövdesi taşıyıp taşımadığını
+// döndürür.
+func isMultipart(r *http.Request) bool {
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	return err == nil && mediaType == "multipart/form-data"
+}
+
+// MaxBodyBytes, istek gövdesinin (multipart dosyaları dahil) toplam boyutunu
+// n bayt ile sınırlar. Sınırı aşan istekler okunmaya devam edilmeden
+// "_payload" alanında payload_too_large hatasıyla reddedilir. Varsayılan
+// sınır DefaultMaxBodyBytes'tır (1 MiB); dosya yükleyen uç noktalarda
+// artırılmalıdır. 0 veya negatif değer sınırı kaldırır.
+func MaxBodyBytes(n int64) Option {
+	return func(c *middlewareConfig) {
+		c.maxBodyBytes = n
+	}
+}
+
+// RequireContentType, gövdeli isteklerin Content-Type başlığının verilen
+// türlerden biri olmasını zorunlu kılar ("application/*" gibi jokerler
+// desteklenir; charset gibi parametreler yok sayılır). Uymayan istekler
+// gövde okunmadan "_payload" alanında content_type hatasıyla reddedilir.
+//
+// Örnek:
+//
+//	httpvalidate.Middleware(schema, httpvalidate.RequireContentType("application/json"))
+func RequireContentType(types ...string) Option {
+	return func(c *middlewareConfig) {
+		c.contentTypes = types
+	}
+}
+
+// hasBody, isteğin okunacak bir gövdesi olup olmadığını döndürür.
+func hasBody(r *http.Request) bool {
+	return r.Body != nil && r.Body != http.NoBody && r.ContentLength != 0
+}
+
+// OnError, doğrulama hatalarında WriteErrors yerine çağrılacak fonksiyonu
+// belirler (örn. HTML formunu hatalarla yeniden göstermek için).
+func OnError(fn func(w http.ResponseWriter, r *http.Request, result *core.ValidationResult)) Option {
+	return func(c *middlewareConfig) {
+		c.onError = fn
+	}
+}
+
+// newMiddlewareConfig, varsayılanları (JSON gövdesi, WriteErrors) seçeneklerle
+// birleştirir.
+func newMiddlewareConfig(opts []Option) middlewareConfig {
+	cfg := middlewareConfig{
+		source: func(r *http.Request, schema core.Schema) (map[string]any, error) {
+			if isMultipart(r) {
+				return formSource(r, schema)
+			}
+			// Boyut sınırı check'te MaxBytesReader ile uygulanır.
+			return decodeBody(r, 0)
+		},
+		onError: func(w http.ResponseWriter, r *http.Request, result *core.ValidationResult) {
+			_ = WriteErrors(w, r, result)
+		},
+		maxBodyBytes:    DefaultMaxBodyBytes,
+		requestIDHeader: DefaultRequestIDHeader,
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return cfg
+}
+
+// validate, isteği ctx'teki istek kimliğiyle doğrular; kimliği sonuca yazar
+// ve başarısız doğrulamayı (Logger verilmişse) loglar.
+func (cfg middlewareConfig) validate(ctx context.Context, r *http.Request, schema core.Schema) *core.ValidationResult {
+	result := cfg.check(ctx, r, schema)
+	result.SetRequestID(core.RequestIDFromContext(ctx))
+	if result.HasErrors() {
+		cfg.logFailure(ctx, r, result)
+	}
+	return result
+}
+
+// check, veriyi seçilen kaynaktan okuyup isteği taşıyan context ile
+// doğrular. Okuma hatası ve boyut sınırı "_payload" alanında raporlanır.
+func (cfg middlewareConfig) check(ctx context.Context, r *http.Request, schema core.Schema) *core.ValidationResult {
+	if len(cfg.contentTypes) > 0 && hasBody(r) {
+		mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+		if !rules.MatchMIME(mediaType, cfg.contentTypes) {
+			result := core.NewResult()
+			result.AddRuleError(payloadField, i18n.KeyContentType, i18n.StringList(cfg.contentTypes))
+			return result
+		}
+	}
+	if cfg.maxBodyBytes > 0 && hasBody(r) {
+		if r.ContentLength > cfg.maxBodyBytes {
+			result := core.NewResult()
+			result.AddRuleError(payloadField, i18n.KeyPayloadTooLarge, cfg.maxBodyBytes)
+			return result
+		}
+		r.Body = http.MaxBytesReader(nil, r.Body, cfg.maxBodyBytes)
+	}
+
+	data, err := cfg.source(r, schema)
+	if err != nil {
+		result := core.NewResult()
+		addReadError(result, payloadField, err, cfg.maxBodyBytes)
+		return result
+	}
+	return core.ValidateContext(ContextWithRequest(ctx, r), schema, data)
+}
+
+// addReadError, gövde okuma hatasını field alanına standart kurallardan
+// biriyle ekler: boyut sınırı payload_too_large, konumu bilinen söz dizimi
+// hataları json_syntax, diğerleri invalid_json olarak raporlanır.
+func addReadError(result *core.ValidationResult, field string, err error, limit int64) {
+	var (
+		tooLarge  *http.MaxBytesError
+		syntaxErr *JSONSyntaxError
+	)
+	switch {
+	case errors.As(err, &tooLarge):
+		result.AddRuleError(field, i18n.KeyPayloadTooLarge, limit)
+	case errors.As(err, &syntaxErr):
+		result.AddRuleError(field, i18n.KeyJSONSyntax, syntaxErr.Line, syntaxErr.Column)
+	default:
+		result.AddRuleError(field, i18n.KeyInvalidJSON)
+	}
+}
+
+// Validate
+// -----------------------------------------------------------------------------
+// İsteği Middleware ile aynı şekilde (aynı veri kaynağı seçenekleriyle)
+// doğrular ancak yanıt yazmaz. Middleware kullanılamayan yerlerde (handler
+// içinde, başka framework adaptörlerinde) kullanılır; OnError seçeneği
+// burada dikkate alınmaz. Sonuca istek kimliği Middleware'deki gibi atanır.
+//
+// Örnek:
+//
+//	res := httpvalidate.Validate(r, schema, httpvalidate.FromQuery())
+func Validate(r *http.Request, schema core.Schema, opts ...Option) *core.ValidationResult {
+	cfg := newMiddlewareConfig(opts)
+	return cfg.validate(cfg.requestContext(r), r, schema)
+}
+
+// Middleware
+// -----------------------------------------------------------------------------
+// İsteği schema ile doğrulayan middleware döndürür. Hata durumunda sonuç
+// WriteErrors ile (422, Accept başlığına göre JSON/XML/metin) yazılır;
+// başarılı isteklerde doğrulanmış veri ValidData/ValidDataFromContext ile
+// handler'dan okunabilir. İstek kimliği yanıt başlığına yazılır ve handler'a
+// context üzerinden (core.RequestIDFromContext) geçer.
+func Middleware(schema core.Schema, opts ...Option) func(http.Handler) http.Handler {
+	cfg := newMiddlewareConfig(opts)
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := cfg.requestContext(r)
+			w.Header().Set(cfg.requestIDHeader, core.RequestIDFromContext(ctx))
+
+			result := cfg.validate(ctx, r, schema)
+			if result.HasErrors() {
+				cfg.onError(w, r.WithContext(ctx), result)
+				return
+			}
+			next.ServeHTTP(w, r.WithContext(ContextWithValidData(ctx, result.ValidData())))
+		})
+	}
+}
+
+// validDataContextKey, context içinde doğrulanmış veriyi taşıyan anahtardır.
+type validDataContextKey struct{}
+
+// ContextWithValidData, doğrulanmış veriyi context'e ekler. Middleware
+// tarafından kullanılır; testlerde handler'ları doğrudan çağırmak için de
+// kullanılabilir.
+func ContextWithValidData(ctx context.Context, data map[string]any) context.Context {
+	return context.WithValue(ctx, validDataContextKey{}, data)
+}
+
+// ValidDataFromContext, Middleware'in eklediği doğrulanmış veriyi döndürür.
+func ValidDataFromContext(ctx context.Context) (map[string]any, bool) {
+	if ctx == nil {
+		return nil, false
+	}
+	data, ok := ctx.Value(validDataContextKey{}).(map[string]any)
+	return data, ok
+}
+
+// ValidData, isteğin doğrulanmış verisini döndürür. Middleware'den geçmemiş
+// isteklerde nil döner.
+func ValidData(r *http.Request) map[string]any {
+	data, _ := ValidDataFromContext(r.Context())
+	return data
+}
+
+// ValidFile, multipart istekte doğrulanmış tek dosyayı döndürür. Alan yoksa
+// veya dosya değilse nil döner.
+func ValidFile(r *http.Request, field string) *multipart.FileHeader {
+	file, _ := ValidData(r)[field].(*multipart.FileHeader)
+	return file
+}
+
+// ValidFiles, multipart istekte doğrulanmış dosyaları döndürür. Dizi alanları
+// (Array().Elements(File())) ve tek dosya alanları desteklenir.
+func ValidFiles(r *http.Request, field string) []*multipart.FileHeader {
+	switch v := ValidData(r)[field].(type) {
+	case *multipart.FileHeader:
+		return []*multipart.FileHeader{v}
+	case []any:
+		files := make([]*multipart.FileHeader, 0, len(v))
+		for _, item := range v {
+			if file, ok := item.(*multipart.FileHeader); ok {
+				files = append(files, file)
+			}
+		}
+		return files
+	}
+	return nil
+}
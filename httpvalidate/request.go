@@ -0,0 +1,240 @@
+package httpvalidate
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"net/url"
+	"unicode/utf8"
+
+	"github.com/biyonik/go-fluent-validator/core"
+)
+
+//
+// -----------------------------------------------------------------------------
+// İstek Doğrulama (gövde, query string, başlıklar)
+// -----------------------------------------------------------------------------
+// Bir *http.Request'in JSON gövdesini, query string'ini ve başlıklarını ayrı
+// şemalarla doğrular ve sonuçları kaynak önekiyle tek bir ValidationResult'ta
+// toplar:
+//
+//	body.email     → gövdedeki "email" alanı
+//	query.page     → ?page=... parametresi
+//	header.X-Api-Key → X-Api-Key başlığı
+//
+// Query ve başlık değerleri string olarak gelir ve core.CoerceValues ile
+// şemadaki tiplere çevrilir: number alanlar json.Number'a (NumberType
+// tarafından sayıya çevrilir), boolean alanlar bool'a, dizi alanlar
+// (tekrarlanan veya virgülle ayrılmış değerlerden) []any'ye.
+//
+// Gövde json.Decoder.UseNumber ile çözülür; boş gövde boş nesne kabul edilir,
+// bozuk JSON "body" alanında (söz dizimi hatalarında satır ve sütunla)
+// raporlanır. Gövde en fazla DefaultMaxBodyBytes kadar okunur; sınırı aşan
+// gövdeler payload_too_large hatasıyla reddedilir. Doğrulama ContextWithRequest ile
+// isteği taşıyan context üzerinden (ValidateCtx) yapılır.
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// DefaultMaxBodyBytes, JSON gövdeleri için varsayılan boyut sınırıdır (1 MiB).
+// DecodeJSON, ValidateRequest ve Middleware bu sınırı kullanır; Middleware'de
+// MaxBodyBytes seçeneğiyle, diğer yerlerde bu değişken değiştirilerek
+// ayarlanır. 0 veya negatif değer sınırı kaldırır.
+var DefaultMaxBodyBytes int64 = 1 << 20
+
+// Sonuçtaki alan adlarına eklenen kaynak önekleri.
+const (
+	SourceBody   = "body"
+	SourceQuery  = "query"
+	SourceHeader = "header"
+)
+
+// ValidateRequest
+// -----------------------------------------------------------------------------
+// İsteğin gövdesini, query string'ini ve başlıklarını ilgili şemalarla
+// doğrular. nil verilen şemanın kaynağı atlanır. Hata yoksa ValidData,
+// {"body": {...}, "query": {...}, "header": {...}} biçiminde döner.
+//
+// Örnek:
+//
+//	res := httpvalidate.ValidateRequest(r, createUserSchema, nil, apiKeySchema)
+//	if res.HasErrors() {
+//	    httpvalidate.WriteErrors(w, r, res)
+//	    return
+//	}
+//	body := res.ValidData()["body"].(map[string]any)
+func ValidateRequest(r *http.Request, bodySchema, querySchema, headerSchema core.Schema) *core.ValidationResult {
+	ctx := ContextWithRequest(r.Context(), r)
+	result := core.NewResult()
+	valid := make(map[string]any)
+
+	run := func(source string, schema core.Schema, data map[string]any) {
+		res := core.ValidateContext(ctx, schema, data)
+		result.MergePrefixed(source, res)
+		valid[source] = res.ValidData()
+	}
+
+	if bodySchema != nil {
+		data, err := decodeBody(r, DefaultMaxBodyBytes)
+		if err != nil {
+			addReadError(result, SourceBody, err, DefaultMaxBodyBytes)
+		} else {
+			run(SourceBody, bodySchema, data)
+		}
+	}
+	if querySchema != nil {
+		run(SourceQuery, querySchema, CoerceValues(r.URL.Query(), querySchema))
+	}
+	if headerSchema != nil {
+		fields := schemaFields(headerSchema)
+		data := make(map[string]any)
+		for name, desc := range fields {
+			if values := r.Header.Values(textproto.CanonicalMIMEHeaderKey(name)); len(values) > 0 {
+				data[name] = core.CoerceStrings(values, desc)
+			}
+		}
+		run(SourceHeader, headerSchema, data)
+	}
+
+	if !result.HasErrors() {
+		result.SetValidData(valid)
+	}
+	return result
+}
+
+// decodeBody, istek gövdesini en fazla limit bayt okuyarak DecodeJSONLimit
+// ile çözer.
+func decodeBody(r *http.Request, limit int64) (map[string]any, error) {
+	if r.Body == nil || r.Body == http.NoBody {
+		return make(map[string]any), nil
+	}
+	return DecodeJSONLimit(r.Body, limit)
+}
+
+// JSONSyntaxError, bozuk bir JSON gövdesindeki hatanın konumunu taşır.
+// Line ve Column 1'den başlar; Column rune olarak sayılır.
+type JSONSyntaxError struct {
+	Line   int
+	Column int
+	Offset int64
+	Err    error
+}
+
+// Error, hatayı konumuyla birlikte döndürür.
+func (e *JSONSyntaxError) Error() string {
+	return fmt.Sprintf("JSON söz dizimi hatası (satır %d, sütun %d): %v", e.Line, e.Column, e.Err)
+}
+
+// Unwrap, alttaki json.SyntaxError veya io.ErrUnexpectedEOF hatasını döndürür.
+func (e *JSONSyntaxError) Unwrap() error {
+	return e.Err
+}
+
+// newJSONSyntaxError, data içindeki offset'i satır ve sütuna çevirir.
+// json.SyntaxError.Offset hatalı karakterden sonrasını gösterdiği için
+// çağıran taraf bir eksiğini verir.
+func newJSONSyntaxError(data []byte, offset int64, err error) *JSONSyntaxError {
+	offset = min(max(offset, 0), int64(len(data)))
+	before := data[:offset]
+	line := bytes.Count(before, []byte("\n")) + 1
+	column := utf8.RuneCount(before[bytes.LastIndexByte(before, '\n')+1:]) + 1
+	return &JSONSyntaxError{Line: line, Column: column, Offset: offset, Err: err}
+}
+
+// DecodeJSON, body'yi UseNumber ile tek bir JSON nesnesi olarak çözer. Boş
+// gövde boş nesne döner; nesne olmayan kök değerler ve nesneden sonra gelen
+// içerik hata verir. Söz dizimi hataları ve yarıda kesilmiş gövdeler
+// *JSONSyntaxError olarak döner. Sayılar json.Number olarak kalır ve
+// NumberType tarafından çevrilir. Gövde DefaultMaxBodyBytes ile sınırlanır
+// (bkz. DecodeJSONLimit).
+func DecodeJSON(body io.Reader) (map[string]any, error) {
+	return DecodeJSONLimit(body, DefaultMaxBodyBytes)
+}
+
+// DecodeJSONLimit, DecodeJSON gibi çalışır ancak body'den en fazla limit bayt
+// okur; daha uzun gövdeler için *http.MaxBytesError döner. limit 0 veya
+// negatifse gövde sınırsız okunur (boyutu başka bir katmanda sınırlanmış
+// veriler için).
+func DecodeJSONLimit(body io.Reader, limit int64) (map[string]any, error) {
+	if limit > 0 {
+		body = io.LimitReader(body, limit+1)
+	}
+	raw, err := io.ReadAll(body)
+	if err != nil {
+		return nil, err
+	}
+	if limit > 0 && int64(len(raw)) > limit {
+		return nil, &http.MaxBytesError{Limit: limit}
+	}
+
+	data := make(map[string]any)
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	if err := dec.Decode(&data); err != nil {
+		var syntaxErr *json.SyntaxError
+		switch {
+		case errors.Is(err, io.EOF):
+			return make(map[string]any), nil
+		case errors.As(err, &syntaxErr):
+			return nil, newJSONSyntaxError(raw, syntaxErr.Offset-1, err)
+		case errors.Is(err, io.ErrUnexpectedEOF):
+			return nil, newJSONSyntaxError(raw, int64(len(raw)), err)
+		}
+		return nil, err
+	}
+	if data == nil {
+		return nil, errors.New("JSON gövdesi bir nesne olmalıdır")
+	}
+	if _, err := dec.Token(); !errors.Is(err, io.EOF) {
+		return nil, errors.New("JSON nesnesinden sonra beklenmeyen içerik")
+	}
+	return data, nil
+}
+
+// CoerceValues, query veya form değerlerini şemadaki tiplere göre çevirerek
+// doğrulanacak veriye dönüştürür (bkz. core.CoerceValues).
+func CoerceValues(values url.Values, schema core.Schema) map[string]any {
+	return core.CoerceValues(values, schemaFields(schema))
+}
+
+// schemaFields, şemanın alan tanımlarını döndürür; şema kendini tanımlayamıyorsa
+// (core.SchemaDescriber sağlamıyorsa) nil döner ve değerler string olarak kalır.
+func schemaFields(schema core.Schema) map[string]*core.TypeDescription {
+	if describer, ok := schema.(core.SchemaDescriber); ok {
+		return describer.Describe().Fields
+	}
+	return nil
+}
+
+// CoerceForm, multipart form değerlerini CoerceValues ile çevirir ve yüklenen
+// dosyaları ekler: şemada dizi olan alanlar []any, diğerleri tek bir
+// *multipart.FileHeader olarak verilir (validation.File ve
+// Array().Elements(File()) ile doğrulanır).
+func CoerceForm(form *multipart.Form, schema core.Schema) map[string]any {
+	fields := schemaFields(schema)
+	data := core.CoerceValues(form.Value, fields)
+	for key, files := range form.File {
+		if len(files) == 0 {
+			continue
+		}
+		if desc := fields[key]; desc == nil || desc.Type != "array" {
+			data[key] = files[0]
+			continue
+		}
+		items := make([]any, len(files))
+		for i, file := range files {
+			items[i] = file
+		}
+		data[key] = items
+	}
+	return data
+}
@@ -0,0 +1,91 @@
+package httpvalidate
+
+import (
+	"context"
+	"crypto/rand"
+	"log/slog"
+	"net/http"
+
+	"github.com/biyonik/go-fluent-validator/core"
+)
+
+//
+// -----------------------------------------------------------------------------
+// İstek Kimliği ve Doğrulama Logları
+// -----------------------------------------------------------------------------
+// Middleware, her isteğe bir kimlik (correlation ID) bağlar: kimlik önce
+// context'ten (core.ContextWithRequestID ile başka bir middleware eklemişse),
+// sonra X-Request-ID başlığından okunur; ikisi de yoksa yeni bir kimlik
+// üretilir. Kimlik;
+//
+//   - hata yanıtına ("request_id" alanı / niteliği) ve yanıt başlığına,
+//   - Logger verilmişse başarısız doğrulama loguna,
+//   - handler'a giden context'e
+//
+// eklenir. Böylece kullanıcının bildirdiği hata yanıtı sunucu loglarıyla
+// eşleştirilebilir. Loglara alan değerleri değil, yalnızca alan ve kural
+// adları yazılır.
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// DefaultRequestIDHeader, istek kimliğinin okunduğu ve yanıta yazıldığı
+// varsayılan başlıktır.
+const DefaultRequestIDHeader = "X-Request-ID"
+
+// RequestIDHeader, istek kimliğinin okunacağı ve yanıta yazılacağı başlığı
+// değiştirir (örn. "X-Correlation-ID").
+func RequestIDHeader(name string) Option {
+	return func(c *middlewareConfig) {
+		c.requestIDHeader = name
+	}
+}
+
+// Logger, başarısız doğrulamaların istek kimliğiyle loglanacağı logger'ı
+// belirler. Loglar Info seviyesinde "validation failed" mesajıyla yazılır.
+//
+// Örnek:
+//
+//	httpvalidate.Middleware(schema, httpvalidate.Logger(slog.Default()))
+func Logger(logger *slog.Logger) Option {
+	return func(c *middlewareConfig) {
+		c.logger = logger
+	}
+}
+
+// requestContext, isteğin context'ine istek kimliğini ekler. Kimlik
+// context'te varsa korunur, yoksa başlıktan okunur veya üretilir.
+func (cfg middlewareConfig) requestContext(r *http.Request) context.Context {
+	ctx := r.Context()
+	if core.RequestIDFromContext(ctx) != "" {
+		return ctx
+	}
+	id := r.Header.Get(cfg.requestIDHeader)
+	if id == "" {
+		id = rand.Text()
+	}
+	return core.ContextWithRequestID(ctx, id)
+}
+
+// logFailure, başarısız doğrulamayı istek kimliği, yöntem, yol ve
+// "alan:kural" listesiyle loglar.
+func (cfg middlewareConfig) logFailure(ctx context.Context, r *http.Request, result *core.ValidationResult) {
+	if cfg.logger == nil {
+		return
+	}
+	failures := result.Failures()
+	rules := make([]string, len(failures))
+	for i, f := range failures {
+		rules[i] = f.Field + ":" + f.Rule
+	}
+	cfg.logger.LogAttrs(ctx, slog.LevelInfo, "validation failed",
+		slog.String("request_id", result.RequestID()),
+		slog.String("method", r.Method),
+		slog.String("path", r.URL.Path),
+		slog.Any("failures", rules),
+	)
+}
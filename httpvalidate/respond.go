@@ -0,0 +1,145 @@
+package httpvalidate
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"mime"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/biyonik/go-fluent-validator/core"
+)
+
+//
+// -----------------------------------------------------------------------------
+// Hata Yanıtı İçerik Anlaşması (JSON, XML, düz metin)
+// -----------------------------------------------------------------------------
+// Doğrulama sonucunu isteğin Accept başlığına göre JSON, XML veya düz metin
+// olarak yazar. Biçimlerin kendisi core.ValidationResult serileştiricileri
+// (MarshalJSON, MarshalXML, MarshalText) tarafından üretilir; bu dosya yalnızca
+// biçim seçimini ve HTTP başlıklarını yönetir.
+//
+// Seçim kuralları:
+//   - Accept başlığındaki medya türleri q değerine göre denenir.
+//   - "+json" / "+xml" son ekli türler (application/problem+json,
+//     application/soap+xml) ilgili biçime eşlenir.
+//   - Başlık yoksa, "*/*" ise veya hiçbir tür desteklenmiyorsa JSON kullanılır.
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// Desteklenen hata yanıtı içerik türleri.
+const (
+	ContentTypeJSON = "application/json"
+	ContentTypeXML  = "application/xml"
+	ContentTypeText = "text/plain"
+)
+
+// NegotiateContentType
+// -----------------------------------------------------------------------------
+// Accept başlığına göre hata yanıtının içerik türünü seçer ve
+// ContentTypeJSON, ContentTypeXML veya ContentTypeText döndürür.
+//
+// Örnek:
+//
+//	httpvalidate.NegotiateContentType("text/xml;q=0.9, text/plain;q=0.5")
+//	// "application/xml"
+func NegotiateContentType(accept string) string {
+	type weighted struct {
+		contentType string
+		q           float64
+	}
+	var candidates []weighted
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+		q := 1.0
+		if raw, ok := params["q"]; ok {
+			if parsed, err := strconv.ParseFloat(raw, 64); err == nil && parsed >= 0 && parsed <= 1 {
+				q = parsed
+			}
+		}
+		if contentType := supportedContentType(mediaType); contentType != "" && q > 0 {
+			candidates = append(candidates, weighted{contentType: contentType, q: q})
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].q > candidates[j].q })
+
+	if len(candidates) == 0 {
+		return ContentTypeJSON
+	}
+	return candidates[0].contentType
+}
+
+// supportedContentType, bir medya türünü desteklenen yanıt türüne eşler;
+// desteklenmiyorsa boş string döndürür.
+func supportedContentType(mediaType string) string {
+	switch {
+	case mediaType == "*/*", mediaType == "application/*", mediaType == "application/json",
+		strings.HasSuffix(mediaType, "+json"):
+		return ContentTypeJSON
+	case mediaType == "application/xml", mediaType == "text/xml", strings.HasSuffix(mediaType, "+xml"):
+		return ContentTypeXML
+	case mediaType == "text/plain", mediaType == "text/*":
+		return ContentTypeText
+	}
+	return ""
+}
+
+// WriteResult
+// -----------------------------------------------------------------------------
+// Sonucu, isteğin Accept başlığına göre seçilen biçimde status koduyla yazar.
+// Yanıta "Vary: Accept" ve UTF-8 karakter setli Content-Type eklenir.
+//
+// Örnek:
+//
+//	res := schema.ValidateCtx(r.Context(), payload)
+//	if res.HasErrors() {
+//	    httpvalidate.WriteErrors(w, r, res)
+//	    return
+//	}
+func WriteResult(w http.ResponseWriter, r *http.Request, status int, result *core.ValidationResult) error {
+	body, contentType, err := MarshalResult(r.Header.Get("Accept"), result)
+	if err != nil {
+		return err
+	}
+
+	w.Header().Add("Vary", "Accept")
+	w.Header().Set("Content-Type", contentType+"; charset=utf-8")
+	w.WriteHeader(status)
+	_, err = w.Write(body)
+	return err
+}
+
+// MarshalResult, sonucu Accept başlık değerine göre seçilen biçimde
+// serileştirir ve içerik türüyle (charset olmadan) döndürür. WriteResult'ın
+// yazma adımından bağımsız kısmıdır; net/http dışındaki framework
+// adaptörlerinde kullanılır.
+func MarshalResult(accept string, result *core.ValidationResult) (body []byte, contentType string, err error) {
+	contentType = NegotiateContentType(accept)
+	switch contentType {
+	case ContentTypeXML:
+		body, err = xml.Marshal(result)
+		if err == nil {
+			body = append([]byte(xml.Header), body...)
+		}
+	case ContentTypeText:
+		body, err = result.MarshalText()
+	default:
+		body, err = json.Marshal(result)
+	}
+	return body, contentType, err
+}
+
+// WriteErrors, sonucu 422 Unprocessable Entity durum koduyla yazar.
+func WriteErrors(w http.ResponseWriter, r *http.Request, result *core.ValidationResult) error {
+	return WriteResult(w, r, http.StatusUnprocessableEntity, result)
+}
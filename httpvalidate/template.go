@@ -0,0 +1,85 @@
+package httpvalidate
+
+import (
+	"html/template"
+
+	"github.com/biyonik/go-fluent-validator/core"
+)
+
+//
+// -----------------------------------------------------------------------------
+// html/template Yardımcıları
+// -----------------------------------------------------------------------------
+// Sunucu tarafında render edilen klasik Go web uygulamalarında doğrulama
+// hatalarını şablonlarda göstermek için bir ValidationResult'a bağlı
+// template.FuncMap üretir:
+//
+//	{{if hasError "email"}}<p class="error">{{firstError "email"}}</p>{{end}}
+//	<input name="email" class="{{errorClass "email"}}" value="{{old "email"}}">
+//	{{range errors "password"}}<li>{{.}}</li>{{end}}
+//
+// Fonksiyonlar şablon ayrıştırılmadan önce tanımlı olmalıdır. Bu yüzden şablon
+// TemplateFuncs(nil) ile bir kez ayrıştırılır; her istekte Clone edilip o
+// isteğin sonucuna bağlı fonksiyonlarla çalıştırılır:
+//
+//	base := template.Must(template.New("form").Funcs(httpvalidate.TemplateFuncs(nil)).Parse(src))
+//	...
+//	tmpl := template.Must(base.Clone())
+//	tmpl.Funcs(httpvalidate.TemplateFuncs(result)).Execute(w, data)
+//
+// Sonuç nil ise (form ilk kez gösteriliyorsa) tüm yardımcılar boş değer döndürür.
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// DefaultErrorClass, errorClass yardımcısının sınıf belirtilmediğinde
+// döndürdüğü CSS sınıfıdır (Bootstrap uyumlu).
+const DefaultErrorClass = "is-invalid"
+
+// TemplateFuncs
+// -----------------------------------------------------------------------------
+// result'a bağlı şablon fonksiyonlarını döndürür:
+//   - hasError "alan": alanın hatası varsa true
+//   - firstError "alan": alanın ilk hata mesajı
+//   - errors "alan": alanın tüm hata mesajları
+//   - errorClass "alan" ["sınıf"]: alan hatalıysa CSS sınıfı (varsayılan
+//     DefaultErrorClass), değilse boş string
+//   - old "alan": alanın eski girdisi (result.OldValue)
+func TemplateFuncs(result *core.ValidationResult) template.FuncMap {
+	return template.FuncMap{
+		"hasError": func(field string) bool {
+			return result != nil && result.HasFieldErrors(field)
+		},
+		"firstError": func(field string) string {
+			if result == nil || !result.HasFieldErrors(field) {
+				return ""
+			}
+			return result.Errors()[field][0]
+		},
+		"errors": func(field string) []string {
+			if result == nil {
+				return nil
+			}
+			return result.Errors()[field]
+		},
+		"errorClass": func(field string, class ...string) string {
+			if result == nil || !result.HasFieldErrors(field) {
+				return ""
+			}
+			if len(class) > 0 {
+				return class[0]
+			}
+			return DefaultErrorClass
+		},
+		"old": func(field string) string {
+			if result == nil {
+				return ""
+			}
+			return result.OldValue(field)
+		},
+	}
+}
@@ -0,0 +1,74 @@
+package i18n
+
+import "strings"
+
+//
+// -----------------------------------------------------------------------------
+// Sağdan Sola (RTL) Diller için Güvenli Mesaj Biçimlendirme
+// -----------------------------------------------------------------------------
+// Arapça, İbranice, Farsça gibi sağdan sola yazılan dillerde, cümle içine
+// yerleştirilen Latin alan adları ve sayılar Unicode bidi algoritması nedeniyle
+// yanlış sırada görüntülenebilir ("age alanı 18'den büyük olmalı" yerine
+// karışık bir sıra). Bu dosya, aktif dil RTL olduğunda mesaja yerleştirilen her
+// değeri FSI (U+2068) ve PDI (U+2069) yön yalıtım işaretleriyle sarmalar.
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+const (
+	// FirstStrongIsolate (FSI), yönü içeriğe göre belirlenen yalıtımı başlatır.
+	FirstStrongIsolate = "\u2068"
+
+	// PopDirectionalIsolate (PDI), açılan yalıtımı kapatır.
+	PopDirectionalIsolate = "\u2069"
+)
+
+// rtlLanguages, sağdan sola yazılan dillerin ISO 639-1/639-3 kodlarıdır.
+var rtlLanguages = map[string]bool{
+	"ar":  true, // Arapça
+	"he":  true, // İbranice
+	"iw":  true, // İbranice (eski kod)
+	"fa":  true, // Farsça
+	"ur":  true, // Urduca
+	"ps":  true, // Peştuca
+	"yi":  true, // Yidiş
+	"dv":  true, // Divehi
+	"sd":  true, // Sindhi
+	"ug":  true, // Uygurca
+	"ckb": true, // Sorani Kürtçesi
+}
+
+// IsRTL
+// -----------------------------------------------------------------------------
+// Dil kodunun sağdan sola yazılan bir dile ait olup olmadığını döndürür.
+// Bölge ekleri dikkate alınmaz ("ar-EG", "he_IL" → true).
+func IsRTL(locale string) bool {
+	lang, _, _ := strings.Cut(strings.ReplaceAll(locale, "_", "-"), "-")
+	return rtlLanguages[strings.ToLower(lang)]
+}
+
+// Isolate
+// -----------------------------------------------------------------------------
+// Metni FSI/PDI yön yalıtım işaretleriyle sarmalar.
+func Isolate(s string) string {
+	return FirstStrongIsolate + s + PopDirectionalIsolate
+}
+
+// SetBidiIsolation
+// -----------------------------------------------------------------------------
+// RTL dillerde yerleştirilen değerlerin yön yalıtımını açar/kapatır.
+// Varsayılan olarak açıktır.
+func SetBidiIsolation(enabled bool) {
+	globalTranslator.SetBidiIsolation(enabled)
+}
+
+// SetBidiIsolation, translator için yön yalıtımını açar/kapatır.
+func (t *Translator) SetBidiIsolation(enabled bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.bidiDisabled = !enabled
+}
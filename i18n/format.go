@@ -0,0 +1,234 @@
+package i18n
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+//
+// -----------------------------------------------------------------------------
+// Mesajlarda Yerel (Locale) Sayı ve Tarih Biçimlendirme
+// -----------------------------------------------------------------------------
+// Min/Max gibi sınırlar mesajlara varsayılan olarak Go'nun %v çıktısıyla
+// yerleştirilir ("1234.5"). Bu dosya, değerlerin dile göre biçimlendirilmesi
+// için opsiyonel bir ValueFormatter kancası ve hazır LocaleFormatter
+// implementasyonunu içerir. Örneğin de/tr kullanıcıları için "1.234,5",
+// tarihler için "02.01.2006" sırası kullanılır.
+//
+// Kanca varsayılan olarak kapalıdır; mevcut mesaj çıktıları değişmez.
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// ValueFormatter, mesaja yerleştirilecek bir değeri dile göre biçimlendirir.
+// İkinci dönüş false ise değer varsayılan fmt biçimlendirmesiyle yazılır.
+type ValueFormatter func(locale string, value any) (string, bool)
+
+// numberFormat, bir dilin ondalık ve binlik ayırıcılarıdır.
+type numberFormat struct {
+	decimal  string
+	grouping string
+}
+
+// localeNumberFormats, LocaleFormatter'ın bildiği sayı biçimleridir.
+var localeNumberFormats = map[string]numberFormat{
+	"en": {decimal: ".", grouping: ","},
+	"ja": {decimal: ".", grouping: ","},
+	"zh": {decimal: ".", grouping: ","},
+	"tr": {decimal: ",", grouping: "."},
+	"de": {decimal: ",", grouping: "."},
+	"es": {decimal: ",", grouping: "."},
+	"fr": {decimal: ",", grouping: " "},
+}
+
+// localeDateLayouts, LocaleFormatter'ın bildiği tarih sıralarıdır.
+var localeDateLayouts = map[string]string{
+	"en": "01/02/2006",
+	"tr": "02.01.2006",
+	"de": "02.01.2006",
+	"fr": "02/01/2006",
+	"es": "02/01/2006",
+	"ja": "2006/01/02",
+	"zh": "2006/01/02",
+}
+
+// DateValue, mesaja yerleştirilen bir tarih sınırını temsil eder. Varsayılan
+// biçimlendirmede tanımlandığı haliyle (Raw) yazılır; LocaleFormatter açıksa
+// Time değeri dilin tarih sırasıyla biçimlendirilir.
+type DateValue struct {
+	Time time.Time
+	Raw  string
+}
+
+// String, fmt.Stringer implementasyonu; tarihin ham halini döndürür.
+func (d DateValue) String() string {
+	return d.Raw
+}
+
+// SetValueFormatter
+// -----------------------------------------------------------------------------
+// Mesajlara yerleştirilen değerler için biçimlendirici kancayı ayarlar.
+// nil verilirse kanca kapatılır.
+//
+// Örnek:
+//
+//	i18n.SetValueFormatter(i18n.LocaleFormatter)
+//	i18n.SetLocale("de")
+//	i18n.Get(i18n.KeyMin, "Betrag", 1234.5) // "Betrag muss mindestens 1.234,5 sein"
+func SetValueFormatter(formatter ValueFormatter) {
+	globalTranslator.SetValueFormatter(formatter)
+}
+
+// SetValueFormatter, translator için biçimlendirici kancayı ayarlar.
+func (t *Translator) SetValueFormatter(formatter ValueFormatter) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.valueFormatter = formatter
+}
+
+// LocaleFormatter
+// -----------------------------------------------------------------------------
+// Sayıları dilin ondalık/binlik ayırıcılarıyla, time.Time değerlerini dilin
+// tarih sırasıyla biçimlendiren hazır ValueFormatter'dır. Bilinmeyen diller ve
+// sayı/tarih olmayan değerler için false döner.
+func LocaleFormatter(locale string, value any) (string, bool) {
+	lang, _, _ := strings.Cut(strings.ReplaceAll(locale, "_", "-"), "-")
+	lang = strings.ToLower(lang)
+
+	if d, ok := value.(DateValue); ok {
+		value = d.Time
+	}
+	if t, ok := value.(time.Time); ok {
+		layout, known := localeDateLayouts[lang]
+		if !known {
+			return "", false
+		}
+		return t.Format(layout), true
+	}
+
+	format, known := localeNumberFormats[lang]
+	if !known {
+		return "", false
+	}
+
+	switch v := value.(type) {
+	case int:
+		return formatInteger(strconv.FormatInt(int64(v), 10), format), true
+	case int8:
+		return formatInteger(strconv.FormatInt(int64(v), 10), format), true
+	case int16:
+		return formatInteger(strconv.FormatInt(int64(v), 10), format), true
+	case int32:
+		return formatInteger(strconv.FormatInt(int64(v), 10), format), true
+	case int64:
+		return formatInteger(strconv.FormatInt(v, 10), format), true
+	case uint:
+		return formatInteger(strconv.FormatUint(uint64(v), 10), format), true
+	case uint32:
+		return formatInteger(strconv.FormatUint(uint64(v), 10), format), true
+	case uint64:
+		return formatInteger(strconv.FormatUint(v, 10), format), true
+	case float32:
+		return formatFloat(float64(v), format)
+	case float64:
+		return formatFloat(v, format)
+	}
+	return "", false
+}
+
+// formatFloat, ondalık sayıyı gereksiz sıfırlar olmadan biçimlendirir.
+func formatFloat(v float64, format numberFormat) (string, bool) {
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return "", false
+	}
+	raw := strconv.FormatFloat(v, 'f', -1, 64)
+	intPart, fracPart, hasFrac := strings.Cut(raw, ".")
+	out := formatInteger(intPart, format)
+	if hasFrac {
+		out += format.decimal + fracPart
+	}
+	return out, true
+}
+
+// formatInteger, tamsayı metnine binlik ayırıcıları ekler.
+func formatInteger(digits string, format numberFormat) string {
+	sign := ""
+	if strings.HasPrefix(digits, "-") {
+		sign, digits = "-", digits[1:]
+	}
+	if len(digits) <= 3 {
+		return sign + digits
+	}
+
+	var b strings.Builder
+	b.WriteString(sign)
+	head := len(digits) % 3
+	if head > 0 {
+		b.WriteString(digits[:head])
+	}
+	for i := head; i < len(digits); i += 3 {
+		if b.Len() > len(sign) {
+			b.WriteString(format.grouping)
+		}
+		b.WriteString(digits[i : i+3])
+	}
+	return b.String()
+}
+
+// messageArg, mesaja yerleştirilen bir değeri fmt fiilini (verb) koruyarak
+// dile göre biçimlendiren, limit karakterden uzunsa kısaltan ve gerekirse
+// yön yalıtım işaretleriyle sarmalayan yardımcı tiptir.
+type messageArg struct {
+	value     any
+	locale    string
+	formatter ValueFormatter
+	isolate   bool
+	limit     int
+}
+
+// Format, fmt.Formatter implementasyonu.
+func (a messageArg) Format(state fmt.State, verb rune) {
+	if a.isolate {
+		fmt.Fprint(state, FirstStrongIsolate)
+	}
+
+	value := a.value
+	if s, ok := value.(string); ok && a.limit > 0 {
+		// Büyük girdiler bütünüyle kopyalanmadan önce kısaltılır; fazladan
+		// bırakılan karakter, Truncate'in üç noktayı eklemesini sağlar.
+		value = Truncate(s, a.limit+1)
+	}
+	text, formatted := "", false
+	if a.formatter != nil && (verb == 'v' || verb == 's' || verb == 'd') {
+		text, formatted = a.formatter(a.locale, value)
+	}
+	if !formatted {
+		text = fmt.Sprintf(fmt.FormatString(state, verb), value)
+	}
+	fmt.Fprint(state, Truncate(text, a.limit))
+
+	if a.isolate {
+		fmt.Fprint(state, PopDirectionalIsolate)
+	}
+}
+
+// wrapArgs, argümanları gerektiğinde messageArg ile sarmalar. Biçimlendirici
+// tanımlı değilse, yön yalıtımı gerekmiyorsa ve uzunluk sınırı kapalıysa
+// argümanlar olduğu gibi döner.
+func wrapArgs(locale string, args []any, formatter ValueFormatter, isolate bool, limit int) []any {
+	if formatter == nil && !isolate && limit <= 0 {
+		return args
+	}
+	wrapped := make([]any, len(args))
+	for i, arg := range args {
+		wrapped[i] = messageArg{value: arg, locale: locale, formatter: formatter, isolate: isolate, limit: limit}
+	}
+	return wrapped
+}
@@ -0,0 +1,321 @@
+package i18n
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+//
+// -----------------------------------------------------------------------------
+// Gettext (.po / .mo) Katalog Desteği
+// -----------------------------------------------------------------------------
+// Bu dosya, Weblate, Crowdin, Poedit gibi standart çeviri araçlarıyla çalışan
+// ekiplerin doğrulama mesajlarını Go map'leri yazmadan çevirebilmesi için
+// gettext kataloglarını okuyan ve şablon (.pot) üreten fonksiyonları içerir.
+//
+// Bir katalog girdisi şu sırayla bir mesaj anahtarına eşlenir:
+//   1. msgctxt bir mesaj anahtarıysa (örn: "validation.required")
+//   2. msgid bir mesaj anahtarıysa
+//   3. msgid, varsayılan dildeki (İngilizce) mesaj metnine eşitse
+//
+// WritePOT ile üretilen şablonda msgctxt anahtarı, msgid İngilizce metni
+// içerir; böylece çevirmenler kaynak metni görür ve eşleme kesin olur.
+// Mesajlar Go fmt fiillerini (%s, %v, %d) kullandığından çevirilerde bu
+// fiiller korunmalıdır.
+//
+// Harici bağımlılık yoktur; .po ve .mo biçimleri saf Go ile ayrıştırılır.
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// moMagic, little-endian .mo dosyalarının sihirli sayısıdır.
+const moMagic = 0x950412de
+
+// catalogEntry, bir gettext katalog girdisidir.
+type catalogEntry struct {
+	context string
+	id      string
+	str     string
+}
+
+// LoadPOFile
+// -----------------------------------------------------------------------------
+// Bir .po dosyasını okuyup mesajlarını locale diline ekler.
+//
+// Örnek:
+//
+//	if err := i18n.LoadPOFile("pt", "locales/pt/validation.po"); err != nil {
+//	    log.Fatal(err)
+//	}
+func LoadPOFile(locale, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return LoadPO(locale, f)
+}
+
+// LoadPO, r'den okunan .po kataloğunu locale diline ekler.
+func LoadPO(locale string, r io.Reader) error {
+	return globalTranslator.LoadPO(locale, r)
+}
+
+// LoadPO, translator'a .po kataloğu ekler.
+func (t *Translator) LoadPO(locale string, r io.Reader) error {
+	entries, err := parsePO(r)
+	if err != nil {
+		return err
+	}
+	t.AddMessages(locale, t.resolveCatalog(entries))
+	return nil
+}
+
+// LoadMOFile
+// -----------------------------------------------------------------------------
+// Derlenmiş bir .mo dosyasını okuyup mesajlarını locale diline ekler.
+func LoadMOFile(locale, path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	return LoadMO(locale, data)
+}
+
+// LoadMO, derlenmiş .mo verisini locale diline ekler.
+func LoadMO(locale string, data []byte) error {
+	return globalTranslator.LoadMO(locale, data)
+}
+
+// LoadMO, translator'a .mo kataloğu ekler.
+func (t *Translator) LoadMO(locale string, data []byte) error {
+	entries, err := parseMO(data)
+	if err != nil {
+		return err
+	}
+	t.AddMessages(locale, t.resolveCatalog(entries))
+	return nil
+}
+
+// WritePOT
+// -----------------------------------------------------------------------------
+// Tüm mesaj anahtarlarını varsayılan dildeki metinleriyle bir gettext şablonu
+// (.pot) olarak yazar. Çeviri araçlarına kaynak olarak verilebilir.
+func WritePOT(w io.Writer) error {
+	return globalTranslator.WritePOT(w)
+}
+
+// WritePOT, translator'ın varsayılan dil mesajlarını .pot olarak yazar.
+func (t *Translator) WritePOT(w io.Writer) error {
+	t.mu.RLock()
+	source := t.messages[t.defaultLocale]
+	keys := make([]string, 0, len(source))
+	for key := range source {
+		keys = append(keys, string(key))
+	}
+	t.mu.RUnlock()
+	sort.Strings(keys)
+
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "msgid \"\"\nmsgstr \"\"\n\"Content-Type: text/plain; charset=UTF-8\\n\"\n")
+	for _, key := range keys {
+		fmt.Fprintf(bw, "\n#, c-format\nmsgctxt %s\nmsgid %s\nmsgstr \"\"\n",
+			strconv.Quote(key), strconv.Quote(source[MessageKey(key)]))
+	}
+	return bw.Flush()
+}
+
+// resolveCatalog, katalog girdilerini mesaj anahtarlarına eşler. Eşlenemeyen
+// girdiler yok sayılır.
+func (t *Translator) resolveCatalog(entries []catalogEntry) Messages {
+	t.mu.RLock()
+	source := t.messages[t.defaultLocale]
+	byText := make(map[string]MessageKey, len(source))
+	for key, text := range source {
+		byText[text] = key
+	}
+	t.mu.RUnlock()
+
+	messages := make(Messages, len(entries))
+	for _, entry := range entries {
+		if entry.str == "" {
+			continue
+		}
+		switch {
+		case isMessageKey(source, entry.context):
+			messages[MessageKey(entry.context)] = entry.str
+		case isMessageKey(source, entry.id):
+			messages[MessageKey(entry.id)] = entry.str
+		default:
+			if key, ok := byText[entry.id]; ok {
+				messages[key] = entry.str
+			}
+		}
+	}
+	return messages
+}
+
+// isMessageKey, s'nin bilinen bir mesaj anahtarı veya "validation." önekli
+// özel bir anahtar olup olmadığını döndürür.
+func isMessageKey(source Messages, s string) bool {
+	if s == "" {
+		return false
+	}
+	if _, ok := source[MessageKey(s)]; ok {
+		return true
+	}
+	return strings.HasPrefix(s, "validation.")
+}
+
+// parsePO, .po biçimindeki girdileri ayrıştırır. Çoğul biçimlerde msgstr[0],
+// "fuzzy" işaretli girdiler ve başlık (boş msgid) atlanır.
+func parsePO(r io.Reader) ([]catalogEntry, error) {
+	var (
+		entries []catalogEntry
+		current catalogEntry
+		target  *string
+		fuzzy   bool
+		lineNo  int
+	)
+
+	// flush, tamamlanan girdiyi listeye ekler ve yeni girdiye hazırlanır.
+	flush := func() {
+		if !fuzzy && current.id != "" {
+			entries = append(entries, current)
+		}
+		current, target, fuzzy = catalogEntry{}, nil, false
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		lineNo++
+		line := strings.TrimSpace(scanner.Text())
+
+		switch {
+		case line == "":
+			flush()
+			continue
+		case strings.HasPrefix(line, "#,"):
+			if current.id != "" {
+				flush()
+			}
+			fuzzy = fuzzy || strings.Contains(line, "fuzzy")
+			continue
+		case strings.HasPrefix(line, "#"):
+			continue
+		}
+
+		keyword, rest, _ := strings.Cut(line, " ")
+		if strings.HasPrefix(line, "\"") {
+			keyword, rest = "", line
+		}
+
+		switch {
+		case keyword == "msgctxt":
+			if current.id != "" {
+				flush()
+			}
+			target = &current.context
+		case keyword == "msgid":
+			if current.id != "" && target != &current.context {
+				flush()
+			}
+			target = &current.id
+		case keyword == "msgid_plural":
+			target = nil
+		case keyword == "msgstr" || keyword == "msgstr[0]":
+			target = &current.str
+		case strings.HasPrefix(keyword, "msgstr["):
+			target = nil
+		case keyword == "":
+			// önceki anahtar kelimenin devam satırı
+		default:
+			return nil, fmt.Errorf("po: satır %d: bilinmeyen anahtar kelime %q", lineNo, keyword)
+		}
+
+		value, err := strconv.Unquote(strings.TrimSpace(rest))
+		if err != nil {
+			return nil, fmt.Errorf("po: satır %d: geçersiz metin: %w", lineNo, err)
+		}
+		if target != nil {
+			*target += value
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	flush()
+
+	return entries, nil
+}
+
+// parseMO, derlenmiş .mo verisini ayrıştırır. Hem little-endian hem
+// big-endian dosyalar desteklenir. Bağlam "ctxt\x04msgid", çoğul biçimler
+// "\x00" ile ayrılmış olarak saklanır; çoğulda ilk biçim kullanılır.
+func parseMO(data []byte) ([]catalogEntry, error) {
+	if len(data) < 28 {
+		return nil, errors.New("mo: dosya çok kısa")
+	}
+
+	var order binary.ByteOrder = binary.LittleEndian
+	if order.Uint32(data) != moMagic {
+		order = binary.BigEndian
+		if order.Uint32(data) != moMagic {
+			return nil, errors.New("mo: geçersiz sihirli sayı")
+		}
+	}
+
+	count := order.Uint32(data[8:])
+	origTable := order.Uint32(data[12:])
+	transTable := order.Uint32(data[16:])
+
+	readString := func(table, index uint32) (string, error) {
+		pos := uint64(table) + uint64(index)*8
+		if pos+8 > uint64(len(data)) {
+			return "", errors.New("mo: tablo dosya sınırını aşıyor")
+		}
+		length := uint64(order.Uint32(data[pos:]))
+		offset := uint64(order.Uint32(data[pos+4:]))
+		if offset+length > uint64(len(data)) {
+			return "", errors.New("mo: metin dosya sınırını aşıyor")
+		}
+		return string(data[offset : offset+length]), nil
+	}
+
+	entries := make([]catalogEntry, 0, count)
+	for i := uint32(0); i < count; i++ {
+		orig, err := readString(origTable, i)
+		if err != nil {
+			return nil, err
+		}
+		trans, err := readString(transTable, i)
+		if err != nil {
+			return nil, err
+		}
+
+		var entry catalogEntry
+		if ctx, id, found := strings.Cut(orig, "\x04"); found {
+			entry.context, orig = ctx, id
+		}
+		entry.id, _, _ = strings.Cut(orig, "\x00")
+		entry.str, _, _ = strings.Cut(trans, "\x00")
+		if entry.id == "" {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
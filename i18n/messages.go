@@ -33,12 +33,19 @@ package i18n
 
 import (
 	"fmt"
+	"strings"
 	"sync"
 )
 
 // MessageKey, mesaj anahtarı için tip tanımı
 type MessageKey string
 
+// Rule, anahtarın "validation." öneki olmadan kural adını döndürür
+// (örn: KeyMinLength → "min_length"). Hata kodları ve istatistiklerde kullanılır.
+func (k MessageKey) Rule() string {
+	return strings.TrimPrefix(string(k), "validation.")
+}
+
 // Validation message keys
 const (
 	KeyRequired          MessageKey = "validation.required"
@@ -102,6 +109,146 @@ const (
 	KeyUnique         MessageKey = "validation.unique"
 	KeyArrayContains  MessageKey = "validation.array_contains"
 	KeyNotEmpty       MessageKey = "validation.not_empty"
+	// Change validators
+	KeyImmutable    MessageKey = "validation.immutable"
+	KeyOnlyIncrease MessageKey = "validation.only_increase"
+	KeyOnlyDecrease MessageKey = "validation.only_decrease"
+	KeyTransition   MessageKey = "validation.transition"
+	// Schema flow
+	KeyInvalidStep MessageKey = "validation.invalid_step"
+	// Field comparison
+	KeySame      MessageKey = "validation.same"
+	KeyDifferent MessageKey = "validation.different"
+	// Discriminated unions
+	KeyDiscriminator MessageKey = "validation.discriminator"
+	// Payload guards
+	KeyPayloadTooLarge MessageKey = "validation.payload_too_large"
+	// Regex safety
+	KeyRegexTimeout MessageKey = "validation.regex_timeout"
+	// Unicode string validators
+	KeyAlphaUnicode        MessageKey = "validation.alpha_unicode"
+	KeyAlphaNumericUnicode MessageKey = "validation.alphanumeric_unicode"
+	KeyAlphaSpace          MessageKey = "validation.alpha_space"
+	// Person names
+	KeyPersonName MessageKey = "validation.person_name"
+	// Kart BIN sorgusu
+	KeyCardLookup  MessageKey = "validation.card_lookup"
+	KeyCardKind    MessageKey = "validation.card_kind"
+	KeyCardCountry MessageKey = "validation.card_country"
+	// Kart ile ödeme
+	KeyCardExpiry  MessageKey = "validation.card_expiry"
+	KeyCardExpired MessageKey = "validation.card_expired"
+	KeyCardCVV     MessageKey = "validation.card_cvv"
+	// Tekrarlama kuralı
+	KeyRRule MessageKey = "validation.rrule"
+	// Yapısal tanımlayıcılar
+	KeyE164    MessageKey = "validation.e164"
+	KeyDID     MessageKey = "validation.did"
+	KeyURN     MessageKey = "validation.urn"
+	KeyOTPCode MessageKey = "validation.otp_code"
+	// HTTP başlık güvenliği
+	KeyHeaderValue    MessageKey = "validation.header_value"
+	KeyHeaderFilename MessageKey = "validation.header_filename"
+	// Form kötüye kullanım korumaları
+	KeyHoneypot MessageKey = "validation.honeypot"
+	KeyCSRF     MessageKey = "validation.csrf"
+	// Captcha
+	KeyCaptcha MessageKey = "validation.captcha"
+	// Zaman tabanlı tek kullanımlık şifre
+	KeyTOTP MessageKey = "validation.totp"
+	// Şifre geçmişi ve kişisel veri benzerliği
+	KeyPasswordSimilar MessageKey = "validation.password_similar"
+	KeyPasswordReused  MessageKey = "validation.password_reused"
+	// bcrypt bayt sınırı uyarısı
+	KeyPasswordTruncated MessageKey = "validation.password_truncated"
+	// E-posta alan adı kısıtları
+	KeyEmailDomainIn    MessageKey = "validation.email_domain_in"
+	KeyEmailDomainNotIn MessageKey = "validation.email_domain_not_in"
+	// Ücretsiz sağlayıcı ve rol hesabı tespiti
+	KeyEmailFreeProvider MessageKey = "validation.email_free_provider"
+	KeyEmailRoleAccount  MessageKey = "validation.email_role_account"
+	// Alan grubu kuralları
+	KeyRequireAnyOf     MessageKey = "validation.require_any_of"
+	KeyRequireAllOrNone MessageKey = "validation.require_all_or_none"
+	// Aralık çakışması
+	KeyNoOverlap MessageKey = "validation.no_overlap"
+	// Checksum
+	KeyChecksum MessageKey = "validation.checksum"
+	// National ID
+	KeyNationalID MessageKey = "validation.national_id"
+	// License plate
+	KeyLicensePlate MessageKey = "validation.license_plate"
+	// Turkish business numbers
+	KeyMERSIS        MessageKey = "validation.mersis"
+	KeyTaxOfficeCode MessageKey = "validation.tax_office"
+	KeySGKNumber     MessageKey = "validation.sgk_number"
+	// Raw JSON
+	KeyInvalidJSON MessageKey = "validation.invalid_json"
+	// IP policies
+	KeyIPNotPrivate  MessageKey = "validation.ip_not_private"
+	KeyIPNotLoopback MessageKey = "validation.ip_not_loopback"
+	KeyIPPublicOnly  MessageKey = "validation.ip_public_only"
+	// URL SSRF guard
+	KeyURLPrivateHost MessageKey = "validation.url_private_host"
+	KeyURLScheme      MessageKey = "validation.url_scheme"
+	KeyURLPort        MessageKey = "validation.url_port"
+	// Webhook callback challenge
+	KeyCallbackChallenge MessageKey = "validation.callback_challenge"
+	// DNS record checks
+	KeyDomainResolvable MessageKey = "validation.domain_resolvable"
+	KeyDomainMX         MessageKey = "validation.domain_mx"
+	KeyDomainTXT        MessageKey = "validation.domain_txt"
+	// PEM certificates and keys
+	KeyPEM        MessageKey = "validation.pem"
+	KeyPEMKeyType MessageKey = "validation.pem_key_type"
+	KeyPEMKeySize MessageKey = "validation.pem_key_size"
+	KeyPEMExpired MessageKey = "validation.pem_expired"
+	// SSH public keys
+	KeySSHPublicKey MessageKey = "validation.ssh_public_key"
+	KeySSHKeyType   MessageKey = "validation.ssh_key_type"
+	KeySSHKeySize   MessageKey = "validation.ssh_key_size"
+	// JSON Pointer and JSONPath
+	KeyJSONPointer MessageKey = "validation.json_pointer"
+	KeyJSONPath    MessageKey = "validation.json_path"
+	// File uploads
+	KeyFile          MessageKey = "validation.file"
+	KeyFileSize      MessageKey = "validation.file_size"
+	KeyFileExtension MessageKey = "validation.file_extension"
+	KeyFileMIME      MessageKey = "validation.file_mime"
+	// Images
+	KeyImage            MessageKey = "validation.image"
+	KeyImageFormat      MessageKey = "validation.image_format"
+	KeyImageMinWidth    MessageKey = "validation.image_min_width"
+	KeyImageMaxWidth    MessageKey = "validation.image_max_width"
+	KeyImageMinHeight   MessageKey = "validation.image_min_height"
+	KeyImageMaxHeight   MessageKey = "validation.image_max_height"
+	KeyImageMegapixels  MessageKey = "validation.image_megapixels"
+	KeyImageAspectRatio MessageKey = "validation.image_aspect_ratio"
+	// JSON Patch
+	KeyJSONPatch       MessageKey = "validation.json_patch"
+	KeyJSONPatchPath   MessageKey = "validation.json_patch_path"
+	KeyJSONPatchRemove MessageKey = "validation.json_patch_remove"
+	KeyJSONPatchMove   MessageKey = "validation.json_patch_move"
+	KeyInvalidXML MessageKey = "validation.invalid_xml"
+	KeyJSONSyntax  MessageKey = "validation.json_syntax"
+	KeyContentType MessageKey = "validation.content_type"
+	KeyIdempotencyKey    MessageKey = "validation.idempotency_key"
+	KeyIdempotencyReplay MessageKey = "validation.idempotency_replay"
+	KeyAnyOf MessageKey = "validation.any_of"
+	KeyNot   MessageKey = "validation.not"
+	KeyOneOfSchema MessageKey = "validation.one_of_schema"
+	KeySchemaRef MessageKey = "validation.schema_ref"
+	KeyDeadline MessageKey = "validation.deadline"
+	KeyUnion MessageKey = "validation.union"
+	KeyUnknownField MessageKey = "validation.unknown_field"
+	// Map (anahtar/değer) tipi
+	KeyMinKeys MessageKey = "validation.min_keys"
+	KeyMaxKeys MessageKey = "validation.max_keys"
+	KeyMapKey  MessageKey = "validation.map_key"
+	// Literal (sabit değer) tipi
+	KeyLiteral MessageKey = "validation.literal"
+	// Tamsayı taşması
+	KeyIntegerRange MessageKey = "validation.integer_range"
 )
 
 // Messages, bir dil için tüm mesajları içeren harita
@@ -114,6 +261,10 @@ type Translator struct {
 	defaultLocale   string
 	messages        map[string]Messages
 	fallbackEnabled bool
+	bidiDisabled    bool
+	valueFormatter  ValueFormatter
+	listLimit       int
+	valueLimit      int
 }
 
 var (
@@ -130,6 +281,8 @@ func init() {
 			defaultLocale:   "en",
 			messages:        make(map[string]Messages),
 			fallbackEnabled: true,
+			listLimit:       DefaultValueListLimit,
+			valueLimit:      DefaultValueLengthLimit,
 		}
 		globalTranslator.loadDefaultMessages()
 	})
@@ -200,6 +353,146 @@ func (t *Translator) loadDefaultMessages() {
 		KeyUnique:        "%s must contain only unique elements",
 		KeyArrayContains: "%s must contain the value '%v'",
 		KeyNotEmpty:      "%s must not be empty",
+		// Change validators
+		KeyImmutable:    "%s cannot be changed",
+		KeyOnlyIncrease: "%s can only increase (current: %v)",
+		KeyOnlyDecrease: "%s can only decrease (current: %v)",
+		KeyTransition:   "%s cannot change from '%v' to '%v'",
+		// Schema flow
+		KeyInvalidStep: "step %d does not exist (total steps: %d)",
+		// Field comparison
+		KeySame:      "%s must match {other}",
+		KeyDifferent: "%s must be different from {other}",
+		// Discriminated unions
+		KeyDiscriminator: "%s must be one of: %s (got '%v')",
+		// Payload guards
+		KeyPayloadTooLarge: "payload must not exceed %d bytes",
+		// Regex safety
+		KeyRegexTimeout: "%s could not be checked against the required format in time",
+		// Unicode string validators
+		KeyAlphaUnicode:        "%s must contain only letters",
+		KeyAlphaNumericUnicode: "%s must contain only letters and numbers",
+		KeyAlphaSpace:          "%s must contain only letters and spaces",
+		// Person names
+		KeyPersonName: "%s must be a valid person name",
+		// Kart BIN sorgusu
+		KeyCardLookup:  "%s could not be verified",
+		KeyCardKind:    "%s must be one of these card types: %s",
+		KeyCardCountry: "%s must be issued in one of these countries: %s",
+		// Kart ile ödeme
+		KeyCardExpiry:  "%s must be a valid expiry date (MM/YY)",
+		KeyCardExpired: "%s has expired",
+		KeyCardCVV:     "%s must be %d digits for this card",
+		// Tekrarlama kuralı
+		KeyRRule: "%s must be a valid recurrence rule (RFC 5545)",
+		// Yapısal tanımlayıcılar
+		KeyE164:    "%s must be a phone number in E.164 format",
+		KeyDID:     "%s must be a valid decentralized identifier (DID)",
+		KeyURN:     "%s must be a valid URN",
+		KeyOTPCode: "%s must be a %d-digit code",
+		// HTTP başlık güvenliği
+		KeyHeaderValue:    "%s must not contain line breaks or control characters",
+		KeyHeaderFilename: "%s must be a safe file name",
+		// Form kötüye kullanım korumaları
+		KeyHoneypot: "%s must be left empty",
+		KeyCSRF:     "%s is invalid or has expired, please reload the form",
+		// Captcha
+		KeyCaptcha: "%s verification failed, please try again",
+		// Zaman tabanlı tek kullanımlık şifre
+		KeyTOTP: "%s is not a valid authentication code",
+		// Şifre geçmişi ve kişisel veri benzerliği
+		KeyPasswordSimilar: "%s is too similar to %s",
+		KeyPasswordReused:  "%s has been used before, please choose a new one",
+		// bcrypt bayt sınırı uyarısı
+		KeyPasswordTruncated: "%s is longer than %d bytes; bcrypt ignores everything after this limit",
+		// E-posta alan adı kısıtları
+		KeyEmailDomainIn:    "%s must use one of the following domains: %s",
+		KeyEmailDomainNotIn: "%s uses a domain that is not allowed",
+		// Ücretsiz sağlayıcı ve rol hesabı tespiti
+		KeyEmailFreeProvider: "%s must be a business email address",
+		KeyEmailRoleAccount:  "%s must be a personal address, not a role account",
+		// Alan grubu kuralları
+		KeyRequireAnyOf:     "%s is required when none of {values} are present",
+		KeyRequireAllOrNone: "%s is required together with {values}",
+		// Aralık çakışması
+		KeyNoOverlap: "%s overlaps with %s",
+		// Checksum
+		KeyChecksum: "%s has an invalid check digit",
+		// National ID
+		KeyNationalID: "%s must be a valid national identification number",
+		// License plate
+		KeyLicensePlate: "%s must be a valid license plate",
+		// Turkish business numbers
+		KeyMERSIS:        "%s must be a valid MERSIS number",
+		KeyTaxOfficeCode: "%s must be a valid tax office code",
+		KeySGKNumber:     "%s must be a valid SGK workplace registration number",
+		// Raw JSON
+		KeyInvalidJSON: "payload must be a valid JSON object",
+		// IP policies
+		KeyIPNotPrivate:  "%s must not be a private network address",
+		KeyIPNotLoopback: "%s must not be a loopback address",
+		KeyIPPublicOnly:  "%s must be a public IP address",
+		// URL SSRF guard
+		KeyURLPrivateHost: "%s must not point to a private or internal host",
+		KeyURLScheme:      "%s must use one of the following schemes: %s",
+		KeyURLPort:        "%s must use one of the following ports: %s",
+		// Webhook callback challenge
+		KeyCallbackChallenge: "%s could not be verified as a callback URL",
+		// DNS record checks
+		KeyDomainResolvable: "%s must be a domain that resolves in DNS",
+		KeyDomainMX:         "%s must be a domain that accepts email",
+		KeyDomainTXT:        "%s must publish the required DNS TXT record",
+		// PEM certificates and keys
+		KeyPEM:        "%s must contain a valid PEM encoded %s",
+		KeyPEMKeyType: "%s must use one of the following key types: %s",
+		KeyPEMKeySize: "%s uses a key that is too small",
+		KeyPEMExpired: "%s must contain a certificate that is currently valid",
+		// SSH public keys
+		KeySSHPublicKey: "%s must be a valid SSH public key",
+		KeySSHKeyType:   "%s must use one of the following key types: %s",
+		KeySSHKeySize:   "%s must use a key of at least %d bits",
+		// JSON Pointer and JSONPath
+		KeyJSONPointer: "%s must be a valid JSON Pointer",
+		KeyJSONPath:    "%s must be a valid JSONPath expression",
+		// File uploads
+		KeyFile:          "%s must be an uploaded file",
+		KeyFileSize:      "%s must not be larger than %s",
+		KeyFileExtension: "%s must have one of the following extensions: %s",
+		KeyFileMIME:      "%s must be a file of type: %s",
+		// Images
+		KeyImage:            "%s must be a valid image",
+		KeyImageFormat:      "%s must be an image of type: %s",
+		KeyImageMinWidth:    "%s must be at least %d pixels wide",
+		KeyImageMaxWidth:    "%s must not be wider than %d pixels",
+		KeyImageMinHeight:   "%s must be at least %d pixels tall",
+		KeyImageMaxHeight:   "%s must not be taller than %d pixels",
+		KeyImageMegapixels:  "%s must not exceed %s megapixels",
+		KeyImageAspectRatio: "%s must have an aspect ratio of %s",
+		// JSON Patch
+		KeyJSONPatch:       "%s must be a valid JSON Patch document",
+		KeyJSONPatchPath:   "%s refers to a location that does not exist",
+		KeyJSONPatchRemove: "%s refers to a required field that cannot be removed",
+		KeyJSONPatchMove:   "%s cannot be moved into one of its children",
+		KeyInvalidXML: "payload must be a valid XML document",
+		KeyJSONSyntax:  "payload is not valid JSON: syntax error at line %d, column %d",
+		KeyContentType: "content type must be one of: %s",
+		KeyIdempotencyKey:    "%s must be a UUID or ULID idempotency key of at most %d characters",
+		KeyIdempotencyReplay: "%s has already been used",
+		KeyAnyOf: "payload must match at least one of %d alternative schemas",
+		KeyNot:   "payload must not match the excluded schema",
+		KeyOneOfSchema: "payload must match exactly one of %d alternative schemas",
+		KeySchemaRef: "%s refers to an unregistered schema \"%s\"",
+		KeyDeadline: "validation did not finish within its time limit",
+		KeyUnion: "%s must match one of %d allowed types",
+		KeyUnknownField: "%s is not an allowed field",
+		// Map (anahtar/değer) tipi
+		KeyMinKeys: "%s must contain at least %d keys",
+		KeyMaxKeys: "%s must contain at most %d keys",
+		KeyMapKey:  "%s contains an invalid key: %s",
+		// Literal (sabit değer) tipi
+		KeyLiteral: "%s must be {value}",
+		// Tamsayı taşması
+		KeyIntegerRange: "%s is outside the supported integer range",
 	}
 
 	// Turkish messages
@@ -265,6 +558,146 @@ func (t *Translator) loadDefaultMessages() {
 		KeyUnique:        "%s alanı sadece benzersiz elemanlar içermelidir",
 		KeyArrayContains: "%s alanı '%v' değerini içermelidir",
 		KeyNotEmpty:      "%s alanı boş olmamalıdır",
+		// Change validators
+		KeyImmutable:    "%s alanı değiştirilemez",
+		KeyOnlyIncrease: "%s alanı yalnızca artırılabilir (mevcut: %v)",
+		KeyOnlyDecrease: "%s alanı yalnızca azaltılabilir (mevcut: %v)",
+		KeyTransition:   "%s alanı '%v' değerinden '%v' değerine geçemez",
+		// Schema flow
+		KeyInvalidStep: "%d numaralı adım bulunamadı (toplam adım: %d)",
+		// Field comparison
+		KeySame:      "%s alanı {other} ile eşleşmelidir",
+		KeyDifferent: "%s alanı {other} alanından farklı olmalıdır",
+		// Discriminated unions
+		KeyDiscriminator: "%s alanı şunlardan biri olmalıdır: %s ('%v' verildi)",
+		// Payload guards
+		KeyPayloadTooLarge: "gönderilen veri %d baytı aşmamalıdır",
+		// Regex safety
+		KeyRegexTimeout: "%s alanı gerekli formata göre zamanında kontrol edilemedi",
+		// Unicode string validators
+		KeyAlphaUnicode:        "%s alanı sadece harf içermelidir",
+		KeyAlphaNumericUnicode: "%s alanı sadece harf ve rakam içermelidir",
+		KeyAlphaSpace:          "%s alanı sadece harf ve boşluk içermelidir",
+		// Person names
+		KeyPersonName: "%s alanı geçerli bir kişi adı olmalıdır",
+		// Kart BIN sorgusu
+		KeyCardLookup:  "%s doğrulanamadı",
+		KeyCardKind:    "%s şu kart türlerinden biri olmalıdır: %s",
+		KeyCardCountry: "%s şu ülkelerden birinde çıkarılmış olmalıdır: %s",
+		// Kart ile ödeme
+		KeyCardExpiry:  "%s geçerli bir son kullanma tarihi olmalıdır (AA/YY)",
+		KeyCardExpired: "%s süresi dolmuş",
+		KeyCardCVV:     "%s bu kart için %d haneli olmalıdır",
+		// Tekrarlama kuralı
+		KeyRRule: "%s geçerli bir tekrarlama kuralı olmalıdır (RFC 5545)",
+		// Yapısal tanımlayıcılar
+		KeyE164:    "%s E.164 biçiminde bir telefon numarası olmalıdır",
+		KeyDID:     "%s geçerli bir merkeziyetsiz tanımlayıcı (DID) olmalıdır",
+		KeyURN:     "%s geçerli bir URN olmalıdır",
+		KeyOTPCode: "%s %d haneli bir kod olmalıdır",
+		// HTTP başlık güvenliği
+		KeyHeaderValue:    "%s satır sonu veya kontrol karakteri içermemelidir",
+		KeyHeaderFilename: "%s güvenli bir dosya adı olmalıdır",
+		// Form kötüye kullanım korumaları
+		KeyHoneypot: "%s alanı boş bırakılmalıdır",
+		KeyCSRF:     "%s geçersiz veya süresi dolmuş, lütfen formu yeniden yükleyin",
+		// Captcha
+		KeyCaptcha: "%s doğrulaması başarısız oldu, lütfen tekrar deneyin",
+		// Zaman tabanlı tek kullanımlık şifre
+		KeyTOTP: "%s geçerli bir doğrulama kodu değil",
+		// Şifre geçmişi ve kişisel veri benzerliği
+		KeyPasswordSimilar: "%s, %s alanına çok benziyor",
+		KeyPasswordReused:  "%s daha önce kullanılmış, lütfen yeni bir şifre seçin",
+		// bcrypt bayt sınırı uyarısı
+		KeyPasswordTruncated: "%s %d bayttan uzun; bcrypt bu sınırdan sonrasını yok sayar",
+		// E-posta alan adı kısıtları
+		KeyEmailDomainIn:    "%s şu alan adlarından birini kullanmalıdır: %s",
+		KeyEmailDomainNotIn: "%s izin verilmeyen bir alan adı kullanıyor",
+		// Ücretsiz sağlayıcı ve rol hesabı tespiti
+		KeyEmailFreeProvider: "%s kurumsal bir e-posta adresi olmalıdır",
+		KeyEmailRoleAccount:  "%s rol hesabı değil, kişisel bir adres olmalıdır",
+		// Alan grubu kuralları
+		KeyRequireAnyOf:     "{values} alanlarından hiçbiri girilmediğinde %s zorunludur",
+		KeyRequireAllOrNone: "%s, {values} ile birlikte girilmelidir",
+		// Aralık çakışması
+		KeyNoOverlap: "%s, %s ile çakışıyor",
+		// Checksum
+		KeyChecksum: "%s geçersiz bir kontrol basamağı içeriyor",
+		// National ID
+		KeyNationalID: "%s geçerli bir kimlik numarası olmalıdır",
+		// License plate
+		KeyLicensePlate: "%s geçerli bir araç plakası olmalıdır",
+		// Turkish business numbers
+		KeyMERSIS:        "%s geçerli bir MERSİS numarası olmalıdır",
+		KeyTaxOfficeCode: "%s geçerli bir vergi dairesi kodu olmalıdır",
+		KeySGKNumber:     "%s geçerli bir SGK işyeri sicil numarası olmalıdır",
+		// Raw JSON
+		KeyInvalidJSON: "gönderilen veri geçerli bir JSON nesnesi olmalıdır",
+		// IP policies
+		KeyIPNotPrivate:  "%s özel ağ adresi olmamalıdır",
+		KeyIPNotLoopback: "%s loopback adresi olmamalıdır",
+		KeyIPPublicOnly:  "%s genel (public) bir IP adresi olmalıdır",
+		// URL SSRF guard
+		KeyURLPrivateHost: "%s özel veya dahili bir adresi göstermemelidir",
+		KeyURLScheme:      "%s şu şemalardan birini kullanmalıdır: %s",
+		KeyURLPort:        "%s şu portlardan birini kullanmalıdır: %s",
+		// Webhook callback challenge
+		KeyCallbackChallenge: "%s geri çağırma adresi olarak doğrulanamadı",
+		// DNS record checks
+		KeyDomainResolvable: "%s DNS'te çözülebilen bir alan adı olmalıdır",
+		KeyDomainMX:         "%s e-posta kabul eden bir alan adı olmalıdır",
+		KeyDomainTXT:        "%s gerekli DNS TXT kaydını yayımlamalıdır",
+		// PEM certificates and keys
+		KeyPEM:        "%s geçerli bir PEM biçimli %s içermelidir",
+		KeyPEMKeyType: "%s şu anahtar tiplerinden birini kullanmalıdır: %s",
+		KeyPEMKeySize: "%s çok kısa bir anahtar kullanıyor",
+		KeyPEMExpired: "%s şu anda geçerli olan bir sertifika içermelidir",
+		// SSH public keys
+		KeySSHPublicKey: "%s geçerli bir SSH açık anahtarı olmalıdır",
+		KeySSHKeyType:   "%s şu anahtar tiplerinden birini kullanmalıdır: %s",
+		KeySSHKeySize:   "%s en az %d bitlik bir anahtar kullanmalıdır",
+		// JSON Pointer and JSONPath
+		KeyJSONPointer: "%s geçerli bir JSON Pointer olmalıdır",
+		KeyJSONPath:    "%s geçerli bir JSONPath ifadesi olmalıdır",
+		// File uploads
+		KeyFile:          "%s yüklenmiş bir dosya olmalıdır",
+		KeyFileSize:      "%s en fazla %s olabilir",
+		KeyFileExtension: "%s şu uzantılardan birine sahip olmalıdır: %s",
+		KeyFileMIME:      "%s şu türlerden birinde bir dosya olmalıdır: %s",
+		// Images
+		KeyImage:            "%s geçerli bir görsel olmalıdır",
+		KeyImageFormat:      "%s şu biçimlerden birinde bir görsel olmalıdır: %s",
+		KeyImageMinWidth:    "%s en az %d piksel genişliğinde olmalıdır",
+		KeyImageMaxWidth:    "%s en fazla %d piksel genişliğinde olabilir",
+		KeyImageMinHeight:   "%s en az %d piksel yüksekliğinde olmalıdır",
+		KeyImageMaxHeight:   "%s en fazla %d piksel yüksekliğinde olabilir",
+		KeyImageMegapixels:  "%s en fazla %s megapiksel olabilir",
+		KeyImageAspectRatio: "%s en-boy oranı %s olmalıdır",
+		// JSON Patch
+		KeyJSONPatch:       "%s geçerli bir JSON Patch dokümanı olmalıdır",
+		KeyJSONPatchPath:   "%s var olmayan bir konumu gösteriyor",
+		KeyJSONPatchRemove: "%s silinemeyen zorunlu bir alanı gösteriyor",
+		KeyJSONPatchMove:   "%s kendi alt öğelerinden birine taşınamaz",
+		KeyInvalidXML: "gönderilen veri geçerli bir XML dokümanı olmalıdır",
+		KeyJSONSyntax:  "gönderilen veri geçerli bir JSON değil: %d. satır, %d. sütunda söz dizimi hatası",
+		KeyContentType: "içerik türü şunlardan biri olmalıdır: %s",
+		KeyIdempotencyKey:    "%s en fazla %d karakterlik UUID veya ULID biçiminde bir idempotency anahtarı olmalıdır",
+		KeyIdempotencyReplay: "%s daha önce kullanılmış",
+		KeyAnyOf: "gönderilen veri %d alternatif şemadan en az birine uymalıdır",
+		KeyNot:   "gönderilen veri hariç tutulan şemaya uymamalıdır",
+		KeyOneOfSchema: "gönderilen veri %d alternatif şemadan tam olarak birine uymalıdır",
+		KeySchemaRef: "%s kayıtlı olmayan \"%s\" şemasına başvuruyor",
+		KeyDeadline: "doğrulama süre sınırı içinde tamamlanamadı",
+		KeyUnion: "%s alanı izin verilen %d tipten birine uymalıdır",
+		KeyUnknownField: "%s alanına izin verilmiyor",
+		// Map (anahtar/değer) tipi
+		KeyMinKeys: "%s alanında en az %d anahtar olmalıdır",
+		KeyMaxKeys: "%s alanında en fazla %d anahtar olmalıdır",
+		KeyMapKey:  "%s alanında geçersiz anahtar var: %s",
+		// Literal (sabit değer) tipi
+		KeyLiteral: "%s alanı {value} olmalıdır",
+		// Tamsayı taşması
+		KeyIntegerRange: "%s alanı desteklenen tamsayı aralığının dışında",
 	}
 
 	// German messages
@@ -329,6 +762,146 @@ func (t *Translator) loadDefaultMessages() {
 		KeyUnique:        "%s darf nur eindeutige Elemente enthalten",
 		KeyArrayContains: "%s muss den Wert '%v' enthalten",
 		KeyNotEmpty:      "%s darf nicht leer sein",
+		// Change validators
+		KeyImmutable:    "%s kann nicht geändert werden",
+		KeyOnlyIncrease: "%s darf nur erhöht werden (aktuell: %v)",
+		KeyOnlyDecrease: "%s darf nur verringert werden (aktuell: %v)",
+		KeyTransition:   "%s kann nicht von '%v' zu '%v' wechseln",
+		// Schema flow
+		KeyInvalidStep: "Schritt %d existiert nicht (Schritte insgesamt: %d)",
+		// Field comparison
+		KeySame:      "%s muss mit {other} übereinstimmen",
+		KeyDifferent: "%s muss sich von {other} unterscheiden",
+		// Discriminated unions
+		KeyDiscriminator: "%s muss einer der folgenden Werte sein: %s (erhalten: '%v')",
+		// Payload guards
+		KeyPayloadTooLarge: "die Nutzdaten dürfen %d Bytes nicht überschreiten",
+		// Regex safety
+		KeyRegexTimeout: "%s konnte nicht rechtzeitig gegen das erforderliche Format geprüft werden",
+		// Unicode string validators
+		KeyAlphaUnicode:        "%s darf nur Buchstaben enthalten",
+		KeyAlphaNumericUnicode: "%s darf nur Buchstaben und Ziffern enthalten",
+		KeyAlphaSpace:          "%s darf nur Buchstaben und Leerzeichen enthalten",
+		// Person names
+		KeyPersonName: "%s muss ein gültiger Personenname sein",
+		// Kart BIN sorgusu
+		KeyCardLookup:  "%s konnte nicht überprüft werden",
+		KeyCardKind:    "%s muss einer dieser Kartentypen sein: %s",
+		KeyCardCountry: "%s muss in einem dieser Länder ausgestellt sein: %s",
+		// Kart ile ödeme
+		KeyCardExpiry:  "%s muss ein gültiges Ablaufdatum sein (MM/JJ)",
+		KeyCardExpired: "%s ist abgelaufen",
+		KeyCardCVV:     "%s muss für diese Karte %d Ziffern haben",
+		// Tekrarlama kuralı
+		KeyRRule: "%s muss eine gültige Wiederholungsregel sein (RFC 5545)",
+		// Yapısal tanımlayıcılar
+		KeyE164:    "%s muss eine Telefonnummer im E.164-Format sein",
+		KeyDID:     "%s muss ein gültiger dezentraler Identifikator (DID) sein",
+		KeyURN:     "%s muss eine gültige URN sein",
+		KeyOTPCode: "%s muss ein %d-stelliger Code sein",
+		// HTTP başlık güvenliği
+		KeyHeaderValue:    "%s darf keine Zeilenumbrüche oder Steuerzeichen enthalten",
+		KeyHeaderFilename: "%s muss ein sicherer Dateiname sein",
+		// Form kötüye kullanım korumaları
+		KeyHoneypot: "%s muss leer bleiben",
+		KeyCSRF:     "%s ist ungültig oder abgelaufen, bitte laden Sie das Formular neu",
+		// Captcha
+		KeyCaptcha: "Die Überprüfung von %s ist fehlgeschlagen, bitte versuchen Sie es erneut",
+		// Zaman tabanlı tek kullanımlık şifre
+		KeyTOTP: "%s ist kein gültiger Authentifizierungscode",
+		// Şifre geçmişi ve kişisel veri benzerliği
+		KeyPasswordSimilar: "%s ist %s zu ähnlich",
+		KeyPasswordReused:  "%s wurde bereits verwendet, bitte wählen Sie ein neues",
+		// bcrypt bayt sınırı uyarısı
+		KeyPasswordTruncated: "%s ist länger als %d Bytes; bcrypt ignoriert alles nach dieser Grenze",
+		// E-posta alan adı kısıtları
+		KeyEmailDomainIn:    "%s muss eine der folgenden Domains verwenden: %s",
+		KeyEmailDomainNotIn: "%s verwendet eine nicht zulässige Domain",
+		// Ücretsiz sağlayıcı ve rol hesabı tespiti
+		KeyEmailFreeProvider: "%s muss eine geschäftliche E-Mail-Adresse sein",
+		KeyEmailRoleAccount:  "%s muss eine persönliche Adresse sein, kein Funktionspostfach",
+		// Alan grubu kuralları
+		KeyRequireAnyOf:     "%s ist erforderlich, wenn keines von {values} angegeben ist",
+		KeyRequireAllOrNone: "%s ist zusammen mit {values} erforderlich",
+		// Aralık çakışması
+		KeyNoOverlap: "%s überschneidet sich mit %s",
+		// Checksum
+		KeyChecksum: "%s hat eine ungültige Prüfziffer",
+		// National ID
+		KeyNationalID: "%s muss eine gültige Identifikationsnummer sein",
+		// License plate
+		KeyLicensePlate: "%s muss ein gültiges Kfz-Kennzeichen sein",
+		// Turkish business numbers
+		KeyMERSIS:        "%s muss eine gültige MERSIS-Nummer sein",
+		KeyTaxOfficeCode: "%s muss ein gültiger Finanzamtscode sein",
+		KeySGKNumber:     "%s muss eine gültige SGK-Betriebsnummer sein",
+		// Raw JSON
+		KeyInvalidJSON: "die Nutzdaten müssen ein gültiges JSON-Objekt sein",
+		// IP policies
+		KeyIPNotPrivate:  "%s darf keine private Netzwerkadresse sein",
+		KeyIPNotLoopback: "%s darf keine Loopback-Adresse sein",
+		KeyIPPublicOnly:  "%s muss eine öffentliche IP-Adresse sein",
+		// URL SSRF guard
+		KeyURLPrivateHost: "%s darf nicht auf einen privaten oder internen Host verweisen",
+		KeyURLScheme:      "%s muss eines der folgenden Schemata verwenden: %s",
+		KeyURLPort:        "%s muss einen der folgenden Ports verwenden: %s",
+		// Webhook callback challenge
+		KeyCallbackChallenge: "%s konnte nicht als Callback-URL verifiziert werden",
+		// DNS record checks
+		KeyDomainResolvable: "%s muss eine im DNS auflösbare Domain sein",
+		KeyDomainMX:         "%s muss eine Domain sein, die E-Mails annimmt",
+		KeyDomainTXT:        "%s muss den erforderlichen DNS-TXT-Eintrag veröffentlichen",
+		// PEM certificates and keys
+		KeyPEM:        "%s muss ein gültiges PEM-kodiertes %s enthalten",
+		KeyPEMKeyType: "%s muss einen der folgenden Schlüsseltypen verwenden: %s",
+		KeyPEMKeySize: "%s verwendet einen zu kurzen Schlüssel",
+		KeyPEMExpired: "%s muss ein derzeit gültiges Zertifikat enthalten",
+		// SSH public keys
+		KeySSHPublicKey: "%s muss ein gültiger öffentlicher SSH-Schlüssel sein",
+		KeySSHKeyType:   "%s muss einen der folgenden Schlüsseltypen verwenden: %s",
+		KeySSHKeySize:   "%s muss einen Schlüssel mit mindestens %d Bit verwenden",
+		// JSON Pointer and JSONPath
+		KeyJSONPointer: "%s muss ein gültiger JSON Pointer sein",
+		KeyJSONPath:    "%s muss ein gültiger JSONPath-Ausdruck sein",
+		// File uploads
+		KeyFile:          "%s muss eine hochgeladene Datei sein",
+		KeyFileSize:      "%s darf nicht größer als %s sein",
+		KeyFileExtension: "%s muss eine der folgenden Dateiendungen haben: %s",
+		KeyFileMIME:      "%s muss eine Datei vom Typ %s sein",
+		// Images
+		KeyImage:            "%s muss ein gültiges Bild sein",
+		KeyImageFormat:      "%s muss ein Bild vom Typ %s sein",
+		KeyImageMinWidth:    "%s muss mindestens %d Pixel breit sein",
+		KeyImageMaxWidth:    "%s darf höchstens %d Pixel breit sein",
+		KeyImageMinHeight:   "%s muss mindestens %d Pixel hoch sein",
+		KeyImageMaxHeight:   "%s darf höchstens %d Pixel hoch sein",
+		KeyImageMegapixels:  "%s darf %s Megapixel nicht überschreiten",
+		KeyImageAspectRatio: "%s muss ein Seitenverhältnis von %s haben",
+		// JSON Patch
+		KeyJSONPatch:       "%s muss ein gültiges JSON-Patch-Dokument sein",
+		KeyJSONPatchPath:   "%s verweist auf eine nicht vorhandene Position",
+		KeyJSONPatchRemove: "%s verweist auf ein Pflichtfeld, das nicht entfernt werden kann",
+		KeyJSONPatchMove:   "%s kann nicht in eines seiner Unterelemente verschoben werden",
+		KeyInvalidXML: "die Nutzdaten müssen ein gültiges XML-Dokument sein",
+		KeyJSONSyntax:  "die Nutzdaten sind kein gültiges JSON: Syntaxfehler in Zeile %d, Spalte %d",
+		KeyContentType: "der Inhaltstyp muss einer der folgenden sein: %s",
+		KeyIdempotencyKey:    "%s muss ein Idempotenzschlüssel im UUID- oder ULID-Format mit höchstens %d Zeichen sein",
+		KeyIdempotencyReplay: "%s wurde bereits verwendet",
+		KeyAnyOf: "die Daten müssen mindestens einem von %d alternativen Schemas entsprechen",
+		KeyNot:   "die Daten dürfen dem ausgeschlossenen Schema nicht entsprechen",
+		KeyOneOfSchema: "die Daten müssen genau einem von %d alternativen Schemas entsprechen",
+		KeySchemaRef: "%s verweist auf das nicht registrierte Schema \"%s\"",
+		KeyDeadline: "die Validierung wurde nicht innerhalb des Zeitlimits abgeschlossen",
+		KeyUnion: "%s muss einem von %d erlaubten Typen entsprechen",
+		KeyUnknownField: "%s ist kein erlaubtes Feld",
+		// Map (anahtar/değer) tipi
+		KeyMinKeys: "%s muss mindestens %d Schlüssel enthalten",
+		KeyMaxKeys: "%s darf höchstens %d Schlüssel enthalten",
+		KeyMapKey:  "%s enthält einen ungültigen Schlüssel: %s",
+		// Literal (sabit değer) tipi
+		KeyLiteral: "%s muss {value} sein",
+		// Tamsayı taşması
+		KeyIntegerRange: "%s liegt außerhalb des unterstützten Ganzzahlbereichs",
 	}
 
 	// French messages
@@ -393,6 +966,146 @@ func (t *Translator) loadDefaultMessages() {
 		KeyUnique:        "%s ne doit contenir que des éléments uniques",
 		KeyArrayContains: "%s doit contenir la valeur '%v'",
 		KeyNotEmpty:      "%s ne doit pas être vide",
+		// Change validators
+		KeyImmutable:    "%s ne peut pas être modifié",
+		KeyOnlyIncrease: "%s ne peut qu'augmenter (actuel : %v)",
+		KeyOnlyDecrease: "%s ne peut que diminuer (actuel : %v)",
+		KeyTransition:   "%s ne peut pas passer de '%v' à '%v'",
+		// Schema flow
+		KeyInvalidStep: "l'étape %d n'existe pas (nombre total d'étapes : %d)",
+		// Field comparison
+		KeySame:      "%s doit correspondre à {other}",
+		KeyDifferent: "%s doit être différent de {other}",
+		// Discriminated unions
+		KeyDiscriminator: "%s doit être l'un des suivants : %s (reçu : '%v')",
+		// Payload guards
+		KeyPayloadTooLarge: "la charge utile ne doit pas dépasser %d octets",
+		// Regex safety
+		KeyRegexTimeout: "%s n'a pas pu être vérifié au format requis dans le délai imparti",
+		// Unicode string validators
+		KeyAlphaUnicode:        "%s ne doit contenir que des lettres",
+		KeyAlphaNumericUnicode: "%s ne doit contenir que des lettres et des chiffres",
+		KeyAlphaSpace:          "%s ne doit contenir que des lettres et des espaces",
+		// Person names
+		KeyPersonName: "%s doit être un nom de personne valide",
+		// Kart BIN sorgusu
+		KeyCardLookup:  "%s n'a pas pu être vérifié",
+		KeyCardKind:    "%s doit être l'un de ces types de carte : %s",
+		KeyCardCountry: "%s doit être émise dans l'un de ces pays : %s",
+		// Kart ile ödeme
+		KeyCardExpiry:  "%s doit être une date d'expiration valide (MM/AA)",
+		KeyCardExpired: "%s a expiré",
+		KeyCardCVV:     "%s doit comporter %d chiffres pour cette carte",
+		// Tekrarlama kuralı
+		KeyRRule: "%s doit être une règle de récurrence valide (RFC 5545)",
+		// Yapısal tanımlayıcılar
+		KeyE164:    "%s doit être un numéro de téléphone au format E.164",
+		KeyDID:     "%s doit être un identifiant décentralisé (DID) valide",
+		KeyURN:     "%s doit être un URN valide",
+		KeyOTPCode: "%s doit être un code à %d chiffres",
+		// HTTP başlık güvenliği
+		KeyHeaderValue:    "%s ne doit pas contenir de sauts de ligne ni de caractères de contrôle",
+		KeyHeaderFilename: "%s doit être un nom de fichier sûr",
+		// Form kötüye kullanım korumaları
+		KeyHoneypot: "%s doit rester vide",
+		KeyCSRF:     "%s est invalide ou a expiré, veuillez recharger le formulaire",
+		// Captcha
+		KeyCaptcha: "La vérification de %s a échoué, veuillez réessayer",
+		// Zaman tabanlı tek kullanımlık şifre
+		KeyTOTP: "%s n'est pas un code d'authentification valide",
+		// Şifre geçmişi ve kişisel veri benzerliği
+		KeyPasswordSimilar: "%s est trop similaire à %s",
+		KeyPasswordReused:  "%s a déjà été utilisé, veuillez en choisir un nouveau",
+		// bcrypt bayt sınırı uyarısı
+		KeyPasswordTruncated: "%s dépasse %d octets ; bcrypt ignore tout ce qui suit cette limite",
+		// E-posta alan adı kısıtları
+		KeyEmailDomainIn:    "%s doit utiliser l'un des domaines suivants : %s",
+		KeyEmailDomainNotIn: "%s utilise un domaine non autorisé",
+		// Ücretsiz sağlayıcı ve rol hesabı tespiti
+		KeyEmailFreeProvider: "%s doit être une adresse e-mail professionnelle",
+		KeyEmailRoleAccount:  "%s doit être une adresse personnelle, pas un compte générique",
+		// Alan grubu kuralları
+		KeyRequireAnyOf:     "%s est obligatoire lorsqu'aucun de {values} n'est renseigné",
+		KeyRequireAllOrNone: "%s est obligatoire avec {values}",
+		// Aralık çakışması
+		KeyNoOverlap: "%s chevauche %s",
+		// Checksum
+		KeyChecksum: "%s contient un chiffre de contrôle invalide",
+		// National ID
+		KeyNationalID: "%s doit être un numéro d'identification national valide",
+		// License plate
+		KeyLicensePlate: "%s doit être une plaque d'immatriculation valide",
+		// Turkish business numbers
+		KeyMERSIS:        "%s doit être un numéro MERSIS valide",
+		KeyTaxOfficeCode: "%s doit être un code de bureau des impôts valide",
+		KeySGKNumber:     "%s doit être un numéro d'immatriculation SGK valide",
+		// Raw JSON
+		KeyInvalidJSON: "la charge utile doit être un objet JSON valide",
+		// IP policies
+		KeyIPNotPrivate:  "%s ne doit pas être une adresse de réseau privé",
+		KeyIPNotLoopback: "%s ne doit pas être une adresse de bouclage",
+		KeyIPPublicOnly:  "%s doit être une adresse IP publique",
+		// URL SSRF guard
+		KeyURLPrivateHost: "%s ne doit pas pointer vers un hôte privé ou interne",
+		KeyURLScheme:      "%s doit utiliser l'un des schémas suivants : %s",
+		KeyURLPort:        "%s doit utiliser l'un des ports suivants : %s",
+		// Webhook callback challenge
+		KeyCallbackChallenge: "%s n'a pas pu être vérifié comme URL de rappel",
+		// DNS record checks
+		KeyDomainResolvable: "%s doit être un domaine résolu par le DNS",
+		KeyDomainMX:         "%s doit être un domaine qui accepte les e-mails",
+		KeyDomainTXT:        "%s doit publier l'enregistrement DNS TXT requis",
+		// PEM certificates and keys
+		KeyPEM:        "%s doit contenir un %s valide au format PEM",
+		KeyPEMKeyType: "%s doit utiliser l'un des types de clé suivants : %s",
+		KeyPEMKeySize: "%s utilise une clé trop courte",
+		KeyPEMExpired: "%s doit contenir un certificat actuellement valide",
+		// SSH public keys
+		KeySSHPublicKey: "%s doit être une clé publique SSH valide",
+		KeySSHKeyType:   "%s doit utiliser l'un des types de clé suivants : %s",
+		KeySSHKeySize:   "%s doit utiliser une clé d'au moins %d bits",
+		// JSON Pointer and JSONPath
+		KeyJSONPointer: "%s doit être un JSON Pointer valide",
+		KeyJSONPath:    "%s doit être une expression JSONPath valide",
+		// File uploads
+		KeyFile:          "%s doit être un fichier téléversé",
+		KeyFileSize:      "%s ne doit pas dépasser %s",
+		KeyFileExtension: "%s doit avoir l'une des extensions suivantes : %s",
+		KeyFileMIME:      "%s doit être un fichier de type : %s",
+		// Images
+		KeyImage:            "%s doit être une image valide",
+		KeyImageFormat:      "%s doit être une image de type : %s",
+		KeyImageMinWidth:    "%s doit avoir une largeur d'au moins %d pixels",
+		KeyImageMaxWidth:    "%s ne doit pas dépasser %d pixels de largeur",
+		KeyImageMinHeight:   "%s doit avoir une hauteur d'au moins %d pixels",
+		KeyImageMaxHeight:   "%s ne doit pas dépasser %d pixels de hauteur",
+		KeyImageMegapixels:  "%s ne doit pas dépasser %s mégapixels",
+		KeyImageAspectRatio: "%s doit avoir un rapport d'aspect de %s",
+		// JSON Patch
+		KeyJSONPatch:       "%s doit être un document JSON Patch valide",
+		KeyJSONPatchPath:   "%s fait référence à un emplacement inexistant",
+		KeyJSONPatchRemove: "%s fait référence à un champ obligatoire qui ne peut pas être supprimé",
+		KeyJSONPatchMove:   "%s ne peut pas être déplacé dans l'un de ses enfants",
+		KeyInvalidXML: "la charge utile doit être un document XML valide",
+		KeyJSONSyntax:  "la charge utile n'est pas un JSON valide : erreur de syntaxe à la ligne %d, colonne %d",
+		KeyContentType: "le type de contenu doit être l'un des suivants : %s",
+		KeyIdempotencyKey:    "%s doit être une clé d'idempotence UUID ou ULID d'au plus %d caractères",
+		KeyIdempotencyReplay: "%s a déjà été utilisé",
+		KeyAnyOf: "les données doivent correspondre à au moins un des %d schémas alternatifs",
+		KeyNot:   "les données ne doivent pas correspondre au schéma exclu",
+		KeyOneOfSchema: "les données doivent correspondre à exactement un des %d schémas alternatifs",
+		KeySchemaRef: "%s fait référence au schéma non enregistré \"%s\"",
+		KeyDeadline: "la validation ne s'est pas terminée dans le délai imparti",
+		KeyUnion: "%s doit correspondre à l'un des %d types autorisés",
+		KeyUnknownField: "%s n'est pas un champ autorisé",
+		// Map (anahtar/değer) tipi
+		KeyMinKeys: "%s doit contenir au moins %d clés",
+		KeyMaxKeys: "%s doit contenir au maximum %d clés",
+		KeyMapKey:  "%s contient une clé invalide : %s",
+		// Literal (sabit değer) tipi
+		KeyLiteral: "%s doit être {value}",
+		// Tamsayı taşması
+		KeyIntegerRange: "%s est en dehors de la plage d'entiers prise en charge",
 	}
 
 	// Spanish messages
@@ -457,6 +1170,146 @@ func (t *Translator) loadDefaultMessages() {
 		KeyUnique:        "%s debe contener solo elementos únicos",
 		KeyArrayContains: "%s debe contener el valor '%v'",
 		KeyNotEmpty:      "%s no debe estar vacío",
+		// Change validators
+		KeyImmutable:    "%s no se puede modificar",
+		KeyOnlyIncrease: "%s solo puede aumentar (actual: %v)",
+		KeyOnlyDecrease: "%s solo puede disminuir (actual: %v)",
+		KeyTransition:   "%s no puede cambiar de '%v' a '%v'",
+		// Schema flow
+		KeyInvalidStep: "el paso %d no existe (total de pasos: %d)",
+		// Field comparison
+		KeySame:      "%s debe coincidir con {other}",
+		KeyDifferent: "%s debe ser diferente de {other}",
+		// Discriminated unions
+		KeyDiscriminator: "%s debe ser uno de los siguientes: %s (recibido: '%v')",
+		// Payload guards
+		KeyPayloadTooLarge: "la carga útil no debe superar %d bytes",
+		// Regex safety
+		KeyRegexTimeout: "%s no pudo comprobarse con el formato requerido a tiempo",
+		// Unicode string validators
+		KeyAlphaUnicode:        "%s debe contener solo letras",
+		KeyAlphaNumericUnicode: "%s debe contener solo letras y números",
+		KeyAlphaSpace:          "%s debe contener solo letras y espacios",
+		// Person names
+		KeyPersonName: "%s debe ser un nombre de persona válido",
+		// Kart BIN sorgusu
+		KeyCardLookup:  "%s no pudo ser verificado",
+		KeyCardKind:    "%s debe ser uno de estos tipos de tarjeta: %s",
+		KeyCardCountry: "%s debe estar emitida en uno de estos países: %s",
+		// Kart ile ödeme
+		KeyCardExpiry:  "%s debe ser una fecha de vencimiento válida (MM/AA)",
+		KeyCardExpired: "%s ha vencido",
+		KeyCardCVV:     "%s debe tener %d dígitos para esta tarjeta",
+		// Tekrarlama kuralı
+		KeyRRule: "%s debe ser una regla de recurrencia válida (RFC 5545)",
+		// Yapısal tanımlayıcılar
+		KeyE164:    "%s debe ser un número de teléfono en formato E.164",
+		KeyDID:     "%s debe ser un identificador descentralizado (DID) válido",
+		KeyURN:     "%s debe ser un URN válido",
+		KeyOTPCode: "%s debe ser un código de %d dígitos",
+		// HTTP başlık güvenliği
+		KeyHeaderValue:    "%s no debe contener saltos de línea ni caracteres de control",
+		KeyHeaderFilename: "%s debe ser un nombre de archivo seguro",
+		// Form kötüye kullanım korumaları
+		KeyHoneypot: "%s debe dejarse vacío",
+		KeyCSRF:     "%s no es válido o ha caducado, vuelva a cargar el formulario",
+		// Captcha
+		KeyCaptcha: "La verificación de %s ha fallado, inténtelo de nuevo",
+		// Zaman tabanlı tek kullanımlık şifre
+		KeyTOTP: "%s no es un código de autenticación válido",
+		// Şifre geçmişi ve kişisel veri benzerliği
+		KeyPasswordSimilar: "%s es demasiado similar a %s",
+		KeyPasswordReused:  "%s ya se ha utilizado, elija uno nuevo",
+		// bcrypt bayt sınırı uyarısı
+		KeyPasswordTruncated: "%s supera los %d bytes; bcrypt ignora todo lo que sigue a este límite",
+		// E-posta alan adı kısıtları
+		KeyEmailDomainIn:    "%s debe usar uno de los siguientes dominios: %s",
+		KeyEmailDomainNotIn: "%s usa un dominio no permitido",
+		// Ücretsiz sağlayıcı ve rol hesabı tespiti
+		KeyEmailFreeProvider: "%s debe ser una dirección de correo corporativa",
+		KeyEmailRoleAccount:  "%s debe ser una dirección personal, no una cuenta genérica",
+		// Alan grubu kuralları
+		KeyRequireAnyOf:     "%s es obligatorio cuando ninguno de {values} está presente",
+		KeyRequireAllOrNone: "%s es obligatorio junto con {values}",
+		// Aralık çakışması
+		KeyNoOverlap: "%s se superpone con %s",
+		// Checksum
+		KeyChecksum: "%s tiene un dígito de control inválido",
+		// National ID
+		KeyNationalID: "%s debe ser un número de identificación nacional válido",
+		// License plate
+		KeyLicensePlate: "%s debe ser una matrícula válida",
+		// Turkish business numbers
+		KeyMERSIS:        "%s debe ser un número MERSIS válido",
+		KeyTaxOfficeCode: "%s debe ser un código de oficina tributaria válido",
+		KeySGKNumber:     "%s debe ser un número de registro SGK válido",
+		// Raw JSON
+		KeyInvalidJSON: "la carga útil debe ser un objeto JSON válido",
+		// IP policies
+		KeyIPNotPrivate:  "%s no debe ser una dirección de red privada",
+		KeyIPNotLoopback: "%s no debe ser una dirección de loopback",
+		KeyIPPublicOnly:  "%s debe ser una dirección IP pública",
+		// URL SSRF guard
+		KeyURLPrivateHost: "%s no debe apuntar a un host privado o interno",
+		KeyURLScheme:      "%s debe usar uno de los siguientes esquemas: %s",
+		KeyURLPort:        "%s debe usar uno de los siguientes puertos: %s",
+		// Webhook callback challenge
+		KeyCallbackChallenge: "%s no se pudo verificar como URL de devolución de llamada",
+		// DNS record checks
+		KeyDomainResolvable: "%s debe ser un dominio que se resuelva en DNS",
+		KeyDomainMX:         "%s debe ser un dominio que acepte correo electrónico",
+		KeyDomainTXT:        "%s debe publicar el registro DNS TXT requerido",
+		// PEM certificates and keys
+		KeyPEM:        "%s debe contener un %s válido en formato PEM",
+		KeyPEMKeyType: "%s debe usar uno de los siguientes tipos de clave: %s",
+		KeyPEMKeySize: "%s usa una clave demasiado corta",
+		KeyPEMExpired: "%s debe contener un certificado actualmente válido",
+		// SSH public keys
+		KeySSHPublicKey: "%s debe ser una clave pública SSH válida",
+		KeySSHKeyType:   "%s debe usar uno de los siguientes tipos de clave: %s",
+		KeySSHKeySize:   "%s debe usar una clave de al menos %d bits",
+		// JSON Pointer and JSONPath
+		KeyJSONPointer: "%s debe ser un JSON Pointer válido",
+		KeyJSONPath:    "%s debe ser una expresión JSONPath válida",
+		// File uploads
+		KeyFile:          "%s debe ser un archivo subido",
+		KeyFileSize:      "%s no debe superar %s",
+		KeyFileExtension: "%s debe tener una de las siguientes extensiones: %s",
+		KeyFileMIME:      "%s debe ser un archivo de tipo: %s",
+		// Images
+		KeyImage:            "%s debe ser una imagen válida",
+		KeyImageFormat:      "%s debe ser una imagen de tipo: %s",
+		KeyImageMinWidth:    "%s debe tener al menos %d píxeles de ancho",
+		KeyImageMaxWidth:    "%s no debe superar los %d píxeles de ancho",
+		KeyImageMinHeight:   "%s debe tener al menos %d píxeles de alto",
+		KeyImageMaxHeight:   "%s no debe superar los %d píxeles de alto",
+		KeyImageMegapixels:  "%s no debe superar los %s megapíxeles",
+		KeyImageAspectRatio: "%s debe tener una relación de aspecto de %s",
+		// JSON Patch
+		KeyJSONPatch:       "%s debe ser un documento JSON Patch válido",
+		KeyJSONPatchPath:   "%s hace referencia a una ubicación que no existe",
+		KeyJSONPatchRemove: "%s hace referencia a un campo obligatorio que no se puede eliminar",
+		KeyJSONPatchMove:   "%s no se puede mover a uno de sus hijos",
+		KeyInvalidXML: "la carga útil debe ser un documento XML válido",
+		KeyJSONSyntax:  "la carga útil no es un JSON válido: error de sintaxis en la línea %d, columna %d",
+		KeyContentType: "el tipo de contenido debe ser uno de: %s",
+		KeyIdempotencyKey:    "%s debe ser una clave de idempotencia UUID o ULID de como máximo %d caracteres",
+		KeyIdempotencyReplay: "%s ya se ha utilizado",
+		KeyAnyOf: "los datos deben coincidir con al menos uno de los %d esquemas alternativos",
+		KeyNot:   "los datos no deben coincidir con el esquema excluido",
+		KeyOneOfSchema: "los datos deben coincidir exactamente con uno de los %d esquemas alternativos",
+		KeySchemaRef: "%s hace referencia al esquema no registrado \"%s\"",
+		KeyDeadline: "la validación no terminó dentro del límite de tiempo",
+		KeyUnion: "%s debe coincidir con uno de los %d tipos permitidos",
+		KeyUnknownField: "%s no es un campo permitido",
+		// Map (anahtar/değer) tipi
+		KeyMinKeys: "%s debe contener al menos %d claves",
+		KeyMaxKeys: "%s debe contener como máximo %d claves",
+		KeyMapKey:  "%s contiene una clave no válida: %s",
+		// Literal (sabit değer) tipi
+		KeyLiteral: "%s debe ser {value}",
+		// Tamsayı taşması
+		KeyIntegerRange: "%s está fuera del rango de enteros admitido",
 	}
 
 	// Japanese messages
@@ -521,6 +1374,146 @@ func (t *Translator) loadDefaultMessages() {
 		KeyUnique:        "%sは一意の要素のみを含む必要があります",
 		KeyArrayContains: "%sは値'%v'を含む必要があります",
 		KeyNotEmpty:      "%sは空であってはいけません",
+		// Change validators
+		KeyImmutable:    "%sは変更できません",
+		KeyOnlyIncrease: "%sは増加のみ可能です (現在: %v)",
+		KeyOnlyDecrease: "%sは減少のみ可能です (現在: %v)",
+		KeyTransition:   "%sは'%v'から'%v'に変更できません",
+		// Schema flow
+		KeyInvalidStep: "ステップ%dは存在しません（全ステップ数: %d）",
+		// Field comparison
+		KeySame:      "%sは{other}と一致する必要があります",
+		KeyDifferent: "%sは{other}と異なる必要があります",
+		// Discriminated unions
+		KeyDiscriminator: "%sは次のいずれかである必要があります: %s（受け取った値: '%v'）",
+		// Payload guards
+		KeyPayloadTooLarge: "ペイロードは%dバイトを超えてはいけません",
+		// Regex safety
+		KeyRegexTimeout: "%sを必要な形式で時間内に検証できませんでした",
+		// Unicode string validators
+		KeyAlphaUnicode:        "%sは文字のみを含む必要があります",
+		KeyAlphaNumericUnicode: "%sは文字と数字のみを含む必要があります",
+		KeyAlphaSpace:          "%sは文字とスペースのみを含む必要があります",
+		// Person names
+		KeyPersonName: "%sは有効な人名である必要があります",
+		// Kart BIN sorgusu
+		KeyCardLookup:  "%sを確認できませんでした",
+		KeyCardKind:    "%sは次のカード種別のいずれかである必要があります: %s",
+		KeyCardCountry: "%sは次のいずれかの国で発行されている必要があります: %s",
+		// Kart ile ödeme
+		KeyCardExpiry:  "%sは有効な有効期限(MM/YY)である必要があります",
+		KeyCardExpired: "%sの有効期限が切れています",
+		KeyCardCVV:     "%sはこのカードでは%d桁である必要があります",
+		// Tekrarlama kuralı
+		KeyRRule: "%sは有効な繰り返しルール(RFC 5545)である必要があります",
+		// Yapısal tanımlayıcılar
+		KeyE164:    "%sはE.164形式の電話番号である必要があります",
+		KeyDID:     "%sは有効な分散型識別子(DID)である必要があります",
+		KeyURN:     "%sは有効なURNである必要があります",
+		KeyOTPCode: "%sは%d桁のコードである必要があります",
+		// HTTP başlık güvenliği
+		KeyHeaderValue:    "%sに改行や制御文字を含めることはできません",
+		KeyHeaderFilename: "%sは安全なファイル名である必要があります",
+		// Form kötüye kullanım korumaları
+		KeyHoneypot: "%sは空のままにする必要があります",
+		KeyCSRF:     "%sが無効か期限切れです。フォームを再読み込みしてください",
+		// Captcha
+		KeyCaptcha: "%sの確認に失敗しました。もう一度お試しください",
+		// Zaman tabanlı tek kullanımlık şifre
+		KeyTOTP: "%sは有効な認証コードではありません",
+		// Şifre geçmişi ve kişisel veri benzerliği
+		KeyPasswordSimilar: "%sは%sと似すぎています",
+		KeyPasswordReused:  "%sは以前に使用されています。新しいものを選択してください",
+		// bcrypt bayt sınırı uyarısı
+		KeyPasswordTruncated: "%sは%dバイトを超えています。bcryptはこの上限以降を無視します",
+		// E-posta alan adı kısıtları
+		KeyEmailDomainIn:    "%sは次のドメインのいずれかを使用する必要があります: %s",
+		KeyEmailDomainNotIn: "%sは許可されていないドメインを使用しています",
+		// Ücretsiz sağlayıcı ve rol hesabı tespiti
+		KeyEmailFreeProvider: "%sはビジネス用のメールアドレスである必要があります",
+		KeyEmailRoleAccount:  "%sは役割アカウントではなく個人のアドレスである必要があります",
+		// Alan grubu kuralları
+		KeyRequireAnyOf:     "{values}のいずれも入力されていない場合、%sは必須です",
+		KeyRequireAllOrNone: "%sは{values}と一緒に入力する必要があります",
+		// Aralık çakışması
+		KeyNoOverlap: "%sは%sと重複しています",
+		// Checksum
+		KeyChecksum: "%sのチェックディジットが無効です",
+		// National ID
+		KeyNationalID: "%sは有効な国民識別番号である必要があります",
+		// License plate
+		KeyLicensePlate: "%sは有効なナンバープレートである必要があります",
+		// Turkish business numbers
+		KeyMERSIS:        "%sは有効なMERSIS番号である必要があります",
+		KeyTaxOfficeCode: "%sは有効な税務署コードである必要があります",
+		KeySGKNumber:     "%sは有効なSGK事業所登録番号である必要があります",
+		// Raw JSON
+		KeyInvalidJSON: "ペイロードは有効なJSONオブジェクトである必要があります",
+		// IP policies
+		KeyIPNotPrivate:  "%sはプライベートネットワークアドレスであってはいけません",
+		KeyIPNotLoopback: "%sはループバックアドレスであってはいけません",
+		KeyIPPublicOnly:  "%sはパブリックIPアドレスである必要があります",
+		// URL SSRF guard
+		KeyURLPrivateHost: "%sはプライベートまたは内部ホストを指してはいけません",
+		KeyURLScheme:      "%sは次のスキームのいずれかを使用する必要があります: %s",
+		KeyURLPort:        "%sは次のポートのいずれかを使用する必要があります: %s",
+		// Webhook callback challenge
+		KeyCallbackChallenge: "%sをコールバックURLとして検証できませんでした",
+		// DNS record checks
+		KeyDomainResolvable: "%sはDNSで解決できるドメインである必要があります",
+		KeyDomainMX:         "%sはメールを受信できるドメインである必要があります",
+		KeyDomainTXT:        "%sは必要なDNS TXTレコードを公開する必要があります",
+		// PEM certificates and keys
+		KeyPEM:        "%sには有効なPEM形式の%sが含まれている必要があります",
+		KeyPEMKeyType: "%sは次の鍵タイプのいずれかを使用する必要があります: %s",
+		KeyPEMKeySize: "%sの鍵長が短すぎます",
+		KeyPEMExpired: "%sには現在有効な証明書が含まれている必要があります",
+		// SSH public keys
+		KeySSHPublicKey: "%sは有効なSSH公開鍵である必要があります",
+		KeySSHKeyType:   "%sは次の鍵タイプのいずれかを使用する必要があります: %s",
+		KeySSHKeySize:   "%sは%dビット以上の鍵を使用する必要があります",
+		// JSON Pointer and JSONPath
+		KeyJSONPointer: "%sは有効なJSON Pointerである必要があります",
+		KeyJSONPath:    "%sは有効なJSONPath式である必要があります",
+		// File uploads
+		KeyFile:          "%sはアップロードされたファイルである必要があります",
+		KeyFileSize:      "%sは%s以下である必要があります",
+		KeyFileExtension: "%sは次の拡張子のいずれかである必要があります: %s",
+		KeyFileMIME:      "%sは次の種類のファイルである必要があります: %s",
+		// Images
+		KeyImage:            "%sは有効な画像である必要があります",
+		KeyImageFormat:      "%sは次の形式の画像である必要があります: %s",
+		KeyImageMinWidth:    "%sの幅は%dピクセル以上である必要があります",
+		KeyImageMaxWidth:    "%sの幅は%dピクセル以下である必要があります",
+		KeyImageMinHeight:   "%sの高さは%dピクセル以上である必要があります",
+		KeyImageMaxHeight:   "%sの高さは%dピクセル以下である必要があります",
+		KeyImageMegapixels:  "%sは%sメガピクセル以下である必要があります",
+		KeyImageAspectRatio: "%sのアスペクト比は%sである必要があります",
+		// JSON Patch
+		KeyJSONPatch:       "%sは有効なJSON Patchドキュメントである必要があります",
+		KeyJSONPatchPath:   "%sは存在しない位置を指しています",
+		KeyJSONPatchRemove: "%sは削除できない必須フィールドを指しています",
+		KeyJSONPatchMove:   "%sを自身の子要素に移動することはできません",
+		KeyInvalidXML: "ペイロードは有効なXMLドキュメントである必要があります",
+		KeyJSONSyntax:  "ペイロードは有効なJSONではありません: %d行目、%d列目で構文エラー",
+		KeyContentType: "コンテンツタイプは次のいずれかである必要があります: %s",
+		KeyIdempotencyKey:    "%sは%d文字以内のUUIDまたはULID形式の冪等キーである必要があります",
+		KeyIdempotencyReplay: "%sは既に使用されています",
+		KeyAnyOf: "データは%d個の代替スキーマのうち少なくとも1つに一致する必要があります",
+		KeyNot:   "データは除外されたスキーマに一致してはいけません",
+		KeyOneOfSchema: "データは%d個の代替スキーマのうち正確に1つに一致する必要があります",
+		KeySchemaRef: "%s は登録されていないスキーマ \"%s\" を参照しています",
+		KeyDeadline: "検証が制限時間内に完了しませんでした",
+		KeyUnion: "%sは許可された%d個の型のいずれかに一致する必要があります",
+		KeyUnknownField: "%sは許可されていないフィールドです",
+		// Map (anahtar/değer) tipi
+		KeyMinKeys: "%sには少なくとも%d個のキーが必要です",
+		KeyMaxKeys: "%sのキーは最大%d個までです",
+		KeyMapKey:  "%sに無効なキーが含まれています: %s",
+		// Literal (sabit değer) tipi
+		KeyLiteral: "%sは{value}である必要があります",
+		// Tamsayı taşması
+		KeyIntegerRange: "%sはサポートされている整数の範囲外です",
 	}
 
 	// Chinese (Simplified) messages
@@ -585,6 +1578,146 @@ func (t *Translator) loadDefaultMessages() {
 		KeyUnique:        "%s必须只包含唯一元素",
 		KeyArrayContains: "%s必须包含值'%v'",
 		KeyNotEmpty:      "%s不能为空",
+		// Change validators
+		KeyImmutable:    "%s不能被修改",
+		KeyOnlyIncrease: "%s只能增加（当前：%v）",
+		KeyOnlyDecrease: "%s只能减少（当前：%v）",
+		KeyTransition:   "%s不能从'%v'变更为'%v'",
+		// Schema flow
+		KeyInvalidStep: "步骤%d不存在（总步骤数：%d）",
+		// Field comparison
+		KeySame:      "%s必须与{other}一致",
+		KeyDifferent: "%s必须与{other}不同",
+		// Discriminated unions
+		KeyDiscriminator: "%s必须是以下之一：%s（收到：'%v'）",
+		// Payload guards
+		KeyPayloadTooLarge: "请求数据不得超过%d字节",
+		// Regex safety
+		KeyRegexTimeout: "%s未能在规定时间内完成格式校验",
+		// Unicode string validators
+		KeyAlphaUnicode:        "%s必须只包含字母",
+		KeyAlphaNumericUnicode: "%s必须只包含字母和数字",
+		KeyAlphaSpace:          "%s必须只包含字母和空格",
+		// Person names
+		KeyPersonName: "%s必须是有效的人名",
+		// Kart BIN sorgusu
+		KeyCardLookup:  "%s无法验证",
+		KeyCardKind:    "%s必须是以下卡类型之一：%s",
+		KeyCardCountry: "%s必须由以下国家/地区之一发行：%s",
+		// Kart ile ödeme
+		KeyCardExpiry:  "%s必须是有效的有效期(MM/YY)",
+		KeyCardExpired: "%s已过期",
+		KeyCardCVV:     "%s对于此卡必须为%d位数字",
+		// Tekrarlama kuralı
+		KeyRRule: "%s必须是有效的重复规则(RFC 5545)",
+		// Yapısal tanımlayıcılar
+		KeyE164:    "%s必须是E.164格式的电话号码",
+		KeyDID:     "%s必须是有效的去中心化标识符(DID)",
+		KeyURN:     "%s必须是有效的URN",
+		KeyOTPCode: "%s必须是%d位数字代码",
+		// HTTP başlık güvenliği
+		KeyHeaderValue:    "%s不得包含换行符或控制字符",
+		KeyHeaderFilename: "%s必须是安全的文件名",
+		// Form kötüye kullanım korumaları
+		KeyHoneypot: "%s必须留空",
+		KeyCSRF:     "%s无效或已过期，请重新加载表单",
+		// Captcha
+		KeyCaptcha: "%s验证失败，请重试",
+		// Zaman tabanlı tek kullanımlık şifre
+		KeyTOTP: "%s不是有效的验证码",
+		// Şifre geçmişi ve kişisel veri benzerliği
+		KeyPasswordSimilar: "%s与%s过于相似",
+		KeyPasswordReused:  "%s之前已使用过，请选择新的",
+		// bcrypt bayt sınırı uyarısı
+		KeyPasswordTruncated: "%s超过%d字节；bcrypt会忽略超出部分",
+		// E-posta alan adı kısıtları
+		KeyEmailDomainIn:    "%s必须使用以下域名之一：%s",
+		KeyEmailDomainNotIn: "%s使用了不允许的域名",
+		// Ücretsiz sağlayıcı ve rol hesabı tespiti
+		KeyEmailFreeProvider: "%s必须是企业邮箱地址",
+		KeyEmailRoleAccount:  "%s必须是个人地址，而不是角色账户",
+		// Alan grubu kuralları
+		KeyRequireAnyOf:     "当{values}均未填写时，%s为必填项",
+		KeyRequireAllOrNone: "%s必须与{values}一起填写",
+		// Aralık çakışması
+		KeyNoOverlap: "%s与%s重叠",
+		// Checksum
+		KeyChecksum: "%s的校验位无效",
+		// National ID
+		KeyNationalID: "%s必须是有效的身份证号码",
+		// License plate
+		KeyLicensePlate: "%s必须是有效的车牌号",
+		// Turkish business numbers
+		KeyMERSIS:        "%s必须是有效的MERSIS编号",
+		KeyTaxOfficeCode: "%s必须是有效的税务局代码",
+		KeySGKNumber:     "%s必须是有效的SGK工作场所登记号",
+		// Raw JSON
+		KeyInvalidJSON: "请求数据必须是有效的JSON对象",
+		// IP policies
+		KeyIPNotPrivate:  "%s不能是私有网络地址",
+		KeyIPNotLoopback: "%s不能是环回地址",
+		KeyIPPublicOnly:  "%s必须是公网IP地址",
+		// URL SSRF guard
+		KeyURLPrivateHost: "%s不能指向私有或内部主机",
+		KeyURLScheme:      "%s必须使用以下协议之一：%s",
+		KeyURLPort:        "%s必须使用以下端口之一：%s",
+		// Webhook callback challenge
+		KeyCallbackChallenge: "无法验证%s为回调地址",
+		// DNS record checks
+		KeyDomainResolvable: "%s必须是可在DNS中解析的域名",
+		KeyDomainMX:         "%s必须是可以接收电子邮件的域名",
+		KeyDomainTXT:        "%s必须发布所需的DNS TXT记录",
+		// PEM certificates and keys
+		KeyPEM:        "%s必须包含有效的PEM编码%s",
+		KeyPEMKeyType: "%s必须使用以下密钥类型之一：%s",
+		KeyPEMKeySize: "%s使用的密钥长度过短",
+		KeyPEMExpired: "%s必须包含当前有效的证书",
+		// SSH public keys
+		KeySSHPublicKey: "%s必须是有效的SSH公钥",
+		KeySSHKeyType:   "%s必须使用以下密钥类型之一：%s",
+		KeySSHKeySize:   "%s必须使用至少%d位的密钥",
+		// JSON Pointer and JSONPath
+		KeyJSONPointer: "%s必须是有效的JSON Pointer",
+		KeyJSONPath:    "%s必须是有效的JSONPath表达式",
+		// File uploads
+		KeyFile:          "%s必须是上传的文件",
+		KeyFileSize:      "%s不能大于%s",
+		KeyFileExtension: "%s的扩展名必须是以下之一：%s",
+		KeyFileMIME:      "%s必须是以下类型的文件：%s",
+		// Images
+		KeyImage:            "%s必须是有效的图片",
+		KeyImageFormat:      "%s必须是以下格式的图片：%s",
+		KeyImageMinWidth:    "%s的宽度必须至少为%d像素",
+		KeyImageMaxWidth:    "%s的宽度不能超过%d像素",
+		KeyImageMinHeight:   "%s的高度必须至少为%d像素",
+		KeyImageMaxHeight:   "%s的高度不能超过%d像素",
+		KeyImageMegapixels:  "%s不能超过%s百万像素",
+		KeyImageAspectRatio: "%s的宽高比必须为%s",
+		// JSON Patch
+		KeyJSONPatch:       "%s必须是有效的JSON Patch文档",
+		KeyJSONPatchPath:   "%s指向不存在的位置",
+		KeyJSONPatchRemove: "%s指向无法删除的必填字段",
+		KeyJSONPatchMove:   "%s不能移动到其子元素中",
+		KeyInvalidXML: "请求数据必须是有效的XML文档",
+		KeyJSONSyntax:  "请求数据不是有效的JSON：第%d行第%d列存在语法错误",
+		KeyContentType: "内容类型必须是以下之一：%s",
+		KeyIdempotencyKey:    "%s 必须是不超过 %d 个字符的 UUID 或 ULID 幂等键",
+		KeyIdempotencyReplay: "%s 已被使用",
+		KeyAnyOf: "数据必须至少符合 %d 个备选模式中的一个",
+		KeyNot:   "数据不得符合被排除的模式",
+		KeyOneOfSchema: "数据必须恰好符合 %d 个备选模式中的一个",
+		KeySchemaRef: "%s 引用了未注册的模式 \"%s\"",
+		KeyDeadline: "验证未在时间限制内完成",
+		KeyUnion: "%s 必须符合 %d 种允许类型之一",
+		KeyUnknownField: "%s 不是允许的字段",
+		// Map (anahtar/değer) tipi
+		KeyMinKeys: "%s 至少必须包含 %d 个键",
+		KeyMaxKeys: "%s 最多只能包含 %d 个键",
+		KeyMapKey:  "%s 包含无效的键: %s",
+		// Literal (sabit değer) tipi
+		KeyLiteral: "%s 必须为 {value}",
+		// Tamsayı taşması
+		KeyIntegerRange: "%s 超出了支持的整数范围",
 	}
 }
 
@@ -671,19 +1804,43 @@ func Get(key MessageKey, args ...any) string {
 func (t *Translator) Get(key MessageKey, args ...any) string {
 	t.mu.RLock()
 	defer t.mu.RUnlock()
+	return t.get(t.currentLocale, key, args)
+}
+
+// GetIn, mesajı aktif dilden bağımsız olarak belirtilen dilde döndürür.
+// İstek bazında dil seçen uygulamalar (örn. Accept-Language) global aktif dili
+// değiştirmeden mesaj üretmek için bunu kullanır.
+//
+// Örnek:
+//
+//	msg := i18n.GetIn("de", i18n.KeyRequired, "Email")
+//	// "Email ist erforderlich"
+func GetIn(locale string, key MessageKey, args ...any) string {
+	return globalTranslator.GetIn(locale, key, args...)
+}
 
-	// Önce aktif dilde ara
-	if messages, exists := t.messages[t.currentLocale]; exists {
+// GetIn, mesajı belirtilen dilde döndürür ve placeholder'ları doldurur
+func (t *Translator) GetIn(locale string, key MessageKey, args ...any) string {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.get(locale, key, args)
+}
+
+// get, mesajı locale dilinde arar; bulunamazsa (fallback açıksa) varsayılan
+// dile düşer. Çağıran okuma kilidini tutmalıdır.
+func (t *Translator) get(locale string, key MessageKey, args []any) string {
+	// Önce istenen dilde ara
+	if messages, exists := t.messages[locale]; exists {
 		if msg, found := messages[key]; found {
-			return fmt.Sprintf(msg, args...)
+			return t.format(locale, msg, args)
 		}
 	}
 
 	// Fallback enabled ise default dili dene
-	if t.fallbackEnabled && t.currentLocale != t.defaultLocale {
+	if t.fallbackEnabled && locale != t.defaultLocale {
 		if messages, exists := t.messages[t.defaultLocale]; exists {
 			if msg, found := messages[key]; found {
-				return fmt.Sprintf(msg, args...)
+				return t.format(t.defaultLocale, msg, args)
 			}
 		}
 	}
@@ -692,6 +1849,21 @@ func (t *Translator) Get(key MessageKey, args ...any) string {
 	return fmt.Sprintf("[%s]", key)
 }
 
+// format, mesaj şablonunu mesajın ait olduğu dile göre doldurur. Değerler
+// tanımlıysa ValueFormatter ile biçimlendirilir ve uzunluk sınırına göre
+// kısaltılır (bkz. SetValueLengthLimit); RTL dillerde (yön yalıtımı
+// kapatılmadıysa) FSI/PDI işaretleriyle sarmalanır. Attrs argümanları {isim}
+// yer tutucularını doldurur.
+func (t *Translator) format(locale, msg string, args []any) string {
+	isolate := !t.bidiDisabled && IsRTL(locale)
+	args, attrs := t.prepareArgs(locale, args)
+	if attrs != nil {
+		msg = t.replacePlaceholders(locale, msg, attrs, isolate)
+		args = trimArgs(msg, args)
+	}
+	return fmt.Sprintf(msg, wrapArgs(locale, args, t.valueFormatter, isolate, t.valueLimit)...)
+}
+
 // T, Get fonksiyonunun kısa alias'ı (Laravel'deki __() veya t() gibi)
 func T(key MessageKey, args ...any) string {
 	return Get(key, args...)
@@ -0,0 +1,153 @@
+package i18n
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+//
+// -----------------------------------------------------------------------------
+// Dil Eşleştirme (Accept-Language) ve İstek Bazlı Dil
+// -----------------------------------------------------------------------------
+// Çok dilli API'lerde hata mesajının dili, global aktif dil yerine isteği
+// yapan istemcinin tercihine göre seçilmelidir. Bu dosya:
+//
+//   - Accept-Language başlığını (RFC 9110 §12.5.4) kalite (q) değerleriyle
+//     ayrıştırıp yüklü dillerle eşleştiren MatchLocale fonksiyonunu,
+//   - Seçilen dili context üzerinden taşıyan ContextWithLocale ve
+//     LocaleFromContext yardımcılarını içerir.
+//
+// Eşleştirme önce tam etiketi ("pt-BR"), ardından temel dili ("pt") dener;
+// büyük/küçük harf ve "_" / "-" ayırıcı farkı gözetilmez.
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// localeContextKey, context içinde istek dilini taşıyan anahtardır.
+type localeContextKey struct{}
+
+// ContextWithLocale, mesajların üretileceği dili context'e ekler. Bu context
+// ile yapılan ValidateCtx çağrıları hata mesajlarını bu dilde döndürür.
+//
+// Örnek:
+//
+//	ctx := i18n.ContextWithLocale(r.Context(), "tr")
+//	res := schema.ValidateCtx(ctx, data)
+func ContextWithLocale(ctx context.Context, locale string) context.Context {
+	return context.WithValue(ctx, localeContextKey{}, locale)
+}
+
+// LocaleFromContext, context'e eklenmiş dili döndürür.
+func LocaleFromContext(ctx context.Context) (string, bool) {
+	if ctx == nil {
+		return "", false
+	}
+	locale, ok := ctx.Value(localeContextKey{}).(string)
+	return locale, ok && locale != ""
+}
+
+// MatchLocale
+// -----------------------------------------------------------------------------
+// Accept-Language başlığını yüklü dillerle eşleştirir ve en yüksek öncelikli
+// eşleşmeyi döndürür. supported verilirse yalnızca bu diller arasında seçim
+// yapılır. Hiçbir dil eşleşmezse ikinci dönüş false olur; çağıran bu durumda
+// varsayılan dili kullanmalıdır.
+//
+// Örnek:
+//
+//	locale, ok := i18n.MatchLocale("de-CH, fr;q=0.8, en;q=0.5")
+//	// "de", true
+func MatchLocale(acceptLanguage string, supported ...string) (string, bool) {
+	return globalTranslator.MatchLocale(acceptLanguage, supported...)
+}
+
+// MatchLocale, başlığı translator'a yüklü dillerle eşleştirir
+func (t *Translator) MatchLocale(acceptLanguage string, supported ...string) (string, bool) {
+	candidates := supported
+	if len(candidates) == 0 {
+		candidates = t.GetAvailableLocales()
+		sort.Strings(candidates)
+	}
+
+	for _, tag := range parseAcceptLanguage(acceptLanguage) {
+		if locale, ok := matchTag(tag, candidates); ok {
+			return locale, true
+		}
+	}
+	return "", false
+}
+
+// parseAcceptLanguage, başlıktaki dil etiketlerini q değerine göre azalan
+// sırada döndürür. q=0 olan ve "*" etiketleri atlanır; eşit öncelikte
+// başlıktaki sıra korunur.
+func parseAcceptLanguage(header string) []string {
+	type weighted struct {
+		tag string
+		q   float64
+	}
+	var tags []weighted
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		tag = strings.TrimSpace(tag)
+		if tag == "" || tag == "*" {
+			continue
+		}
+		q := 1.0
+		for _, param := range strings.Split(params, ";") {
+			name, value, ok := strings.Cut(strings.TrimSpace(param), "=")
+			if !ok || strings.TrimSpace(name) != "q" {
+				continue
+			}
+			parsed, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+			if err != nil || parsed < 0 || parsed > 1 {
+				parsed = 0
+			}
+			q = parsed
+		}
+		if q > 0 {
+			tags = append(tags, weighted{tag: tag, q: q})
+		}
+	}
+	sort.SliceStable(tags, func(i, j int) bool { return tags[i].q > tags[j].q })
+
+	out := make([]string, len(tags))
+	for i, w := range tags {
+		out[i] = w.tag
+	}
+	return out
+}
+
+// matchTag, tek bir dil etiketini adaylarla sırasıyla tam etiket, temel dil
+// ve adayın temel dili düzeyinde eşleştirir ("pt" isteği "pt-BR"ye düşer).
+func matchTag(tag string, candidates []string) (string, bool) {
+	tag = normalizeTag(tag)
+	base, _, _ := strings.Cut(tag, "-")
+	for _, candidate := range candidates {
+		if normalizeTag(candidate) == tag {
+			return candidate, true
+		}
+	}
+	for _, candidate := range candidates {
+		if normalizeTag(candidate) == base {
+			return candidate, true
+		}
+	}
+	for _, candidate := range candidates {
+		if candidateBase, _, _ := strings.Cut(normalizeTag(candidate), "-"); candidateBase == base {
+			return candidate, true
+		}
+	}
+	return "", false
+}
+
+// normalizeTag, etiketi karşılaştırma için küçük harfe ve "-" ayırıcısına
+// çevirir.
+func normalizeTag(tag string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(tag), "_", "-"))
+}
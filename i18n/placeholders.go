@@ -0,0 +1,212 @@
+package i18n
+
+import (
+	"fmt"
+	"strings"
+)
+
+//
+// -----------------------------------------------------------------------------
+// Adlandırılmış Yer Tutucular ({other}, {values})
+// -----------------------------------------------------------------------------
+// Mesajlar konumsal fmt fiillerine (%s, %v) ek olarak {isim} biçiminde
+// adlandırılmış yer tutucular içerebilir. Değerleri, Get'e argüman olarak
+// verilen Attrs haritası sağlar; konumsal argümanlar etkilenmez:
+//
+//	i18n.Get(i18n.KeySame, "Şifre", i18n.Attrs{"other": "Şifre Tekrar"})
+//	// "Şifre alanı Şifre Tekrar ile eşleşmelidir"
+//
+// Kütüphanenin doldurduğu yer tutucular:
+//   - {attribute}: Doğrulanan alanın etiketi
+//   - {other}:     Karşılaştırılan alanın etiketi (Same, Different)
+//   - {values}:    İzin verilen değerlerin listesi (OneOf)
+//
+// ValueList tipindeki değerler dile göre ayırıcılarla birleştirilir ve uzun
+// listeler kısaltılır ("a, b, c, and 3 more"). Böylece uzun enum listeleri
+// okunamaz mesajlar üretmez.
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// DefaultValueListLimit, bir ValueList'in kısaltılmadan gösterilen en fazla
+// eleman sayısıdır.
+const DefaultValueListLimit = 5
+
+// Attrs, mesajdaki {isim} yer tutucularını dolduran adlandırılmış
+// argümanlardır. Get'e konumsal argümanlarla birlikte herhangi bir sırada
+// verilebilir.
+type Attrs map[string]any
+
+// ValueList, mesaja dile göre birleştirilerek yerleştirilen değer listesidir.
+type ValueList []any
+
+// String, fmt.Stringer implementasyonu; Translator dışında kullanıldığında
+// değerleri kısaltmadan virgülle birleştirir.
+func (l ValueList) String() string {
+	parts := make([]string, len(l))
+	for i, v := range l {
+		parts[i] = fmt.Sprint(v)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// StringList, []string değerlerinden bir ValueList oluşturur.
+func StringList(values []string) ValueList {
+	list := make(ValueList, len(values))
+	for i, v := range values {
+		list[i] = v
+	}
+	return list
+}
+
+// listFormat, bir dilin liste birleştirme kurallarıdır.
+type listFormat struct {
+	separator string // Elemanlar arası ayırıcı
+	pair      string // İki elemanlı listede ayırıcı
+	last      string // Son elemandan önceki ayırıcı
+	more      string // Kısaltılan eleman sayısı için şablon (%d)
+}
+
+// localeListFormats, dillere göre liste birleştirme kurallarıdır. Bilinmeyen
+// diller için İngilizce kullanılır.
+var localeListFormats = map[string]listFormat{
+	"en": {separator: ", ", pair: " and ", last: ", and ", more: ", and %d more"},
+	"tr": {separator: ", ", pair: " ve ", last: " ve ", more: " ve %d diğer"},
+	"de": {separator: ", ", pair: " und ", last: " und ", more: " und %d weitere"},
+	"fr": {separator: ", ", pair: " et ", last: " et ", more: " et %d autres"},
+	"es": {separator: ", ", pair: " y ", last: " y ", more: " y %d más"},
+	"ja": {separator: "、", pair: "、", last: "、", more: "、他%d件"},
+	"zh": {separator: "、", pair: "和", last: "和", more: "等%d项"},
+}
+
+// SetValueListLimit
+// -----------------------------------------------------------------------------
+// ValueList'lerin kısaltılmadan gösterilecek en fazla eleman sayısını
+// ayarlar. 0 veya negatif değer kısaltmayı kapatır.
+func SetValueListLimit(limit int) {
+	globalTranslator.SetValueListLimit(limit)
+}
+
+// SetValueListLimit, translator için liste kısaltma sınırını ayarlar.
+func (t *Translator) SetValueListLimit(limit int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.listLimit = limit
+}
+
+// formatList, listeyi dilin ayırıcılarıyla birleştirir ve limit aşılıyorsa
+// kalan eleman sayısını ekler. Elemanlar ValueFormatter ile biçimlendirilir.
+func (t *Translator) formatList(locale string, list ValueList) string {
+	lang, _, _ := strings.Cut(strings.ReplaceAll(locale, "_", "-"), "-")
+	format, known := localeListFormats[strings.ToLower(lang)]
+	if !known {
+		format = localeListFormats["en"]
+	}
+
+	parts := make([]string, len(list))
+	for i, v := range list {
+		parts[i] = t.formatValue(locale, v)
+	}
+
+	if t.listLimit > 0 && len(parts) > t.listLimit {
+		hidden := len(parts) - t.listLimit
+		return strings.Join(parts[:t.listLimit], format.separator) + fmt.Sprintf(format.more, hidden)
+	}
+
+	switch len(parts) {
+	case 0:
+		return ""
+	case 1:
+		return parts[0]
+	case 2:
+		return parts[0] + format.pair + parts[1]
+	}
+	return strings.Join(parts[:len(parts)-1], format.separator) + format.last + parts[len(parts)-1]
+}
+
+// formatValue, tek bir değeri (varsa) ValueFormatter ile metne çevirir ve
+// uzunluk sınırına göre kısaltır. Listelerde sınır her elemana ayrı uygulanır.
+func (t *Translator) formatValue(locale string, value any) string {
+	if list, ok := value.(ValueList); ok {
+		return t.formatList(locale, list)
+	}
+	if t.valueFormatter != nil {
+		if s, ok := t.valueFormatter(locale, value); ok {
+			return Truncate(s, t.valueLimit)
+		}
+	}
+	return Truncate(fmt.Sprint(value), t.valueLimit)
+}
+
+// prepareArgs, Attrs argümanlarını ayırır ve ValueList'leri dile göre
+// birleştirilmiş metne çevirir.
+func (t *Translator) prepareArgs(locale string, args []any) ([]any, Attrs) {
+	var attrs Attrs
+	positional := args[:0:0]
+	for _, arg := range args {
+		switch v := arg.(type) {
+		case Attrs:
+			if attrs == nil {
+				attrs = Attrs{}
+			}
+			for name, value := range v {
+				attrs[name] = value
+			}
+		case ValueList:
+			positional = append(positional, t.formatList(locale, v))
+		default:
+			positional = append(positional, arg)
+		}
+	}
+	return positional, attrs
+}
+
+// replacePlaceholders, mesajdaki {isim} yer tutucularını attrs değerleriyle
+// doldurur. Değerler fmt şablonuna yerleştirildiğinden % karakterleri kaçışlanır.
+func (t *Translator) replacePlaceholders(locale, msg string, attrs Attrs, isolate bool) string {
+	if len(attrs) == 0 || !strings.Contains(msg, "{") {
+		return msg
+	}
+
+	pairs := make([]string, 0, len(attrs)*2)
+	for name, value := range attrs {
+		text := t.formatValue(locale, value)
+		if isolate {
+			text = Isolate(text)
+		}
+		pairs = append(pairs, "{"+name+"}", strings.ReplaceAll(text, "%", "%%"))
+	}
+	return strings.NewReplacer(pairs...).Replace(msg)
+}
+
+// trimArgs, Attrs kullanılan mesajlarda şablonun tüketmediği konumsal
+// argümanları atar. Böylece yalnızca {isim} yer tutucusu içeren özel mesajlar
+// "%!(EXTRA ...)" çıktısı üretmez. İndeksli fiiller (%[1]s) varsa argümanlar
+// olduğu gibi bırakılır.
+func trimArgs(msg string, args []any) []any {
+	verbs := 0
+	for i := 0; i < len(msg); i++ {
+		if msg[i] != '%' {
+			continue
+		}
+		i++
+		if i < len(msg) && msg[i] == '%' {
+			continue
+		}
+		for i < len(msg) && strings.IndexByte("+-# 0123456789.*[]", msg[i]) >= 0 {
+			if msg[i] == '[' {
+				return args
+			}
+			i++
+		}
+		verbs++
+	}
+	if verbs < len(args) {
+		return args[:verbs]
+	}
+	return args
+}
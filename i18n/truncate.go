@@ -0,0 +1,68 @@
+package i18n
+
+import "unicode/utf8"
+
+//
+// -----------------------------------------------------------------------------
+// Mesaj Argümanlarının Uzunluk Sınırı
+// -----------------------------------------------------------------------------
+// Hata mesajlarına yerleştirilen değerler (alan yolları, izin verilen
+// değerler, girdinin kendisi) dışarıdan gelen veriden türeyebilir. 1 MB'lık
+// bir string'in mesaja olduğu gibi kopyalanması, hatanın kendisini bellek ve
+// log açısından bir saldırı aracına çevirir. Bu nedenle her argüman, mesaja
+// yerleştirilmeden önce en fazla DefaultValueLengthLimit karaktere kısaltılır
+// ve sonuna üç nokta (…) eklenir:
+//
+//	i18n.SetValueLengthLimit(32)
+//	i18n.Get(i18n.KeyOneOf, strings.Repeat("a", 1<<20), "x, y")
+//	// "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa… must be one of: x, y"
+//
+// Sınır mesaj şablonunun kendisine değil, yalnızca argümanlara uygulanır.
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// DefaultValueLengthLimit, mesaja yerleştirilen bir argümanın kısaltılmadan
+// gösterilen en fazla karakter (rune) sayısıdır.
+const DefaultValueLengthLimit = 128
+
+// Ellipsis, kısaltılan argümanların sonuna eklenen işarettir.
+const Ellipsis = "…"
+
+// SetValueLengthLimit
+// -----------------------------------------------------------------------------
+// Mesajlara yerleştirilen argümanların en fazla karakter sayısını ayarlar.
+// 0 veya negatif değer kısaltmayı kapatır.
+func SetValueLengthLimit(limit int) {
+	globalTranslator.SetValueLengthLimit(limit)
+}
+
+// SetValueLengthLimit, translator için argüman uzunluk sınırını ayarlar.
+func (t *Translator) SetValueLengthLimit(limit int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.valueLimit = limit
+}
+
+// Truncate
+// -----------------------------------------------------------------------------
+// s, limit karakterden uzunsa ilk limit karakterini Ellipsis ile birlikte
+// döndürür; değilse s'yi olduğu gibi döndürür. limit 0 veya negatifse
+// kısaltma yapılmaz.
+func Truncate(s string, limit int) string {
+	if limit <= 0 || len(s) <= limit || utf8.RuneCountInString(s) <= limit {
+		return s
+	}
+	end, count := 0, 0
+	for end = range s {
+		if count == limit {
+			break
+		}
+		count++
+	}
+	return s[:end] + Ellipsis
+}
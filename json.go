@@ -0,0 +1,78 @@
+package validation
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+
+	"github.com/biyonik/go-fluent-validator/core"
+	"github.com/biyonik/go-fluent-validator/i18n"
+)
+
+//
+// -----------------------------------------------------------------------------
+// Ham JSON Doğrulama
+// -----------------------------------------------------------------------------
+// API gövdelerini json.Unmarshal + map[string]any adımı olmadan doğrudan
+// doğrular. Çözme işlemi json.Decoder.UseNumber ile yapılır; böylece sayılar
+// float64'e zorlanmaz, büyük tamsayılar hassasiyet kaybetmez ve NumberType
+// json.Number değerlerini int64/float64'e çevirerek ValidData'ya yazar.
+//
+// Gövde tek bir JSON nesnesi olmalıdır; bozuk JSON, nesne olmayan kök değer
+// veya nesneden sonra gelen ek içerik "_payload" alanında "invalid_json" kuralıyla
+// raporlanır.
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// ValidateJSON
+// -----------------------------------------------------------------------------
+// Ham JSON baytlarını çözer ve şemayla doğrular.
+//
+// Örnek:
+//
+//	res := schema.ValidateJSON([]byte(`{"email":"ada@example.com","age":36}`))
+func (vs *ValidationSchema) ValidateJSON(data []byte) *core.ValidationResult {
+	return vs.ValidateReader(bytes.NewReader(data))
+}
+
+// ValidateReader
+// -----------------------------------------------------------------------------
+// r'den tek bir JSON nesnesi okur ve şemayla doğrular (örn. r.Body).
+func (vs *ValidationSchema) ValidateReader(r io.Reader) *core.ValidationResult {
+	data, err := decodeJSONObject(r)
+	if err != nil {
+		result := core.NewResult()
+		result.AddRuleError(payloadField, i18n.KeyInvalidJSON)
+		vs.finalize(context.Background(), result)
+		return result
+	}
+	return vs.Validate(data)
+}
+
+// errTrailingJSON, JSON nesnesinden sonra ek içerik bulunduğunu belirtir.
+var errTrailingJSON = errors.New("JSON nesnesinden sonra beklenmeyen içerik")
+
+// decodeJSONObject, r'den UseNumber ile tek bir JSON nesnesi çözer.
+func decodeJSONObject(r io.Reader) (map[string]any, error) {
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+
+	var data map[string]any
+	if err := dec.Decode(&data); err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, errors.New("JSON gövdesi bir nesne olmalıdır")
+	}
+	if _, err := dec.Token(); !errors.Is(err, io.EOF) {
+		return nil, errTrailingJSON
+	}
+	return data, nil
+}
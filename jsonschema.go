@@ -0,0 +1,503 @@
+package validation
+
+import (
+	"regexp"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/biyonik/go-fluent-validator/core"
+)
+
+//
+// -----------------------------------------------------------------------------
+// JSON Schema (draft 2020-12) Dışa Aktarımı
+// -----------------------------------------------------------------------------
+// Şemanın Describe() çıktısını JSON Schema dokümanına çevirir; böylece aynı
+// kurallar frontend doğrulamasını (Ajv vb.) ve API dokümantasyonunu
+// besleyebilir:
+//
+//	doc := schema.ToJSONSchema()
+//	body, _ := json.MarshalIndent(doc, "", "  ")
+//
+// Eşlemeler:
+//   - string min/max → minLength/maxLength, one_of → enum, regex/starts_with/
+//     ends_with/contains → pattern, email/url/uuid/ip/json_pointer → format
+//   - number min/max/between → minimum/maximum, positive/negative →
+//     exclusiveMinimum/exclusiveMaximum, multiple_of → multipleOf, integer →
+//     "integer" tipi
+//   - array min/max/not_empty → minItems/maxItems, unique → uniqueItems,
+//     eleman şeması → items, ElementsBy varyantları → oneOf
+//   - object alt alanları → properties/required, Literal → const, Any →
+//     kısıtsız şema ({})
+//   - map min_keys/max_keys → minProperties/maxProperties, anahtar şeması →
+//     propertyNames, değer şeması → additionalProperties
+//   - When(...) dalları → allOf içinde if/then, RequireAnyOf → anyOf
+//   - AllOf(...) → allOf dalları; AnyOf(...), OneOf(...) ve Not(...) → allOf
+//     içinde anyOf, oneOf ve not
+//   - Ref(...) → yerinde açılmış alt şema; döngüsel başvurular → $defs
+//     içindeki şemaya $ref
+//   - Nullable → tip listesinde "null" (veya {"type": "null"} ile anyOf),
+//     Optional → required listesinden çıkarılır
+//   - Label → title, Describe → description, Example → examples, Sensitive →
+//     writeOnly, Deprecated → deprecated
+//
+// JSON Schema'da karşılığı olmayan kurallar (checksum, national_id, DNS ve
+// diğer dış kaynaklı kontroller, özel doğrulayıcılar) atlanır; üretilen şema
+// sunucu tarafındaki doğrulamanın gevşek bir alt kümesidir.
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// JSONSchemaDialect, üretilen dokümanların $schema değeridir.
+const JSONSchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+
+// jsonSchemaDefsPrefix, döngüsel Ref düğümlerinin $ref önekidir.
+const jsonSchemaDefsPrefix = "#/$defs/"
+
+// stringPatterns, parametresiz string kurallarının pattern karşılıklarıdır.
+var stringPatterns = map[string]string{
+	"alpha":        "^[a-zA-Z]+$",
+	"alphanumeric": "^[a-zA-Z0-9]+$",
+	"numeric":      "^[0-9]+$",
+	"hex":          "^[0-9a-fA-F]+$",
+	"e164":         `^\+[1-9][0-9]{1,14}$`,
+}
+
+// stringFormats, parametresiz string kurallarının format karşılıklarıdır.
+var stringFormats = map[string]string{
+	"email":        "email",
+	"url":          "uri",
+	"json_pointer": "json-pointer",
+}
+
+// ToJSONSchema
+// -----------------------------------------------------------------------------
+// Şemayı JSON Schema (draft 2020-12) dokümanı olarak döndürür. Sonuç
+// json.Marshal ile doğrudan serileştirilebilir.
+func (vs *ValidationSchema) ToJSONSchema() map[string]any {
+	desc := vs.Describe()
+	doc := jsonSchemaObject(desc)
+	if defs := jsonSchemaDefs(desc); len(defs) > 0 {
+		doc["$defs"] = defs
+	}
+	doc["$schema"] = JSONSchemaDialect
+	return doc
+}
+
+// jsonSchemaObject, bir şema tanımını "object" JSON Schema'sına çevirir.
+func jsonSchemaObject(desc *core.SchemaDescription) map[string]any {
+	out := jsonSchemaFields(desc.Fields)
+
+	var allOf []any
+	for _, cond := range desc.Conditionals {
+		then := map[string]any{}
+		if cond.Schema != nil {
+			then = jsonSchemaObject(cond.Schema)
+			delete(then, "type")
+		}
+		allOf = append(allOf, map[string]any{
+			"if": map[string]any{
+				"properties": map[string]any{cond.Field: map[string]any{"const": cond.Equals}},
+				"required":   []string{cond.Field},
+			},
+			"then": then,
+		})
+	}
+	for _, rule := range desc.Rules {
+		if rule.Name != "require_any_of" {
+			continue
+		}
+		var anyOf []any
+		for _, field := range paramStrings(rule.Params, "fields") {
+			anyOf = append(anyOf, map[string]any{"required": []string{field}})
+		}
+		allOf = append(allOf, map[string]any{"anyOf": anyOf})
+	}
+	for _, c := range desc.Compositions {
+		branches := make([]any, len(c.Schemas))
+		for i, sub := range c.Schemas {
+			branches[i] = jsonSchemaObject(sub)
+		}
+		switch c.Kind {
+		case compositionAllOf:
+			allOf = append(allOf, branches...)
+		case compositionAnyOf:
+			allOf = append(allOf, map[string]any{"anyOf": branches})
+		case compositionOneOf:
+			allOf = append(allOf, map[string]any{"oneOf": branches})
+		case compositionNot:
+			allOf = append(allOf, map[string]any{"not": branches[0]})
+		}
+	}
+	if len(allOf) > 0 {
+		out["allOf"] = allOf
+	}
+	return out
+}
+
+// jsonSchemaFields, alan tanımlarından properties/required içeren bir
+// "object" şeması üretir.
+func jsonSchemaFields(fields map[string]*core.TypeDescription) map[string]any {
+	properties := make(map[string]any, len(fields))
+	var required []string
+	for name, field := range fields {
+		properties[name] = jsonSchemaType(field)
+		if field.Required && !field.Optional {
+			required = append(required, name)
+		}
+	}
+	out := map[string]any{"type": "object", "properties": properties}
+	if len(required) > 0 {
+		sort.Strings(required)
+		out["required"] = required
+	}
+	return out
+}
+
+// jsonSchemaType, tek bir tip tanımını JSON Schema'ya çevirir.
+func jsonSchemaType(desc *core.TypeDescription) map[string]any {
+	if desc == nil {
+		return map[string]any{}
+	}
+
+	var out map[string]any
+	switch desc.Type {
+	case "string", "email", "uuid", "iban", "credit_card", "csrf_token", "captcha":
+		out = jsonSchemaString(desc)
+	case "number":
+		out = jsonSchemaNumber(desc)
+	case "boolean":
+		out = map[string]any{"type": "boolean"}
+	case "date":
+		out = jsonSchemaDate(desc)
+	case "object":
+		out = jsonSchemaObjectType(desc)
+	case "array":
+		out = jsonSchemaArray(desc)
+	case "map":
+		out = jsonSchemaMap(desc)
+	case "file", "image":
+		out = map[string]any{"type": "string", "contentEncoding": "binary"}
+		if rule := desc.Rule("mime_types"); rule != nil {
+			if types := paramStrings(rule.Params, "values"); len(types) == 1 {
+				out["contentMediaType"] = types[0]
+			}
+		}
+	case "union":
+		anyOf := make([]any, len(desc.Union))
+		for i, alternative := range desc.Union {
+			anyOf[i] = jsonSchemaType(alternative)
+		}
+		out = map[string]any{"anyOf": anyOf}
+	case "literal":
+		out = map[string]any{"const": desc.Rule("literal").Params["value"]}
+	case "json_patch":
+		out = map[string]any{"type": "array", "items": map[string]any{
+			"type":     "object",
+			"required": []string{"op", "path"},
+			"properties": map[string]any{
+				"op":   map[string]any{"enum": []string{"add", "remove", "replace", "move", "copy", "test"}},
+				"path": map[string]any{"type": "string", "format": "json-pointer"},
+				"from": map[string]any{"type": "string", "format": "json-pointer"},
+			},
+		}}
+	default:
+		out = map[string]any{}
+	}
+	if desc.Nullable {
+		out = jsonSchemaNullable(out)
+	}
+
+	if desc.Label != "" {
+		out["title"] = desc.Label
+	}
+	if desc.Description != "" {
+		out["description"] = desc.Description
+	}
+	if desc.Default != nil {
+		out["default"] = desc.Default
+	}
+	if len(desc.Examples) > 0 {
+		out["examples"] = desc.Examples
+	}
+	if desc.Deprecated {
+		out["deprecated"] = true
+	}
+	if desc.Sensitive {
+		out["writeOnly"] = true
+	}
+	return out
+}
+
+// jsonSchemaNullable, şemaya null değeri ekler: enum içermeyen tek tipli
+// şemalarda tip listesine "null" eklenir, diğerleri {"type": "null"} ile anyOf
+// içine alınır.
+func jsonSchemaNullable(out map[string]any) map[string]any {
+	if typ, ok := out["type"].(string); ok && out["enum"] == nil {
+		out["type"] = []any{typ, "null"}
+		return out
+	}
+	if len(out) == 0 {
+		return out
+	}
+	return map[string]any{"anyOf": []any{out, map[string]any{"type": "null"}}}
+}
+
+// jsonSchemaString, string tabanlı tipleri çevirir.
+func jsonSchemaString(desc *core.TypeDescription) map[string]any {
+	out := map[string]any{"type": "string"}
+	switch desc.Type {
+	case "email":
+		out["format"] = "email"
+	case "uuid":
+		out["format"] = "uuid"
+	}
+
+	var patterns []string
+	for _, rule := range desc.Rules {
+		p := rule.Params
+		switch rule.Name {
+		case "min":
+			out["minLength"] = paramInt(p, "value")
+		case "max":
+			out["maxLength"] = paramInt(p, "value")
+		case "one_of":
+			out["enum"] = paramStrings(p, "values")
+		case "regex":
+			pattern, _ := paramString(p, "pattern")
+			patterns = append(patterns, pattern)
+		case "starts_with":
+			value, _ := paramString(p, "value")
+			patterns = append(patterns, "^"+regexp.QuoteMeta(value))
+		case "ends_with":
+			value, _ := paramString(p, "value")
+			patterns = append(patterns, regexp.QuoteMeta(value)+"$")
+		case "contains":
+			value, _ := paramString(p, "value")
+			patterns = append(patterns, regexp.QuoteMeta(value))
+		case "ip":
+			switch paramInt(p, "version") {
+			case 4:
+				out["format"] = "ipv4"
+			case 6:
+				out["format"] = "ipv6"
+			}
+		default:
+			if format, ok := stringFormats[rule.Name]; ok {
+				out["format"] = format
+			} else if pattern, ok := stringPatterns[rule.Name]; ok {
+				patterns = append(patterns, pattern)
+			}
+		}
+	}
+
+	switch len(patterns) {
+	case 0:
+	case 1:
+		out["pattern"] = patterns[0]
+	default:
+		all := make([]any, len(patterns))
+		for i, pattern := range patterns {
+			all[i] = map[string]any{"pattern": pattern}
+		}
+		out["allOf"] = all
+	}
+	return out
+}
+
+// jsonSchemaNumber, sayı tipini çevirir.
+func jsonSchemaNumber(desc *core.TypeDescription) map[string]any {
+	out := map[string]any{"type": "number"}
+	for _, rule := range desc.Rules {
+		p := rule.Params
+		switch rule.Name {
+		case "integer":
+			out["type"] = "integer"
+		case "min":
+			out["minimum"], _ = paramNumber(p, "value")
+		case "max":
+			out["maximum"], _ = paramNumber(p, "value")
+		case "between":
+			out["minimum"], _ = paramNumber(p, "min")
+			out["maximum"], _ = paramNumber(p, "max")
+		case "positive":
+			out["exclusiveMinimum"] = 0
+		case "negative":
+			out["exclusiveMaximum"] = 0
+		case "multiple_of":
+			out["multipleOf"], _ = paramNumber(p, "value")
+		}
+	}
+	return out
+}
+
+// jsonSchemaDate, tarih tipini layout'una göre "date" veya "date-time"
+// biçimli bir string olarak çevirir.
+func jsonSchemaDate(desc *core.TypeDescription) map[string]any {
+	out := map[string]any{"type": "string"}
+	if rule := desc.Rule("format"); rule != nil {
+		switch layout, _ := paramString(rule.Params, "layout"); layout {
+		case time.DateOnly:
+			out["format"] = "date"
+		case time.RFC3339, time.RFC3339Nano:
+			out["format"] = "date-time"
+		}
+	}
+	return out
+}
+
+// jsonSchemaObjectType, iç içe nesne tipini çevirir.
+func jsonSchemaObjectType(desc *core.TypeDescription) map[string]any {
+	if desc.Ref != "" && desc.Fields == nil {
+		return map[string]any{"$ref": jsonSchemaDefsPrefix + desc.Ref}
+	}
+	if len(desc.Variants) > 0 {
+		return jsonSchemaVariants(desc)
+	}
+	if desc.Fields == nil {
+		return map[string]any{"type": "object"}
+	}
+	return jsonSchemaFields(desc.Fields)
+}
+
+// jsonSchemaVariants, ayrık birleşimi ayırıcı alanı sabitlenmiş oneOf
+// dallarına çevirir.
+func jsonSchemaVariants(desc *core.TypeDescription) map[string]any {
+	var oneOf []any
+	for _, kind := range sortedKeys(desc.Variants) {
+		variant := jsonSchemaType(desc.Variants[kind])
+		properties, _ := variant["properties"].(map[string]any)
+		if properties == nil {
+			properties = map[string]any{}
+			variant["properties"] = properties
+		}
+		properties[desc.Discriminator] = map[string]any{"const": kind}
+		required, _ := variant["required"].([]string)
+		if !slices.Contains(required, desc.Discriminator) {
+			required = append(required, desc.Discriminator)
+			sort.Strings(required)
+		}
+		variant["required"] = required
+		oneOf = append(oneOf, variant)
+	}
+	return map[string]any{"oneOf": oneOf}
+}
+
+// jsonSchemaArray, dizi tipini çevirir.
+func jsonSchemaArray(desc *core.TypeDescription) map[string]any {
+	out := map[string]any{"type": "array"}
+	for _, rule := range desc.Rules {
+		p := rule.Params
+		switch rule.Name {
+		case "min":
+			out["minItems"] = paramInt(p, "value")
+		case "max":
+			out["maxItems"] = paramInt(p, "value")
+		case "not_empty":
+			if _, ok := out["minItems"]; !ok {
+				out["minItems"] = 1
+			}
+		case "unique":
+			out["uniqueItems"] = true
+		case "contains":
+			out["contains"] = map[string]any{"const": p["value"]}
+		}
+	}
+	if desc.Elements != nil {
+		out["items"] = jsonSchemaType(desc.Elements)
+	}
+	return out
+}
+
+// jsonSchemaMap, anahtar/değer tipini çevirir.
+func jsonSchemaMap(desc *core.TypeDescription) map[string]any {
+	out := map[string]any{"type": "object"}
+	for _, rule := range desc.Rules {
+		switch rule.Name {
+		case "min_keys":
+			out["minProperties"] = paramInt(rule.Params, "value")
+		case "max_keys":
+			out["maxProperties"] = paramInt(rule.Params, "value")
+		}
+	}
+	if desc.Keys != nil {
+		out["propertyNames"] = jsonSchemaType(desc.Keys)
+	}
+	if desc.Values != nil {
+		out["additionalProperties"] = jsonSchemaType(desc.Values)
+	}
+	return out
+}
+
+// jsonSchemaDefs, döngü nedeniyle açılmadan bırakılan Ref düğümlerinin
+// şemalarını $defs için üretir. Kayıtlı olmayan başvurular serbest nesne
+// olarak yazılır.
+func jsonSchemaDefs(desc *core.SchemaDescription) map[string]any {
+	defs := map[string]any{}
+	pending := refLeaves(desc, nil)
+	for len(pending) > 0 {
+		name := pending[0]
+		pending = pending[1:]
+		if _, done := defs[name]; done {
+			continue
+		}
+		schema, ok := schemaRefs.Get(name)
+		if !ok {
+			defs[name] = map[string]any{"type": "object"}
+			continue
+		}
+		sub := schema.describe([]string{name})
+		defs[name] = jsonSchemaObject(sub)
+		pending = refLeaves(sub, pending)
+	}
+	return defs
+}
+
+// refLeaves, şema tanımındaki alanları açılmamış Ref düğümlerinin adlarını
+// out'a ekler.
+func refLeaves(desc *core.SchemaDescription, out []string) []string {
+	if desc == nil {
+		return out
+	}
+	var walk func(*core.TypeDescription)
+	walk = func(t *core.TypeDescription) {
+		if t == nil {
+			return
+		}
+		if t.Ref != "" && t.Fields == nil {
+			out = append(out, t.Ref)
+		}
+		for _, name := range sortedKeys(t.Fields) {
+			walk(t.Fields[name])
+		}
+		walk(t.Elements)
+		walk(t.Keys)
+		walk(t.Values)
+		for _, name := range sortedKeys(t.Variants) {
+			walk(t.Variants[name])
+		}
+		for _, alternative := range t.Union {
+			walk(alternative)
+		}
+	}
+	for _, name := range sortedKeys(desc.Fields) {
+		walk(desc.Fields[name])
+	}
+	for _, cond := range desc.Conditionals {
+		out = refLeaves(cond.Schema, out)
+	}
+	for _, feature := range desc.Features {
+		out = refLeaves(feature.Schema, out)
+	}
+	for _, c := range desc.Compositions {
+		for _, sub := range c.Schemas {
+			out = refLeaves(sub, out)
+		}
+	}
+	return out
+}
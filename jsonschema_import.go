@@ -0,0 +1,856 @@
+package validation
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/biyonik/go-fluent-validator/core"
+)
+
+//
+// -----------------------------------------------------------------------------
+// JSON Schema İçe Aktarımı
+// -----------------------------------------------------------------------------
+// Başka bir ekipte veya araçta tutulan JSON Schema dokümanlarından şema
+// kurar. Doküman önce şema tanımına (core.SchemaDescription) çevrilir,
+// ardından FromDescription ile derlenir; böylece içe aktarılan şemalar
+// Describe, MarshalJSON ve ToJSONSchema ile diğer şemalar gibi kullanılır.
+//
+//	schema, err := validation.FromJSONSchema(doc)
+//
+// Eşlemeler ToJSONSchema'nın tersidir:
+//   - string minLength/maxLength → Min/Max, enum/const → OneOf, pattern ve
+//     allOf içindeki pattern'ler → Regex (düz metin "^TR", "\\.pdf$" desenleri
+//     StartsWith/EndsWith; alan başına tek genel desen desteklenir), format
+//     email/uri/ipv4/ipv6/json-pointer → Email/URL/IP/JSONPointer, format
+//     uuid → Uuid(), format date/date-time → Date()
+//   - integer → Number().Integer(), minimum/maximum → Min/Max,
+//     exclusiveMinimum: 0 / exclusiveMaximum: 0 → Positive/Negative,
+//     multipleOf → MultipleOf
+//   - array minItems/maxItems → Min/Max, uniqueItems → Unique, items →
+//     Elements, sabit ayırıcılı oneOf dalları → ElementsBy
+//   - object properties/required → Object().Shape ve Required
+//   - properties içermeyen object'te propertyNames → Map().Keys, şema olan
+//     additionalProperties → Values, minProperties/maxProperties →
+//     MinKeys/MaxKeys
+//   - kökteki allOf if/then dalları → When(...), anyOf required → RequireAnyOf
+//   - kökteki (veya allOf içindeki) diğer allOf dalları → AllOf(...), anyOf →
+//     AnyOf(...), oneOf → OneOf(...), not → Not(...)
+//   - title → Label, description, default, examples, deprecated, writeOnly →
+//     Sensitive
+//
+// Yerel $ref'ler ("#/$defs/..." ve "#/definitions/...") çözülür; döngüsel
+// referanslar ve dış dokümanlar desteklenmez. Tip listesindeki "null" ve
+// {"anyOf": [X, {"type": "null"}]} biçimi Nullable olarak okunur. Bilinmeyen format değerleri JSON Schema'daki gibi açıklama kabul
+// edilir; properties içeren nesnelerde additionalProperties yok sayılır (şemada olmayan alanlar zaten
+// ValidData'ya yazılmaz). Karşılığı olmayan diğer doğrulama anahtar kelimeleri
+// (alan seviyesinde not/anyOf, exclusiveMinimum: 5 vb.) sessizce gevşetilmek
+// yerine ErrUnsupportedJSONSchema ile reddedilir.
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// ErrUnsupportedJSONSchema, JSON Schema dokümanında karşılığı olmayan bir
+// yapı bulunduğunu belirtir.
+var ErrUnsupportedJSONSchema = errors.New("desteklenmeyen JSON Schema yapısı")
+
+// jsonSchemaAnnotations, doğrulamayı etkilemeyen ve her düğümde kabul edilen
+// anahtar kelimelerdir.
+var jsonSchemaAnnotations = map[string]bool{
+	"$schema": true, "$id": true, "$comment": true, "$anchor": true,
+	"$defs": true, "definitions": true, "title": true, "description": true,
+	"default": true, "examples": true, "deprecated": true, "readOnly": true,
+	"writeOnly": true, "type": true, "format": true, "contentMediaType": true,
+	"contentEncoding": true, "additionalProperties": true, "$ref": true,
+}
+
+// FromJSONSchema
+// -----------------------------------------------------------------------------
+// Bir JSON Schema dokümanından (kök tipi "object" olmalıdır) çalıştırılabilir
+// bir ValidationSchema oluşturur.
+//
+// Parametreler:
+//   - data: JSON Schema dokümanı
+//   - opts: Make ile aynı şema seçenekleri
+//
+// Dönüş:
+//   - *ValidationSchema
+//   - error: Doküman çözülemezse veya ErrUnsupportedJSONSchema sarmalayan
+//     desteklenmeyen bir yapı içeriyorsa
+func FromJSONSchema(data []byte, opts ...SchemaOption) (*ValidationSchema, error) {
+	var doc map[string]any
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("JSON Schema çözülemedi: %w", err)
+	}
+	im := &jsonSchemaImporter{root: doc}
+	desc, err := im.schema("#", doc)
+	if err != nil {
+		return nil, err
+	}
+	return FromDescription(desc, opts...)
+}
+
+// jsonSchemaImporter, $ref çözümü için kök dokümanı ve çözülmekte olan
+// referans zincirini tutar.
+type jsonSchemaImporter struct {
+	root map[string]any
+	refs []string
+}
+
+// unsupportedJSONSchema, path'teki desteklenmeyen yapı için hata üretir.
+func unsupportedJSONSchema(path, keyword string) error {
+	return fmt.Errorf("%w: %s: %q", ErrUnsupportedJSONSchema, path, keyword)
+}
+
+// checkKeywords, node'da açıklamalar ve allowed dışında anahtar kelime
+// bulunmadığını denetler.
+func checkKeywords(path string, node map[string]any, allowed ...string) error {
+	keys := make([]string, 0, len(node))
+	for key := range node {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if !jsonSchemaAnnotations[key] && !slices.Contains(allowed, key) {
+			return unsupportedJSONSchema(path, key)
+		}
+	}
+	return nil
+}
+
+// deref, node bir $ref ise hedef düğümü döndürür. Döngüsel referanslarda
+// hata döner; dönen release fonksiyonu referans zincirinden çıkar.
+func (im *jsonSchemaImporter) deref(path string, node map[string]any) (map[string]any, func(), error) {
+	ref, ok := node["$ref"].(string)
+	if !ok {
+		return node, func() {}, nil
+	}
+	if slices.Contains(im.refs, ref) {
+		return nil, nil, unsupportedJSONSchema(path, "döngüsel $ref "+ref)
+	}
+	fragment, local := strings.CutPrefix(ref, "#")
+	if !local {
+		return nil, nil, unsupportedJSONSchema(path, "dış $ref "+ref)
+	}
+
+	var target any = im.root
+	for _, token := range strings.Split(strings.TrimPrefix(fragment, "/"), "/") {
+		if token == "" {
+			continue
+		}
+		token = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+		m, _ := target.(map[string]any)
+		if target = m[token]; target == nil {
+			return nil, nil, fmt.Errorf("%s: $ref çözülemedi: %s", path, ref)
+		}
+	}
+	resolved, ok := target.(map[string]any)
+	if !ok {
+		return nil, nil, fmt.Errorf("%s: $ref bir şemayı göstermiyor: %s", path, ref)
+	}
+
+	im.refs = append(im.refs, ref)
+	release := func() { im.refs = im.refs[:len(im.refs)-1] }
+	if len(node) == 1 {
+		return resolved, release, nil
+	}
+	merged := make(map[string]any, len(resolved)+len(node))
+	for k, v := range resolved {
+		merged[k] = v
+	}
+	for k, v := range node {
+		if k != "$ref" {
+			merged[k] = v
+		}
+	}
+	return merged, release, nil
+}
+
+// schema, kök (veya koşullu dal) düğümünü şema tanımına çevirir.
+func (im *jsonSchemaImporter) schema(path string, node map[string]any) (*core.SchemaDescription, error) {
+	node, release, err := im.deref(path, node)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	if typ, _ := jsonSchemaTypeName(node); typ != "" && typ != "object" {
+		return nil, fmt.Errorf("%s: kök şema object olmalıdır, %q alındı", path, typ)
+	}
+	if err := checkKeywords(path, node, "properties", "required", "allOf", "anyOf", "oneOf", "not"); err != nil {
+		return nil, err
+	}
+
+	fields, err := im.fields(path, node)
+	if err != nil {
+		return nil, err
+	}
+	desc := &core.SchemaDescription{Fields: fields}
+
+	var all core.CompositionDescription
+	allOf, _ := node["allOf"].([]any)
+	for i, item := range allOf {
+		branch, _ := item.(map[string]any)
+		branchPath := fmt.Sprintf("%s/allOf/%d", path, i)
+		switch {
+		case branch["if"] != nil:
+			cond, err := im.conditional(branchPath, branch)
+			if err != nil {
+				return nil, err
+			}
+			desc.Conditionals = append(desc.Conditionals, cond)
+		case isRequireAnyOf(branch):
+			rule, err := requireAnyOfRule(branchPath, branch)
+			if err != nil {
+				return nil, err
+			}
+			desc.Rules = append(desc.Rules, rule)
+		case len(branch) == 1 && (branch["anyOf"] != nil || branch["oneOf"] != nil || branch["not"] != nil):
+			c, err := im.composition(branchPath, branch)
+			if err != nil {
+				return nil, err
+			}
+			desc.Compositions = append(desc.Compositions, c)
+		default:
+			sub, err := im.schema(branchPath, branch)
+			if err != nil {
+				return nil, err
+			}
+			all.Kind = compositionAllOf
+			all.Schemas = append(all.Schemas, sub)
+		}
+	}
+	if all.Kind != "" {
+		desc.Compositions = append(desc.Compositions, all)
+	}
+	for _, keyword := range []string{"anyOf", "oneOf", "not"} {
+		value, ok := node[keyword]
+		if !ok {
+			continue
+		}
+		branch := map[string]any{keyword: value}
+		if isRequireAnyOf(branch) {
+			rule, err := requireAnyOfRule(path, branch)
+			if err != nil {
+				return nil, err
+			}
+			desc.Rules = append(desc.Rules, rule)
+			continue
+		}
+		c, err := im.composition(path, branch)
+		if err != nil {
+			return nil, err
+		}
+		desc.Compositions = append(desc.Compositions, c)
+	}
+	return desc, nil
+}
+
+// composition, tek anahtarlı {"anyOf": [...]}, {"oneOf": [...]} veya
+// {"not": {...}} dalını AnyOf/OneOf/Not birleşimine çevirir.
+func (im *jsonSchemaImporter) composition(path string, branch map[string]any) (core.CompositionDescription, error) {
+	if not, ok := branch["not"].(map[string]any); ok {
+		sub, err := im.schema(path+"/not", not)
+		if err != nil {
+			return core.CompositionDescription{}, err
+		}
+		return core.CompositionDescription{Kind: compositionNot, Schemas: []*core.SchemaDescription{sub}}, nil
+	}
+
+	keyword, kind := "anyOf", compositionAnyOf
+	if _, ok := branch["oneOf"]; ok {
+		keyword, kind = "oneOf", compositionOneOf
+	}
+	options, _ := branch[keyword].([]any)
+	if len(options) == 0 {
+		return core.CompositionDescription{}, unsupportedJSONSchema(path, keyword)
+	}
+	c := core.CompositionDescription{Kind: kind}
+	for i, item := range options {
+		option, _ := item.(map[string]any)
+		sub, err := im.schema(fmt.Sprintf("%s/%s/%d", path, keyword, i), option)
+		if err != nil {
+			return c, err
+		}
+		c.Schemas = append(c.Schemas, sub)
+	}
+	return c, nil
+}
+
+// isRequireAnyOf, dalın yalnızca tek alanlı required seçeneklerinden oluşan
+// bir anyOf (RequireAnyOf) olup olmadığını döndürür.
+func isRequireAnyOf(branch map[string]any) bool {
+	anyOf, _ := branch["anyOf"].([]any)
+	if len(branch) != 1 || len(anyOf) == 0 {
+		return false
+	}
+	for _, item := range anyOf {
+		option, _ := item.(map[string]any)
+		if len(option) != 1 || len(paramStrings(option, "required")) != 1 {
+			return false
+		}
+	}
+	return true
+}
+
+// conditional, {"if": {"properties": {f: {"const": v}}}, "then": {...}}
+// dalını When(f, v, ...) tanımına çevirir.
+func (im *jsonSchemaImporter) conditional(path string, branch map[string]any) (core.ConditionalDescription, error) {
+	var cond core.ConditionalDescription
+	if err := checkKeywords(path, branch, "if", "then"); err != nil {
+		return cond, err
+	}
+	ifNode, _ := branch["if"].(map[string]any)
+	properties, _ := ifNode["properties"].(map[string]any)
+	if len(properties) != 1 {
+		return cond, unsupportedJSONSchema(path, "if")
+	}
+	for field, value := range properties {
+		constNode, _ := value.(map[string]any)
+		equals, ok := constNode["const"]
+		if !ok || len(constNode) != 1 {
+			return cond, unsupportedJSONSchema(path+"/if", field)
+		}
+		cond.Field = field
+		cond.Equals = equals
+	}
+
+	then, _ := branch["then"].(map[string]any)
+	sub, err := im.schema(path+"/then", then)
+	if err != nil {
+		return cond, err
+	}
+	cond.Schema = sub
+	return cond, nil
+}
+
+// requireAnyOfRule, {"anyOf": [{"required": ["a"]}, ...]} dalını
+// require_any_of kuralına çevirir.
+func requireAnyOfRule(path string, branch map[string]any) (core.RuleDescription, error) {
+	if err := checkKeywords(path, branch, "anyOf"); err != nil {
+		return core.RuleDescription{}, err
+	}
+	anyOf, _ := branch["anyOf"].([]any)
+	fields := make([]any, 0, len(anyOf))
+	for _, item := range anyOf {
+		option, _ := item.(map[string]any)
+		required := paramStrings(option, "required")
+		if len(option) != 1 || len(required) != 1 {
+			return core.RuleDescription{}, unsupportedJSONSchema(path, "anyOf")
+		}
+		fields = append(fields, required[0])
+	}
+	return core.RuleDescription{Name: "require_any_of", Params: map[string]any{"fields": fields}}, nil
+}
+
+// fields, properties/required anahtar kelimelerinden alan tanımlarını üretir.
+func (im *jsonSchemaImporter) fields(path string, node map[string]any) (map[string]*core.TypeDescription, error) {
+	properties, _ := node["properties"].(map[string]any)
+	fields := make(map[string]*core.TypeDescription, len(properties))
+	for name, value := range properties {
+		child, ok := value.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("%s/properties/%s: şema nesnesi bekleniyordu", path, name)
+		}
+		desc, err := im.typeDesc(path+"/properties/"+name, child)
+		if err != nil {
+			return nil, err
+		}
+		fields[name] = desc
+	}
+	for _, name := range paramStrings(node, "required") {
+		field, ok := fields[name]
+		if !ok {
+			return nil, fmt.Errorf("%s: zorunlu alan %q properties içinde tanımlı değil", path, name)
+		}
+		field.Required = true
+	}
+	return fields, nil
+}
+
+// jsonSchemaTypeName, "type" anahtar kelimesini okur; ["string", "null"]
+// gibi listelerde "null" atlanır. Tip yoksa properties/items/enum
+// değerlerinden çıkarılır.
+func jsonSchemaTypeName(node map[string]any) (string, error) {
+	var names []string
+	switch t := node["type"].(type) {
+	case string:
+		names = []string{t}
+	case []any:
+		for _, item := range t {
+			if s, ok := item.(string); ok && s != "null" {
+				names = append(names, s)
+			}
+		}
+	}
+	switch len(names) {
+	case 0:
+	case 1:
+		return names[0], nil
+	default:
+		return "", fmt.Errorf("birden fazla tip: %s", strings.Join(names, ", "))
+	}
+
+	switch {
+	case node["properties"] != nil:
+		return "object", nil
+	case node["items"] != nil:
+		return "array", nil
+	}
+	values, _ := node["enum"].([]any)
+	if c, ok := node["const"]; ok {
+		values = []any{c}
+	}
+	if len(values) > 0 {
+		if _, ok := values[0].(string); ok {
+			return "string", nil
+		}
+	}
+	return "", nil
+}
+
+// typeDesc, tek bir alan düğümünü tip tanımına çevirir.
+func (im *jsonSchemaImporter) typeDesc(path string, node map[string]any) (*core.TypeDescription, error) {
+	node, release, err := im.deref(path, node)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	if inner, ok := jsonSchemaNullableOf(node); ok {
+		desc, err := im.typeDesc(path, inner)
+		if err != nil {
+			return nil, err
+		}
+		desc.Nullable = true
+		return desc, nil
+	}
+
+	typ, err := jsonSchemaTypeName(node)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s: %v", ErrUnsupportedJSONSchema, path, err)
+	}
+
+	var desc *core.TypeDescription
+	switch typ {
+	case "string":
+		desc, err = importJSONSchemaString(path, node)
+	case "integer", "number":
+		desc, err = importJSONSchemaNumber(path, node, typ == "integer")
+	case "boolean":
+		desc, err = &core.TypeDescription{Type: "boolean"}, checkKeywords(path, node)
+	case "object":
+		if isJSONSchemaMap(node) {
+			desc, err = im.mapType(path, node)
+		} else if err = checkKeywords(path, node, "properties", "required"); err == nil {
+			desc = &core.TypeDescription{Type: "object"}
+			desc.Fields, err = im.fields(path, node)
+		}
+	case "array":
+		desc, err = im.array(path, node)
+	case "":
+		return nil, fmt.Errorf("%s: tip belirtilmemiş", path)
+	default:
+		return nil, unsupportedJSONSchema(path, "type "+typ)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	applyJSONSchemaMeta(desc, node)
+	desc.Nullable = jsonSchemaHasNull(node)
+	return desc, nil
+}
+
+// jsonSchemaHasNull, "type" listesinin "null" içerip içermediğini döndürür.
+func jsonSchemaHasNull(node map[string]any) bool {
+	types, _ := node["type"].([]any)
+	for _, item := range types {
+		if item == "null" {
+			return true
+		}
+	}
+	return false
+}
+
+// jsonSchemaNullableOf, {"anyOf": [X, {"type": "null"}]} biçimindeki düğümün
+// null olmayan dalını, dış düğümdeki açıklama anahtar kelimeleriyle
+// birleştirerek döndürür.
+func jsonSchemaNullableOf(node map[string]any) (map[string]any, bool) {
+	anyOf, _ := node["anyOf"].([]any)
+	if len(anyOf) != 2 {
+		return nil, false
+	}
+	var inner map[string]any
+	nulls := 0
+	for _, item := range anyOf {
+		branch, _ := item.(map[string]any)
+		if len(branch) == 1 && branch["type"] == "null" {
+			nulls++
+		} else {
+			inner = branch
+		}
+	}
+	if nulls != 1 || inner == nil {
+		return nil, false
+	}
+	merged := maps.Clone(inner)
+	for key, value := range node {
+		if key != "anyOf" {
+			merged[key] = value
+		}
+	}
+	return merged, true
+}
+
+// applyJSONSchemaMeta, açıklama anahtar kelimelerini tip tanımına yazar.
+func applyJSONSchemaMeta(desc *core.TypeDescription, node map[string]any) {
+	if title, ok := paramString(node, "title"); ok {
+		desc.Label = title
+	}
+	if description, ok := paramString(node, "description"); ok {
+		desc.Description = description
+	}
+	if value, ok := node["default"]; ok {
+		desc.Default = value
+	}
+	if examples, ok := node["examples"].([]any); ok {
+		desc.Examples = examples
+	}
+	if deprecated, _ := paramBool(node, "deprecated"); deprecated {
+		desc.Deprecated = true
+	}
+	if writeOnly, _ := paramBool(node, "writeOnly"); writeOnly {
+		desc.Sensitive = true
+	}
+}
+
+// jsonSchemaEnum, enum veya const değerlerini string listesi olarak okur.
+func jsonSchemaEnum(path string, node map[string]any) ([]any, error) {
+	values, ok := node["enum"].([]any)
+	if c, isConst := node["const"]; isConst {
+		values, ok = []any{c}, true
+	}
+	if !ok {
+		return nil, nil
+	}
+	for _, value := range values {
+		if _, isString := value.(string); !isString {
+			return nil, unsupportedJSONSchema(path, "string olmayan enum değeri")
+		}
+	}
+	return values, nil
+}
+
+// importJSONSchemaString, string düğümünü string, uuid veya date tanımına
+// çevirir.
+func importJSONSchemaString(path string, node map[string]any) (*core.TypeDescription, error) {
+	if err := checkKeywords(path, node, "minLength", "maxLength", "pattern", "allOf", "enum", "const"); err != nil {
+		return nil, err
+	}
+
+	format, _ := paramString(node, "format")
+	switch format {
+	case "uuid":
+		return &core.TypeDescription{Type: "uuid"}, checkKeywords(path, node)
+	case "date", "date-time":
+		layout := time.DateOnly
+		if format == "date-time" {
+			layout = time.RFC3339
+		}
+		desc := &core.TypeDescription{Type: "date"}
+		desc.AddRule("format", map[string]any{"layout": layout})
+		return desc, checkKeywords(path, node)
+	}
+
+	desc := &core.TypeDescription{Type: "string"}
+	if v, ok := paramNumber(node, "minLength"); ok {
+		desc.AddRule("min", map[string]any{"value": int(v)})
+	}
+	if v, ok := paramNumber(node, "maxLength"); ok {
+		desc.AddRule("max", map[string]any{"value": int(v)})
+	}
+	switch format {
+	case "ipv4":
+		desc.AddRule("ip", map[string]any{"version": 4})
+	case "ipv6":
+		desc.AddRule("ip", map[string]any{"version": 6})
+	default:
+		for rule, f := range stringFormats {
+			if f == format {
+				desc.AddRule(rule, nil)
+			}
+		}
+	}
+	if pattern, ok := paramString(node, "pattern"); ok {
+		desc.AddRule(patternRule(pattern))
+	}
+	allOf, _ := node["allOf"].([]any)
+	for _, item := range allOf {
+		branch, _ := item.(map[string]any)
+		pattern, ok := paramString(branch, "pattern")
+		if !ok || len(branch) != 1 {
+			return nil, unsupportedJSONSchema(path, "allOf")
+		}
+		desc.AddRule(patternRule(pattern))
+	}
+
+	regexes := 0
+	for _, rule := range desc.Rules {
+		if rule.Name == "regex" {
+			regexes++
+		}
+	}
+	if regexes > 1 {
+		return nil, unsupportedJSONSchema(path, "birden fazla pattern")
+	}
+
+	values, err := jsonSchemaEnum(path, node)
+	if err != nil {
+		return nil, err
+	}
+	if values != nil {
+		desc.AddRule("one_of", map[string]any{"values": values})
+	}
+	return desc, nil
+}
+
+// patternRule, pattern değerini ToJSONSchema'nın ürettiği karşılığı olan
+// kurala (alpha, hex, starts_with...) veya regex kuralına çevirir.
+func patternRule(pattern string) (string, map[string]any) {
+	for rule, p := range stringPatterns {
+		if p == pattern {
+			return rule, nil
+		}
+	}
+
+	body, anchored := strings.CutPrefix(pattern, "^")
+	body, terminated := strings.CutSuffix(body, "$")
+	if literal, ok := regexLiteral(body); ok && !(anchored && terminated) {
+		switch {
+		case anchored:
+			return "starts_with", map[string]any{"value": literal}
+		case terminated:
+			return "ends_with", map[string]any{"value": literal}
+		default:
+			return "contains", map[string]any{"value": literal}
+		}
+	}
+	return "regex", map[string]any{"pattern": pattern}
+}
+
+// regexLiteral, regexp.QuoteMeta ile kaçışlanmış bir deseni düz metne
+// çevirir. Desen özel karakter içeriyorsa false döner.
+func regexLiteral(pattern string) (string, bool) {
+	var b strings.Builder
+	for i := 0; i < len(pattern); i++ {
+		c := pattern[i]
+		if c == '\\' && i+1 < len(pattern) {
+			i++
+			c = pattern[i]
+		}
+		b.WriteByte(c)
+	}
+	literal := b.String()
+	return literal, literal != "" && regexp.QuoteMeta(literal) == pattern
+}
+
+// importJSONSchemaNumber, integer/number düğümünü sayı tanımına çevirir.
+func importJSONSchemaNumber(path string, node map[string]any, integer bool) (*core.TypeDescription, error) {
+	if err := checkKeywords(path, node, "minimum", "maximum", "exclusiveMinimum", "exclusiveMaximum", "multipleOf"); err != nil {
+		return nil, err
+	}
+
+	desc := &core.TypeDescription{Type: "number"}
+	if integer {
+		desc.AddRule("integer", nil)
+	}
+	if v, ok := paramNumber(node, "minimum"); ok {
+		desc.AddRule("min", map[string]any{"value": v})
+	}
+	if v, ok := paramNumber(node, "maximum"); ok {
+		desc.AddRule("max", map[string]any{"value": v})
+	}
+	if v, ok := paramNumber(node, "exclusiveMinimum"); ok {
+		if v != 0 {
+			return nil, unsupportedJSONSchema(path, "exclusiveMinimum")
+		}
+		desc.AddRule("positive", nil)
+	}
+	if v, ok := paramNumber(node, "exclusiveMaximum"); ok {
+		if v != 0 {
+			return nil, unsupportedJSONSchema(path, "exclusiveMaximum")
+		}
+		desc.AddRule("negative", nil)
+	}
+	if v, ok := paramNumber(node, "multipleOf"); ok {
+		desc.AddRule("multiple_of", map[string]any{"value": v})
+	}
+	return desc, nil
+}
+
+// array, dizi düğümünü eleman ve varyant tanımlarıyla çevirir.
+func (im *jsonSchemaImporter) array(path string, node map[string]any) (*core.TypeDescription, error) {
+	if err := checkKeywords(path, node, "minItems", "maxItems", "uniqueItems", "items", "contains"); err != nil {
+		return nil, err
+	}
+
+	desc := &core.TypeDescription{Type: "array"}
+	if v, ok := paramNumber(node, "minItems"); ok {
+		desc.AddRule("min", map[string]any{"value": int(v)})
+	}
+	if v, ok := paramNumber(node, "maxItems"); ok {
+		desc.AddRule("max", map[string]any{"value": int(v)})
+	}
+	if unique, _ := paramBool(node, "uniqueItems"); unique {
+		desc.AddRule("unique", nil)
+	}
+	if contains, ok := node["contains"].(map[string]any); ok {
+		value, isConst := contains["const"]
+		if !isConst || len(contains) != 1 {
+			return nil, unsupportedJSONSchema(path, "contains")
+		}
+		desc.AddRule("contains", map[string]any{"value": value})
+	}
+
+	items, ok := node["items"].(map[string]any)
+	if !ok {
+		return desc, nil
+	}
+	var err error
+	if _, variants := items["oneOf"]; variants {
+		desc.Elements, err = im.variants(path+"/items", items)
+	} else {
+		desc.Elements, err = im.typeDesc(path+"/items", items)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return desc, nil
+}
+
+// isJSONSchemaMap, properties içermeyen ve anahtar/değer kısıtı taşıyan
+// object düğümlerini ayırt eder.
+func isJSONSchemaMap(node map[string]any) bool {
+	if _, ok := node["properties"]; ok {
+		return false
+	}
+	_, values := node["additionalProperties"].(map[string]any)
+	_, keys := node["propertyNames"]
+	_, minKeys := node["minProperties"]
+	_, maxKeys := node["maxProperties"]
+	return values || keys || minKeys || maxKeys
+}
+
+// mapType, anahtar/değer kısıtlı object düğümünü Map tanımına çevirir.
+func (im *jsonSchemaImporter) mapType(path string, node map[string]any) (*core.TypeDescription, error) {
+	if err := checkKeywords(path, node, "propertyNames", "minProperties", "maxProperties"); err != nil {
+		return nil, err
+	}
+
+	desc := &core.TypeDescription{Type: "map"}
+	if v, ok := paramNumber(node, "minProperties"); ok {
+		desc.AddRule("min_keys", map[string]any{"value": int(v)})
+	}
+	if v, ok := paramNumber(node, "maxProperties"); ok {
+		desc.AddRule("max_keys", map[string]any{"value": int(v)})
+	}
+	var err error
+	if keys, ok := node["propertyNames"].(map[string]any); ok {
+		// Anahtarlar her zaman string olduğundan propertyNames'te tip
+		// çoğunlukla yazılmaz.
+		if _, typed := keys["type"]; !typed {
+			keys = maps.Clone(keys)
+			keys["type"] = "string"
+		}
+		if desc.Keys, err = im.typeDesc(path+"/propertyNames", keys); err != nil {
+			return nil, err
+		}
+	}
+	if values, ok := node["additionalProperties"].(map[string]any); ok {
+		if desc.Values, err = im.typeDesc(path+"/additionalProperties", values); err != nil {
+			return nil, err
+		}
+	}
+	return desc, nil
+}
+
+// variants, her dalında aynı alanı const ile sabitleyen oneOf düğümünü
+// ElementsBy tanımına çevirir.
+func (im *jsonSchemaImporter) variants(path string, node map[string]any) (*core.TypeDescription, error) {
+	if err := checkKeywords(path, node, "oneOf"); err != nil {
+		return nil, err
+	}
+	oneOf, _ := node["oneOf"].([]any)
+
+	discriminator := ""
+	kinds := make([]string, len(oneOf))
+	branches := make([]map[string]any, len(oneOf))
+	for i, item := range oneOf {
+		branch, _ := item.(map[string]any)
+		branch, release, err := im.deref(fmt.Sprintf("%s/oneOf/%d", path, i), branch)
+		if err != nil {
+			return nil, err
+		}
+		release()
+		branches[i] = branch
+
+		properties, _ := branch["properties"].(map[string]any)
+		for name, value := range properties {
+			prop, _ := value.(map[string]any)
+			kind, ok := prop["const"].(string)
+			if !ok || (discriminator != "" && name != discriminator) {
+				continue
+			}
+			discriminator, kinds[i] = name, kind
+		}
+		if kinds[i] == "" {
+			return nil, unsupportedJSONSchema(path, "ayırıcı alanı olmayan oneOf")
+		}
+	}
+
+	desc := &core.TypeDescription{
+		Type:          "object",
+		Discriminator: discriminator,
+		Variants:      make(map[string]*core.TypeDescription, len(branches)),
+	}
+	for i, branch := range branches {
+		properties, _ := branch["properties"].(map[string]any)
+		trimmed := make(map[string]any, len(branch))
+		for k, v := range branch {
+			trimmed[k] = v
+		}
+		rest := make(map[string]any, len(properties))
+		for name, value := range properties {
+			if name != discriminator {
+				rest[name] = value
+			}
+		}
+		trimmed["properties"] = rest
+		var required []any
+		for _, name := range paramStrings(branch, "required") {
+			if name != discriminator {
+				required = append(required, name)
+			}
+		}
+		trimmed["required"] = required
+
+		variant, err := im.typeDesc(fmt.Sprintf("%s/oneOf/%d", path, i), trimmed)
+		if err != nil {
+			return nil, err
+		}
+		desc.Variants[kinds[i]] = variant
+	}
+	return desc, nil
+}
@@ -0,0 +1,122 @@
+package validation
+
+import (
+	"reflect"
+	"slices"
+	"sync"
+
+	"github.com/biyonik/go-fluent-validator/core"
+	"github.com/biyonik/go-fluent-validator/types"
+)
+
+//
+// -----------------------------------------------------------------------------
+// Tembel (Lazy) ve Özyinelemeli Tipler
+// -----------------------------------------------------------------------------
+// Kendine başvuran yapıların (yorum ağaçları, kategori hiyerarşileri, iç içe
+// menüler) tipi, oluşturulurken kendisini içeremeyeceği için Lazy ile
+// ertelenir. fn ilk Transform/Validate çağrısında bir kez çalıştırılır ve
+// sonucu saklanır:
+//
+//	var comment validation.Type
+//	comment = validation.Object().Shape(map[string]validation.Type{
+//	    "body":    validation.String().Required(),
+//	    "replies": validation.Array().Elements(validation.Lazy(func() core.Type {
+//	        return comment
+//	    })),
+//	})
+//
+// Veri sonlu olduğundan doğrulama her zaman sonlanır; iç içe hatalar
+// "replies[0].replies[1].body" gibi yollarla raporlanır. Describe, tembel
+// tipi yerinde açar; aynı fonksiyona ikinci kez ulaşıldığında döngü algılanır
+// ve o düğüm "lazy" tipinde (alanları olmadan) bırakılır. Bu düğümler JSON
+// Schema'da serbest bir şema ({}) olarak yazılır ve anlık görüntüden geri
+// yüklenemez.
+//
+// İsimle paylaşılan alt şemalar için Register/Ref kullanılır.
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// Lazy
+// -----------------------------------------------------------------------------
+// Tipi ilk kullanıldığında fn ile oluşturan bir alan tipi döndürür.
+//
+// Örnek:
+//
+//	"children": validation.Array().Elements(validation.Lazy(func() core.Type {
+//	    return category
+//	}))
+func Lazy(fn func() core.Type) *LazyType {
+	return &LazyType{build: fn}
+}
+
+// LazyType, asıl tipi ilk kullanımda oluşturan alan tipidir.
+type LazyType struct {
+	build func() core.Type
+	once  sync.Once
+	typ   core.Type
+}
+
+// Type, ertelenmiş tipi (gerekirse oluşturarak) döndürür.
+func (l *LazyType) Type() core.Type {
+	l.once.Do(func() { l.typ = l.build() })
+	return l.typ
+}
+
+// Transform, değeri ertelenmiş tiple dönüştürür.
+func (l *LazyType) Transform(value any) (any, error) {
+	return l.Type().Transform(value)
+}
+
+// Validate, değeri ertelenmiş tiple doğrular.
+func (l *LazyType) Validate(field string, value any, result *core.ValidationResult) {
+	l.Type().Validate(field, value, result)
+}
+
+// Introspect, tembel tipi açmadan tanımlar; açma işlemi Describe sırasında
+// döngü denetimiyle yapılır (bkz. expandLazy).
+func (l *LazyType) Introspect() *core.TypeDescription {
+	return &core.TypeDescription{Type: "lazy"}
+}
+
+// expandLazy, typ ve onun tanımı olan desc'i birlikte dolaşarak "lazy"
+// düğümlerini ertelenmiş tiplerin tanımlarıyla değiştirir. seen, açılmakta
+// olan tembel tiplerin fonksiyonlarıdır; aynı fonksiyona yeniden ulaşılırsa
+// düğüm olduğu gibi bırakılır.
+func expandLazy(typ core.Type, desc *core.TypeDescription, seen []uintptr) {
+	if typ == nil || desc == nil {
+		return
+	}
+	switch t := typ.(type) {
+	case *LazyType:
+		fn := reflect.ValueOf(t.build).Pointer()
+		if slices.Contains(seen, fn) {
+			return
+		}
+		inner := t.Type()
+		if expanded := core.DescribeType(inner); expanded != nil {
+			*desc = *expanded
+		}
+		expandLazy(inner, desc, append(seen, fn))
+	case *types.ObjectType:
+		for name, field := range t.GetShape() {
+			expandLazy(field, desc.Fields[name], seen)
+		}
+	case *types.ArrayType:
+		expandLazy(t.GetElementSchema(), desc.Elements, seen)
+	case *types.MapType:
+		expandLazy(t.GetKeySchema(), desc.Keys, seen)
+		expandLazy(t.GetValueSchema(), desc.Values, seen)
+	case *UnionType:
+		for i, alternative := range t.Types() {
+			if i < len(desc.Union) {
+				expandLazy(alternative, desc.Union[i], seen)
+			}
+		}
+	}
+}
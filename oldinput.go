@@ -0,0 +1,91 @@
+package validation
+
+import "github.com/biyonik/go-fluent-validator/core"
+
+//
+// -----------------------------------------------------------------------------
+// Form Yeniden Doldurma (Old Input)
+// -----------------------------------------------------------------------------
+// Sunucu tarafında render edilen HTML uygulamalarında, başarısız bir form
+// gönderiminden sonra formun kullanıcının girdiği değerlerle yeniden
+// doldurulması gerekir (Laravel'deki old() yardımcısı). Şema, doğrulama
+// başarısız olduğunda ham girdiyi result.OldInput() altında saklar.
+//
+// Hassas alanlar eski girdiye eklenmez:
+//   - Sensitive() veya Password() ile işaretlenen string alanlar,
+//   - CreditCard() alanları,
+//   - WithSensitiveFields ile bildirilen alanlar,
+//   - DefaultSensitiveFields içindeki alan adları.
+//
+// İç içe nesnelerde (Object().Shape(...)) alt alanların hassasiyeti de dikkate
+// alınır.
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// DefaultSensitiveFields, tipinden bağımsız olarak her zaman hassas kabul
+// edilen alan adlarıdır.
+var DefaultSensitiveFields = []string{"password", "password_confirmation", "current_password"}
+
+// WithSensitiveFields
+// -----------------------------------------------------------------------------
+// Verilen üst seviye alanları hassas olarak işaretler; değerleri
+// result.OldInput() çıktısına eklenmez.
+//
+// Örnek:
+//
+//	schema := validation.Make(validation.WithSensitiveFields("pin", "security_answer"))
+func WithSensitiveFields(fields ...string) SchemaOption {
+	return func(vs *ValidationSchema) {
+		if vs.sensitiveFields == nil {
+			vs.sensitiveFields = make(map[string]bool)
+		}
+		for _, field := range fields {
+			vs.sensitiveFields[field] = true
+		}
+	}
+}
+
+// oldInput, ham girdinin hassas alanları çıkarılmış kopyasını döndürür.
+func (vs *ValidationSchema) oldInput(data map[string]any) map[string]any {
+	return maskSensitive(data, vs.shape, vs.sensitiveFields)
+}
+
+// maskSensitive, shape'e göre hassas alanları çıkararak data'yı kopyalar ve
+// iç içe nesnelere iner.
+func maskSensitive(data map[string]any, shape map[string]core.Type, extra map[string]bool) map[string]any {
+	out := make(map[string]any, len(data))
+	for field, value := range data {
+		if extra[field] || isDefaultSensitive(field) {
+			continue
+		}
+		typ := shape[field]
+		if s, ok := typ.(core.SensitiveType); ok && s.IsSensitive() {
+			continue
+		}
+		if nested, ok := value.(map[string]any); ok {
+			var nestedShape map[string]core.Type
+			if provider, ok := typ.(core.ShapeProvider); ok {
+				nestedShape = provider.GetShape()
+			}
+			value = maskSensitive(nested, nestedShape, nil)
+		}
+		out[field] = value
+	}
+	return out
+}
+
+// isDefaultSensitive, alan adının DefaultSensitiveFields içinde olup
+// olmadığını döndürür.
+func isDefaultSensitive(field string) bool {
+	for _, name := range DefaultSensitiveFields {
+		if name == field {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,218 @@
+package validation
+
+import (
+	"bytes"
+	"encoding/json"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+//
+// -----------------------------------------------------------------------------
+// OpenAPI 3.1 Bileşen Üretimi
+// -----------------------------------------------------------------------------
+// Kayıt defterindeki şemaları OpenAPI 3.1 "components.schemas" bölümüne
+// çevirir; böylece istek doğrulaması ve API dokümanı aynı kaynaktan üretilir
+// ve birbirinden kopamaz:
+//
+//	out, _ := registry.OpenAPIYAML()
+//	os.WriteFile("openapi.components.yaml", out, 0o644)
+//
+// OpenAPI 3.1, JSON Schema draft 2020-12 ile uyumlu olduğu için her şema
+// ToJSONSchema ile aynı eşlemelerle üretilir ($schema anahtarı hariç).
+// Describe() ile açıklaması verilmemiş alanlarda description, Label'dan
+// türetilir. Çıktı deterministiktir: şemalar ve anahtarlar sıralıdır.
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// OpenAPIComponents
+// -----------------------------------------------------------------------------
+// Kayıtlı şemaları {"schemas": {ad: şema}} biçiminde OpenAPI 3.1 components
+// nesnesi olarak döndürür. Sonuç bir OpenAPI dokümanının "components"
+// anahtarına doğrudan yerleştirilebilir.
+func (r *Registry) OpenAPIComponents() map[string]any {
+	current := *r.schemas.Load()
+	schemas := make(map[string]any, len(current))
+	defs := map[string]any{}
+	for name, schema := range current {
+		desc := schema.Describe()
+		doc := jsonSchemaObject(desc)
+		openAPIDescriptions(doc)
+		schemas[name] = doc
+		for ref, def := range jsonSchemaDefs(desc) {
+			openAPIDescriptions(def)
+			defs[ref] = def
+		}
+	}
+	// Döngüsel Ref hedefleri, aynı adda bir şema yoksa components'a eklenir.
+	for ref, def := range defs {
+		if _, exists := schemas[ref]; !exists {
+			schemas[ref] = def
+		}
+	}
+	openAPIRefs(schemas)
+	return map[string]any{"schemas": schemas}
+}
+
+// OpenAPIJSON, kayıtlı şemaları {"components": {"schemas": ...}} biçiminde
+// girintili JSON olarak üretir.
+func (r *Registry) OpenAPIJSON() ([]byte, error) {
+	return json.MarshalIndent(map[string]any{"components": r.OpenAPIComponents()}, "", "  ")
+}
+
+// OpenAPIYAML, kayıtlı şemaları components.schemas YAML belgesi olarak
+// üretir. Harici bağımlılık gerektirmeyen, blok stilinde bir yazıcı kullanılır.
+func (r *Registry) OpenAPIYAML() ([]byte, error) {
+	raw, err := json.Marshal(map[string]any{"components": r.OpenAPIComponents()})
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	var doc any
+	if err := dec.Decode(&doc); err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	writeYAML(&buf, doc, 0)
+	return buf.Bytes(), nil
+}
+
+// openAPIDescriptions, title'ı olup description'ı olmayan alt şemalara
+// title'dan türetilen description ekler.
+func openAPIDescriptions(node any) {
+	switch v := node.(type) {
+	case map[string]any:
+		if title, ok := v["title"].(string); ok {
+			if _, described := v["description"]; !described {
+				v["description"] = title
+			}
+		}
+		for key, child := range v {
+			if key != "default" && key != "examples" && key != "const" && key != "enum" {
+				openAPIDescriptions(child)
+			}
+		}
+	case []any:
+		for _, child := range v {
+			openAPIDescriptions(child)
+		}
+	}
+}
+
+// yamlPlain, tırnaksız yazılabilecek YAML skalerleridir.
+var yamlPlain = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$ ./-]*$`)
+
+// yamlReserved, tırnaksız yazıldığında string olarak okunmayan değerlerdir.
+var yamlReserved = map[string]bool{
+	"true": true, "false": true, "null": true, "yes": true, "no": true,
+	"on": true, "off": true, "y": true, "n": true, "~": true,
+}
+
+// yamlString, s'yi gerekirse JSON uyumlu çift tırnaklı biçimde yazar.
+func yamlString(s string) string {
+	if yamlPlain.MatchString(s) && !yamlReserved[strings.ToLower(s)] && !strings.HasSuffix(s, " ") {
+		return s
+	}
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	_ = enc.Encode(s)
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+// yamlScalar, skaler bir değeri YAML olarak döndürür.
+func yamlScalar(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return "null"
+	case string:
+		return yamlString(v)
+	case bool:
+		if v {
+			return "true"
+		}
+		return "false"
+	case json.Number:
+		return v.String()
+	}
+	return yamlString(strings.TrimSpace(jsonText(v)))
+}
+
+// jsonText, beklenmeyen skalerleri JSON metni olarak döndürür.
+func jsonText(v any) string {
+	raw, _ := json.Marshal(v)
+	return string(raw)
+}
+
+// writeYAML, çözülmüş JSON değerini (map[string]any, []any, skalerler) blok
+// stilinde YAML olarak yazar. indent, map anahtarlarının girinti seviyesidir.
+func writeYAML(buf *bytes.Buffer, v any, indent int) {
+	pad := strings.Repeat("  ", indent)
+	switch v := v.(type) {
+	case map[string]any:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			buf.WriteString(pad + yamlString(key) + ":")
+			writeYAMLValue(buf, v[key], indent+1)
+		}
+	case []any:
+		for _, item := range v {
+			buf.WriteString(pad + "-")
+			writeYAMLValue(buf, item, indent+1)
+		}
+	default:
+		buf.WriteString(pad + yamlScalar(v) + "\n")
+	}
+}
+
+// writeYAMLValue, bir anahtarın veya liste elemanının değerini yazar: boş
+// koleksiyonlar ve skalerler aynı satıra, diğerleri alt satırlara yazılır.
+func writeYAMLValue(buf *bytes.Buffer, v any, indent int) {
+	switch c := v.(type) {
+	case map[string]any:
+		if len(c) == 0 {
+			buf.WriteString(" {}\n")
+			return
+		}
+	case []any:
+		if len(c) == 0 {
+			buf.WriteString(" []\n")
+			return
+		}
+	default:
+		buf.WriteString(" " + yamlScalar(v) + "\n")
+		return
+	}
+	buf.WriteString("\n")
+	writeYAML(buf, v, indent)
+}
+
+// openAPIRefs, $defs'e işaret eden $ref değerlerini components.schemas'a
+// yönlendirir.
+func openAPIRefs(node any) {
+	switch v := node.(type) {
+	case map[string]any:
+		if ref, ok := v["$ref"].(string); ok {
+			v["$ref"] = "#/components/schemas/" + strings.TrimPrefix(ref, jsonSchemaDefsPrefix)
+		}
+		for _, child := range v {
+			openAPIRefs(child)
+		}
+	case []any:
+		for _, child := range v {
+			openAPIRefs(child)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package validation
+
+import (
+	"github.com/biyonik/go-fluent-validator/core"
+	"github.com/biyonik/go-fluent-validator/rules"
+	"github.com/biyonik/go-fluent-validator/types"
+)
+
+//
+// -----------------------------------------------------------------------------
+// Şema Seçenekleri (Schema Options)
+// -----------------------------------------------------------------------------
+// Bu dosya, Make() fonksiyonuna verilebilen fonksiyonel seçenekleri içerir.
+// Her seçenek, şemanın davranışını (otomatik trim gibi) tüm alanlar için tek
+// bir noktadan değiştirmeye yarar. Böylece her alan tanımında aynı zincir
+// çağrılarının tekrar tekrar yazılmasına gerek kalmaz.
+//
+// Örnek:
+//
+//	schema := validation.Make(validation.WithAutoTrim()).Shape(map[string]validation.Type{
+//	    "name":     validation.String().Required(),
+//	    "password": validation.String().Required().NoTrim(),
+//	})
+//
+// Modların tamamı Config ile tek seferde, genel olarak (SetDefaultConfig)
+// veya şema bazında (MakeWith) da verilebilir.
+//
+// Metadata:
+// @author    Ahmet ALTUN
+// @github    github.com/biyonik
+// @linkedin  linkedin.com/in/biyonik
+// @email     ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// SchemaOption, ValidationSchema üzerinde bir ayarı uygulayan fonksiyon tipidir.
+type SchemaOption func(*ValidationSchema)
+
+// WithAutoTrim
+// -----------------------------------------------------------------------------
+// Şemadaki tüm string değerlerin (iç içe nesne ve dizi elemanları dahil),
+// dönüşüm ve doğrulama adımlarından önce strings.TrimSpace ile temizlenmesini
+// sağlar. NoTrim() ile işaretlenen alanlar bu işlemden muaf tutulur.
+func WithAutoTrim() SchemaOption {
+	return func(vs *ValidationSchema) {
+		vs.autoTrim = true
+	}
+}
+
+// WithSeverityPolicy
+// -----------------------------------------------------------------------------
+// Severity(...) ile işaretlenmiş alanların ihlallerinin doğrulamayı engelleyip
+// engellemeyeceğine karar veren politikayı belirler. Varsayılan politika
+// (core.DefaultSeverityPolicy) yalnızca "error" seviyesini engeller; diğer
+// seviyeler result.Warnings() / result.Infos() altında raporlanır.
+//
+// Örnek:
+//
+//	policy := core.DefaultSeverityPolicy
+//	if os.Getenv("APP_ENV") == "production" {
+//	    policy = core.BlockAtOrAbove(core.SeverityWarning)
+//	}
+//	schema := validation.Make(validation.WithSeverityPolicy(policy))
+func WithSeverityPolicy(policy core.SeverityPolicy) SchemaOption {
+	return func(vs *ValidationSchema) {
+		vs.severityPolicy = policy
+	}
+}
+
+// SetDefaultPasswordRules
+// -----------------------------------------------------------------------------
+// Kurum genelindeki şifre politikasını bir kez tanımlar; sonradan oluşturulan
+// tüm String().Password() alanları bu politikadan başlar. Uygulama
+// başlangıcında, şemalar oluşturulmadan önce çağrılmalıdır.
+//
+// Örnek:
+//
+//	validation.SetDefaultPasswordRules(rules.PasswordOWASP())
+//	schema := validation.Make().Shape(map[string]validation.Type{
+//	    "password": validation.String().Required().Password(),
+//	})
+func SetDefaultPasswordRules(policy rules.PasswordRules) {
+	types.SetDefaultPasswordRules(policy)
+}
@@ -0,0 +1,88 @@
+package validation
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/biyonik/go-fluent-validator/core"
+	"github.com/biyonik/go-fluent-validator/i18n"
+	"github.com/biyonik/go-fluent-validator/rules"
+)
+
+//
+// -----------------------------------------------------------------------------
+// Hazır Kart Ödeme Şeması
+// -----------------------------------------------------------------------------
+// Ödeme sayfalarında (3-D Secure öncesi) kart bilgilerini tek seferde
+// doğrulayan hazır CardPayment şemasını içerir. Kart numarası, son kullanma
+// tarihi, güvenlik kodu ve kart sahibi adı alan seviyesinde; son kullanma
+// tarihinin geçmemiş olması ve güvenlik kodu uzunluğunun kart markasıyla
+// uyumu ise alanlar arası kurallarla denetlenir.
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// CardPayment
+// -----------------------------------------------------------------------------
+// Kart ile ödeme verisini doğrulayan hazır bir şema oluşturur. Beklenen
+// alanlar:
+//   - number : Kart numarası (format + Luhn)
+//   - expiry : Son kullanma tarihi ("AA/YY" veya "AA/YYYY"), geçmiş olamaz
+//   - cvv    : Güvenlik kodu; American Express için 4, diğerleri için 3 hane
+//   - holder : Kart sahibinin adı (PersonName kuralları)
+//
+// Dönen şema bağımsız kullanılabileceği gibi Discriminated varyantı veya
+// When dalı olarak daha büyük bir ödeme şemasına alt şema olarak eklenebilir.
+//
+// Örnek:
+//
+//	checkout := validation.Discriminated("method", map[string]validation.Schema{
+//	    "card": validation.CardPayment(),
+//	    "iban": validation.Make().Shape(map[string]validation.Type{
+//	        "iban": validation.Iban().Required(),
+//	    }),
+//	})
+func CardPayment(opts ...SchemaOption) *ValidationSchema {
+	vs := Make(opts...)
+	vs.Shape(map[string]core.Type{
+		"number": CreditCard().Required(),
+		"expiry": String().Required(),
+		"cvv":    String().Required().Numeric().Sensitive(),
+		"holder": String().Required().PersonName(),
+	})
+
+	vs.crossValidators = append(vs.crossValidators,
+		crossValidator{
+			fields: []string{"expiry"},
+			rule:   "card_expiry",
+			fn: func(data map[string]any) error {
+				expiry, _ := data["expiry"].(string)
+				month, year, ok := rules.ParseCardExpiry(expiry)
+				if !ok {
+					return newKeyedFieldError("expiry", i18n.KeyCardExpiry, vs.labelOf("expiry"))
+				}
+				if rules.IsCardExpired(month, year, time.Now()) {
+					return newKeyedFieldError("expiry", i18n.KeyCardExpired, vs.labelOf("expiry"))
+				}
+				return nil
+			},
+		},
+		crossValidator{
+			fields: []string{"number", "cvv"},
+			rule:   "card_cvv",
+			fn: func(data map[string]any) error {
+				number := fmt.Sprint(data["number"])
+				cvv, _ := data["cvv"].(string)
+				if want := rules.CardCVVLength(number); len(cvv) != want {
+					return newKeyedFieldError("cvv", i18n.KeyCardCVV, vs.labelOf("cvv"), want)
+				}
+				return nil
+			},
+		},
+	)
+	return vs
+}
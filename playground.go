@@ -0,0 +1,300 @@
+package validation
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/biyonik/go-fluent-validator/core"
+)
+
+//
+// -----------------------------------------------------------------------------
+// go-playground/validator Etiket Uyumluluğu
+// -----------------------------------------------------------------------------
+// Zaten go-playground/validator için etiketlenmiş kod tabanlarının bu pakete
+// kademeli geçişi için validate:"required,email,gte=18" biçimindeki
+// etiketleri şema tanımına çevirir. Struct ile aynı yansıma ve önbellek
+// altyapısını kullanır; yalnızca etiket söz dizimi farklıdır:
+//
+//	type SignupRequest struct {
+//	    Email    string   `json:"email" validate:"required,email,max=100"`
+//	    Age      int      `json:"age" validate:"omitempty,gte=18,lte=130"`
+//	    Role     string   `json:"role" validate:"oneof=admin editor"`
+//	    Password string   `json:"password" validate:"required,min=8"`
+//	    Confirm  string   `json:"confirm" validate:"eqfield=Password"`
+//	    Tags     []string `json:"tags" validate:"max=5,dive,min=2"`
+//	}
+//	res := validation.PlaygroundStruct(req)
+//
+// Desteklenen etiketler:
+//   - required, omitempty (alanlar zaten isteğe bağlıdır), "-"
+//   - min, max, len, gte, lte, eq; gt/lt tamsayı, string ve dizilerde bir
+//     fazlası/eksiği olarak, ondalıklarda yalnızca 0 için (positive/negative)
+//   - oneof (boşlukla ayrılmış), contains, startswith, endswith
+//   - email, url, uri, uuid, uuid3/4/5, ip, ipv4, ipv6, mac, e164, alpha,
+//     alphanum, alphaunicode, alphanumunicode, numeric, number, hexadecimal,
+//     base64
+//   - dive: sonraki etiketler dizi elemanlarına uygulanır
+//   - eqfield/nefield: yalnızca üst seviye alanlarda, Same/Different olarak
+//
+// Karşılığı olmayan etiketler (required_if, "|" ile veya, excludes...)
+// sessizce atlanmak yerine şema derlenirken hata döndürür.
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// playgroundSchemas, struct tipine göre derlenmiş uyumluluk şemalarının
+// önbelleğidir.
+var playgroundSchemas sync.Map // reflect.Type → structSchemaEntry
+
+// playgroundRules, parametresiz go-playground etiketlerinin kural adlarıdır.
+var playgroundRules = map[string]string{
+	"email":           "email",
+	"url":             "url",
+	"uri":             "url",
+	"alpha":           "alpha",
+	"alphanum":        "alphanumeric",
+	"alphaunicode":    "alpha_unicode",
+	"alphanumunicode": "alphanumeric_unicode",
+	"numeric":         "numeric",
+	"number":          "numeric",
+	"hexadecimal":     "hex",
+	"base64":          "base64",
+	"mac":             "mac",
+	"e164":            "e164",
+	"ip":              "ip",
+}
+
+// playgroundParams, tek parametreli go-playground etiketlerinin kural ve
+// parametre adlarıdır.
+var playgroundParams = map[string][2]string{
+	"contains":   {"contains", "value"},
+	"startswith": {"starts_with", "value"},
+	"endswith":   {"ends_with", "value"},
+}
+
+// PlaygroundStruct
+// -----------------------------------------------------------------------------
+// v'yi go-playground/validator söz dizimindeki validate etiketlerinden
+// üretilen şemayla doğrular. Sonuç Struct ile aynı biçimdedir; etiketler
+// derlenemezse hata "_payload" alanına eklenir.
+//
+// Örnek:
+//
+//	res := validation.PlaygroundStruct(req)
+//	if res.HasErrors() {
+//	    return res.Errors()
+//	}
+func PlaygroundStruct(v any) *core.ValidationResult {
+	schema, err := PlaygroundSchema(v)
+	if err != nil {
+		result := core.NewResult()
+		result.AddErrorRule(payloadField, "validate", err.Error())
+		return result
+	}
+	data, _ := normalizeValue(reflect.ValueOf(v), jsonFieldName).(map[string]any)
+	return schema.Validate(data)
+}
+
+// PlaygroundSchema
+// -----------------------------------------------------------------------------
+// v'nin tipinden go-playground/validator etiketlerine göre şemayı derler (veya
+// önbellekten döndürür). Dönen şemanın Describe() çıktısı, etiketlerin bu
+// paketteki karşılıklarını gösterdiği için geçişte Struct etiketlerine
+// dönüştürmek amacıyla StructTag ile birlikte kullanılabilir.
+func PlaygroundSchema(v any) (*ValidationSchema, error) {
+	rt := reflect.TypeOf(v)
+	for rt != nil && rt.Kind() == reflect.Pointer {
+		rt = rt.Elem()
+	}
+	if rt == nil || rt.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("validation.PlaygroundStruct: struct bekleniyordu, %v alındı", reflect.TypeOf(v))
+	}
+
+	return compileStruct(rt, &playgroundSchemas, func(fields map[string]*core.TypeDescription) (*core.SchemaDescription, error) {
+		return playgroundFieldRules(rt, fields)
+	}, applyPlaygroundTag)
+}
+
+// playgroundFieldRules, üst seviye alanlardaki eqfield/nefield işaretlerini
+// Go alan adlarını json adlarına çevirerek şema kurallarına taşır.
+func playgroundFieldRules(rt reflect.Type, fields map[string]*core.TypeDescription) (*core.SchemaDescription, error) {
+	desc := &core.SchemaDescription{Fields: fields}
+	names := make(map[string]string)
+	collectFieldNames(rt, names)
+
+	for _, name := range sortedKeys(fields) {
+		field := fields[name]
+		var rest []core.RuleDescription
+		for _, rule := range field.Rules {
+			if rule.Name != "eqfield" && rule.Name != "nefield" {
+				rest = append(rest, rule)
+				continue
+			}
+			goName, _ := paramString(rule.Params, "field")
+			other, ok := names[goName]
+			if !ok {
+				return nil, fmt.Errorf("%s: %s=%s alanı bulunamadı", name, rule.Name, goName)
+			}
+			schemaRule := "same"
+			if rule.Name == "nefield" {
+				schemaRule = "different"
+			}
+			desc.Rules = append(desc.Rules, core.RuleDescription{
+				Name:   schemaRule,
+				Params: map[string]any{"field": name, "other": other},
+			})
+		}
+		field.Rules = rest
+	}
+	return desc, nil
+}
+
+// collectFieldNames, struct'ın (gömülü struct'lar dahil) Go alan adlarını
+// json adlarına eşler.
+func collectFieldNames(rt reflect.Type, out map[string]string) {
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		if field.Anonymous && field.Tag.Get("json") == "" {
+			embedded := field.Type
+			for embedded.Kind() == reflect.Pointer {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				collectFieldNames(embedded, out)
+				continue
+			}
+		}
+		if names := jsonFieldName(field); len(names) > 0 {
+			out[field.Name] = names[0]
+		}
+	}
+}
+
+// applyPlaygroundTag, "required,min=3,dive,email" biçimindeki etiketi desc'e
+// uygular. dive sonrasındaki etiketler dizi elemanlarına aktarılır.
+func applyPlaygroundTag(desc *core.TypeDescription, tag string) error {
+	parts := strings.Split(tag, ",")
+	for i, part := range parts {
+		part = strings.TrimSpace(part)
+		if part != "dive" {
+			if err := applyPlaygroundRule(desc, part); err != nil {
+				return err
+			}
+			continue
+		}
+		if desc.Type != "array" || desc.Elements == nil {
+			return fmt.Errorf("dive yalnızca slice ve dizilerde kullanılabilir")
+		}
+		return applyPlaygroundTag(desc.Elements, strings.Join(parts[i+1:], ","))
+	}
+	return nil
+}
+
+// applyPlaygroundRule, tek bir go-playground etiketini desc'e uygular.
+func applyPlaygroundRule(desc *core.TypeDescription, part string) error {
+	if strings.Contains(part, "|") {
+		return fmt.Errorf("desteklenmeyen go-playground etiketi %q: \"|\" ile veya", part)
+	}
+	name, arg, hasArg := strings.Cut(part, "=")
+
+	switch {
+	case name == "" || name == "omitempty":
+		return nil
+	case name == "required":
+		desc.Required = true
+		return nil
+	case name == "uuid" || name == "uuid3" || name == "uuid4" || name == "uuid5":
+		if desc.Type != "string" {
+			return fmt.Errorf("%s yalnızca string alanlarda kullanılabilir", name)
+		}
+		desc.Type = "uuid"
+		if version := strings.TrimPrefix(name, "uuid"); version != "" {
+			desc.AddRule("version", map[string]any{"value": int(version[0] - '0')})
+		}
+		return nil
+	case name == "ipv4" || name == "ipv6":
+		desc.AddRule("ip", map[string]any{"version": float64(name[3] - '0')})
+		return nil
+	case name == "eqfield" || name == "nefield":
+		desc.AddRule(name, map[string]any{"field": arg})
+		return nil
+	case name == "oneof" && hasArg:
+		values := strings.Fields(arg)
+		items := make([]any, len(values))
+		for i, v := range values {
+			items[i] = v
+		}
+		desc.AddRule("one_of", map[string]any{"values": items})
+		return nil
+	}
+
+	if rule, ok := playgroundRules[name]; ok && !hasArg {
+		desc.AddRule(rule, nil)
+		return nil
+	}
+	if param, ok := playgroundParams[name]; ok && hasArg {
+		desc.AddRule(param[0], map[string]any{param[1]: arg})
+		return nil
+	}
+	if hasArg {
+		return applyPlaygroundBound(desc, name, arg)
+	}
+	return fmt.Errorf("desteklenmeyen go-playground etiketi %q", part)
+}
+
+// applyPlaygroundBound, min/max/len/gte/lte/gt/lt/eq sınırlarını desc'in
+// tipine göre (uzunluk, değer veya eleman sayısı) kurallara çevirir.
+func applyPlaygroundBound(desc *core.TypeDescription, name, arg string) error {
+	if desc.Type == "date" || desc.Type == "boolean" {
+		return fmt.Errorf("desteklenmeyen go-playground etiketi %q: %s alanı", name+"="+arg, desc.Type)
+	}
+	if name == "eq" && desc.Type == "string" {
+		desc.AddRule("one_of", map[string]any{"values": []any{arg}})
+		return nil
+	}
+
+	n, err := strconv.ParseFloat(arg, 64)
+	if err != nil {
+		return fmt.Errorf("%s: sayısal parametre bekleniyordu, %q alındı", name, arg)
+	}
+	// Ondalık sayılar dışında (uzunluk, eleman sayısı, tamsayı) sınırlar
+	// kesin olmayan karşılaştırmalara çevrilebilir.
+	discrete := desc.Type != "number" || desc.HasRule("integer")
+
+	switch name {
+	case "min", "gte":
+		desc.AddRule("min", map[string]any{"value": n})
+	case "max", "lte":
+		desc.AddRule("max", map[string]any{"value": n})
+	case "len", "eq":
+		desc.AddRule("min", map[string]any{"value": n})
+		desc.AddRule("max", map[string]any{"value": n})
+	case "gt", "lt":
+		switch {
+		case discrete && name == "gt":
+			desc.AddRule("min", map[string]any{"value": n + 1})
+		case discrete:
+			desc.AddRule("max", map[string]any{"value": n - 1})
+		case n == 0 && name == "gt":
+			desc.AddRule("positive", nil)
+		case n == 0:
+			desc.AddRule("negative", nil)
+		default:
+			return fmt.Errorf("desteklenmeyen go-playground etiketi %q: ondalık alanda yalnızca 0 ile", name+"="+arg)
+		}
+	default:
+		return fmt.Errorf("desteklenmeyen go-playground etiketi %q", name+"="+arg)
+	}
+	return nil
+}
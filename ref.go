@@ -0,0 +1,185 @@
+package validation
+
+import (
+	"slices"
+
+	"github.com/biyonik/go-fluent-validator/core"
+	"github.com/biyonik/go-fluent-validator/i18n"
+	"github.com/biyonik/go-fluent-validator/types"
+)
+
+//
+// -----------------------------------------------------------------------------
+// Şema Başvuruları (Register / Ref)
+// -----------------------------------------------------------------------------
+// Sık kullanılan alt şemaların (adres, para tutarı, kişi...) bir kez
+// tanımlanıp kod tabanının her yerinde JSON Schema'daki $ref gibi isimle
+// kullanılmasını sağlar:
+//
+//	validation.Register("Address", addressSchema)
+//
+//	order := validation.Make().Shape(map[string]validation.Type{
+//	    "shipping": validation.Ref("Address").Required(),
+//	    "billing":  validation.Ref("Address"),
+//	    "stops":    validation.Array().Elements(validation.Ref("Address")),
+//	})
+//
+// Başvurular doğrulama anında çözülür; bu yüzden Ref, şema kaydedilmeden
+// önce de kullanılabilir ve kendine başvuran (ağaç yapılı) şemalar
+// tanımlanabilir. Veri sonlu olduğundan doğrulama her zaman sonlanır.
+// Describe ise başvuruları yerinde açar; açılmakta olan bir şemaya yeniden
+// ulaşıldığında döngü algılanır ve o düğüm yalnızca Ref adıyla (alanları
+// olmadan) bırakılır. ToJSONSchema bu düğümleri $defs'e $ref olarak yazar.
+//
+// Başvurulan şemanın alanları Object(...).Shape gibi uygulanır; When,
+// CrossValidate ve birleşim kuralları yalnızca şema doğrudan çalıştırıldığında
+// işler. Kayıtlı olmayan bir şemaya başvuran alan schema_ref hatası alır.
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// schemaRefs, Register ile kaydedilen ve Ref ile başvurulan şemalardır.
+var schemaRefs = NewRegistry()
+
+// Register
+// -----------------------------------------------------------------------------
+// schema'yı Ref(name) ile başvurulabilecek şekilde kaydeder; aynı isimde bir
+// şema varsa değiştirilir. Eşzamanlı kullanıma güvenlidir.
+//
+// Örnek:
+//
+//	validation.Register("Address", addressSchema)
+func Register(name string, schema *ValidationSchema) {
+	schemaRefs.Register(name, schema)
+}
+
+// Ref
+// -----------------------------------------------------------------------------
+// Register ile kaydedilmiş name adlı şemaya başvuran bir alan tipi oluşturur.
+//
+// Örnek:
+//
+//	"address": validation.Ref("Address").Required()
+func Ref(name string) *RefType {
+	return &RefType{name: name}
+}
+
+// RefType, kayıtlı bir şemaya isimle başvuran alan tipidir.
+type RefType struct {
+	core.BaseType
+	name string
+}
+
+// Required, alanın boş geçilemeyeceğini belirtir.
+func (r *RefType) Required() *RefType {
+	r.SetRequired()
+	return r
+}
+
+// Optional, alanın anahtarının payload'da hiç bulunmayabileceğini belirtir;
+// eksik anahtar Required olsa bile geçerlidir ve ValidData'ya yazılmaz.
+func (r *RefType) Optional() *RefType {
+	r.SetOptional()
+	return r
+}
+
+// Nullable, alanın açıkça null gönderilebileceğini belirtir; null değer
+// Required olsa bile geçerlidir ve ValidData'da korunur.
+func (r *RefType) Nullable() *RefType {
+	r.SetNullable()
+	return r
+}
+
+// Label, doğrulama hatalarında gösterilecek kullanıcı dostu alan adını belirler.
+func (r *RefType) Label(label string) *RefType {
+	r.SetLabel(label)
+	return r
+}
+
+// Describe, alan için dokümantasyon amaçlı açıklama tanımlar.
+func (r *RefType) Describe(text string) *RefType {
+	r.SetDescription(text)
+	return r
+}
+
+// Name, başvurulan şemanın adını döndürür.
+func (r *RefType) Name() string {
+	return r.name
+}
+
+// object, başvurulan şemanın alanlarıyla bir ObjectType döndürür; şema
+// kayıtlı değilse false döner.
+func (r *RefType) object() (*types.ObjectType, bool) {
+	schema, ok := schemaRefs.Get(r.name)
+	if !ok {
+		return nil, false
+	}
+	return Object().Shape(schema.GetShape()), true
+}
+
+// Transform, ortak dönüşümlerden sonra başvurulan şemanın alan
+// dönüşümlerini uygular.
+func (r *RefType) Transform(value any) (any, error) {
+	value, err := r.BaseType.Transform(value)
+	if err != nil || value == nil {
+		return value, err
+	}
+	if obj, ok := r.object(); ok {
+		return obj.Transform(value)
+	}
+	return value, nil
+}
+
+// Validate, değeri başvurulan şemanın alanlarıyla doğrular. Hatalar
+// "shipping.city" gibi alan yoluyla raporlanır.
+func (r *RefType) Validate(field string, value any, result *core.ValidationResult) {
+	r.BaseType.Validate(field, value, result)
+	if result.HasFieldErrors(field) || value == nil {
+		return
+	}
+	obj, ok := r.object()
+	if !ok {
+		result.AddRuleError(field, i18n.KeySchemaRef, r.GetLabel(field), r.name)
+		return
+	}
+	obj.Validate(field, value, result)
+}
+
+// Introspect, başvuruyu yalnızca adıyla tanımlar; alanlar Describe sırasında
+// döngü denetimiyle açılır (bkz. expandRefs).
+func (r *RefType) Introspect() *core.TypeDescription {
+	desc := r.DescribeBase("object")
+	desc.Ref = r.name
+	return desc
+}
+
+// expandRefs, desc ağacındaki Ref düğümlerini kayıtlı şemaların alanlarıyla
+// doldurur. stack, açılmakta olan şemalardır; bunlardan birine yeniden
+// ulaşılırsa düğüm döngü olarak alanları olmadan bırakılır.
+func expandRefs(desc *core.TypeDescription, stack []string) {
+	if desc == nil {
+		return
+	}
+	if desc.Ref != "" && desc.Fields == nil && !slices.Contains(stack, desc.Ref) {
+		if schema, ok := schemaRefs.Get(desc.Ref); ok {
+			desc.Fields = schema.describe(append(stack, desc.Ref)).Fields
+		}
+		return
+	}
+	for _, field := range desc.Fields {
+		expandRefs(field, stack)
+	}
+	expandRefs(desc.Elements, stack)
+	expandRefs(desc.Keys, stack)
+	expandRefs(desc.Values, stack)
+	for _, variant := range desc.Variants {
+		expandRefs(variant, stack)
+	}
+	for _, alternative := range desc.Union {
+		expandRefs(alternative, stack)
+	}
+}
@@ -0,0 +1,99 @@
+package validation
+
+import (
+	"sort"
+	"sync"
+	"sync/atomic"
+
+	"github.com/biyonik/go-fluent-validator/core"
+)
+
+//
+// -----------------------------------------------------------------------------
+// Şema Kayıt Defteri (Registry)
+// -----------------------------------------------------------------------------
+// Uygulamadaki şemaları isimle saklayan, eşzamanlı erişime güvenli kayıt
+// defterini içerir. Okumalar kilitsizdir; kayıt ve yeniden yükleme işlemleri
+// şema kümesini kopyalayıp tek adımda (atomik olarak) değiştirir. Böylece
+// doğrulama yapan istekler yeniden yükleme sırasında ya eski ya da yeni şemayı
+// görür, yarım güncellenmiş bir kümeyi asla görmez.
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// Registry, isimlendirilmiş şemaları tutan kayıt defteridir. Sıfır değeri
+// kullanıma hazır değildir; NewRegistry ile oluşturulur.
+type Registry struct {
+	mu      sync.Mutex // yazıcıları sıraya koyar
+	schemas atomic.Pointer[map[string]*ValidationSchema]
+}
+
+// NewRegistry, boş bir kayıt defteri oluşturur.
+func NewRegistry() *Registry {
+	r := &Registry{}
+	empty := make(map[string]*ValidationSchema)
+	r.schemas.Store(&empty)
+	return r
+}
+
+// Register, şemayı verilen isimle kaydeder; aynı isimde bir şema varsa
+// değiştirilir.
+func (r *Registry) Register(name string, schema *ValidationSchema) {
+	r.swap(map[string]*ValidationSchema{name: schema}, nil)
+}
+
+// Unregister, verilen isimdeki şemayı kayıt defterinden çıkarır.
+func (r *Registry) Unregister(name string) {
+	r.swap(nil, []string{name})
+}
+
+// Get, verilen isimdeki şemayı döndürür.
+func (r *Registry) Get(name string) (*ValidationSchema, bool) {
+	schema, ok := (*r.schemas.Load())[name]
+	return schema, ok
+}
+
+// Names, kayıtlı şema isimlerini sıralı olarak döndürür.
+func (r *Registry) Names() []string {
+	current := *r.schemas.Load()
+	names := make([]string, 0, len(current))
+	for name := range current {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Validate, verilen isimdeki şemayla doğrulama yapar. Şema kayıtlı değilse
+// ikinci dönüş false olur.
+func (r *Registry) Validate(name string, data map[string]any) (*core.ValidationResult, bool) {
+	schema, ok := r.Get(name)
+	if !ok {
+		return nil, false
+	}
+	return schema.Validate(data), true
+}
+
+// swap, set içindeki şemaları ekler/değiştirir ve remove içindekileri çıkarır.
+// Tüm değişiklikler okuyuculara tek seferde görünür.
+func (r *Registry) swap(set map[string]*ValidationSchema, remove []string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	current := *r.schemas.Load()
+	next := make(map[string]*ValidationSchema, len(current)+len(set))
+	for name, schema := range current {
+		next[name] = schema
+	}
+	for _, name := range remove {
+		delete(next, name)
+	}
+	for name, schema := range set {
+		next[name] = schema
+	}
+	r.schemas.Store(&next)
+}
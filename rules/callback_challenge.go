@@ -0,0 +1,254 @@
+package rules
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"crypto/tls"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+)
+
+//
+// -----------------------------------------------------------------------------
+// Geri Çağırma Adresi Doğrulaması (Webhook Challenge)
+// -----------------------------------------------------------------------------
+// Webhook aboneliği kaydeden uç noktalarda, kaydedilen adresin gerçekten
+// abonenin kontrolünde olduğunu doğrular. Doğrulama sırasında adrese rastgele
+// bir token içeren GET isteği yapılır:
+//
+//	GET https://example.com/hooks?challenge=5f2c...e1
+//
+// Adres, 200 durum koduyla gövdede token'ın kendisini döndürmelidir (baştaki
+// ve sondaki boşluklar yok sayılır). Varsayılan olarak yalnızca https
+// adresleri kabul edilir, TLS 1.2 altı reddedilir, yönlendirmeler izlenmez ve
+// istek DefaultChallengeTimeout sonunda iptal edilir.
+//
+// İstek kullanıcının verdiği adrese yapıldığı için varsayılan istemci,
+// bağlantı anında bağlanılan adresi IsPublicIP ile denetler; özel, loopback
+// ve metadata (169.254.169.254) adreslerine bağlantı ErrPrivateHost ile
+// reddedilir. Böylece ön çözümlemeden sonra adresi değişen (DNS rebinding)
+// host'lar da engellenir. Alan üzerinde NoPrivateHosts/ResolveHosts yine de
+// önerilir; bu kontroller başarısız olursa challenge isteği hiç yapılmaz.
+// Ortam değişkenlerindeki proxy ayarları yalnızca WithChallengeProxy ile
+// kullanılır.
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// DefaultChallengeTimeout, challenge isteğinin varsayılan zaman aşımıdır.
+const DefaultChallengeTimeout = 5 * time.Second
+
+// DefaultChallengeParam, token'ın gönderildiği varsayılan query parametresidir.
+const DefaultChallengeParam = "challenge"
+
+// maxChallengeResponse, challenge yanıtından okunan en fazla bayt sayısıdır.
+const maxChallengeResponse = 1024
+
+var (
+	// ErrInsecureCallback, adresin https olmadığını belirtir.
+	ErrInsecureCallback = errors.New("callback: adres https olmalıdır")
+
+	// ErrChallengeMismatch, adresin token'ı geri döndürmediğini belirtir.
+	ErrChallengeMismatch = errors.New("callback: challenge yanıtı eşleşmedi")
+)
+
+// CallbackVerifier, bir geri çağırma adresinin sahipliğini doğrulayan
+// fonksiyondur. Adres doğrulanamazsa hata döner.
+type CallbackVerifier func(ctx context.Context, callbackURL string) error
+
+// ChallengeOption, challenge doğrulayıcısının davranışını değiştiren
+// seçeneklerdir.
+type ChallengeOption func(*challengeConfig)
+
+// challengeConfig, doğrulayıcı seçeneklerinin toplandığı yapıdır.
+type challengeConfig struct {
+	client        *http.Client
+	timeout       time.Duration
+	param         string
+	allowInsecure bool
+	proxy         bool
+	token         func() (string, error)
+}
+
+// WithChallengeTimeout, challenge isteğinin zaman aşımını belirler.
+func WithChallengeTimeout(d time.Duration) ChallengeOption {
+	return func(c *challengeConfig) {
+		c.timeout = d
+	}
+}
+
+// WithChallengeClient, istek için kullanılacak HTTP istemcisini belirler.
+// Özel istemcilerde yönlendirme ve TLS politikası istemciye aittir.
+func WithChallengeClient(client *http.Client) ChallengeOption {
+	return func(c *challengeConfig) {
+		c.client = client
+	}
+}
+
+// WithChallengeProxy, isteğin ortam değişkenlerindeki (HTTPS_PROXY,
+// NO_PROXY...) proxy üzerinden yapılmasını sağlar. Bu durumda bağlantı proxy'ye
+// kurulduğu için hedef adres denetimi proxy'nin çıkış politikasına bırakılır.
+// WithChallengeClient ile birlikte verildiğinde etkisizdir.
+func WithChallengeProxy() ChallengeOption {
+	return func(c *challengeConfig) {
+		c.proxy = true
+	}
+}
+
+// WithChallengeParam, token'ın gönderileceği query parametresini belirler
+// (örn. "hub.challenge").
+func WithChallengeParam(param string) ChallengeOption {
+	return func(c *challengeConfig) {
+		c.param = param
+	}
+}
+
+// WithChallengeToken, token üreticisini değiştirir (testler ve sağlayıcıya
+// özel biçimler için).
+func WithChallengeToken(fn func() (string, error)) ChallengeOption {
+	return func(c *challengeConfig) {
+		c.token = fn
+	}
+}
+
+// AllowInsecureCallback, http adreslerinin de kabul edilmesini sağlar (yerel
+// geliştirme ortamları için).
+func AllowInsecureCallback() ChallengeOption {
+	return func(c *challengeConfig) {
+		c.allowInsecure = true
+	}
+}
+
+// ChallengeCallback
+// -----------------------------------------------------------------------------
+// Adrese challenge isteği yaparak sahipliği doğrulayan bir CallbackVerifier
+// döndürür.
+//
+// Örnek:
+//
+//	"callback_url": validation.String().Required().URL().ResolveHosts().
+//	    VerifyCallback(rules.ChallengeCallback(rules.WithChallengeParam("hub.challenge"))),
+func ChallengeCallback(opts ...ChallengeOption) CallbackVerifier {
+	cfg := challengeConfig{
+		timeout: DefaultChallengeTimeout,
+		param:   DefaultChallengeParam,
+		token:   randomChallengeToken,
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	if cfg.client == nil {
+		cfg.client = defaultChallengeClient
+		if cfg.proxy {
+			cfg.client = proxyChallengeClient
+		}
+	}
+
+	return func(ctx context.Context, callbackURL string) error {
+		u, err := url.Parse(callbackURL)
+		if err != nil {
+			return err
+		}
+		if !strings.EqualFold(u.Scheme, "https") && !(cfg.allowInsecure && strings.EqualFold(u.Scheme, "http")) {
+			return ErrInsecureCallback
+		}
+
+		token, err := cfg.token()
+		if err != nil {
+			return err
+		}
+		query := u.Query()
+		query.Set(cfg.param, token)
+		u.RawQuery = query.Encode()
+
+		if cfg.timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, cfg.timeout)
+			defer cancel()
+		}
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+		if err != nil {
+			return err
+		}
+
+		resp, err := cfg.client.Do(req)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return fmt.Errorf("callback: beklenmeyen durum kodu %d", resp.StatusCode)
+		}
+		body, err := io.ReadAll(io.LimitReader(resp.Body, maxChallengeResponse))
+		if err != nil {
+			return err
+		}
+		if subtle.ConstantTimeCompare([]byte(strings.TrimSpace(string(body))), []byte(token)) != 1 {
+			return ErrChallengeMismatch
+		}
+		return nil
+	}
+}
+
+// defaultChallengeClient, yönlendirmeleri izlemeyen, TLS 1.2 altını reddeden
+// ve yalnızca genel (public) adreslere bağlanan istemcidir. Yönlendirmeler,
+// doğrulanan adresten başka bir hedefe (örn. iç ağ) istek yapılmasına yol
+// açabileceği için izlenmez.
+var defaultChallengeClient = newChallengeClient(&http.Transport{
+	DialContext:     (&net.Dialer{Control: publicOnlyControl}).DialContext,
+	TLSClientConfig: &tls.Config{MinVersion: tls.VersionTLS12},
+})
+
+// proxyChallengeClient, WithChallengeProxy ile seçilen ve ortamdaki proxy
+// üzerinden bağlanan istemcidir.
+var proxyChallengeClient = newChallengeClient(&http.Transport{
+	Proxy:           http.ProxyFromEnvironment,
+	TLSClientConfig: &tls.Config{MinVersion: tls.VersionTLS12},
+})
+
+// newChallengeClient, yönlendirmeleri izlemeyen bir istemci oluşturur.
+func newChallengeClient(transport *http.Transport) *http.Client {
+	return &http.Client{
+		Transport: transport,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// publicOnlyControl, soket bağlanmadan hemen önce çağrılır ve çözümlenmiş
+// adres genel değilse bağlantıyı ErrPrivateHost ile reddeder.
+func publicOnlyControl(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip, err := netip.ParseAddr(host)
+	if err != nil || !IsPublicIP(ip) {
+		return ErrPrivateHost
+	}
+	return nil
+}
+
+// randomChallengeToken, 128 bit rastgele hex token üretir.
+func randomChallengeToken() (string, error) {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b[:]), nil
+}
@@ -0,0 +1,163 @@
+package rules
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+//
+// -----------------------------------------------------------------------------
+// Captcha Doğrulaması (reCAPTCHA, hCaptcha, Cloudflare Turnstile)
+// -----------------------------------------------------------------------------
+// Kayıt/iletişim formlarındaki captcha token'larını sağlayıcının "siteverify"
+// uç noktasına sorarak doğrulayan fonksiyonları içerir. Üç sağlayıcı da aynı
+// istek (secret, response, remoteip form alanları) ve yanıt biçimini
+// (success, score, action, hostname, error-codes) kullandığı için tek bir
+// istemci üzerinden çalışırlar.
+//
+// Doğrulayıcılar context'e duyarlıdır; context iptal edildiğinde veya
+// zaman aşımı dolduğunda hata döner. Şema içinde validation.Captcha(...) alanı
+// ile kullanılır; skor eşiği ve beklenen action alan üzerinde tanımlanır.
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// Sağlayıcıların doğrulama uç noktaları.
+const (
+	RecaptchaVerifyURL = "https://www.google.com/recaptcha/api/siteverify"
+	HCaptchaVerifyURL  = "https://api.hcaptcha.com/siteverify"
+	TurnstileVerifyURL = "https://challenges.cloudflare.com/turnstile/v0/siteverify"
+)
+
+// DefaultCaptchaTimeout, sağlayıcıya yapılan isteğin varsayılan zaman aşımıdır.
+const DefaultCaptchaTimeout = 5 * time.Second
+
+// CaptchaResult, sağlayıcının doğrulama yanıtıdır. Score ve Action yalnızca
+// skor tabanlı sağlayıcılarda (reCAPTCHA v3, hCaptcha Enterprise) doludur.
+type CaptchaResult struct {
+	Success    bool     `json:"success"`
+	Score      *float64 `json:"score,omitempty"`
+	Action     string   `json:"action,omitempty"`
+	Hostname   string   `json:"hostname,omitempty"`
+	ErrorCodes []string `json:"error-codes,omitempty"`
+}
+
+// CaptchaVerifier, bir captcha token'ını sağlayıcıyla doğrulayan fonksiyondur.
+// Ağ veya sağlayıcı hatalarında error döner; token geçersizse
+// CaptchaResult.Success false olur.
+type CaptchaVerifier func(ctx context.Context, token string) (CaptchaResult, error)
+
+// CaptchaOption, captcha doğrulayıcılarının davranışını değiştiren
+// seçeneklerdir.
+type CaptchaOption func(*captchaConfig)
+
+// captchaConfig, doğrulayıcı seçeneklerinin toplandığı yapıdır.
+type captchaConfig struct {
+	endpoint string
+	client   *http.Client
+	timeout  time.Duration
+	remoteIP func(ctx context.Context) string
+}
+
+// WithCaptchaTimeout, sağlayıcıya yapılan isteğin zaman aşımını belirler.
+func WithCaptchaTimeout(d time.Duration) CaptchaOption {
+	return func(c *captchaConfig) {
+		c.timeout = d
+	}
+}
+
+// WithCaptchaClient, istekler için kullanılacak HTTP istemcisini belirler.
+func WithCaptchaClient(client *http.Client) CaptchaOption {
+	return func(c *captchaConfig) {
+		c.client = client
+	}
+}
+
+// WithCaptchaEndpoint, sağlayıcının doğrulama adresini değiştirir (kurumsal
+// vekil sunucular ve testler için).
+func WithCaptchaEndpoint(endpoint string) CaptchaOption {
+	return func(c *captchaConfig) {
+		c.endpoint = endpoint
+	}
+}
+
+// WithCaptchaRemoteIP, istemci IP adresini context'ten çıkaran fonksiyonu
+// belirler. Adres boş değilse sağlayıcıya "remoteip" olarak gönderilir.
+func WithCaptchaRemoteIP(fn func(ctx context.Context) string) CaptchaOption {
+	return func(c *captchaConfig) {
+		c.remoteIP = fn
+	}
+}
+
+// Recaptcha, Google reCAPTCHA (v2/v3) token'larını doğrulayan bir
+// CaptchaVerifier döndürür.
+//
+// Örnek:
+//
+//	"captcha": validation.Captcha(rules.Recaptcha(os.Getenv("RECAPTCHA_SECRET"))).MinScore(0.5).Action("signup"),
+func Recaptcha(secret string, opts ...CaptchaOption) CaptchaVerifier {
+	return newCaptchaVerifier(RecaptchaVerifyURL, secret, opts)
+}
+
+// HCaptcha, hCaptcha token'larını doğrulayan bir CaptchaVerifier döndürür.
+func HCaptcha(secret string, opts ...CaptchaOption) CaptchaVerifier {
+	return newCaptchaVerifier(HCaptchaVerifyURL, secret, opts)
+}
+
+// Turnstile, Cloudflare Turnstile token'larını doğrulayan bir CaptchaVerifier
+// döndürür.
+func Turnstile(secret string, opts ...CaptchaOption) CaptchaVerifier {
+	return newCaptchaVerifier(TurnstileVerifyURL, secret, opts)
+}
+
+// newCaptchaVerifier, siteverify protokolünü konuşan ortak doğrulayıcıyı
+// oluşturur.
+func newCaptchaVerifier(endpoint, secret string, opts []CaptchaOption) CaptchaVerifier {
+	cfg := captchaConfig{endpoint: endpoint, client: http.DefaultClient, timeout: DefaultCaptchaTimeout}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	return func(ctx context.Context, token string) (CaptchaResult, error) {
+		var result CaptchaResult
+		if cfg.timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, cfg.timeout)
+			defer cancel()
+		}
+
+		form := url.Values{"secret": {secret}, "response": {token}}
+		if cfg.remoteIP != nil {
+			if ip := cfg.remoteIP(ctx); ip != "" {
+				form.Set("remoteip", ip)
+			}
+		}
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, cfg.endpoint, strings.NewReader(form.Encode()))
+		if err != nil {
+			return result, err
+		}
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+		resp, err := cfg.client.Do(req)
+		if err != nil {
+			return result, err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return result, fmt.Errorf("captcha: beklenmeyen durum kodu %d", resp.StatusCode)
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+			return result, fmt.Errorf("captcha: yanıt çözümlenemedi: %w", err)
+		}
+		return result, nil
+	}
+}
@@ -0,0 +1,222 @@
+package rules
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+//
+// -----------------------------------------------------------------------------
+// Kontrol Basamağı (Checksum) Algoritmaları
+// -----------------------------------------------------------------------------
+// Kimlik ve hesap numaralarında yazım hatalarını yakalamak için kullanılan
+// kontrol basamağı algoritmalarını tek bir isimle seçilebilir hale getirir:
+//
+//   - luhn:     Kredi kartları, IMEI, Kanada SIN (ISO/IEC 7812-1)
+//   - verhoeff: Hindistan Aadhaar; tüm tek hane hatalarını ve komşu yer
+//     değişikliklerini yakalar
+//   - damm:     Verhoeff'in eşdeğeri, tek tablo ile
+//   - mod97:    ISO 7064 MOD 97-10; IBAN, LEI ve bazı ulusal hesap numaraları
+//     (harfler A=10 … Z=35 olarak sayılır)
+//
+// Tüm algoritmalar kontrol basamağını değerin son hanesi (mod97'de son iki
+// hanesi) olarak bekler. Boşluk ve tire ayırıcıları yok sayılır. Yeni
+// algoritmalar RegisterChecksum ile eklenebilir ve String().Checksum(ad) ile
+// kullanılabilir.
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// Yerleşik algoritma adları.
+const (
+	ChecksumLuhn     = "luhn"
+	ChecksumVerhoeff = "verhoeff"
+	ChecksumDamm     = "damm"
+	ChecksumMod97    = "mod97"
+)
+
+// ErrUnknownChecksum, kayıtlı olmayan bir algoritma adı istendiğinde döner.
+var ErrUnknownChecksum = errors.New("bilinmeyen checksum algoritması")
+
+// ChecksumFunc, değerin kontrol basamağının doğru olup olmadığını döndürür.
+type ChecksumFunc func(value string) bool
+
+var (
+	checksumsMu sync.RWMutex
+	checksums   = map[string]ChecksumFunc{
+		ChecksumLuhn:     Luhn,
+		ChecksumVerhoeff: Verhoeff,
+		ChecksumDamm:     Damm,
+		ChecksumMod97:    Mod97,
+	}
+)
+
+// Checksum
+// -----------------------------------------------------------------------------
+// Adı verilen algoritmanın doğrulama fonksiyonunu döndürür. Ad büyük/küçük
+// harf duyarsızdır.
+//
+// Örnek:
+//
+//	verify, err := rules.Checksum("verhoeff")
+//	ok := err == nil && verify("2363")
+func Checksum(algorithm string) (ChecksumFunc, error) {
+	checksumsMu.RLock()
+	defer checksumsMu.RUnlock()
+	fn, ok := checksums[strings.ToLower(algorithm)]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownChecksum, algorithm)
+	}
+	return fn, nil
+}
+
+// RegisterChecksum, name adıyla yeni bir algoritma kaydeder veya mevcut olanı
+// değiştirir.
+func RegisterChecksum(name string, fn ChecksumFunc) {
+	checksumsMu.Lock()
+	defer checksumsMu.Unlock()
+	checksums[strings.ToLower(name)] = fn
+}
+
+// ChecksumAlgorithms, kayıtlı algoritma adlarını sıralı döndürür.
+func ChecksumAlgorithms() []string {
+	checksumsMu.RLock()
+	defer checksumsMu.RUnlock()
+	names := make([]string, 0, len(checksums))
+	for name := range checksums {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// checksumDigits, ayırıcıları atarak değeri rakam dizisine çevirir. Rakam
+// olmayan bir karakter varsa veya değer boşsa ok false döner.
+func checksumDigits(value string) (digits []int, ok bool) {
+	for _, r := range value {
+		switch {
+		case r == ' ' || r == '-':
+			continue
+		case r >= '0' && r <= '9':
+			digits = append(digits, int(r-'0'))
+		default:
+			return nil, false
+		}
+	}
+	return digits, len(digits) > 0
+}
+
+// Luhn, değerin Luhn (mod 10) kontrol basamağını doğrular.
+func Luhn(value string) bool {
+	digits, ok := checksumDigits(value)
+	if !ok || len(digits) < 2 {
+		return false
+	}
+	sum := 0
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := digits[i]
+		if (len(digits)-1-i)%2 == 1 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+	}
+	return sum%10 == 0
+}
+
+// verhoeffD, dihedral grup D5 çarpım tablosudur.
+var verhoeffD = [10][10]int{
+	{0, 1, 2, 3, 4, 5, 6, 7, 8, 9},
+	{1, 2, 3, 4, 0, 6, 7, 8, 9, 5},
+	{2, 3, 4, 0, 1, 7, 8, 9, 5, 6},
+	{3, 4, 0, 1, 2, 8, 9, 5, 6, 7},
+	{4, 0, 1, 2, 3, 9, 5, 6, 7, 8},
+	{5, 9, 8, 7, 6, 0, 4, 3, 2, 1},
+	{6, 5, 9, 8, 7, 1, 0, 4, 3, 2},
+	{7, 6, 5, 9, 8, 2, 1, 0, 4, 3},
+	{8, 7, 6, 5, 9, 3, 2, 1, 0, 4},
+	{9, 8, 7, 6, 5, 4, 3, 2, 1, 0},
+}
+
+// verhoeffP, konuma bağlı permütasyon tablosudur.
+var verhoeffP = [8][10]int{
+	{0, 1, 2, 3, 4, 5, 6, 7, 8, 9},
+	{1, 5, 7, 6, 2, 8, 3, 0, 9, 4},
+	{5, 8, 0, 3, 7, 9, 6, 1, 4, 2},
+	{8, 9, 1, 6, 0, 4, 3, 5, 2, 7},
+	{9, 4, 5, 3, 1, 2, 6, 8, 7, 0},
+	{4, 2, 8, 6, 5, 7, 3, 9, 0, 1},
+	{2, 7, 9, 3, 8, 0, 6, 4, 1, 5},
+	{7, 0, 4, 6, 9, 1, 3, 2, 5, 8},
+}
+
+// Verhoeff, değerin Verhoeff kontrol basamağını doğrular.
+func Verhoeff(value string) bool {
+	digits, ok := checksumDigits(value)
+	if !ok || len(digits) < 2 {
+		return false
+	}
+	c := 0
+	for i := 0; i < len(digits); i++ {
+		c = verhoeffD[c][verhoeffP[i%8][digits[len(digits)-1-i]]]
+	}
+	return c == 0
+}
+
+// dammTable, Damm algoritmasının 10. dereceden zayıf tamamen
+// antisimetrik quasigroup tablosudur.
+var dammTable = [10][10]int{
+	{0, 3, 1, 7, 5, 9, 8, 6, 4, 2},
+	{7, 0, 9, 2, 1, 5, 4, 8, 6, 3},
+	{4, 2, 0, 6, 8, 7, 1, 3, 5, 9},
+	{1, 7, 5, 0, 9, 8, 3, 4, 2, 6},
+	{6, 1, 2, 3, 0, 4, 5, 9, 7, 8},
+	{3, 6, 7, 4, 2, 0, 9, 5, 8, 1},
+	{5, 8, 6, 9, 7, 2, 0, 1, 3, 4},
+	{8, 9, 4, 5, 3, 6, 2, 0, 1, 7},
+	{9, 4, 3, 8, 6, 1, 7, 2, 0, 5},
+	{2, 5, 8, 1, 4, 3, 6, 7, 9, 0},
+}
+
+// Damm, değerin Damm kontrol basamağını doğrular.
+func Damm(value string) bool {
+	digits, ok := checksumDigits(value)
+	if !ok || len(digits) < 2 {
+		return false
+	}
+	interim := 0
+	for _, d := range digits {
+		interim = dammTable[interim][d]
+	}
+	return interim == 0
+}
+
+// Mod97, değerin ISO 7064 MOD 97-10 kontrolünü doğrular: harfler A=10 … Z=35
+// olarak açılır ve elde edilen sayının 97'ye bölümünden kalan 1 olmalıdır.
+// IBAN'larda ülke kodu ve kontrol basamakları önceden sona taşınmalıdır.
+func Mod97(value string) bool {
+	remainder, count := 0, 0
+	for _, r := range strings.ToUpper(value) {
+		switch {
+		case r == ' ' || r == '-':
+			continue
+		case r >= '0' && r <= '9':
+			remainder = (remainder*10 + int(r-'0')) % 97
+		case r >= 'A' && r <= 'Z':
+			remainder = (remainder*100 + int(r-'A'+10)) % 97
+		default:
+			return false
+		}
+		count++
+	}
+	return count >= 3 && remainder == 1
+}
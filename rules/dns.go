@@ -0,0 +1,162 @@
+package rules
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+	"sync"
+	"time"
+)
+
+//
+// -----------------------------------------------------------------------------
+// DNS Kaydı Kontrolleri
+// -----------------------------------------------------------------------------
+// Müşteriye ait alan adlarını doğrulayan kayıt akışlarında, alan adının DNS'te
+// gerçekten var olduğunu, e-posta kabul ettiğini (MX) veya sahiplik doğrulama
+// kaydını (TXT, örn. "acme-verify=...") yayımladığını denetler.
+//
+// Sorgular context'e duyarlıdır ve varsayılan olarak net.DefaultResolver ile
+// yapılır; SetDNSResolver ile özel bir çözümleyici (ve testlerde sahte bir
+// çözümleyici) verilebilir. Başarılı sonuçlar ve "kayıt yok" yanıtları
+// DNSCacheTTL süresince önbelleğe alınır; geçici hatalar (zaman aşımı,
+// sunucu hatası) önbelleğe alınmaz.
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// ErrNoDNSRecord, istenen DNS kaydının bulunmadığını belirtir.
+var ErrNoDNSRecord = errors.New("dns: kayıt bulunamadı")
+
+// DNSResolver, DNS kontrollerinin kullandığı çözümleyicidir. *net.Resolver bu
+// arayüzü karşılar.
+type DNSResolver interface {
+	LookupHost(ctx context.Context, host string) ([]string, error)
+	LookupMX(ctx context.Context, name string) ([]*net.MX, error)
+	LookupTXT(ctx context.Context, name string) ([]string, error)
+}
+
+// DNSCacheTTL, DNS sonuçlarının önbellekte tutulma süresidir. 0 önbelleği
+// devre dışı bırakır.
+var DNSCacheTTL = 5 * time.Minute
+
+var (
+	dnsMu       sync.RWMutex
+	dnsResolver DNSResolver = net.DefaultResolver
+	dnsCache                = map[string]dnsCacheEntry{}
+)
+
+// dnsCacheEntry, bir sorgunun önbellekteki sonucudur.
+type dnsCacheEntry struct {
+	records []string
+	expires time.Time
+}
+
+// SetDNSResolver, DNS kontrollerinde kullanılacak çözümleyiciyi değiştirir ve
+// önbelleği temizler. nil verilirse net.DefaultResolver kullanılır.
+func SetDNSResolver(r DNSResolver) {
+	if r == nil {
+		r = net.DefaultResolver
+	}
+	dnsMu.Lock()
+	dnsResolver = r
+	dnsCache = map[string]dnsCacheEntry{}
+	dnsMu.Unlock()
+}
+
+// ClearDNSCache, önbelleğe alınmış DNS sonuçlarını siler.
+func ClearDNSCache() {
+	dnsMu.Lock()
+	dnsCache = map[string]dnsCacheEntry{}
+	dnsMu.Unlock()
+}
+
+// DomainResolvable, alan adının en az bir adrese (A/AAAA) çözüldüğünü denetler.
+func DomainResolvable(ctx context.Context, domain string) error {
+	_, err := lookupDNS(ctx, "host", domain, func(r DNSResolver, name string) ([]string, error) {
+		return r.LookupHost(ctx, name)
+	})
+	return err
+}
+
+// HasMX, alan adının e-posta kabul eden en az bir MX kaydı olduğunu denetler.
+// RFC 7505 "null MX" kaydı (".") e-posta kabul edilmediği anlamına gelir.
+func HasMX(ctx context.Context, domain string) error {
+	_, err := lookupDNS(ctx, "mx", domain, func(r DNSResolver, name string) ([]string, error) {
+		records, err := r.LookupMX(ctx, name)
+		var hosts []string
+		for _, mx := range records {
+			if host := strings.TrimSuffix(mx.Host, "."); host != "" {
+				hosts = append(hosts, host)
+			}
+		}
+		return hosts, err
+	})
+	return err
+}
+
+// HasTXT, alan adının prefix ile başlayan bir TXT kaydı yayımladığını denetler
+// (örn. "acme-verify=8f3a..."). prefix boşsa herhangi bir TXT kaydı yeterlidir.
+//
+// Örnek:
+//
+//	err := rules.HasTXT(ctx, "example.com", "acme-verify="+token)
+func HasTXT(ctx context.Context, domain, prefix string) error {
+	records, err := lookupDNS(ctx, "txt", domain, func(r DNSResolver, name string) ([]string, error) {
+		return r.LookupTXT(ctx, name)
+	})
+	if err != nil {
+		return err
+	}
+	for _, txt := range records {
+		if strings.HasPrefix(txt, prefix) {
+			return nil
+		}
+	}
+	return ErrNoDNSRecord
+}
+
+// lookupDNS, sorguyu önbellek üzerinden çalıştırır. Kayıt yoksa
+// ErrNoDNSRecord döner.
+func lookupDNS(ctx context.Context, kind, domain string, query func(DNSResolver, string) ([]string, error)) ([]string, error) {
+	name := strings.TrimSuffix(strings.ToLower(strings.TrimSpace(domain)), ".")
+	if name == "" {
+		return nil, ErrNoDNSRecord
+	}
+	key := kind + ":" + name
+
+	dnsMu.RLock()
+	entry, cached := dnsCache[key]
+	resolver := dnsResolver
+	dnsMu.RUnlock()
+	if cached && time.Now().Before(entry.expires) {
+		if len(entry.records) == 0 {
+			return nil, ErrNoDNSRecord
+		}
+		return entry.records, nil
+	}
+
+	records, err := query(resolver, name)
+	var dnsErr *net.DNSError
+	switch {
+	case err != nil && !(errors.As(err, &dnsErr) && dnsErr.IsNotFound):
+		return nil, err
+	case err != nil:
+		records = nil
+	}
+
+	if DNSCacheTTL > 0 {
+		dnsMu.Lock()
+		dnsCache[key] = dnsCacheEntry{records: records, expires: time.Now().Add(DNSCacheTTL)}
+		dnsMu.Unlock()
+	}
+	if len(records) == 0 {
+		return nil, ErrNoDNSRecord
+	}
+	return records, nil
+}
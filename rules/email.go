@@ -0,0 +1,62 @@
+package rules
+
+import "strings"
+
+//
+// -----------------------------------------------------------------------------
+// E-posta Adresi Parçaları
+// -----------------------------------------------------------------------------
+// E-posta adreslerini yerel kısım (local part) ve alan adı (domain) olarak
+// ayıran, alan adını normalize eden ve alan adı listeleriyle eşleştiren
+// yardımcılar. Kurumsal kayıt akışlarındaki izinli/yasaklı alan adı
+// kontrolleri (validation.Email().DomainIn(...)) bunlar üzerine kuruludur.
+//
+// Yerel kısım RFC 5321 gereği büyük/küçük harf duyarlı kabul edilir ve
+// değiştirilmez; alan adı ise her zaman küçük harfe çevrilir.
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// SplitEmail, adresi son "@" işaretinden yerel kısım ve alan adı olarak ayırır.
+// İki parçadan biri boşsa ok false döner.
+//
+// Örnek:
+//
+//	local, domain, ok := rules.SplitEmail("Ahmet.Altun@Example.COM") // "Ahmet.Altun", "Example.COM", true
+func SplitEmail(address string) (local, domain string, ok bool) {
+	at := strings.LastIndex(address, "@")
+	if at <= 0 || at == len(address)-1 {
+		return "", "", false
+	}
+	return address[:at], address[at+1:], true
+}
+
+// NormalizeEmail, adresin baş/son boşluklarını temizler ve alan adını küçük
+// harfe çevirir. Geçerli bir adres değilse yalnızca boşluklar temizlenir.
+func NormalizeEmail(address string) string {
+	address = strings.TrimSpace(address)
+	local, domain, ok := SplitEmail(address)
+	if !ok {
+		return address
+	}
+	return local + "@" + strings.ToLower(domain)
+}
+
+// EmailDomainMatches, domain'in listedeki alan adlarından biri veya onların
+// bir alt alan adı olup olmadığını döndürür ("example.com" girdisi
+// "mail.example.com" ile de eşleşir). Karşılaştırma büyük/küçük harf
+// duyarsızdır.
+func EmailDomainMatches(domain string, domains []string) bool {
+	domain = strings.TrimSuffix(strings.ToLower(domain), ".")
+	for _, d := range domains {
+		d = strings.TrimPrefix(strings.ToLower(d), "@")
+		if domain == d || strings.HasSuffix(domain, "."+d) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,142 @@
+package rules
+
+import (
+	_ "embed"
+	"sort"
+	"strings"
+	"sync"
+)
+
+//
+// -----------------------------------------------------------------------------
+// Ücretsiz E-posta Sağlayıcıları ve Rol Hesapları
+// -----------------------------------------------------------------------------
+// B2B form ve müşteri adayı (lead) toplama akışlarında kişisel e-posta
+// sağlayıcılarından (gmail.com, outlook.com ...) ve kişiye ait olmayan rol
+// hesaplarından (admin@, info@, noreply@ ...) gelen adresleri tespit eder.
+//
+// Listeler data/ dizinindeki düz metin dosyalarından derleme anında gömülür;
+// güncellemek için dosyaya satır eklemek yeterlidir. Uygulamalar listeleri
+// çalışma zamanında Set*/Add* fonksiyonlarıyla değiştirebilir veya
+// genişletebilir.
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+//go:embed data/free_email_providers.txt
+var freeEmailProvidersData string
+
+//go:embed data/role_accounts.txt
+var roleAccountsData string
+
+var (
+	emailListsMu       sync.RWMutex
+	freeEmailProviders = parseEmailList(freeEmailProvidersData)
+	roleAccounts       = parseEmailList(roleAccountsData)
+)
+
+// parseEmailList, satır başına bir değer içeren listeyi küçük harfli bir
+// kümeye çevirir. Boş satırlar ve "#" yorumları atlanır.
+func parseEmailList(data string) map[string]bool {
+	set := make(map[string]bool)
+	for _, line := range strings.Split(data, "\n") {
+		line = strings.ToLower(strings.TrimSpace(line))
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		set[line] = true
+	}
+	return set
+}
+
+// IsFreeEmailProvider, domain'in (veya üst alan adlarından birinin) ücretsiz
+// e-posta sağlayıcıları listesinde olup olmadığını döndürür.
+func IsFreeEmailProvider(domain string) bool {
+	domain = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(domain)), ".")
+	emailListsMu.RLock()
+	defer emailListsMu.RUnlock()
+	for domain != "" {
+		if freeEmailProviders[domain] {
+			return true
+		}
+		dot := strings.Index(domain, ".")
+		if dot < 0 {
+			break
+		}
+		domain = domain[dot+1:]
+	}
+	return false
+}
+
+// IsRoleAccount, yerel kısmın bir rol hesabı olup olmadığını döndürür.
+// "+etiket" ekleri yok sayılır ("support+eu" → "support").
+func IsRoleAccount(local string) bool {
+	local = strings.ToLower(strings.TrimSpace(local))
+	if plus := strings.Index(local, "+"); plus >= 0 {
+		local = local[:plus]
+	}
+	emailListsMu.RLock()
+	defer emailListsMu.RUnlock()
+	return roleAccounts[local]
+}
+
+// SetFreeEmailProviders, gömülü ücretsiz sağlayıcı listesini domains ile
+// değiştirir.
+func SetFreeEmailProviders(domains []string) {
+	emailListsMu.Lock()
+	defer emailListsMu.Unlock()
+	freeEmailProviders = parseEmailList(strings.Join(domains, "\n"))
+}
+
+// AddFreeEmailProviders, ücretsiz sağlayıcı listesine alan adları ekler.
+func AddFreeEmailProviders(domains ...string) {
+	emailListsMu.Lock()
+	defer emailListsMu.Unlock()
+	for domain := range parseEmailList(strings.Join(domains, "\n")) {
+		freeEmailProviders[domain] = true
+	}
+}
+
+// FreeEmailProviders, geçerli ücretsiz sağlayıcı listesini sıralı döndürür.
+func FreeEmailProviders() []string {
+	emailListsMu.RLock()
+	defer emailListsMu.RUnlock()
+	return sortedSet(freeEmailProviders)
+}
+
+// SetRoleAccounts, gömülü rol hesabı listesini names ile değiştirir.
+func SetRoleAccounts(names []string) {
+	emailListsMu.Lock()
+	defer emailListsMu.Unlock()
+	roleAccounts = parseEmailList(strings.Join(names, "\n"))
+}
+
+// AddRoleAccounts, rol hesabı listesine yerel kısımlar ekler.
+func AddRoleAccounts(names ...string) {
+	emailListsMu.Lock()
+	defer emailListsMu.Unlock()
+	for name := range parseEmailList(strings.Join(names, "\n")) {
+		roleAccounts[name] = true
+	}
+}
+
+// RoleAccounts, geçerli rol hesabı listesini sıralı döndürür.
+func RoleAccounts() []string {
+	emailListsMu.RLock()
+	defer emailListsMu.RUnlock()
+	return sortedSet(roleAccounts)
+}
+
+// sortedSet, kümenin elemanlarını sıralı bir dilim olarak döndürür.
+func sortedSet(set map[string]bool) []string {
+	out := make([]string, 0, len(set))
+	for v := range set {
+		out = append(out, v)
+	}
+	sort.Strings(out)
+	return out
+}
@@ -0,0 +1,85 @@
+package rules
+
+import (
+	"io"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"strings"
+)
+
+//
+// -----------------------------------------------------------------------------
+// Dosya Yükleme Kuralları
+// -----------------------------------------------------------------------------
+// Multipart formlarla yüklenen dosyaların türünü ve uzantısını denetleyen
+// yardımcı fonksiyonları içerir. MIME türü istemcinin gönderdiği
+// Content-Type başlığından değil, dosyanın ilk 512 baytından
+// (http.DetectContentType) belirlenir; böylece uzantısı veya başlığı
+// değiştirilmiş dosyalar yakalanır.
+//
+//	rules.MatchMIME("image/png", []string{"image/*"})          // true
+//	rules.HasExtension("rapor.PDF", []string{"pdf", ".docx"})  // true
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// SniffLength, MIME türü tespiti için okunan en fazla bayt sayısıdır.
+const SniffLength = 512
+
+// SniffMIME, r'nin ilk SniffLength baytından içerik türünü (parametresiz, örn.
+// "image/png") tespit eder. Tanınmayan içerik "application/octet-stream" olur.
+func SniffMIME(r io.Reader) (string, error) {
+	buf := make([]byte, SniffLength)
+	n, err := io.ReadFull(r, buf)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return "", err
+	}
+	mediaType, _, err := mime.ParseMediaType(http.DetectContentType(buf[:n]))
+	if err != nil {
+		return "", err
+	}
+	return mediaType, nil
+}
+
+// MatchMIME, mediaType'ın allowed listesindeki türlerden biriyle eşleşip
+// eşleşmediğini döndürür. "image/*" gibi alt tür jokerleri ve
+// "application/*+json" gibi yapısal son ek jokerleri desteklenir;
+// karşılaştırma büyük/küçük harf duyarsızdır.
+func MatchMIME(mediaType string, allowed []string) bool {
+	mediaType = strings.ToLower(mediaType)
+	for _, a := range allowed {
+		a = strings.ToLower(strings.TrimSpace(a))
+		if a == mediaType || a == "*/*" {
+			return true
+		}
+		if prefix, ok := strings.CutSuffix(a, "/*"); ok && strings.HasPrefix(mediaType, prefix+"/") {
+			return true
+		}
+		if prefix, suffix, ok := strings.Cut(a, "/*+"); ok && strings.HasPrefix(mediaType, prefix+"/") &&
+			strings.HasSuffix(mediaType, "+"+suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// HasExtension, dosya adının uzantısının allowed listesinde olup olmadığını
+// döndürür. Uzantılar noktalı veya noktasız verilebilir; karşılaştırma
+// büyük/küçük harf duyarsızdır.
+func HasExtension(filename string, allowed []string) bool {
+	ext := strings.TrimPrefix(strings.ToLower(filepath.Ext(filename)), ".")
+	if ext == "" {
+		return false
+	}
+	for _, a := range allowed {
+		if strings.TrimPrefix(strings.ToLower(a), ".") == ext {
+			return true
+		}
+	}
+	return false
+}
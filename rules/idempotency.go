@@ -0,0 +1,70 @@
+package rules
+
+import (
+	"context"
+	"strings"
+)
+
+//
+// -----------------------------------------------------------------------------
+// Idempotency-Key Kuralları
+// -----------------------------------------------------------------------------
+// Ödeme tarzı API'lerde istemcinin aynı isteği güvenle tekrar gönderebilmesi
+// için kullanılan Idempotency-Key başlığının biçimini denetler. Anahtarın
+// tahmin edilemez ve çakışmaz olması için UUID (RFC 9562, tüm sürümler) veya
+// ULID biçimi beklenir; uzunluk IdempotencyKeyMaxLength ile sınırlanır.
+//
+// Anahtarın daha önce kullanılıp kullanılmadığı uygulamanın deposuna bağlıdır;
+// bu kontrol IdempotencyChecker ile şemanın dış kaynaklı (asenkron) kontrol
+// adımında yapılır.
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// IdempotencyKeyMaxLength, bir idempotency anahtarının varsayılan en büyük
+// uzunluğudur.
+const IdempotencyKeyMaxLength = 255
+
+// crockfordBase32, ULID'lerde kullanılan Crockford Base32 alfabesidir
+// (I, L, O ve U hariç).
+const crockfordBase32 = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// IdempotencyChecker, anahtarın daha önce (farklı bir istekle) kullanılıp
+// kullanılmadığını döndürür. Hata dönerse anahtar reddedilir.
+type IdempotencyChecker func(ctx context.Context, key string) (used bool, err error)
+
+// IsValidULID, değerin 26 karakterlik bir ULID olup olmadığını döndürür.
+// Büyük/küçük harf duyarsızdır; ilk karakter 48 bitlik zaman damgası sınırı
+// nedeniyle en fazla "7" olabilir.
+func IsValidULID(value string) bool {
+	if len(value) != 26 {
+		return false
+	}
+	value = strings.ToUpper(value)
+	if value[0] > '7' {
+		return false
+	}
+	for i := 0; i < len(value); i++ {
+		if strings.IndexByte(crockfordBase32, value[i]) < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// IsValidIdempotencyKey, değerin en fazla maxLength karakterlik bir UUID veya
+// ULID olup olmadığını döndürür. maxLength 0 ise IdempotencyKeyMaxLength
+// kullanılır.
+func IsValidIdempotencyKey(value string, maxLength int) bool {
+	if maxLength <= 0 {
+		maxLength = IdempotencyKeyMaxLength
+	}
+	if len(value) > maxLength {
+		return false
+	}
+	return IsValidUUID(value, 0) || IsValidULID(value)
+}
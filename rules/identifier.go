@@ -0,0 +1,67 @@
+package rules
+
+import (
+	"regexp"
+	"strings"
+)
+
+//
+// -----------------------------------------------------------------------------
+// Yapısal Tanımlayıcı Kuralları
+// -----------------------------------------------------------------------------
+// UUID/MAC/IBAN dışında sık kullanılan yapısal tanımlayıcıların biçim
+// kontrollerini içerir:
+//   - E.164 uluslararası telefon numaraları (+905551112233)
+//   - W3C DID (Decentralized Identifier) değerleri (did:web:example.com)
+//   - RFC 8141 URN'leri (urn:isbn:0451450523)
+//   - Sabit uzunluklu sayısal tek kullanımlık kodlar (OTP)
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+var (
+	e164Pattern = regexp.MustCompile(`^\+[1-9][0-9]{1,14}$`)
+	// did:<method>:<method-specific-id>; id, ":" ile ayrılmış idchar dizileridir
+	// ve boş bir segmentle bitemez.
+	didPattern = regexp.MustCompile(`^did:[a-z0-9]+:(?:(?:[A-Za-z0-9._-]|%[0-9A-Fa-f]{2})*:)*(?:[A-Za-z0-9._-]|%[0-9A-Fa-f]{2})+$`)
+	// urn:<NID>:<NSS>[?+r][?=q][#f] (RFC 8141)
+	urnPattern = regexp.MustCompile(`^(?i:urn):([A-Za-z0-9][A-Za-z0-9-]{0,30}[A-Za-z0-9]):((?:[A-Za-z0-9\-._~!$&'()*+,;=:@/]|%[0-9A-Fa-f]{2})+)(?:\?\+[^?#]+)?(?:\?=[^#]+)?(?:#.*)?$`)
+)
+
+// IsValidE164, değerin E.164 biçiminde ("+" ve en fazla 15 hane) bir telefon
+// numarası olup olmadığını döndürür.
+func IsValidE164(value string) bool {
+	return e164Pattern.MatchString(value)
+}
+
+// IsValidDID, değerin W3C DID Core söz dizimine uygun bir merkeziyetsiz
+// tanımlayıcı olup olmadığını döndürür. DID URL bileşenleri (path, query,
+// fragment) kabul edilmez.
+func IsValidDID(value string) bool {
+	return didPattern.MatchString(value)
+}
+
+// IsValidURN, değerin RFC 8141'e uygun bir URN olup olmadığını döndürür.
+// "urn" NID'si ayrılmış olduğundan reddedilir.
+func IsValidURN(value string) bool {
+	m := urnPattern.FindStringSubmatch(value)
+	return m != nil && !strings.EqualFold(m[1], "urn")
+}
+
+// IsValidOTPCode, değerin tam olarak length haneden oluşan sayısal bir kod
+// olup olmadığını döndürür.
+func IsValidOTPCode(value string, length int) bool {
+	if len(value) != length {
+		return false
+	}
+	for _, r := range value {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,147 @@
+package rules
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"image"
+	_ "image/gif"  // GIF başlık çözücüsü
+	_ "image/jpeg" // JPEG başlık çözücüsü
+	_ "image/png"  // PNG başlık çözücüsü
+	"io"
+	"math"
+	"strings"
+)
+
+//
+// -----------------------------------------------------------------------------
+// Görsel Başlık Çözümleme
+// -----------------------------------------------------------------------------
+// Yüklenen görsellerin biçimini ve boyutlarını piksel verisini çözmeden,
+// yalnızca dosya başlığını okuyarak tespit eder. Böylece boyut ve megapiksel
+// sınırları, bellekte açılması tehlikeli "decompression bomb" dosyaları
+// açılmadan uygulanabilir.
+//
+// PNG, JPEG ve GIF için standart kütüphanenin image.DecodeConfig'i kullanılır;
+// WebP (VP8, VP8L ve VP8X) başlıkları harici bağımlılık olmadan burada
+// ayrıştırılır.
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// Desteklenen görsel biçimleri.
+const (
+	ImagePNG  = "png"
+	ImageJPEG = "jpeg"
+	ImageGIF  = "gif"
+	ImageWebP = "webp"
+)
+
+// AspectRatioTolerance, en-boy oranı karşılaştırmalarında kabul edilen göreli
+// sapmadır (%1); yeniden boyutlandırmadan kaynaklanan piksel yuvarlamalarını
+// tolere eder.
+const AspectRatioTolerance = 0.01
+
+// ErrImageFormat, içeriğin tanınan bir görsel biçiminde olmadığını belirtir.
+var ErrImageFormat = errors.New("image: tanınmayan görsel biçimi")
+
+// ImageConfig, görselin başlığından okunan bilgilerdir.
+type ImageConfig struct {
+	Format string
+	Width  int
+	Height int
+}
+
+// Megapixels, görselin milyon piksel cinsinden alanını döndürür.
+func (c ImageConfig) Megapixels() float64 {
+	return float64(c.Width) * float64(c.Height) / 1e6
+}
+
+// DecodeImageConfig
+// -----------------------------------------------------------------------------
+// r'den yalnızca görsel başlığını okuyarak biçimi ve boyutları döndürür.
+//
+// Örnek:
+//
+//	cfg, err := rules.DecodeImageConfig(file)
+//	// cfg.Format == "png", cfg.Width == 800, cfg.Height == 600
+func DecodeImageConfig(r io.Reader) (ImageConfig, error) {
+	br := bufio.NewReader(r)
+	if header, err := br.Peek(12); err == nil && string(header[:4]) == "RIFF" && string(header[8:]) == "WEBP" {
+		return decodeWebPConfig(br)
+	}
+	cfg, format, err := image.DecodeConfig(br)
+	if err != nil {
+		if errors.Is(err, image.ErrFormat) {
+			return ImageConfig{}, ErrImageFormat
+		}
+		return ImageConfig{}, err
+	}
+	return ImageConfig{Format: format, Width: cfg.Width, Height: cfg.Height}, nil
+}
+
+// NormalizeImageFormat, biçim adını karşılaştırma için normalleştirir ("JPG"
+// → "jpeg").
+func NormalizeImageFormat(format string) string {
+	format = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(format), "."))
+	if format == "jpg" {
+		return ImageJPEG
+	}
+	return format
+}
+
+// MatchAspectRatio, width:height oranının ratioW:ratioH oranına
+// AspectRatioTolerance içinde eşit olup olmadığını döndürür.
+func MatchAspectRatio(width, height, ratioW, ratioH int) bool {
+	if width <= 0 || height <= 0 || ratioW <= 0 || ratioH <= 0 {
+		return false
+	}
+	actual := float64(width) / float64(height)
+	expected := float64(ratioW) / float64(ratioH)
+	return math.Abs(actual-expected)/expected <= AspectRatioTolerance
+}
+
+// decodeWebPConfig, RIFF/WEBP kabındaki ilk chunk'tan boyutları okur.
+func decodeWebPConfig(r io.Reader) (ImageConfig, error) {
+	var header [30]byte
+	if _, err := io.ReadFull(r, header[:20]); err != nil {
+		return ImageConfig{}, ErrImageFormat
+	}
+	cfg := ImageConfig{Format: ImageWebP}
+	chunk := header[12:16]
+	switch string(chunk) {
+	case "VP8 ":
+		// frame tag (3) + start code 9d 01 2a + 14 bit genişlik/yükseklik
+		if _, err := io.ReadFull(r, header[20:30]); err != nil ||
+			header[23] != 0x9d || header[24] != 0x01 || header[25] != 0x2a {
+			return ImageConfig{}, ErrImageFormat
+		}
+		cfg.Width = int(binary.LittleEndian.Uint16(header[26:28]) & 0x3fff)
+		cfg.Height = int(binary.LittleEndian.Uint16(header[28:30]) & 0x3fff)
+	case "VP8L":
+		// imza 0x2f + 14 bit (genişlik-1) + 14 bit (yükseklik-1)
+		if _, err := io.ReadFull(r, header[20:25]); err != nil || header[20] != 0x2f {
+			return ImageConfig{}, ErrImageFormat
+		}
+		bits := binary.LittleEndian.Uint32(header[21:25])
+		cfg.Width = int(bits&0x3fff) + 1
+		cfg.Height = int(bits>>14&0x3fff) + 1
+	case "VP8X":
+		// bayraklar (1) + ayrılmış (3) + 24 bit (genişlik-1) + 24 bit (yükseklik-1)
+		if _, err := io.ReadFull(r, header[20:30]); err != nil {
+			return ImageConfig{}, ErrImageFormat
+		}
+		cfg.Width = int(uint32(header[24])|uint32(header[25])<<8|uint32(header[26])<<16) + 1
+		cfg.Height = int(uint32(header[27])|uint32(header[28])<<8|uint32(header[29])<<16) + 1
+	default:
+		return ImageConfig{}, ErrImageFormat
+	}
+	if cfg.Width == 0 || cfg.Height == 0 {
+		return ImageConfig{}, ErrImageFormat
+	}
+	return cfg, nil
+}
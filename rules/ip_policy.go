@@ -0,0 +1,107 @@
+package rules
+
+import (
+	"errors"
+	"net/netip"
+	"strings"
+)
+
+//
+// -----------------------------------------------------------------------------
+// IP Adresi Politikaları
+// -----------------------------------------------------------------------------
+// Geri çağırma (callback/webhook) adresi veya sunucu IP'si kabul eden API'lerde
+// iç ağa yönelen (SSRF'e açık) hedefleri doğrulama sırasında reddetmek için
+// kullanılır. IPPolicy, ayrıştırılmış bir netip.Addr alır ve adres kabul
+// edilmiyorsa hata döner; coğrafi konum veya ASN kontrolleri gibi uygulamaya
+// özel kurallar da aynı imzayla yazılabilir.
+//
+// IPv4 eşlemeli IPv6 adresleri (::ffff:10.0.0.1) kontrol öncesi IPv4'e
+// çevrilir; böylece özel adresler bu biçimle gizlenemez.
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// IP politikası hataları.
+var (
+	ErrPrivateIP   = errors.New("özel ağ adresi kabul edilmiyor")
+	ErrLoopbackIP  = errors.New("geri döngü (loopback) adresi kabul edilmiyor")
+	ErrNonPublicIP = errors.New("genel (public) olmayan adres kabul edilmiyor")
+)
+
+// IPPolicy, bir IP adresinin kabul edilip edilmediğine karar veren
+// fonksiyondur. Adres kabul edilmiyorsa hata döner.
+type IPPolicy func(ip netip.Addr) error
+
+// nonPublicPrefixes, IsPrivate/IsLoopback gibi yerleşik kontrollerin
+// kapsamadığı, genel internette yönlendirilmeyen özel amaçlı bloklardır.
+var nonPublicPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),       // "bu ağ"
+	netip.MustParsePrefix("100.64.0.0/10"),   // CGNAT (RFC 6598)
+	netip.MustParsePrefix("192.0.0.0/24"),    // IETF protokol atamaları
+	netip.MustParsePrefix("192.0.2.0/24"),    // TEST-NET-1
+	netip.MustParsePrefix("198.18.0.0/15"),   // kıyaslama testleri
+	netip.MustParsePrefix("198.51.100.0/24"), // TEST-NET-2
+	netip.MustParsePrefix("203.0.113.0/24"),  // TEST-NET-3
+	netip.MustParsePrefix("240.0.0.0/4"),     // ayrılmış
+	netip.MustParsePrefix("64:ff9b::/96"),    // NAT64
+	netip.MustParsePrefix("100::/64"),        // discard
+	netip.MustParsePrefix("2001:db8::/32"),   // dokümantasyon
+}
+
+// ParseIPAddr, s'yi netip.Addr olarak ayrıştırır ve IPv4 eşlemeli IPv6
+// adreslerini IPv4'e çevirir. Köşeli parantezli IPv6 ("[::1]") kabul edilir.
+func ParseIPAddr(s string) (netip.Addr, bool) {
+	s = strings.TrimSuffix(strings.TrimPrefix(strings.TrimSpace(s), "["), "]")
+	ip, err := netip.ParseAddr(s)
+	if err != nil {
+		return netip.Addr{}, false
+	}
+	return ip.Unmap(), true
+}
+
+// IsPublicIP, adresin genel internette yönlendirilebilir bir tekil (unicast)
+// adres olup olmadığını döndürür. Özel, loopback, link-local (169.254.0.0/16
+// bulut metadata adresi dahil), multicast, belirtilmemiş ve özel amaçlı
+// bloklar false döner.
+func IsPublicIP(ip netip.Addr) bool {
+	ip = ip.Unmap()
+	if !ip.IsValid() || !ip.IsGlobalUnicast() || ip.IsPrivate() {
+		return false
+	}
+	for _, prefix := range nonPublicPrefixes {
+		if prefix.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// NotPrivateIP, özel ağ adreslerini (10/8, 172.16/12, 192.168/16, fc00::/7)
+// reddeden IPPolicy'dir.
+func NotPrivateIP(ip netip.Addr) error {
+	if ip.Unmap().IsPrivate() {
+		return ErrPrivateIP
+	}
+	return nil
+}
+
+// NotLoopbackIP, loopback adreslerini (127/8, ::1) reddeden IPPolicy'dir.
+func NotLoopbackIP(ip netip.Addr) error {
+	if ip.Unmap().IsLoopback() {
+		return ErrLoopbackIP
+	}
+	return nil
+}
+
+// PublicIPOnly, IsPublicIP false dönen tüm adresleri reddeden IPPolicy'dir.
+func PublicIPOnly(ip netip.Addr) error {
+	if !IsPublicIP(ip) {
+		return ErrNonPublicIP
+	}
+	return nil
+}
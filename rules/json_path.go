@@ -0,0 +1,418 @@
+package rules
+
+import (
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+//
+// -----------------------------------------------------------------------------
+// JSON Pointer (RFC 6901) ve JSONPath (RFC 9535) Sözdizimi Doğrulaması
+// -----------------------------------------------------------------------------
+// Webhook filtreleri ve JSON Patch işlemleri gibi başka bir dokümana ifadeyle
+// başvuran yüklerde, ifadenin sözdizimini doğrulayan fonksiyonları içerir.
+// Yalnızca sözdizimi denetlenir; ifade herhangi bir dokümana uygulanmaz.
+//
+//	/users/0/name          → JSON Pointer
+//	/a~1b/m~0n             → "a/b" ve "m~n" anahtarları
+//	$.store.book[?@.price < 10].title
+//	$..author
+//
+// JSONPath denetimi RFC 9535 dilbilgisini izler: kök "$", nokta ve köşeli
+// parantez gösterimleri, "..", joker "*", indeks, dilim (start:end:step),
+// tırnaklı adlar ve "?" filtre ifadeleri (karşılaştırma, &&, ||, !, parantez,
+// @/$ sorguları ve fonksiyon çağrıları). Fonksiyon tip denetimi yapılmaz.
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// maxJSONPathInt, RFC 9535'in I-JSON ile sınırladığı en büyük tamsayıdır.
+const maxJSONPathInt = 1<<53 - 1
+
+// IsValidJSONPointer, value'nun RFC 6901 JSON Pointer olup olmadığını
+// döndürür. Boş string tüm dokümanı gösterir; diğer değerler "/" ile başlamalı
+// ve "~" yalnızca "~0" veya "~1" olarak kullanılmalıdır.
+func IsValidJSONPointer(value string) bool {
+	if value == "" {
+		return true
+	}
+	if value[0] != '/' || !utf8.ValidString(value) {
+		return false
+	}
+	for i := 0; i < len(value); i++ {
+		if value[i] == '~' && (i+1 == len(value) || (value[i+1] != '0' && value[i+1] != '1')) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsValidJSONPath
+// -----------------------------------------------------------------------------
+// value'nun RFC 9535 JSONPath sorgusu olarak ayrıştırılabildiğini döndürür.
+//
+// Örnek:
+//
+//	rules.IsValidJSONPath("$.items[*].id")  // true
+//	rules.IsValidJSONPath("items[0]")       // false ($ ile başlamalı)
+func IsValidJSONPath(value string) bool {
+	if !utf8.ValidString(value) {
+		return false
+	}
+	p := &jsonPathParser{src: value}
+	if !p.eat('$') || !p.segments() {
+		return false
+	}
+	return p.pos == len(p.src)
+}
+
+// jsonPathParser, RFC 9535 için özyinelemeli iniş ayrıştırıcısıdır.
+type jsonPathParser struct {
+	src string
+	pos int
+}
+
+// peek, sıradaki baytı döndürür (sonda 0).
+func (p *jsonPathParser) peek() byte {
+	if p.pos < len(p.src) {
+		return p.src[p.pos]
+	}
+	return 0
+}
+
+// eat, sıradaki bayt c ise onu tüketir.
+func (p *jsonPathParser) eat(c byte) bool {
+	if p.peek() == c {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+// eatString, sıradaki girdi s ile başlıyorsa onu tüketir.
+func (p *jsonPathParser) eatString(s string) bool {
+	if strings.HasPrefix(p.src[p.pos:], s) {
+		p.pos += len(s)
+		return true
+	}
+	return false
+}
+
+// blank, boşluk karakterlerini atlar.
+func (p *jsonPathParser) blank() {
+	for p.pos < len(p.src) && strings.IndexByte(" \t\n\r", p.src[p.pos]) >= 0 {
+		p.pos++
+	}
+}
+
+// segments, sıfır veya daha fazla segmenti ayrıştırır. Sondaki boşluklar
+// segment olmadığı için tüketilmez.
+func (p *jsonPathParser) segments() bool {
+	for {
+		start := p.pos
+		p.blank()
+		switch p.peek() {
+		case '[':
+			if !p.bracketed() {
+				return false
+			}
+		case '.':
+			p.pos++
+			if p.eat('.') {
+				if p.peek() == '[' {
+					if !p.bracketed() {
+						return false
+					}
+					continue
+				}
+			}
+			if !p.eat('*') && !p.memberName() {
+				return false
+			}
+		default:
+			p.pos = start
+			return true
+		}
+	}
+}
+
+// memberName, nokta gösterimindeki kısa üye adını ayrıştırır.
+func (p *jsonPathParser) memberName() bool {
+	start := p.pos
+	for p.pos < len(p.src) {
+		r, size := utf8.DecodeRuneInString(p.src[p.pos:])
+		first := p.pos == start
+		if !(r == '_' || r >= 0x80 || (r|0x20 >= 'a' && r|0x20 <= 'z') || (!first && r >= '0' && r <= '9')) {
+			break
+		}
+		p.pos += size
+	}
+	return p.pos > start
+}
+
+// bracketed, "[" seçici *("," seçici) "]" yapısını ayrıştırır.
+func (p *jsonPathParser) bracketed() bool {
+	if !p.eat('[') {
+		return false
+	}
+	for {
+		p.blank()
+		if !p.selector() {
+			return false
+		}
+		p.blank()
+		if p.eat(']') {
+			return true
+		}
+		if !p.eat(',') {
+			return false
+		}
+	}
+}
+
+// selector, ad, joker, indeks, dilim veya filtre seçicisini ayrıştırır.
+func (p *jsonPathParser) selector() bool {
+	switch c := p.peek(); {
+	case c == '\'' || c == '"':
+		return p.stringLiteral()
+	case c == '*':
+		p.pos++
+		return true
+	case c == '?':
+		p.pos++
+		p.blank()
+		return p.logicalOr(false)
+	}
+
+	// indeks veya dilim: [start][:[end][:[step]]]
+	if p.peek() != ':' && !p.integer() {
+		return false
+	}
+	if !p.peekAfterBlank(':') {
+		return true
+	}
+	for i := 0; i < 2; i++ {
+		p.blank()
+		if !p.eat(':') {
+			break
+		}
+		p.blank()
+		if c := p.peek(); c == '-' || (c >= '0' && c <= '9') {
+			if !p.integer() {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// peekAfterBlank, boşluklardan sonra c gelip gelmediğini konumu değiştirmeden
+// döndürür.
+func (p *jsonPathParser) peekAfterBlank(c byte) bool {
+	start := p.pos
+	p.blank()
+	ok := p.peek() == c
+	p.pos = start
+	return ok
+}
+
+// integer, RFC 9535 tamsayısını ("0" veya başında sıfır olmayan, isteğe bağlı
+// eksi işaretli) I-JSON aralığında ayrıştırır.
+func (p *jsonPathParser) integer() bool {
+	start := p.pos
+	p.eat('-')
+	digits := p.pos
+	for c := p.peek(); c >= '0' && c <= '9'; c = p.peek() {
+		p.pos++
+	}
+	text := p.src[start:p.pos]
+	switch {
+	case p.pos == digits, text == "-0", p.src[digits] == '0' && p.pos-digits > 1:
+		return false
+	}
+	n, err := strconv.ParseInt(text, 10, 64)
+	return err == nil && n >= -maxJSONPathInt && n <= maxJSONPathInt
+}
+
+// stringLiteral, tek veya çift tırnaklı, kaçış dizileri içerebilen string
+// sabitini ayrıştırır.
+func (p *jsonPathParser) stringLiteral() bool {
+	quote := p.peek()
+	p.pos++
+	for p.pos < len(p.src) {
+		c := p.src[p.pos]
+		p.pos++
+		switch {
+		case c == quote:
+			return true
+		case c < 0x20:
+			return false
+		case c == '\\':
+			esc := p.peek()
+			p.pos++
+			switch {
+			case esc == quote, strings.IndexByte(`bfnrt/\`, esc) >= 0:
+			case esc == 'u':
+				if p.pos+4 > len(p.src) {
+					return false
+				}
+				if _, err := strconv.ParseUint(p.src[p.pos:p.pos+4], 16, 16); err != nil {
+					return false
+				}
+				p.pos += 4
+			default:
+				return false
+			}
+		}
+	}
+	return false
+}
+
+// logicalOr, "||" ile bağlanan mantıksal ifadeyi ayrıştırır. literal, fonksiyon
+// argümanlarında tek başına sabitlere izin verir.
+func (p *jsonPathParser) logicalOr(literal bool) bool {
+	for {
+		if !p.logicalAnd(literal) {
+			return false
+		}
+		start := p.pos
+		p.blank()
+		if !p.eatString("||") {
+			p.pos = start
+			return true
+		}
+		p.blank()
+	}
+}
+
+// logicalAnd, "&&" ile bağlanan temel ifadeleri ayrıştırır.
+func (p *jsonPathParser) logicalAnd(literal bool) bool {
+	for {
+		if !p.basicExpr(literal) {
+			return false
+		}
+		start := p.pos
+		p.blank()
+		if !p.eatString("&&") {
+			p.pos = start
+			return true
+		}
+		p.blank()
+	}
+}
+
+// basicExpr, parantezli ifade, karşılaştırma veya varlık testini ayrıştırır.
+func (p *jsonPathParser) basicExpr(literal bool) bool {
+	negated := p.eat('!')
+	if negated {
+		p.blank()
+	}
+	if p.eat('(') {
+		p.blank()
+		if !p.logicalOr(false) {
+			return false
+		}
+		p.blank()
+		return p.eat(')')
+	}
+
+	isLiteral, ok := p.comparable()
+	if !ok {
+		return false
+	}
+	start := p.pos
+	p.blank()
+	for _, op := range []string{"==", "!=", "<=", ">=", "<", ">"} {
+		if p.eatString(op) {
+			p.blank()
+			_, ok := p.comparable()
+			return ok && !negated
+		}
+	}
+	p.pos = start
+	return !isLiteral || (literal && !negated)
+}
+
+// comparable, sabit, sorgu (@ veya $) ya da fonksiyon çağrısını ayrıştırır.
+func (p *jsonPathParser) comparable() (isLiteral, ok bool) {
+	switch c := p.peek(); {
+	case c == '@' || c == '$':
+		p.pos++
+		return false, p.segments()
+	case c == '\'' || c == '"':
+		return true, p.stringLiteral()
+	case c == '-' || (c >= '0' && c <= '9'):
+		return true, p.number()
+	case p.eatString("true"), p.eatString("false"), p.eatString("null"):
+		return true, true
+	case c >= 'a' && c <= 'z':
+		return false, p.function()
+	}
+	return false, false
+}
+
+// number, JSON sayı sabitini ayrıştırır ("-0" dahil).
+func (p *jsonPathParser) number() bool {
+	start := p.pos
+	p.eat('-')
+	digits := p.pos
+	for c := p.peek(); c >= '0' && c <= '9'; c = p.peek() {
+		p.pos++
+	}
+	if p.pos == digits || (p.src[digits] == '0' && p.pos-digits > 1) {
+		return false
+	}
+	if p.eat('.') && !p.digits() {
+		return false
+	}
+	if p.eat('e') || p.eat('E') {
+		if !p.eat('-') {
+			p.eat('+')
+		}
+		if !p.digits() {
+			return false
+		}
+	}
+	return p.pos > start
+}
+
+// digits, en az bir rakam tüketir.
+func (p *jsonPathParser) digits() bool {
+	start := p.pos
+	for c := p.peek(); c >= '0' && c <= '9'; c = p.peek() {
+		p.pos++
+	}
+	return p.pos > start
+}
+
+// function, "ad(arg, ...)" biçimindeki fonksiyon çağrısını ayrıştırır.
+func (p *jsonPathParser) function() bool {
+	for c := p.peek(); (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9') || c == '_'; c = p.peek() {
+		p.pos++
+	}
+	if !p.eat('(') {
+		return false
+	}
+	p.blank()
+	if p.eat(')') {
+		return true
+	}
+	for {
+		if !p.logicalOr(true) {
+			return false
+		}
+		p.blank()
+		if p.eat(')') {
+			return true
+		}
+		if !p.eat(',') {
+			return false
+		}
+		p.blank()
+	}
+}
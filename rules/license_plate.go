@@ -0,0 +1,141 @@
+package rules
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+)
+
+//
+// -----------------------------------------------------------------------------
+// Araç Plakası Biçimleri
+// -----------------------------------------------------------------------------
+// Lojistik, otopark ve filo uygulamaları için ülkelere göre plaka biçimlerini
+// doğrular. Plaka karşılaştırılmadan önce boşluk ve tireler atılır ve harfler
+// büyütülür; "34 ABC 123", "34-abc-123" ve "34ABC123" aynı kabul edilir.
+//
+// Yerleşik tablolar:
+//
+//	TR  İl kodu (01–81) + 1 harf/4–5 hane, 2 harf/3–4 hane, 3 harf/2–3 hane
+//	DE  1–3 harf bölge + 1–2 harf + 1–4 hane, isteğe bağlı E/H son eki
+//	GB  2 harf + 2 hane yaş kodu + 3 harf (2001 sonrası biçim)
+//	FR  SIV biçimi: 2 harf + 3 hane + 2 harf (I, O, U kullanılmaz)
+//	IT  2 harf + 3 hane + 2 harf (I, O, Q, U kullanılmaz)
+//	ES  4 hane + 3 ünsüz (2000 sonrası biçim)
+//
+// Yalnızca biçim denetlenir; plakanın kayıtlı olduğu doğrulanmaz. Tabloda
+// olmayan ülkeler veya bölgesel biçimler (örn. "US-CA") RegisterLicensePlate
+// ile eklenebilir.
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// ErrUnknownLicensePlateCountry, plaka biçimi tanımlı olmayan bir ülke kodu
+// istendiğinde döner.
+var ErrUnknownLicensePlateCountry = errors.New("plaka biçimi tanımlı olmayan ülke")
+
+// trProvince, Türkiye il kodlarıdır (01–81).
+const trProvince = `(0[1-9]|[1-7][0-9]|8[01])`
+
+var (
+	licensePlatesMu sync.RWMutex
+	licensePlates   = map[string][]*regexp.Regexp{
+		"TR": {
+			regexp.MustCompile(`^` + trProvince + `[A-Z]\d{4,5}$`),
+			regexp.MustCompile(`^` + trProvince + `[A-Z]{2}\d{3,4}$`),
+			regexp.MustCompile(`^` + trProvince + `[A-Z]{3}\d{2,3}$`),
+		},
+		"DE": {regexp.MustCompile(`^[A-ZÄÖÜ]{1,3}[A-Z]{1,2}[1-9]\d{0,3}[EH]?$`)},
+		"GB": {regexp.MustCompile(`^[A-Z]{2}\d{2}[A-Z]{3}$`)},
+		"FR": {regexp.MustCompile(`^[A-HJ-NP-TV-Z]{2}\d{3}[A-HJ-NP-TV-Z]{2}$`)},
+		"IT": {regexp.MustCompile(`^[A-HJ-NPR-TV-Z]{2}\d{3}[A-HJ-NPR-TV-Z]{2}$`)},
+		"ES": {regexp.MustCompile(`^\d{4}[BCDFGHJKLMNPRSTVWXYZ]{3}$`)},
+	}
+)
+
+// NormalizeLicensePlate, plakadaki boşluk ve tireleri atar ve harfleri büyütür.
+func NormalizeLicensePlate(plate string) string {
+	return strings.ToUpper(strings.NewReplacer(" ", "", "-", "").Replace(strings.TrimSpace(plate)))
+}
+
+// LicensePlate
+// -----------------------------------------------------------------------------
+// country için plaka doğrulama fonksiyonunu döndürür. Fonksiyon, çağrı anında
+// kayıtlı olan biçimleri kullanır.
+//
+// Örnek:
+//
+//	valid, err := rules.LicensePlate("TR")
+//	ok := err == nil && valid("34 ABC 123")
+func LicensePlate(country string) (func(plate string) bool, error) {
+	licensePlatesMu.RLock()
+	patterns, ok := licensePlates[strings.ToUpper(country)]
+	licensePlatesMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownLicensePlateCountry, country)
+	}
+
+	return func(plate string) bool {
+		plate = NormalizeLicensePlate(plate)
+		for _, re := range patterns {
+			if re.MatchString(plate) {
+				return true
+			}
+		}
+		return false
+	}, nil
+}
+
+// IsValidLicensePlate, plate'in country için geçerli bir plaka biçiminde olup
+// olmadığını döndürür. Biçimi tanımlı olmayan ülkelerde false döner.
+func IsValidLicensePlate(country, plate string) bool {
+	valid, err := LicensePlate(country)
+	return err == nil && valid(plate)
+}
+
+// RegisterLicensePlate
+// -----------------------------------------------------------------------------
+// country için plaka biçimlerini tanımlar; mevcut biçimlerin yerine geçer.
+// Desenler normalize edilmiş plakayla (boşluksuz, tiresiz, büyük harf)
+// karşılaştırılır ve ^…$ ile tam eşleşme içermelidir.
+//
+// Örnek:
+//
+//	err := rules.RegisterLicensePlate("US-CA", `^\d[A-Z]{3}\d{3}$`)
+func RegisterLicensePlate(country string, patterns ...string) error {
+	if len(patterns) == 0 {
+		return fmt.Errorf("%s: en az bir plaka deseni gerekli", country)
+	}
+	compiled := make([]*regexp.Regexp, len(patterns))
+	for i, pattern := range patterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return fmt.Errorf("%s: geçersiz plaka deseni: %w", country, err)
+		}
+		compiled[i] = re
+	}
+
+	licensePlatesMu.Lock()
+	defer licensePlatesMu.Unlock()
+	licensePlates[strings.ToUpper(country)] = compiled
+	return nil
+}
+
+// LicensePlateCountries, plaka biçimi tanımlı ülke kodlarını sıralı döndürür.
+func LicensePlateCountries() []string {
+	licensePlatesMu.RLock()
+	defer licensePlatesMu.RUnlock()
+	countries := make([]string, 0, len(licensePlates))
+	for country := range licensePlates {
+		countries = append(countries, country)
+	}
+	sort.Strings(countries)
+	return countries
+}
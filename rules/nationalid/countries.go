@@ -0,0 +1,138 @@
+package nationalid
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// TCKN, Türkiye Cumhuriyeti Kimlik Numarasını doğrular: 11 hane, ilk hane 0
+// olamaz; 10. hane tek ve çift konumdaki hanelerden, 11. hane ilk 10 hanenin
+// toplamından hesaplanır.
+func TCKN(value string) bool {
+	d, ok := digitsOf(strings.TrimSpace(value))
+	if !ok || len(d) != 11 || d[0] == 0 {
+		return false
+	}
+	odd := d[0] + d[2] + d[4] + d[6] + d[8]
+	even := d[1] + d[3] + d[5] + d[7]
+	if ((odd*7-even)%10+10)%10 != d[9] {
+		return false
+	}
+	sum := 0
+	for _, digit := range d[:10] {
+		sum += digit
+	}
+	return sum%10 == d[10]
+}
+
+// ssnRegex, tireli veya tiresiz SSN biçimidir.
+var ssnRegex = regexp.MustCompile(`^(\d{3})-?(\d{2})-?(\d{4})$`)
+
+// SSN, ABD Sosyal Güvenlik Numarasının biçimini doğrular (AAA-GG-SSSS veya 9
+// hane). Alan numarası 000, 666 veya 9xx; grup 00; seri 0000 olamaz. Kontrol
+// basamağı yoktur.
+func SSN(value string) bool {
+	m := ssnRegex.FindStringSubmatch(strings.TrimSpace(value))
+	if m == nil {
+		return false
+	}
+	area, group, serial := m[1], m[2], m[3]
+	return area != "000" && area != "666" && area[0] != '9' && group != "00" && serial != "0000"
+}
+
+// ninoRegex, NINO biçimidir: iki önek harfi, altı hane ve A–D son eki.
+var ninoRegex = regexp.MustCompile(`^[A-CEGHJ-PR-TW-Z][A-CEGHJ-NPR-TW-Z]\d{6}[A-D]$`)
+
+// ninoInvalidPrefixes, HMRC'nin vermediği önek çiftleridir.
+var ninoInvalidPrefixes = map[string]bool{
+	"BG": true, "GB": true, "KN": true, "NK": true, "NT": true, "TN": true, "ZZ": true,
+}
+
+// NINO, Birleşik Krallık National Insurance numarasının biçimini doğrular
+// (örn. "QQ 12 34 56 C"). Kullanılmayan harfler ve önekler reddedilir;
+// kontrol basamağı yoktur.
+func NINO(value string) bool {
+	v := compact(value)
+	return ninoRegex.MatchString(v) && !ninoInvalidPrefixes[v[:2]]
+}
+
+// SteuerID, Almanya vergi kimlik numarasını doğrular: 11 hane, ilk hane 0
+// olamaz; ilk 10 hanede tam bir rakam iki veya üç kez geçer (üç kez geçen
+// rakam art arda üç kez yazılamaz) ve son hane ISO 7064 MOD 11,10 kontrol
+// basamağıdır.
+func SteuerID(value string) bool {
+	d, ok := digitsOf(compact(value))
+	if !ok || len(d) != 11 || d[0] == 0 {
+		return false
+	}
+
+	var counts [10]int
+	for _, digit := range d[:10] {
+		counts[digit]++
+	}
+	repeated := -1
+	for digit, n := range counts {
+		switch {
+		case n <= 1:
+		case (n == 2 || n == 3) && repeated == -1:
+			repeated = digit
+		default:
+			return false
+		}
+	}
+	if repeated == -1 {
+		return false
+	}
+	if counts[repeated] == 3 {
+		for i := 0; i+2 < 10; i++ {
+			if d[i] == repeated && d[i+1] == repeated && d[i+2] == repeated {
+				return false
+			}
+		}
+	}
+
+	product := 10
+	for _, digit := range d[:10] {
+		sum := (digit + product) % 10
+		if sum == 0 {
+			sum = 10
+		}
+		product = (sum * 2) % 11
+	}
+	check := 11 - product
+	if check == 10 {
+		check = 0
+	}
+	return check == d[10]
+}
+
+// dniLetters, DNI/NIE kontrol harfleridir (numara mod 23 ile seçilir).
+const dniLetters = "TRWAGMYFPDXBNJZSQVHLCKE"
+
+// dniRegex, DNI (8 hane) ve NIE (X/Y/Z + 7 hane) biçimidir.
+var dniRegex = regexp.MustCompile(`^([XYZ]\d{7}|\d{8})([A-Z])$`)
+
+// DNI, İspanya DNI (örn. "12345678Z") ve yabancılar için NIE (örn.
+// "X1234567L") numaralarını doğrular. NIE'de X, Y, Z önekleri sırasıyla 0, 1,
+// 2 olarak sayılır; son harf numaranın 23'e bölümünden kalana göre seçilir.
+func DNI(value string) bool {
+	m := dniRegex.FindStringSubmatch(compact(value))
+	if m == nil {
+		return false
+	}
+	number := m[1]
+	switch number[0] {
+	case 'X':
+		number = "0" + number[1:]
+	case 'Y':
+		number = "1" + number[1:]
+	case 'Z':
+		number = "2" + number[1:]
+	}
+	n, err := strconv.Atoi(number)
+	if err != nil {
+		return false
+	}
+	return dniLetters[n%23] == m[2][0]
+}
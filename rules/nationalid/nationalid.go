@@ -0,0 +1,138 @@
+// Package nationalid, ülkelere özgü kimlik numaralarını doğrulayan
+// fonksiyonları içerir.
+//
+// -----------------------------------------------------------------------------
+// Ulusal Kimlik Numaraları
+// -----------------------------------------------------------------------------
+// Desteklenen numaralar ve yapılan kontrol türü:
+//
+//	Ülke  Numara                           Kontrol
+//	TR    T.C. Kimlik No (TCKN)            checksum (10. ve 11. hane)
+//	US    Social Security Number (SSN)     yalnızca biçim
+//	GB    National Insurance No (NINO)     yalnızca biçim
+//	DE    Steuerliche Identifikationsnr.   checksum (ISO 7064 MOD 11,10)
+//	ES    DNI / NIE                        checksum (mod 23 kontrol harfi)
+//
+// "Yalnızca biçim" kontrolleri, numaranın resmi yapıya uyduğunu ve
+// kullanılmayan aralıklarda (örn. SSN'de 000, 666, 9xx alan numaraları)
+// olmadığını denetler; numaranın gerçekten verilmiş olduğunu garanti etmez.
+// Checksum kontrolleri yazım hatalarını da yakalar.
+//
+// Ülke kodları ISO 3166-1 alpha-2'dir; "UK", "GB" için takma ad olarak kabul
+// edilir. Yeni ülkeler Register ile eklenebilir. Şemada
+// String().NationalID("ES") ile kullanılır.
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+package nationalid
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// ErrUnsupportedCountry, kayıtlı doğrulayıcısı olmayan bir ülke kodu
+// istendiğinde döner.
+var ErrUnsupportedCountry = errors.New("desteklenmeyen ülke kodu")
+
+// Validator, bir kimlik numarasının geçerli olup olmadığını döndürür.
+type Validator func(value string) bool
+
+// Spec, bir ülkenin kimlik numarası doğrulayıcısını tanımlar. Checksum,
+// doğrulayıcının kontrol basamağı hesapladığını (false ise yalnızca biçim
+// denetlediğini) belirtir.
+type Spec struct {
+	Country  string
+	Name     string
+	Checksum bool
+	Validate Validator
+}
+
+var (
+	specsMu sync.RWMutex
+	specs   = map[string]Spec{
+		"TR": {Country: "TR", Name: "TCKN", Checksum: true, Validate: TCKN},
+		"US": {Country: "US", Name: "SSN", Checksum: false, Validate: SSN},
+		"GB": {Country: "GB", Name: "NINO", Checksum: false, Validate: NINO},
+		"DE": {Country: "DE", Name: "Steuer-ID", Checksum: true, Validate: SteuerID},
+		"ES": {Country: "ES", Name: "DNI/NIE", Checksum: true, Validate: DNI},
+	}
+)
+
+// normalizeCountry, ülke kodunu büyük harfe çevirir ve takma adları çözer.
+func normalizeCountry(country string) string {
+	country = strings.ToUpper(strings.TrimSpace(country))
+	if country == "UK" {
+		return "GB"
+	}
+	return country
+}
+
+// Lookup
+// -----------------------------------------------------------------------------
+// country için kayıtlı doğrulayıcıyı döndürür.
+//
+// Örnek:
+//
+//	spec, err := nationalid.Lookup("es")
+//	ok := err == nil && spec.Validate("12345678Z")
+func Lookup(country string) (Spec, error) {
+	specsMu.RLock()
+	defer specsMu.RUnlock()
+	spec, ok := specs[normalizeCountry(country)]
+	if !ok {
+		return Spec{}, fmt.Errorf("%w: %q", ErrUnsupportedCountry, country)
+	}
+	return spec, nil
+}
+
+// Validate, value'nun country için geçerli bir kimlik numarası olup olmadığını
+// döndürür. Desteklenmeyen ülkelerde false döner.
+func Validate(country, value string) bool {
+	spec, err := Lookup(country)
+	return err == nil && spec.Validate(value)
+}
+
+// Register, yeni bir ülke doğrulayıcısı ekler veya mevcut olanı değiştirir.
+func Register(spec Spec) {
+	specsMu.Lock()
+	defer specsMu.Unlock()
+	spec.Country = normalizeCountry(spec.Country)
+	specs[spec.Country] = spec
+}
+
+// Countries, kayıtlı ülke kodlarını sıralı döndürür.
+func Countries() []string {
+	specsMu.RLock()
+	defer specsMu.RUnlock()
+	countries := make([]string, 0, len(specs))
+	for country := range specs {
+		countries = append(countries, country)
+	}
+	sort.Strings(countries)
+	return countries
+}
+
+// compact, boşluk ve tireleri atıp harfleri büyütür.
+func compact(value string) string {
+	return strings.ToUpper(strings.NewReplacer(" ", "", "-", "").Replace(value))
+}
+
+// digitsOf, yalnızca rakamlardan oluşan s'yi rakam dizisine çevirir.
+func digitsOf(s string) ([]int, bool) {
+	digits := make([]int, 0, len(s))
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return nil, false
+		}
+		digits = append(digits, int(r-'0'))
+	}
+	return digits, true
+}
@@ -1,10 +1,13 @@
 package rules
 
 import (
+	"context"
 	"fmt"
 	"math"
 	"regexp"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 )
 
 //
@@ -33,13 +36,22 @@ import (
 // @email ahmet.altun60@gmail.com
 // -----------------------------------------------------------------------------
 
+// BcryptMaxBytes, bcrypt'in dikkate aldığı en uzun şifre uzunluğudur (bayt).
+// Bu sınırı aşan kısım sessizce yok sayılır; çok baytlı karakterler (ç, ş,
+// Kiril, emoji) sınıra karakter sayısından çok daha önce ulaşır.
+const BcryptMaxBytes = 72
+
 // PasswordRules, PHP'deki $passwordRules dizisinin Go struct karşılığıdır.
+// MinLength ve MaxLength karakter (rune), MaxBytes ise UTF-8 bayt olarak
+// ölçülür; MaxBytes 0 ise bayt sınırı uygulanmaz.
 type PasswordRules struct {
 	MinLength         int
 	MaxLength         int
+	MaxBytes          int
 	RequireUppercase  bool
 	RequireLowercase  bool
 	RequireNumeric    bool
+	RequireLetter     bool
 	RequireSpecial    bool
 	SpecialChars      string
 	MinUniqueChars    int
@@ -47,8 +59,20 @@ type PasswordRules struct {
 	DisallowCommon    bool
 	DisallowKeyboard  bool
 	MinEntropy        float64
+
+	// NotSimilarTo, şifrenin benzememesi gereken kardeş alanların (örn.
+	// "username", "email") adlarıdır. Kontrol ValidateCtx'in dış kaynaklı
+	// kontrol adımında, gönderilen verinin tamamı üzerinden yapılır.
+	NotSimilarTo []string
+	// HistoryChecker, şifrenin daha önce kullanılıp kullanılmadığını sorgular.
+	HistoryChecker PasswordHistoryChecker
 }
 
+// PasswordHistoryChecker, şifrenin kullanıcının önceki şifrelerinden biri olup
+// olmadığını döndüren fonksiyondur. ctx, ValidateCtx'e verilen context'tir;
+// kullanıcı kimliğine buradan erişilir. Hata durumunda şifre reddedilir.
+type PasswordHistoryChecker func(ctx context.Context, password string) (reused bool, err error)
+
 // commonPasswords
 // -----------------------------------------------------------------------------
 // Çok yaygın kullanılan şifreleri listeleyen sabit harita.
@@ -124,37 +148,156 @@ func hasRepeatingChars(password string, maxRepeats int) bool {
 	return false
 }
 
+// passwordCharClass, entropi hesabında havuz büyüklüğü ayrı tahmin edilen
+// karakter sınıfıdır.
+type passwordCharClass int
+
+const (
+	classLower passwordCharClass = iota
+	classUpper
+	classDigit
+	classSymbol
+	classLatinLower
+	classLatinUpper
+	classCyrillicLower
+	classCyrillicUpper
+	classGreekLower
+	classGreekUpper
+	classArabic
+	classHebrew
+	classDevanagari
+	classThai
+	classHiragana
+	classKatakana
+	classHangul
+	classHan
+	classEmoji
+	classOtherLetter
+	classOther
+)
+
+// passwordClassPools, her karakter sınıfının entropiye katkı veren havuz
+// büyüklüğüdür. Büyük alfabelerde (Han, Hangul, emoji) tüm blok yerine yaygın
+// kullanılan alt küme esas alınır; böylece entropi olduğundan yüksek
+// hesaplanmaz. ASCII değerleri önceki hesapla aynıdır.
+var passwordClassPools = map[passwordCharClass]float64{
+	classLower:         26,
+	classUpper:         26,
+	classDigit:         10,
+	classSymbol:        32, // PHP'deki varsayılan özel karakter sayısı
+	classLatinLower:    30, // Latin-1 ve Latin Extended-A harfleri (ç, ğ, ş, ü, é ...)
+	classLatinUpper:    30,
+	classCyrillicLower: 33,
+	classCyrillicUpper: 33,
+	classGreekLower:    24,
+	classGreekUpper:    24,
+	classArabic:        28,
+	classHebrew:        22,
+	classDevanagari:    48,
+	classThai:          44,
+	classHiragana:      46,
+	classKatakana:      46,
+	classHangul:        2350, // KS X 1001 hece seti
+	classHan:           2500, // sık kullanılan karakterler
+	classEmoji:         100,
+	classOtherLetter:   50,
+	classOther:         32,
+}
+
+// classifyPasswordRune, r'nin entropi hesabındaki karakter sınıfını döndürür.
+func classifyPasswordRune(r rune) passwordCharClass {
+	switch {
+	case r >= 'a' && r <= 'z':
+		return classLower
+	case r >= 'A' && r <= 'Z':
+		return classUpper
+	case r >= '0' && r <= '9':
+		return classDigit
+	case r < utf8.RuneSelf:
+		return classSymbol
+	case unicode.Is(unicode.Latin, r):
+		if unicode.IsUpper(r) {
+			return classLatinUpper
+		}
+		return classLatinLower
+	case unicode.Is(unicode.Cyrillic, r):
+		if unicode.IsUpper(r) {
+			return classCyrillicUpper
+		}
+		return classCyrillicLower
+	case unicode.Is(unicode.Greek, r):
+		if unicode.IsUpper(r) {
+			return classGreekUpper
+		}
+		return classGreekLower
+	case unicode.Is(unicode.Arabic, r):
+		return classArabic
+	case unicode.Is(unicode.Hebrew, r):
+		return classHebrew
+	case unicode.Is(unicode.Devanagari, r):
+		return classDevanagari
+	case unicode.Is(unicode.Thai, r):
+		return classThai
+	case unicode.Is(unicode.Hiragana, r):
+		return classHiragana
+	case unicode.Is(unicode.Katakana, r):
+		return classKatakana
+	case unicode.Is(unicode.Hangul, r):
+		return classHangul
+	case unicode.Is(unicode.Han, r):
+		return classHan
+	case unicode.IsLetter(r):
+		return classOtherLetter
+	case unicode.Is(unicode.So, r):
+		return classEmoji
+	}
+	return classOther
+}
+
+// isPasswordJoiner, görünür bir karakter oluşturmayan ve uzunluğa sayılmayan
+// rune'ları (ZWJ, varyasyon seçicileri, emoji ten rengi değiştiricileri) ayırt
+// eder. Aksi halde "👨‍👩‍👧" gibi tek bir emoji beş karakter sayılırdı.
+func isPasswordJoiner(r rune) bool {
+	return unicode.Is(unicode.Cf, r) ||
+		unicode.Is(unicode.Variation_Selector, r) ||
+		(r >= 0x1F3FB && r <= 0x1F3FF)
+}
+
+// PasswordEntropy, şifrenin tahmini entropisini bit olarak döndürür (bkz.
+// PasswordRules.MinEntropy). Şifre gücü göstergeleri için kullanılabilir.
+func PasswordEntropy(password string) float64 {
+	return calculatePasswordEntropy(password)
+}
+
 // calculatePasswordEntropy
 // -----------------------------------------------------------------------------
 // Şifrenin tahmin edilebilirlik/karmaşıklık düzeyini hesaplar (entropi).
-// PHP portudur, Go’da math.Log2 ile uygulanır.
-// Harf, rakam ve özel karakter çeşitliliğine göre entropi hesaplanır.
+// Uzunluk bayt yerine karakter (rune) olarak ölçülür; havuz büyüklüğü şifrede
+// geçen her karakter sınıfının (ASCII harf/rakam/sembol, Latin, Kiril,
+// Yunan, CJK, emoji ...) havuzlarının toplamıdır:
+//
+//	entropi = uzunluk × log2(havuz)
+//
+// ASCII şifreler için sonuç önceki (PHP portu) hesapla aynıdır.
 func calculatePasswordEntropy(password string) float64 {
-	length := float64(len(password))
+	length := 0
+	classes := make(map[passwordCharClass]bool)
+	for _, r := range password {
+		if isPasswordJoiner(r) {
+			continue
+		}
+		length++
+		classes[classifyPasswordRune(r)] = true
+	}
 	if length == 0 {
 		return 0
 	}
-	charPool := 0.0
-
-	if regexp.MustCompile(`[a-z]`).MatchString(password) {
-		charPool += 26
-	}
-	if regexp.MustCompile(`[A-Z]`).MatchString(password) {
-		charPool += 26
-	}
-	if regexp.MustCompile(`[0-9]`).MatchString(password) {
-		charPool += 10
-	}
-	if regexp.MustCompile(`[^a-zA-Z0-9]`).MatchString(password) {
-		charPool += 32 // PHP'deki varsayılan özel karakter sayısı
-	}
 
-	if charPool == 0 {
-		return 0
+	charPool := 0.0
+	for class := range classes {
+		charPool += passwordClassPools[class]
 	}
-
-	// PHP'deki log(pool, 2) Go'da math.Log2(pool) demektir.
-	return length * math.Log2(charPool)
+	return float64(length) * math.Log2(charPool)
 }
 
 // ValidatePassword
@@ -169,7 +312,7 @@ func calculatePasswordEntropy(password string) float64 {
 //   - []string: tüm hata mesajları, kurallar sağlanıyorsa boş slice
 //
 // Açıklama:
-// - Minimum ve maksimum uzunluk kontrolleri
+// - Minimum ve maksimum uzunluk (karakter) ve bayt sınırı kontrolleri
 // - Büyük, küçük harf, rakam ve özel karakter kontrolleri
 // - Benzersiz karakter sayısı ve tekrar eden karakterler
 // - Klavye düzeni ve yaygın şifre kontrolü
@@ -180,7 +323,7 @@ func ValidatePassword(password string, rules *PasswordRules) []string {
 		return errors
 	}
 
-	passLen := len(password)
+	passLen := utf8.RuneCountInString(password)
 
 	if passLen < rules.MinLength {
 		errors = append(errors, fmt.Sprintf("en az %d karakter uzunluğunda olmalıdır", rules.MinLength))
@@ -188,6 +331,9 @@ func ValidatePassword(password string, rules *PasswordRules) []string {
 	if passLen > rules.MaxLength {
 		errors = append(errors, fmt.Sprintf("en fazla %d karakter uzunluğunda olmalıdır", rules.MaxLength))
 	}
+	if rules.MaxBytes > 0 && len(password) > rules.MaxBytes {
+		errors = append(errors, fmt.Sprintf("en fazla %d bayt uzunluğunda olmalıdır (çok baytlı karakterler birden fazla bayt sayılır)", rules.MaxBytes))
+	}
 	if rules.RequireUppercase && !regexp.MustCompile(`[A-Z]`).MatchString(password) {
 		errors = append(errors, "en az bir büyük harf içermelidir")
 	}
@@ -197,6 +343,9 @@ func ValidatePassword(password string, rules *PasswordRules) []string {
 	if rules.RequireNumeric && !regexp.MustCompile(`[0-9]`).MatchString(password) {
 		errors = append(errors, "en az bir rakam içermelidir")
 	}
+	if rules.RequireLetter && !regexp.MustCompile(`\pL`).MatchString(password) {
+		errors = append(errors, "en az bir harf içermelidir")
+	}
 	if rules.RequireSpecial {
 		specialChars := regexp.QuoteMeta(rules.SpecialChars)
 		if !regexp.MustCompile(fmt.Sprintf("[%s]", specialChars)).MatchString(password) {
@@ -0,0 +1,82 @@
+package rules
+
+//
+// -----------------------------------------------------------------------------
+// Şifre Politikası Hazır Ayarları
+// -----------------------------------------------------------------------------
+// Yaygın güvenlik standartlarına karşılık gelen hazır PasswordRules
+// değerlerini içerir. Kurumlar politikayı bir kez seçip
+// validation.SetDefaultPasswordRules ile tüm şemalar için varsayılan yapabilir
+// veya tek bir alana types.WithPasswordRules ile uygulayabilir.
+//
+// Hazır ayarlar standartların doğrudan doğrulanabilen kısmını kapsar; sızmış
+// şifre listeleriyle karşılaştırma gibi dış kaynak gerektiren kontroller
+// uygulamaya bırakılır (yerleşik yaygın şifre listesi açıktır).
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// DefaultPasswordRules, kütüphanenin yerleşik şifre politikasıdır: en az 8
+// karakter, büyük/küçük harf, rakam ve özel karakter zorunluluğu, klavye
+// dizilimi ve entropi kontrolleri. bcrypt uyumu için şifre 72 baytı aşamaz.
+func DefaultPasswordRules() PasswordRules {
+	return PasswordRules{
+		MinLength:         8,
+		MaxLength:         72,
+		MaxBytes:          BcryptMaxBytes,
+		RequireUppercase:  true,
+		RequireLowercase:  true,
+		RequireNumeric:    true,
+		RequireSpecial:    true,
+		SpecialChars:      `!@#$%^&*(),.?":{}|<>+-`,
+		MinUniqueChars:    6,
+		MaxRepeatingChars: 3,
+		DisallowCommon:    true,
+		DisallowKeyboard:  true,
+		MinEntropy:        50.0,
+	}
+}
+
+// PasswordNIST, NIST SP 800-63B önerilerine uygun politikadır: en az 8, en
+// fazla 64 karakter; karakter sınıfı zorunluluğu yoktur, yaygın ve sıralı
+// (klavye dizilimi) şifreler reddedilir.
+func PasswordNIST() PasswordRules {
+	return PasswordRules{
+		MinLength:        8,
+		MaxLength:        64,
+		SpecialChars:     DefaultPasswordRules().SpecialChars,
+		DisallowCommon:   true,
+		DisallowKeyboard: true,
+	}
+}
+
+// PasswordOWASP, OWASP ASVS 4.0 (V2.1) önerilerine uygun politikadır: en az
+// 12, en fazla 128 karakter; karakter sınıfı zorunluluğu yoktur, yaygın
+// şifreler reddedilir.
+func PasswordOWASP() PasswordRules {
+	return PasswordRules{
+		MinLength:      12,
+		MaxLength:      128,
+		SpecialChars:   DefaultPasswordRules().SpecialChars,
+		DisallowCommon: true,
+	}
+}
+
+// PasswordPCI, PCI DSS 4.0 (Gereksinim 8.3.6) politikasıdır: en az 12 karakter,
+// hem harf hem rakam içermelidir; yaygın şifreler reddedilir. bcrypt uyumu
+// için şifre 72 baytı aşamaz.
+func PasswordPCI() PasswordRules {
+	return PasswordRules{
+		MinLength:      12,
+		MaxLength:      72,
+		MaxBytes:       BcryptMaxBytes,
+		RequireLetter:  true,
+		RequireNumeric: true,
+		SpecialChars:   DefaultPasswordRules().SpecialChars,
+		DisallowCommon: true,
+	}
+}
@@ -0,0 +1,103 @@
+package rules
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+//
+// -----------------------------------------------------------------------------
+// Şifre / Kişisel Veri Benzerliği
+// -----------------------------------------------------------------------------
+// Şifrenin kullanıcı adı, e-posta veya ad gibi kişisel verilere dayanıp
+// dayanmadığını denetler (NIST SP 800-63B, OWASP ASVS 2.1.x). İki yöntem
+// birlikte kullanılır:
+//   - İçerme: değerin harf/rakam parçalarından (en az 3 karakter) biri şifrede
+//     geçiyorsa ("ahmet.altun@x.com" → "ahmet", "altun", "com" dahil değil)
+//   - Levenshtein: şifre ile değer arasındaki benzerlik oranı eşiği aşıyorsa
+//
+// Karşılaştırmalar büyük/küçük harf duyarsızdır ve rune tabanlıdır.
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// PasswordSimilarityThreshold, Levenshtein benzerlik oranının (1 - mesafe /
+// uzun olanın uzunluğu) şifreyi reddettiği eşiktir.
+const PasswordSimilarityThreshold = 0.7
+
+// minSimilarityPart, içerme kontrolünde dikkate alınan en kısa parça
+// uzunluğudur; daha kısa parçalar yanlış pozitif üretir.
+const minSimilarityPart = 3
+
+// IsSimilarPassword
+// -----------------------------------------------------------------------------
+// password'ün value'ya (veya value'nun bir parçasına) çok benzeyip
+// benzemediğini döndürür. Boş değerler benzer kabul edilmez.
+//
+// Örnek:
+//
+//	rules.IsSimilarPassword("Ahmet2024!", "ahmet.altun@example.com") // true
+//	rules.IsSimilarPassword("kırmızı-Kalem-92", "ahmet")             // false
+func IsSimilarPassword(password, value string) bool {
+	password = strings.ToLower(password)
+	value = strings.ToLower(strings.TrimSpace(value))
+	if password == "" || utf8.RuneCountInString(value) < minSimilarityPart {
+		return false
+	}
+	if strings.Contains(password, value) || similarityRatio(password, value) >= PasswordSimilarityThreshold {
+		return true
+	}
+
+	parts := strings.FieldsFunc(value, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for _, part := range parts {
+		if utf8.RuneCountInString(part) < minSimilarityPart {
+			continue
+		}
+		if strings.Contains(password, part) || similarityRatio(password, part) >= PasswordSimilarityThreshold {
+			return true
+		}
+	}
+	return false
+}
+
+// similarityRatio, iki metin arasındaki Levenshtein mesafesini 0–1 arası bir
+// benzerlik oranına çevirir.
+func similarityRatio(a, b string) float64 {
+	ra, rb := []rune(a), []rune(b)
+	longest := len(ra)
+	if len(rb) > longest {
+		longest = len(rb)
+	}
+	if longest == 0 {
+		return 1
+	}
+	return 1 - float64(levenshtein(ra, rb))/float64(longest)
+}
+
+// levenshtein, iki rune dizisi arasındaki düzenleme mesafesini hesaplar.
+func levenshtein(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
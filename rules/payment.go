@@ -5,6 +5,7 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 	"unicode"
 )
 
@@ -104,6 +105,73 @@ func IsValidCreditCard(cardNumber string, cardType string) bool {
 	return luhnCheck(number)
 }
 
+// CardBINLength, BIN (Bank Identification Number) sorgularında kullanılan
+// önek uzunluğudur.
+const CardBINLength = 6
+
+// CardBIN
+// -----------------------------------------------------------------------------
+// Kart numarasının ilk CardBINLength hanesini (BIN/IIN) döndürür. Boşluk ve
+// tireler yok sayılır; numara yeterince uzun değilse boş string döner.
+//
+// Örnek:
+//
+//	rules.CardBIN("4111 1111 1111 1111") // "411111"
+func CardBIN(cardNumber string) string {
+	var b strings.Builder
+	for _, r := range cardNumber {
+		if r < '0' || r > '9' {
+			continue
+		}
+		b.WriteRune(r)
+		if b.Len() == CardBINLength {
+			return b.String()
+		}
+	}
+	return ""
+}
+
+// cardExpiryPattern, "AA/YY" ve "AA/YYYY" son kullanma tarihi biçimleridir.
+var cardExpiryPattern = regexp.MustCompile(`^(0[1-9]|1[0-2])\s*/\s*(\d{2}|\d{4})$`)
+
+// ParseCardExpiry
+// -----------------------------------------------------------------------------
+// Kart son kullanma tarihini ("12/27", "12/2027") ay ve dört haneli yıl
+// olarak ayrıştırır. İki haneli yıllar 2000'li yıllar kabul edilir.
+//
+// Dönüş:
+//   - month, year: Ayrıştırılan ay ve yıl
+//   - ok: Biçim geçersizse false
+func ParseCardExpiry(expiry string) (month, year int, ok bool) {
+	m := cardExpiryPattern.FindStringSubmatch(strings.TrimSpace(expiry))
+	if m == nil {
+		return 0, 0, false
+	}
+	month, _ = strconv.Atoi(m[1])
+	year, _ = strconv.Atoi(m[2])
+	if len(m[2]) == 2 {
+		year += 2000
+	}
+	return month, year, true
+}
+
+// IsCardExpired, kartın verilen andan önce süresinin dolup dolmadığını
+// döndürür. Kartlar son kullanma ayının sonuna kadar geçerlidir.
+func IsCardExpired(month, year int, now time.Time) bool {
+	endOfMonth := time.Date(year, time.Month(month)+1, 1, 0, 0, 0, 0, now.Location())
+	return !now.Before(endOfMonth)
+}
+
+// CardCVVLength, kart numarasının markasına göre beklenen güvenlik kodu (CVV/CID)
+// uzunluğunu döndürür: American Express için 4, diğer markalar için 3.
+func CardCVVLength(cardNumber string) int {
+	bin := CardBIN(cardNumber)
+	if strings.HasPrefix(bin, "34") || strings.HasPrefix(bin, "37") {
+		return 4
+	}
+	return 3
+}
+
 // IsValidIBAN
 // -----------------------------------------------------------------------------
 // Verilen IBAN numarasının geçerli olup olmadığını kontrol eder.
@@ -0,0 +1,191 @@
+package rules
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+//
+// -----------------------------------------------------------------------------
+// PEM Sertifika ve Anahtar Doğrulaması
+// -----------------------------------------------------------------------------
+// Müşterilerin sertifika veya anahtar yüklediği API'lerde (özel alan adları,
+// SSO/SAML yapılandırması, mTLS) gönderilen PEM metnini ayrıştırır ve
+// denetler:
+//
+//   - "CERTIFICATE": bir veya daha fazla (zincir) X.509 sertifikası
+//   - "PRIVATE KEY": PKCS#8, PKCS#1 ("RSA PRIVATE KEY") veya SEC 1
+//     ("EC PRIVATE KEY") özel anahtarı
+//   - "PUBLIC KEY": PKIX açık anahtarı
+//
+// Anahtar tipi (rsa, ecdsa, ed25519) ve en küçük anahtar boyutu her blokta,
+// geçerlilik süresi ise istenirse zincirdeki her sertifikada denetlenir.
+// Şifrelenmiş (Proc-Type: ENCRYPTED) bloklar ve PEM dışında içerik
+// reddedilir.
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// Desteklenen PEM blok tipleri.
+const (
+	PEMCertificate = "CERTIFICATE"
+	PEMPrivateKey  = "PRIVATE KEY"
+	PEMPublicKey   = "PUBLIC KEY"
+)
+
+// Desteklenen anahtar tipleri.
+const (
+	KeyTypeRSA     = "rsa"
+	KeyTypeECDSA   = "ecdsa"
+	KeyTypeEd25519 = "ed25519"
+)
+
+var (
+	// ErrPEMInvalid, metnin beklenen tipte geçerli bir PEM bloğu olmadığını
+	// belirtir.
+	ErrPEMInvalid = errors.New("pem: geçersiz blok")
+
+	// ErrPEMKeyType, anahtar tipinin izin verilenler arasında olmadığını
+	// belirtir.
+	ErrPEMKeyType = errors.New("pem: izin verilmeyen anahtar tipi")
+
+	// ErrPEMKeySize, anahtarın en küçük boyuttan kısa olduğunu belirtir.
+	ErrPEMKeySize = errors.New("pem: anahtar boyutu yetersiz")
+
+	// ErrCertificateExpired, sertifikanın süresinin dolduğunu (veya
+	// ExpiryMargin içinde dolacağını) ya da henüz geçerli olmadığını belirtir.
+	ErrCertificateExpired = errors.New("pem: sertifika geçerlilik süresi dışında")
+)
+
+// PEMRules, PEM doğrulamasının ayarlarıdır.
+type PEMRules struct {
+	// BlockType, beklenen blok tipidir (PEMCertificate, PEMPrivateKey,
+	// PEMPublicKey).
+	BlockType string
+	// KeyTypes, izin verilen anahtar tipleridir; boşsa tümüne izin verilir.
+	KeyTypes []string
+	// MinRSABits ve MinECBits, en küçük anahtar boyutlarıdır (0 denetlemez).
+	MinRSABits int
+	MinECBits  int
+	// CheckExpiry, sertifikaların geçerlilik aralığını denetler.
+	CheckExpiry bool
+	// ExpiryMargin, sertifikanın en az bu süre daha geçerli olmasını ister.
+	ExpiryMargin time.Duration
+}
+
+// DefaultPEMRules, blockType için önerilen ayarları döndürür: RSA en az 2048,
+// EC en az 256 bit.
+func DefaultPEMRules(blockType string) PEMRules {
+	return PEMRules{BlockType: strings.ToUpper(blockType), MinRSABits: 2048, MinECBits: 256}
+}
+
+// ValidatePEM
+// -----------------------------------------------------------------------------
+// data'yı r'ye göre ayrıştırıp denetler; now, sertifika geçerlilik kontrolünde
+// kullanılan andır. Hatalar ErrPEMInvalid, ErrPEMKeyType, ErrPEMKeySize veya
+// ErrCertificateExpired sarmalar.
+//
+// Örnek:
+//
+//	err := rules.ValidatePEM(certPEM, rules.DefaultPEMRules(rules.PEMCertificate), time.Now())
+func ValidatePEM(data string, r PEMRules, now time.Time) error {
+	rest := []byte(strings.TrimSpace(data))
+	blocks := 0
+	for len(rest) > 0 {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			return ErrPEMInvalid
+		}
+		rest = []byte(strings.TrimSpace(string(rest)))
+		blocks++
+		if blocks > 1 && r.BlockType != PEMCertificate {
+			return fmt.Errorf("%w: birden fazla blok", ErrPEMInvalid)
+		}
+		if _, encrypted := block.Headers["Proc-Type"]; encrypted {
+			return fmt.Errorf("%w: şifrelenmiş blok", ErrPEMInvalid)
+		}
+		if err := validatePEMBlock(block, r, now); err != nil {
+			return err
+		}
+	}
+	if blocks == 0 {
+		return ErrPEMInvalid
+	}
+	return nil
+}
+
+// validatePEMBlock, tek bir bloğu ayrıştırır ve anahtar/süre kurallarını uygular.
+func validatePEMBlock(block *pem.Block, r PEMRules, now time.Time) error {
+	var (
+		key any
+		err error
+	)
+	switch {
+	case r.BlockType == PEMCertificate && block.Type == PEMCertificate:
+		var cert *x509.Certificate
+		if cert, err = x509.ParseCertificate(block.Bytes); err == nil {
+			key = cert.PublicKey
+			if r.CheckExpiry && (now.Before(cert.NotBefore) || now.Add(r.ExpiryMargin).After(cert.NotAfter)) {
+				return ErrCertificateExpired
+			}
+		}
+	case r.BlockType == PEMPrivateKey && block.Type == PEMPrivateKey:
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case r.BlockType == PEMPrivateKey && block.Type == "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case r.BlockType == PEMPrivateKey && block.Type == "EC PRIVATE KEY":
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+	case r.BlockType == PEMPublicKey && block.Type == PEMPublicKey:
+		key, err = x509.ParsePKIXPublicKey(block.Bytes)
+	default:
+		return fmt.Errorf("%w: %q bloğu, %q bekleniyordu", ErrPEMInvalid, block.Type, r.BlockType)
+	}
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrPEMInvalid, err)
+	}
+	return validatePEMKey(key, r)
+}
+
+// validatePEMKey, anahtarın tipini ve boyutunu denetler.
+func validatePEMKey(key any, r PEMRules) error {
+	var (
+		keyType string
+		bits    int
+		minBits int
+	)
+	switch k := key.(type) {
+	case *rsa.PublicKey:
+		keyType, bits, minBits = KeyTypeRSA, k.N.BitLen(), r.MinRSABits
+	case *rsa.PrivateKey:
+		keyType, bits, minBits = KeyTypeRSA, k.N.BitLen(), r.MinRSABits
+	case *ecdsa.PublicKey:
+		keyType, bits, minBits = KeyTypeECDSA, k.Curve.Params().BitSize, r.MinECBits
+	case *ecdsa.PrivateKey:
+		keyType, bits, minBits = KeyTypeECDSA, k.Curve.Params().BitSize, r.MinECBits
+	case ed25519.PublicKey, ed25519.PrivateKey:
+		keyType = KeyTypeEd25519
+	default:
+		return fmt.Errorf("%w: %T", ErrPEMKeyType, key)
+	}
+
+	if len(r.KeyTypes) > 0 && !slices.Contains(r.KeyTypes, keyType) {
+		return fmt.Errorf("%w: %s", ErrPEMKeyType, keyType)
+	}
+	if bits < minBits {
+		return fmt.Errorf("%w: %d < %d bit", ErrPEMKeySize, bits, minBits)
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package rules
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+//
+// -----------------------------------------------------------------------------
+// Kişi Adı Kuralları
+// -----------------------------------------------------------------------------
+// Ad/soyad alanları için ad-hoc regex'ler yerine kullanılan ortak kurallar.
+// Tüm alfabelerdeki harfleri, kesme işaretini (O'Neil, D’Angelo), kısa çizgiyi
+// (Jean-Luc), kelime arası boşlukları ve unvan/baş harf noktalarını
+// (Dr. Ana, J.R.R. Tolkien) kabul eder; rakam ve diğer işaretleri reddeder.
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// PersonNameMaxLength, Max() tanımlanmadığında kişi adı için kullanılan
+// karakter sınırıdır.
+const PersonNameMaxLength = 100
+
+// personNamePattern: harflerden oluşan kelimeler; aralarında kesme işareti,
+// kısa çizgi, nokta ve/veya tek boşluk bulunabilir. İsteğe bağlı son nokta.
+var personNamePattern = regexp.MustCompile(`^[\p{L}\p{M}]+(?:(?:[-'’]|\.?\p{Zs}|\.)[\p{L}\p{M}]+)*\.?$`)
+
+// IsValidPersonName
+// -----------------------------------------------------------------------------
+// Değerin geçerli bir kişi adı olup olmadığını kontrol eder. Boşlukların
+// önceden CollapseWhitespace ile normalize edildiği varsayılır.
+//
+// Örnek:
+//
+//	rules.IsValidPersonName("Ana María O'Neil-Çelik") // true
+//	rules.IsValidPersonName("R2-D2")                  // false
+func IsValidPersonName(name string) bool {
+	return personNamePattern.MatchString(name)
+}
+
+// CollapseWhitespace, baştaki/sondaki boşlukları kaldırır ve ardışık boşluk
+// karakterlerini tek bir boşluğa indirir.
+func CollapseWhitespace(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// TitleCaseName
+// -----------------------------------------------------------------------------
+// Kişi adındaki her kelimenin (boşluk, kısa çizgi veya kesme işaretinden sonra
+// gelen) ilk harfini büyütür. Diğer harflere dokunmaz; böylece "McDonald"
+// gibi kelime içi büyük harfler korunur.
+//
+// Örnek:
+//
+//	rules.TitleCaseName("jean-luc o'neil") // "Jean-Luc O'Neil"
+func TitleCaseName(name string) string {
+	var b strings.Builder
+	b.Grow(len(name))
+	upper := true
+	for _, r := range name {
+		if upper && unicode.IsLetter(r) {
+			b.WriteRune(unicode.ToTitle(r))
+			upper = false
+			continue
+		}
+		b.WriteRune(r)
+		switch {
+		case unicode.IsSpace(r), r == '-', r == '\'', r == '’', r == '.':
+			upper = true
+		case unicode.IsLetter(r):
+			upper = false
+		}
+	}
+	return b.String()
+}
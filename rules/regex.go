@@ -0,0 +1,115 @@
+package rules
+
+import (
+	"errors"
+	"regexp"
+	"sync"
+	"time"
+)
+
+//
+// -----------------------------------------------------------------------------
+// ReDoS'a Karşı Güvenli Regex Eşleştirme
+// -----------------------------------------------------------------------------
+// Kullanıcı tanımlı desenler (Regex(), CharSetPatterns) saldırgan girdilerle
+// birleştiğinde hizmet engelleme (DoS) vektörü olabilir. Go'nun regexp paketi
+// RE2 tabanlıdır ve eşleştirme süresi girdi uzunluğuyla doğrusal artar; geri
+// izleme (backtracking) kaynaklı üstel patlama yaşanmaz. Buna rağmen çok büyük
+// girdiler birden fazla desenle taranırken ciddi CPU tüketebilir.
+//
+// Bu dosya, eşleştirmeden önce girdi uzunluğunu isteğe bağlı olarak
+// sınırlayan MatchRegex fonksiyonunu içerir. Sınırlar varsayılan olarak
+// kapalıdır; SetRegexOptions (genel) veya StringType.RegexOptions (alan
+// bazında) ile açılır. RE2 doğrusal zamanlı olduğu için asıl koruma girdi
+// uzunluğu sınırıdır; zaman aşımı yalnızca beklemeyi keser, eşleştirmeyi
+// iptal etmez (bkz. RegexOptions.Timeout).
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// DefaultRegexMaxInputLength, MaxInputLength için önerilen değerdir (64 KB).
+// Otomatik uygulanmaz; SetRegexOptions ile açıkça verilmelidir.
+const DefaultRegexMaxInputLength = 64 * 1024
+
+var (
+	// ErrRegexInputTooLong, girdi MaxInputLength sınırını aştığında döner.
+	ErrRegexInputTooLong = errors.New("regex girdisi izin verilen uzunluğu aşıyor")
+
+	// ErrRegexTimeout, eşleştirme Timeout süresi içinde tamamlanamadığında döner.
+	ErrRegexTimeout = errors.New("regex eşleştirmesi zaman aşımına uğradı")
+)
+
+// RegexOptions, güvenli regex eşleştirmesinin sınırlarıdır.
+type RegexOptions struct {
+	// MaxInputLength, taranacak en uzun girdidir (bayt). 0 sınırı kapatır.
+	MaxInputLength int
+
+	// Timeout, tek bir eşleştirmenin sonucunun en fazla ne kadar bekleneceğidir.
+	// 0 zaman aşımını kapatır. Go'nun regexp paketi iptal desteklemediği için
+	// zaman aşımı eşleştirmeyi durdurmaz: eşleştirme arka plandaki goroutine'de
+	// sonuna kadar çalışır ve CPU tüketmeye devam eder. CPU kullanımını
+	// sınırlamak için MaxInputLength kullanılmalıdır.
+	Timeout time.Duration
+}
+
+var (
+	regexOptionsMu sync.RWMutex
+	regexOptions   RegexOptions
+)
+
+// SetRegexOptions
+// -----------------------------------------------------------------------------
+// Regex() ve karakter seti doğrulamalarında kullanılan varsayılan sınırları
+// ayarlar. Başlangıçta hiçbir sınır uygulanmaz. Alan bazında
+// StringType.RegexOptions ile geçersiz kılınabilir.
+//
+// Örnek:
+//
+//	rules.SetRegexOptions(rules.RegexOptions{
+//	    MaxInputLength: rules.DefaultRegexMaxInputLength,
+//	})
+func SetRegexOptions(opts RegexOptions) {
+	regexOptionsMu.Lock()
+	defer regexOptionsMu.Unlock()
+	regexOptions = opts
+}
+
+// GetRegexOptions, varsayılan regex sınırlarını döndürür.
+func GetRegexOptions() RegexOptions {
+	regexOptionsMu.RLock()
+	defer regexOptionsMu.RUnlock()
+	return regexOptions
+}
+
+// MatchRegex
+// -----------------------------------------------------------------------------
+// input'u re ile opts sınırları içinde eşleştirir. Girdi çok uzunsa
+// ErrRegexInputTooLong, süre aşılırsa ErrRegexTimeout döner. Zaman aşımında
+// eşleştirme iptal edilmez, arka planda tamamlanır.
+func MatchRegex(re *regexp.Regexp, input string, opts RegexOptions) (bool, error) {
+	if opts.MaxInputLength > 0 && len(input) > opts.MaxInputLength {
+		return false, ErrRegexInputTooLong
+	}
+	if opts.Timeout <= 0 {
+		return re.MatchString(input), nil
+	}
+
+	done := make(chan bool, 1)
+	go func() {
+		done <- re.MatchString(input)
+	}()
+
+	timer := time.NewTimer(opts.Timeout)
+	defer timer.Stop()
+
+	select {
+	case matched := <-done:
+		return matched, nil
+	case <-timer.C:
+		return false, ErrRegexTimeout
+	}
+}
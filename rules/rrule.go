@@ -0,0 +1,198 @@
+package rules
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+//
+// -----------------------------------------------------------------------------
+// Tekrarlama Kuralı (RFC 5545 RRULE) Doğrulaması
+// -----------------------------------------------------------------------------
+// Takvim ve abonelik planlama API'lerinde kullanılan RRULE değerlerini
+// (örn. "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE") RFC 5545 §3.3.10'a göre
+// denetler: FREQ zorunluluğu, bilinen parça adları, değer aralıkları, UNTIL ile
+// COUNT'un birlikte kullanılamaması ve BYxxx parçalarının FREQ ile uyumu.
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+var (
+	rruleFrequencies = map[string]bool{
+		"SECONDLY": true, "MINUTELY": true, "HOURLY": true, "DAILY": true,
+		"WEEKLY": true, "MONTHLY": true, "YEARLY": true,
+	}
+	rruleWeekdays = map[string]bool{
+		"SU": true, "MO": true, "TU": true, "WE": true, "TH": true, "FR": true, "SA": true,
+	}
+	rruleByDayPattern = regexp.MustCompile(`^([+-]?\d{1,2})?([A-Z]{2})$`)
+	rruleUntilPattern = regexp.MustCompile(`^\d{8}(T\d{6}Z?)?$`)
+)
+
+// rruleRanges, sayısal liste alan BYxxx parçalarının izin verilen aralıklarıdır.
+// signed true ise negatif değerler (sondan sayma) de kabul edilir; 0 hiçbir
+// zaman işaretli parçalarda geçerli değildir.
+var rruleRanges = map[string]struct {
+	min, max int
+	signed   bool
+}{
+	"BYSECOND":   {0, 60, false},
+	"BYMINUTE":   {0, 59, false},
+	"BYHOUR":     {0, 23, false},
+	"BYMONTHDAY": {1, 31, true},
+	"BYYEARDAY":  {1, 366, true},
+	"BYWEEKNO":   {1, 53, true},
+	"BYMONTH":    {1, 12, false},
+	"BYSETPOS":   {1, 366, true},
+}
+
+// IsValidRRule, değerin geçerli bir RFC 5545 tekrarlama kuralı olup olmadığını
+// döndürür. Ayrıntılı hata nedeni için ValidateRRule kullanılır.
+func IsValidRRule(rule string) bool {
+	return ValidateRRule(rule) == nil
+}
+
+// ValidateRRule
+// -----------------------------------------------------------------------------
+// RRULE değerini doğrular ve ilk ihlali açıklayan bir hata döndürür. Baştaki
+// "RRULE:" öneki isteğe bağlıdır; "X-" ile başlayan genişletme parçaları
+// yok sayılır.
+//
+// Örnek:
+//
+//	rules.ValidateRRule("FREQ=MONTHLY;BYDAY=-1FR;COUNT=12") // nil
+//	rules.ValidateRRule("FREQ=DAILY;COUNT=5;UNTIL=20250101") // UNTIL ve COUNT birlikte
+func ValidateRRule(rule string) error {
+	rule = strings.TrimPrefix(rule, "RRULE:")
+	if rule == "" {
+		return errors.New("rrule: boş kural")
+	}
+
+	parts := make(map[string]string)
+	var names []string
+	for _, part := range strings.Split(rule, ";") {
+		name, value, ok := strings.Cut(part, "=")
+		if !ok || name == "" || value == "" {
+			return fmt.Errorf("rrule: geçersiz parça %q", part)
+		}
+		name = strings.ToUpper(name)
+		if _, dup := parts[name]; dup {
+			return fmt.Errorf("rrule: %s birden fazla kez tanımlanmış", name)
+		}
+		parts[name] = value
+		names = append(names, name)
+	}
+
+	freq, ok := parts["FREQ"]
+	if !ok {
+		return errors.New("rrule: FREQ zorunludur")
+	}
+	if !rruleFrequencies[freq] {
+		return fmt.Errorf("rrule: geçersiz FREQ %q", freq)
+	}
+	if _, hasUntil := parts["UNTIL"]; hasUntil {
+		if _, hasCount := parts["COUNT"]; hasCount {
+			return errors.New("rrule: UNTIL ve COUNT birlikte kullanılamaz")
+		}
+	}
+
+	for _, name := range names {
+		if err := validateRRulePart(freq, name, parts[name]); err != nil {
+			return err
+		}
+	}
+
+	if _, ok := parts["BYSETPOS"]; ok && !hasRRuleByPart(parts) {
+		return errors.New("rrule: BYSETPOS başka bir BYxxx parçası gerektirir")
+	}
+	return nil
+}
+
+// validateRRulePart, tek bir NAME=VALUE parçasını FREQ bağlamında doğrular.
+func validateRRulePart(freq, name, value string) error {
+	switch name {
+	case "FREQ":
+		return nil
+	case "UNTIL":
+		if !rruleUntilPattern.MatchString(value) {
+			return fmt.Errorf("rrule: geçersiz UNTIL %q", value)
+		}
+	case "COUNT", "INTERVAL":
+		if n, err := strconv.Atoi(value); err != nil || n < 1 {
+			return fmt.Errorf("rrule: %s pozitif bir tamsayı olmalıdır", name)
+		}
+	case "WKST":
+		if !rruleWeekdays[value] {
+			return fmt.Errorf("rrule: geçersiz WKST %q", value)
+		}
+	case "BYDAY":
+		ordinalAllowed := freq == "MONTHLY" || freq == "YEARLY"
+		for _, day := range strings.Split(value, ",") {
+			m := rruleByDayPattern.FindStringSubmatch(day)
+			if m == nil || !rruleWeekdays[m[2]] {
+				return fmt.Errorf("rrule: geçersiz BYDAY %q", day)
+			}
+			if m[1] == "" {
+				continue
+			}
+			if !ordinalAllowed {
+				return errors.New("rrule: BYDAY sıra numarası yalnızca MONTHLY/YEARLY ile kullanılabilir")
+			}
+			if n, _ := strconv.Atoi(m[1]); n == 0 || n < -53 || n > 53 {
+				return fmt.Errorf("rrule: geçersiz BYDAY %q", day)
+			}
+		}
+	default:
+		bounds, known := rruleRanges[name]
+		if !known {
+			if strings.HasPrefix(name, "X-") {
+				return nil
+			}
+			return fmt.Errorf("rrule: bilinmeyen parça %s", name)
+		}
+		if err := checkRRuleFreq(freq, name); err != nil {
+			return err
+		}
+		for _, item := range strings.Split(value, ",") {
+			n, err := strconv.Atoi(item)
+			abs := n
+			if abs < 0 {
+				abs = -abs
+			}
+			if err != nil || (n < 0 && !bounds.signed) || (bounds.signed && n == 0) || abs < bounds.min || abs > bounds.max {
+				return fmt.Errorf("rrule: %s değeri %q aralık dışında", name, item)
+			}
+		}
+	}
+	return nil
+}
+
+// checkRRuleFreq, RFC 5545'in FREQ'e göre yasakladığı BYxxx kombinasyonlarını
+// denetler.
+func checkRRuleFreq(freq, name string) error {
+	switch {
+	case name == "BYMONTHDAY" && freq == "WEEKLY",
+		name == "BYYEARDAY" && (freq == "DAILY" || freq == "WEEKLY" || freq == "MONTHLY"),
+		name == "BYWEEKNO" && freq != "YEARLY":
+		return fmt.Errorf("rrule: %s, FREQ=%s ile kullanılamaz", name, freq)
+	}
+	return nil
+}
+
+// hasRRuleByPart, BYSETPOS dışında en az bir BYxxx parçası olup olmadığını
+// döndürür.
+func hasRRuleByPart(parts map[string]string) bool {
+	for name := range parts {
+		if strings.HasPrefix(name, "BY") && name != "BYSETPOS" {
+			return true
+		}
+	}
+	return false
+}
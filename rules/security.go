@@ -50,11 +50,19 @@ var (
 	trailingDot         = regexp.MustCompile(`\.$`)
 
 	// CharSetPatterns
+	// "alpha", "alphanumeric" ve "numeric" yalnızca ASCII karakterleri kabul
+	// eder. "unicode_*" ve "alpha_space" desenleri tüm alfabelerdeki harfleri
+	// (\p{L}) ve birleşik yazılan aksan işaretlerini (\p{M}) kabul eder; böylece
+	// "Müller", "Çağla", "José" veya ayrıştırılmış (NFD) biçimde gelen girdiler
+	// de geçerli sayılır. Türkçe'deki noktasız ı / noktalı İ de harf kabul edilir.
 	CharSetPatterns = map[string]*regexp.Regexp{
-		"latin":        regexp.MustCompile(`^[\p{Latin}]+$`),
-		"alphanumeric": regexp.MustCompile(`^[a-zA-Z0-9]+$`),
-		"numeric":      regexp.MustCompile(`^[0-9]+$`),
-		"alpha":        regexp.MustCompile(`^[a-zA-Z]+$`),
+		"latin":                regexp.MustCompile(`^[\p{Latin}]+$`),
+		"alphanumeric":         regexp.MustCompile(`^[a-zA-Z0-9]+$`),
+		"numeric":              regexp.MustCompile(`^[0-9]+$`),
+		"alpha":                regexp.MustCompile(`^[a-zA-Z]+$`),
+		"unicode_alpha":        regexp.MustCompile(`^[\p{L}\p{M}]+$`),
+		"unicode_alphanumeric": regexp.MustCompile(`^[\p{L}\p{M}\p{N}]+$`),
+		"alpha_space":          regexp.MustCompile(`^[\p{L}\p{M}]+(\p{Zs}+[\p{L}\p{M}]+)*$`),
 	}
 
 	// SanitizeFilename için Türkçe karakter haritası
@@ -132,6 +140,52 @@ func SanitizeFilename(filename string) string {
 	return filename
 }
 
+// HeaderFilenameMaxLength, Content-Disposition dosya adları için kabul edilen
+// en fazla bayt sayısıdır.
+const HeaderFilenameMaxLength = 255
+
+// IsSafeHeaderValue
+// -----------------------------------------------------------------------------
+// Değerin bir HTTP yanıt başlığına olduğu gibi kopyalanabilir olup olmadığını
+// kontrol eder. Yalnızca görünür ASCII karakterlere (0x21-0x7E), boşluğa ve
+// sekmeye izin verilir; CR/LF (header injection / response splitting), NUL ve
+// diğer kontrol karakterleri ile ASCII dışı baytlar reddedilir.
+//
+// Örnek:
+//
+//	rules.IsSafeHeaderValue("en-US")                    // true
+//	rules.IsSafeHeaderValue("x\r\nSet-Cookie: a=b") // false
+func IsSafeHeaderValue(value string) bool {
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		if c == '\t' || c == ' ' {
+			continue
+		}
+		if c < 0x21 || c > 0x7E {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSafeHeaderFilename
+// -----------------------------------------------------------------------------
+// Değerin Content-Disposition başlığında filename="..." olarak güvenle
+// kullanılabilecek bir dosya adı olup olmadığını kontrol eder. IsSafeHeaderValue
+// kurallarına ek olarak tırnak, ters bölü, yol ayırıcı, noktalı virgül ve yüzde
+// işaretleri (parametre kaçışı, dizin geçişi ve yüzde kodlamalı ad çözümleme
+// farkları), "." / ".." adları ve HeaderFilenameMaxLength üzerindeki değerler
+// reddedilir. ASCII dışı adlar için filename* (RFC 5987) kullanılmalıdır.
+func IsSafeHeaderFilename(name string) bool {
+	if name == "" || name == "." || name == ".." || len(name) > HeaderFilenameMaxLength {
+		return false
+	}
+	if !IsSafeHeaderValue(name) || strings.ContainsAny(name, "\t\"\\/;%") {
+		return false
+	}
+	return strings.TrimSpace(name) == name
+}
+
 // FilterEmoji
 // -----------------------------------------------------------------------------
 // Metin içerisindeki emojileri kaldırır veya bırakır.
@@ -155,14 +209,19 @@ func FilterEmoji(input string, remove bool) string {
 //
 // Parametreler:
 //   - input: doğrulanacak string
-//   - charSet: "latin", "alphanumeric", "numeric", "alpha"
+//   - charSet: "latin", "alphanumeric", "numeric", "alpha", "unicode_alpha",
+//     "unicode_alphanumeric", "alpha_space"
 //
 // Dönüş:
 //   - bool: karakter setine uyuyorsa true, değilse false
+//
+// Eşleştirme SetRegexOptions ile belirlenen sınırlar içinde yapılır; girdi bu
+// sınırları aşarsa false döner.
 func ValidateCharSet(input string, charSet string) bool {
 	pattern, ok := CharSetPatterns[charSet]
 	if !ok {
 		return false // Bilinmeyen karakter seti
 	}
-	return pattern.MatchString(input)
+	matched, err := MatchRegex(pattern, input, GetRegexOptions())
+	return err == nil && matched
 }
@@ -0,0 +1,258 @@
+package rules
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+)
+
+//
+// -----------------------------------------------------------------------------
+// SSH Açık Anahtarı Doğrulaması (OpenSSH authorized_keys)
+// -----------------------------------------------------------------------------
+// Deploy key / SSH anahtarı yönetimi yapan uç noktalarda gönderilen tek satırlık
+// OpenSSH açık anahtarını ayrıştırır:
+//
+//	[seçenekler] <tip> <base64 anahtar> [yorum]
+//	ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAI... deploy@ci
+//	no-pty,command="/bin/deploy" ssh-rsa AAAAB3NzaC1yc2EAAAADAQAB... ci
+//
+// Base64 blob'u SSH kablo biçiminde (RFC 4253, RFC 5656, RFC 8709) çözülür;
+// satırdaki tip ile blob içindeki tipin aynı olması ve blob'da fazladan bayt
+// bulunmaması zorunludur. RSA ve DSA için modül, ECDSA için eğri boyutu
+// anahtar uzunluğu (bit) olarak raporlanır. Harici bağımlılık kullanılmaz.
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// Desteklenen SSH anahtar tipleri.
+const (
+	SSHKeyRSA        = "ssh-rsa"
+	SSHKeyDSA        = "ssh-dss"
+	SSHKeyEd25519    = "ssh-ed25519"
+	SSHKeyECDSA256   = "ecdsa-sha2-nistp256"
+	SSHKeyECDSA384   = "ecdsa-sha2-nistp384"
+	SSHKeyECDSA521   = "ecdsa-sha2-nistp521"
+	SSHKeySKEd25519  = "sk-ssh-ed25519@openssh.com"
+	SSHKeySKECDSA256 = "sk-ecdsa-sha2-nistp256@openssh.com"
+)
+
+// MinSSHRSABits, RSA anahtarları için kabul edilen en kısa modül uzunluğudur.
+const MinSSHRSABits = 2048
+
+// sshEd25519KeyBytes, Ed25519 açık anahtarının bayt uzunluğudur.
+const sshEd25519KeyBytes = 32
+
+// DefaultSSHKeyTypes, SSHPublicKey kuralında tip verilmediğinde kabul edilen
+// tiplerdir. OpenSSH'ın varsayılan olarak kapattığı DSA dahil değildir.
+var DefaultSSHKeyTypes = []string{
+	SSHKeyRSA, SSHKeyEd25519, SSHKeyECDSA256, SSHKeyECDSA384, SSHKeyECDSA521,
+	SSHKeySKEd25519, SSHKeySKECDSA256,
+}
+
+// sshKeyAliases, izin listelerinde kullanılabilen kısa tip adlarıdır.
+var sshKeyAliases = map[string][]string{
+	"rsa":     {SSHKeyRSA},
+	"dsa":     {SSHKeyDSA},
+	"ed25519": {SSHKeyEd25519, SSHKeySKEd25519},
+	"ecdsa":   {SSHKeyECDSA256, SSHKeyECDSA384, SSHKeyECDSA521, SSHKeySKECDSA256},
+}
+
+// SSH anahtarı doğrulama hataları.
+var (
+	ErrSSHKeyInvalid = errors.New("ssh: geçersiz açık anahtar")
+	ErrSSHKeyType    = errors.New("ssh: izin verilmeyen anahtar tipi")
+	ErrSSHKeySize    = errors.New("ssh: anahtar çok kısa")
+)
+
+// SSHPublicKey, ayrıştırılmış bir SSH açık anahtarıdır.
+type SSHPublicKey struct {
+	Type    string
+	Bits    int
+	Comment string
+	Options string
+}
+
+// ParseSSHPublicKey
+// -----------------------------------------------------------------------------
+// authorized_keys biçimindeki tek satırı ayrıştırır.
+//
+// Örnek:
+//
+//	key, err := rules.ParseSSHPublicKey(line)
+//	// key.Type == "ssh-ed25519", key.Bits == 256
+func ParseSSHPublicKey(line string) (SSHPublicKey, error) {
+	var key SSHPublicKey
+	line = strings.TrimSpace(line)
+	if line == "" || strings.ContainsAny(line, "\r\n") {
+		return key, ErrSSHKeyInvalid
+	}
+
+	keyType, rest := cutField(line)
+	if !isSSHKeyType(keyType) {
+		key.Options, rest = cutOptions(line)
+		keyType, rest = cutField(rest)
+		if !isSSHKeyType(keyType) {
+			return key, fmt.Errorf("%w: bilinmeyen tip %q", ErrSSHKeyInvalid, keyType)
+		}
+	}
+	encoded, comment := cutField(rest)
+	key.Type, key.Comment = keyType, strings.TrimSpace(comment)
+
+	blob, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return key, fmt.Errorf("%w: %v", ErrSSHKeyInvalid, err)
+	}
+	if key.Bits, err = sshKeyBits(keyType, blob); err != nil {
+		return key, err
+	}
+	return key, nil
+}
+
+// ValidateSSHPublicKey
+// -----------------------------------------------------------------------------
+// line'ı ayrıştırır; tipin allowed listesinde olmasını (boşsa
+// DefaultSSHKeyTypes) ve RSA anahtarların en az MinSSHRSABits olmasını
+// denetler. Hata ErrSSHKeyInvalid, ErrSSHKeyType veya ErrSSHKeySize sarar.
+func ValidateSSHPublicKey(line string, allowed []string) (SSHPublicKey, error) {
+	key, err := ParseSSHPublicKey(line)
+	switch {
+	case err != nil:
+		return key, err
+	case !SSHKeyTypeAllowed(key.Type, allowed):
+		return key, fmt.Errorf("%w: %s", ErrSSHKeyType, key.Type)
+	case key.Type == SSHKeyRSA && key.Bits < MinSSHRSABits:
+		return key, fmt.Errorf("%w: %d bit", ErrSSHKeySize, key.Bits)
+	}
+	return key, nil
+}
+
+// SSHKeyTypeAllowed, keyType'ın allowed listesinde (tam ad veya rsa, dsa,
+// ed25519, ecdsa kısa adlarıyla) bulunup bulunmadığını döndürür. Liste boşsa
+// DefaultSSHKeyTypes kullanılır.
+func SSHKeyTypeAllowed(keyType string, allowed []string) bool {
+	if len(allowed) == 0 {
+		return slices.Contains(DefaultSSHKeyTypes, keyType)
+	}
+	for _, a := range allowed {
+		a = strings.ToLower(a)
+		if a == keyType || slices.Contains(sshKeyAliases[a], keyType) {
+			return true
+		}
+	}
+	return false
+}
+
+// isSSHKeyType, t'nin desteklenen bir anahtar tipi olup olmadığını döndürür.
+func isSSHKeyType(t string) bool {
+	return t == SSHKeyDSA || slices.Contains(DefaultSSHKeyTypes, t)
+}
+
+// cutField, s'yi ilk boşluktan böler.
+func cutField(s string) (field, rest string) {
+	s = strings.TrimLeft(s, " \t")
+	if i := strings.IndexAny(s, " \t"); i >= 0 {
+		return s[:i], s[i+1:]
+	}
+	return s, ""
+}
+
+// cutOptions, tırnak içindeki boşlukları atlayarak seçenek alanını ayırır.
+func cutOptions(s string) (options, rest string) {
+	quoted := false
+	for i := 0; i < len(s); i++ {
+		switch {
+		case s[i] == '\\' && quoted:
+			i++
+		case s[i] == '"':
+			quoted = !quoted
+		case (s[i] == ' ' || s[i] == '\t') && !quoted:
+			return s[:i], s[i+1:]
+		}
+	}
+	return s, ""
+}
+
+// sshKeyBits, kablo biçimindeki blob'u çözer ve anahtar uzunluğunu döndürür.
+func sshKeyBits(keyType string, blob []byte) (int, error) {
+	r := sshReader{data: blob}
+	if inner := string(r.next()); inner != keyType {
+		return 0, fmt.Errorf("%w: tip uyuşmuyor (%q)", ErrSSHKeyInvalid, inner)
+	}
+
+	var bits int
+	switch keyType {
+	case SSHKeyRSA:
+		e, n := r.mpint(), r.mpint()
+		if e == nil || n == nil || e.Sign() <= 0 || n.Sign() <= 0 {
+			return 0, ErrSSHKeyInvalid
+		}
+		bits = n.BitLen()
+	case SSHKeyDSA:
+		p := r.mpint()
+		r.mpint()
+		r.mpint()
+		r.mpint()
+		if p == nil {
+			return 0, ErrSSHKeyInvalid
+		}
+		bits = p.BitLen()
+	case SSHKeyEd25519, SSHKeySKEd25519:
+		if len(r.next()) != sshEd25519KeyBytes {
+			return 0, ErrSSHKeyInvalid
+		}
+		bits = 256
+	default: // ECDSA ve sk-ECDSA
+		curve := strings.TrimSuffix(strings.TrimPrefix(strings.TrimPrefix(keyType, "sk-"), "ecdsa-sha2-"), "@openssh.com")
+		if string(r.next()) != curve || len(r.next()) == 0 {
+			return 0, ErrSSHKeyInvalid
+		}
+		fmt.Sscanf(curve, "nistp%d", &bits)
+	}
+	if strings.HasPrefix(keyType, "sk-") {
+		r.next() // application (örn. "ssh:")
+	}
+	if r.err || len(r.data) > 0 {
+		return 0, fmt.Errorf("%w: bozuk anahtar verisi", ErrSSHKeyInvalid)
+	}
+	return bits, nil
+}
+
+// sshReader, SSH kablo biçimindeki uzunluk önekli alanları okur.
+type sshReader struct {
+	data []byte
+	err  bool
+}
+
+// next, sıradaki string alanını döndürür.
+func (r *sshReader) next() []byte {
+	if r.err || len(r.data) < 4 {
+		r.err = true
+		return nil
+	}
+	n := binary.BigEndian.Uint32(r.data)
+	if uint64(n) > uint64(len(r.data)-4) {
+		r.err = true
+		return nil
+	}
+	field := r.data[4 : 4+n]
+	r.data = r.data[4+n:]
+	return field
+}
+
+// mpint, sıradaki alanı pozitif bir tamsayı olarak okur.
+func (r *sshReader) mpint() *big.Int {
+	b := r.next()
+	if r.err || len(b) == 0 || b[0]&0x80 != 0 {
+		return nil
+	}
+	return new(big.Int).SetBytes(b)
+}
@@ -0,0 +1,106 @@
+package rules
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+//
+// -----------------------------------------------------------------------------
+// Zaman Tabanlı Tek Kullanımlık Şifre (TOTP, RFC 6238)
+// -----------------------------------------------------------------------------
+// Kimlik doğrulayıcı uygulamaların (Google Authenticator, Authy vb.) ürettiği
+// 6 veya 8 haneli kodları doğrular. Gizli anahtar, uygulamalara QR kodla
+// aktarılan Base32 biçimindedir; HMAC-SHA1 ve 30 saniyelik periyot kullanılır.
+//
+// Saat kaymasına tolerans için skew kadar önceki ve sonraki periyot da kabul
+// edilir (skew=1 → ±30 saniye). Aynı kodun tekrar kullanımını (replay) önlemek
+// uygulamanın sorumluluğundadır.
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// TOTPPeriod, bir TOTP kodunun geçerli olduğu süredir.
+const TOTPPeriod = 30 * time.Second
+
+// DecodeTOTPSecret, Base32 gizli anahtarı çözer. Boşluklar, küçük harfler ve
+// eksik "=" dolguları kabul edilir.
+func DecodeTOTPSecret(secret string) ([]byte, error) {
+	secret = strings.ToUpper(strings.ReplaceAll(secret, " ", ""))
+	secret = strings.TrimRight(secret, "=")
+	key, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(secret)
+	if err != nil {
+		return nil, fmt.Errorf("totp: geçersiz gizli anahtar: %w", err)
+	}
+	if len(key) == 0 {
+		return nil, errors.New("totp: gizli anahtar boş")
+	}
+	return key, nil
+}
+
+// GenerateTOTP, key ve t anı için digits haneli TOTP kodunu üretir.
+func GenerateTOTP(key []byte, t time.Time, digits int) string {
+	counter := uint64(t.Unix() / int64(TOTPPeriod/time.Second))
+	return hotp(key, counter, digits)
+}
+
+// VerifyTOTP
+// -----------------------------------------------------------------------------
+// code'un Base32 secret için t anında (±skew periyot) geçerli olup olmadığını
+// döndürür. Kod 6 veya 8 haneli olmalıdır; karşılaştırma sabit zamanlıdır.
+//
+// Örnek:
+//
+//	ok := rules.VerifyTOTP("JBSWY3DPEHPK3PXP", "492039", time.Now(), 1)
+func VerifyTOTP(secret, code string, t time.Time, skew int) bool {
+	if !IsValidOTPCode(code, 6) && !IsValidOTPCode(code, 8) {
+		return false
+	}
+	key, err := DecodeTOTPSecret(secret)
+	if err != nil {
+		return false
+	}
+	if skew < 0 {
+		skew = 0
+	}
+
+	counter := t.Unix() / int64(TOTPPeriod/time.Second)
+	valid := 0
+	for offset := -skew; offset <= skew; offset++ {
+		if counter+int64(offset) < 0 {
+			continue
+		}
+		expected := hotp(key, uint64(counter+int64(offset)), len(code))
+		valid |= subtle.ConstantTimeCompare([]byte(expected), []byte(code))
+	}
+	return valid == 1
+}
+
+// hotp, RFC 4226 HOTP değerini digits hane olarak üretir.
+func hotp(key []byte, counter uint64, digits int) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], counter)
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	mod := uint32(1)
+	for i := 0; i < digits; i++ {
+		mod *= 10
+	}
+	return fmt.Sprintf("%0*d", digits, value%mod)
+}
@@ -0,0 +1,128 @@
+package rules
+
+import (
+	"strings"
+	"sync"
+)
+
+//
+// -----------------------------------------------------------------------------
+// Türkiye Şirket ve İşyeri Numaraları
+// -----------------------------------------------------------------------------
+// e-Fatura, e-Arşiv ve bordro entegrasyonlarında kullanılan işletme
+// numaralarını doğrular:
+//
+//   - VKN:     10 haneli Vergi Kimlik Numarası; son hane GİB algoritmasıyla
+//     hesaplanan kontrol basamağıdır (checksum)
+//   - MERSİS:  16 haneli Merkezi Sicil Kayıt Sistemi numarası; "0" ile başlar,
+//     sonraki 10 hane şirketin VKN'sidir (VKN checksum'ı denetlenir), son 5
+//     hane sıra numarasıdır
+//   - Vergi dairesi kodu: 6 hane; ilk 3 hane il kodu (001–081), son 3 hane
+//     daire numarasıdır (yalnızca biçim; SetTaxOfficeCodes ile GİB listesi
+//     yüklenirse listede olma şartı da aranır)
+//   - SGK işyeri sicil numarası: 26 hane (mahiyet, işkolu, ünite, sıra no,
+//     il, ilçe, kontrol no, aracı no); yalnızca biçim ve il kodu denetlenir
+//
+// Girdilerdeki boşluk, tire ve noktalar yok sayılır.
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+var (
+	taxOfficesMu sync.RWMutex
+	taxOffices   map[string]bool
+)
+
+// compactTRNumber, boşluk, tire ve noktaları atar; yalnızca rakamlardan
+// oluşmuyorsa ok false döner.
+func compactTRNumber(value string) (digits string, ok bool) {
+	digits = strings.NewReplacer(" ", "", "-", "", ".", "").Replace(value)
+	for _, r := range digits {
+		if r < '0' || r > '9' {
+			return "", false
+		}
+	}
+	return digits, digits != ""
+}
+
+// isTRProvinceCode, üç haneli il kodunun 001–081 aralığında olup olmadığını
+// döndürür.
+func isTRProvinceCode(code string) bool {
+	return len(code) == 3 && code >= "001" && code <= "081"
+}
+
+// IsValidVKN, 10 haneli Vergi Kimlik Numarasının kontrol basamağını doğrular.
+func IsValidVKN(value string) bool {
+	vkn, ok := compactTRNumber(value)
+	if !ok || len(vkn) != 10 {
+		return false
+	}
+	sum := 0
+	for i := 0; i < 9; i++ {
+		tmp := (int(vkn[i]-'0') + 9 - i) % 10
+		v := (tmp * (1 << (9 - i))) % 9
+		if tmp != 0 && v == 0 {
+			v = 9
+		}
+		sum += v
+	}
+	return (10-sum%10)%10 == int(vkn[9]-'0')
+}
+
+// IsValidMERSIS, 16 haneli MERSİS numarasını doğrular: "0" ile başlamalı ve
+// 2–11. haneler geçerli bir VKN olmalıdır.
+func IsValidMERSIS(value string) bool {
+	mersis, ok := compactTRNumber(value)
+	return ok && len(mersis) == 16 && mersis[0] == '0' && IsValidVKN(mersis[1:11])
+}
+
+// IsValidTaxOfficeCode, 6 haneli vergi dairesi kodunu doğrular. Kod listesi
+// yüklenmişse kodun listede olması da gerekir.
+func IsValidTaxOfficeCode(value string) bool {
+	code, ok := compactTRNumber(value)
+	if !ok || len(code) != 6 || !isTRProvinceCode(code[:3]) || code[3:] == "000" {
+		return false
+	}
+	taxOfficesMu.RLock()
+	defer taxOfficesMu.RUnlock()
+	return taxOffices == nil || taxOffices[code]
+}
+
+// SetTaxOfficeCodes, geçerli vergi dairesi kodlarının listesini (örn. GİB'in
+// yayımladığı liste) yükler. nil veya boş liste, yalnızca biçim kontrolüne
+// geri döner.
+func SetTaxOfficeCodes(codes []string) {
+	var set map[string]bool
+	if len(codes) > 0 {
+		set = make(map[string]bool, len(codes))
+		for _, code := range codes {
+			set[strings.TrimSpace(code)] = true
+		}
+	}
+	taxOfficesMu.Lock()
+	defer taxOfficesMu.Unlock()
+	taxOffices = set
+}
+
+// TaxOfficeCodes, yüklenmiş vergi dairesi kodlarını sıralı döndürür; liste
+// yüklenmemişse nil döner.
+func TaxOfficeCodes() []string {
+	taxOfficesMu.RLock()
+	defer taxOfficesMu.RUnlock()
+	if taxOffices == nil {
+		return nil
+	}
+	return sortedSet(taxOffices)
+}
+
+// IsValidSGKNumber, 26 haneli SGK işyeri sicil numarasının biçimini doğrular:
+// mahiyet kodu (ilk hane) 0 olamaz, 17–19. haneler geçerli bir il kodu
+// olmalıdır.
+func IsValidSGKNumber(value string) bool {
+	sgk, ok := compactTRNumber(value)
+	return ok && len(sgk) == 26 && sgk[0] != '0' && isTRProvinceCode(sgk[16:19])
+}
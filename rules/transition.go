@@ -0,0 +1,45 @@
+package rules
+
+//
+// -----------------------------------------------------------------------------
+// Durum Geçişi (State Machine) Kuralları
+// -----------------------------------------------------------------------------
+// Bu dosya, "status" gibi alanların yalnızca izin verilen durumlar arasında
+// geçiş yapabilmesini denetleyen kuralı içerir. CRUD API'lerinde çok sık
+// karşılaşılan "draft → published → archived" gibi akışların tek bir harita
+// ile deklaratif olarak tanımlanmasını sağlar.
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// IsAllowedTransition
+// -----------------------------------------------------------------------------
+// from durumundan to durumuna geçişin izinli olup olmadığını kontrol eder.
+//
+// Parametreler:
+//   - transitions: Her durum için izin verilen hedef durumlar
+//     (örn: {"draft": {"published"}, "published": {"archived"}})
+//   - from: Mevcut durum
+//   - to: Hedef durum
+//
+// Dönüş:
+//   - bool → geçiş izinliyse true
+//
+// Açıklama:
+//   - from ve to aynıysa (durum değişmiyorsa) her zaman true döner.
+//   - Haritada bulunmayan bir mevcut durumdan hiçbir geçişe izin verilmez.
+func IsAllowedTransition(transitions map[string][]string, from, to string) bool {
+	if from == to {
+		return true
+	}
+	for _, allowed := range transitions[from] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,176 @@
+package rules
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+//
+// -----------------------------------------------------------------------------
+// URL SSRF Koruması
+// -----------------------------------------------------------------------------
+// Webhook ve geri çağırma adresi kaydeden uç noktalarda, sunucunun daha sonra
+// istek atacağı URL'nin iç ağı (veritabanları, yönetim panelleri, bulut
+// metadata servisi) hedeflememesini sağlar.
+//
+// Statik kontrol (ağ erişimi yok):
+//   - IP literal host'lar IsPublicIP ile denetlenir; "2130706433", "0x7f.1",
+//     "0177.0.0.1" gibi eski IPv4 yazımları da IP olarak çözülür
+//   - localhost, *.localhost, *.internal, *.local ve bilinen metadata host
+//     adları reddedilir
+//
+// DNS kontrolü (isteğe bağlı): host adı çözülür ve dönen adreslerin tamamı
+// genel olmalıdır. Çözümleme hatası adresin reddedilmesiyle sonuçlanır. DNS
+// yanıtı doğrulama ile gerçek istek arasında değişebileceği için (DNS
+// rebinding) istek atılırken de bağlanılan adres denetlenmelidir.
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// ErrPrivateHost, URL'nin özel, yerel veya metadata adresini hedeflediğini
+// belirtir.
+var ErrPrivateHost = errors.New("url özel veya dahili bir host'u hedefliyor")
+
+// HostResolver, bir host adını IP adreslerine çözen fonksiyondur.
+type HostResolver func(ctx context.Context, host string) ([]netip.Addr, error)
+
+// LookupHost, net.DefaultResolver ile çalışan varsayılan HostResolver'dır.
+func LookupHost(ctx context.Context, host string) ([]netip.Addr, error) {
+	return net.DefaultResolver.LookupNetIP(ctx, "ip", host)
+}
+
+// internalHostNames, adı itibarıyla iç ağa işaret eden host'lardır.
+var internalHostNames = map[string]bool{
+	"localhost":                true,
+	"metadata":                 true,
+	"metadata.google.internal": true,
+	"instance-data":            true,
+	"metadata.azure.com":       true,
+}
+
+// internalHostSuffixes, iç ağa ayrılmış alan adı son ekleridir.
+var internalHostSuffixes = []string{".localhost", ".internal", ".local", ".localdomain"}
+
+// URLHost, URL'nin host kısmını (köşeli parantezsiz, küçük harf, sondaki
+// nokta atılmış) ve portunu döndürür. Port yazılmamışsa şemanın varsayılan
+// portu (http 80, https 443) kullanılır; bilinmiyorsa 0 döner.
+func URLHost(rawURL string) (scheme, host string, port int, err error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", "", 0, err
+	}
+	if u.Host == "" {
+		return "", "", 0, fmt.Errorf("url host içermiyor: %q", rawURL)
+	}
+	scheme = strings.ToLower(u.Scheme)
+	host = strings.TrimSuffix(strings.ToLower(u.Hostname()), ".")
+	if p := u.Port(); p != "" {
+		if port, err = strconv.Atoi(p); err != nil {
+			return "", "", 0, fmt.Errorf("geçersiz port: %q", p)
+		}
+	} else {
+		switch scheme {
+		case "http", "ws":
+			port = 80
+		case "https", "wss":
+			port = 443
+		}
+	}
+	return scheme, host, port, nil
+}
+
+// HostIP, host'u IP adresi olarak yorumlar. Standart yazımların yanında
+// tarayıcı ve HTTP istemcilerinin kabul ettiği eski IPv4 yazımlarını
+// (tek sayı, onaltılık, sekizlik, 2–3 parçalı) da çözer.
+func HostIP(host string) (netip.Addr, bool) {
+	if ip, ok := ParseIPAddr(host); ok {
+		return ip, true
+	}
+	return parseLegacyIPv4(host)
+}
+
+// parseLegacyIPv4, inet_aton biçimindeki IPv4 yazımlarını çözer.
+func parseLegacyIPv4(host string) (netip.Addr, bool) {
+	parts := strings.Split(host, ".")
+	if len(parts) == 0 || len(parts) > 4 {
+		return netip.Addr{}, false
+	}
+	values := make([]uint64, len(parts))
+	for i, part := range parts {
+		v, err := strconv.ParseUint(part, 0, 32)
+		if err != nil || part == "" {
+			return netip.Addr{}, false
+		}
+		values[i] = v
+	}
+
+	// Son parça kalan baytların tamamını doldurur.
+	last := values[len(values)-1]
+	if last >= 1<<(8*(5-len(values))) {
+		return netip.Addr{}, false
+	}
+	n := last
+	for i, v := range values[:len(values)-1] {
+		if v > 255 {
+			return netip.Addr{}, false
+		}
+		n |= v << (8 * (3 - i))
+	}
+	return netip.AddrFrom4([4]byte{byte(n >> 24), byte(n >> 16), byte(n >> 8), byte(n)}), true
+}
+
+// IsInternalHost, host'un (ağ erişimi olmadan) özel, yerel veya metadata
+// adresi olup olmadığını döndürür.
+func IsInternalHost(host string) bool {
+	host = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(host)), ".")
+	if ip, ok := HostIP(host); ok {
+		return !IsPublicIP(ip)
+	}
+	if host == "" || internalHostNames[host] {
+		return true
+	}
+	for _, suffix := range internalHostSuffixes {
+		if strings.HasSuffix(host, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckHostResolvesPublic, host adını resolve ile çözer ve dönen adreslerden
+// herhangi biri genel değilse ErrPrivateHost döner. IP literal host'lar
+// çözülmeden denetlenir.
+func CheckHostResolvesPublic(ctx context.Context, resolve HostResolver, host string) error {
+	if IsInternalHost(host) {
+		return ErrPrivateHost
+	}
+	if _, ok := HostIP(host); ok {
+		return nil
+	}
+	if resolve == nil {
+		resolve = LookupHost
+	}
+	addrs, err := resolve(ctx, host)
+	if err != nil {
+		return fmt.Errorf("%s çözülemedi: %w", host, err)
+	}
+	if len(addrs) == 0 {
+		return fmt.Errorf("%s için adres bulunamadı", host)
+	}
+	for _, addr := range addrs {
+		if !IsPublicIP(addr) {
+			return ErrPrivateHost
+		}
+	}
+	return nil
+}
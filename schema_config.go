@@ -0,0 +1,282 @@
+package validation
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/biyonik/go-fluent-validator/core"
+	"github.com/biyonik/go-fluent-validator/i18n"
+)
+
+//
+// -----------------------------------------------------------------------------
+// Şema Yapılandırması (Config)
+// -----------------------------------------------------------------------------
+// Şema davranışını değiştiren modlar (otomatik trim, payload sınırları, süre
+// sınırı...) tek tek SchemaOption'larla verilebildiği gibi, hepsi tek bir
+// Config değerinde toplanabilir. Config genel varsayılan olarak ayarlanabilir
+// veya şema bazında verilebilir:
+//
+//	validation.SetDefaultConfig(validation.Config{
+//	    AutoTrim:  true,
+//	    Strict:    true,
+//	    MaxErrors: 20,
+//	})
+//
+//	public := validation.Make()                                 // genel yapılandırma
+//	legacy := validation.MakeWith(validation.Config{Coerce: true}) // yalnızca bu yapılandırma
+//
+// Make, genel yapılandırmayla başlar ve ardından verilen seçenekleri uygular;
+// MakeWith ise genel yapılandırma yerine cfg'yi kullanır. Her iki durumda da
+// sonradan verilen SchemaOption'lar Config alanlarını ezer. Genel yapılandırma
+// yalnızca sonradan oluşturulan şemaları etkiler; uygulama başlangıcında
+// ayarlanmalıdır.
+//
+// YAML/JSON alan bildirimlerinden şema kuran FromConfig ile ilgisi yoktur.
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// Config, bir şemanın doğrulama modlarıdır. Sıfır değeri, Make()'in
+// varsayılan davranışıdır.
+type Config struct {
+	// AutoTrim, string değerlerin doğrulamadan önce kırpılmasıdır (bkz.
+	// WithAutoTrim).
+	AutoTrim bool
+
+	// Strict, şemada tanımlı olmayan üst seviye alanların hata olarak
+	// raporlanmasıdır (bkz. WithStrict).
+	Strict bool
+
+	// Coerce, string gelen değerlerin alanın tipine göre çevrilmesidir (bkz.
+	// WithCoerce).
+	Coerce bool
+
+	// Locale, context'te dil yoksa hata mesajlarının üretileceği dildir (bkz.
+	// WithLocale).
+	Locale string
+
+	// MaxErrors, raporlanacak en fazla hata sayısıdır; 0 sınırsızdır (bkz.
+	// WithMaxErrors).
+	MaxErrors int
+
+	// GlobalStringMax, herhangi bir string değerin en fazla karakter
+	// sayısıdır; 0 sınırsızdır (bkz. WithGlobalStringMax).
+	GlobalStringMax int
+
+	// MaxTotalBytes, payload'un en fazla yaklaşık boyutudur; 0 sınırsızdır
+	// (bkz. WithMaxTotalBytes).
+	MaxTotalBytes int
+
+	// Budget, tek bir doğrulamanın en fazla süresidir; 0 sınırsızdır (bkz.
+	// WithBudget).
+	Budget time.Duration
+
+	// SeverityPolicy, önem seviyelerinin engelleyici olup olmadığına karar
+	// verir; nil ise core.DefaultSeverityPolicy kullanılır.
+	SeverityPolicy core.SeverityPolicy
+
+	// SensitiveFields, OldInput çıktısından çıkarılan ek alanlardır (bkz.
+	// WithSensitiveFields).
+	SensitiveFields []string
+}
+
+var (
+	defaultConfigMu sync.RWMutex
+	defaultConfig   Config
+)
+
+// SetDefaultConfig
+// -----------------------------------------------------------------------------
+// Make ile sonradan oluşturulan tüm şemaların başlangıç yapılandırmasını
+// ayarlar.
+//
+// Örnek:
+//
+//	validation.SetDefaultConfig(validation.Config{AutoTrim: true, MaxErrors: 50})
+func SetDefaultConfig(cfg Config) {
+	defaultConfigMu.Lock()
+	defer defaultConfigMu.Unlock()
+	defaultConfig = cfg
+}
+
+// DefaultConfig, geçerli genel yapılandırmayı döndürür.
+func DefaultConfig() Config {
+	defaultConfigMu.RLock()
+	defer defaultConfigMu.RUnlock()
+	return defaultConfig
+}
+
+// MakeWith
+// -----------------------------------------------------------------------------
+// Genel yapılandırma yerine cfg ile yeni bir şema oluşturur. opts, cfg'den
+// sonra uygulanır.
+//
+// Örnek:
+//
+//	schema := validation.MakeWith(validation.Config{Strict: true, Locale: "tr"})
+func MakeWith(cfg Config, opts ...SchemaOption) *ValidationSchema {
+	return Make(append([]SchemaOption{WithConfig(cfg)}, opts...)...)
+}
+
+// WithConfig
+// -----------------------------------------------------------------------------
+// cfg'deki tüm modları şemaya uygular; cfg'de kapalı olan modlar şemada da
+// kapatılır.
+func WithConfig(cfg Config) SchemaOption {
+	return func(vs *ValidationSchema) {
+		vs.autoTrim = cfg.AutoTrim
+		vs.strict = cfg.Strict
+		vs.coerce = cfg.Coerce
+		vs.locale = cfg.Locale
+		vs.maxErrors = cfg.MaxErrors
+		vs.globalStringMax = cfg.GlobalStringMax
+		vs.maxTotalBytes = cfg.MaxTotalBytes
+		vs.budget = cfg.Budget
+		vs.severityPolicy = cfg.SeverityPolicy
+		vs.sensitiveFields = nil
+		WithSensitiveFields(cfg.SensitiveFields...)(vs)
+	}
+}
+
+// WithStrict
+// -----------------------------------------------------------------------------
+// Şemada tanımlı olmayan üst seviye alanları unknown_field hatasıyla reddeder.
+// When, WithFeature ve AllOf/AnyOf/OneOf dallarındaki alanlar da tanımlı
+// sayılır. İç içe çalıştırılan alt şemalarda kontrol yapılmaz; alt şemalar
+// üst şemanın verisinin tamamını gördüğü için kontrol yalnızca en dıştaki
+// şemada anlamlıdır.
+func WithStrict() SchemaOption {
+	return func(vs *ValidationSchema) {
+		vs.strict = true
+	}
+}
+
+// WithCoerce
+// -----------------------------------------------------------------------------
+// Üst seviye string değerleri alanın tipine göre çevirir: number alanlara
+// "42", boolean alanlara "true", dizi alanlara "a,b" gibi değerler kabul
+// edilir (bkz. core.CoerceStrings). Form ve query verisi ValidateValues ile
+// zaten çevrilir; bu seçenek JSON gibi kaynaklardaki string sayılar içindir.
+func WithCoerce() SchemaOption {
+	return func(vs *ValidationSchema) {
+		vs.coerce = true
+	}
+}
+
+// WithLocale
+// -----------------------------------------------------------------------------
+// Hata mesajlarını locale dilinde üretir. Context'te i18n.ContextWithLocale
+// ile taşınan dil varsa o önceliklidir.
+func WithLocale(locale string) SchemaOption {
+	return func(vs *ValidationSchema) {
+		vs.locale = locale
+	}
+}
+
+// WithMaxErrors
+// -----------------------------------------------------------------------------
+// Sonuçta en fazla n hata tutar (alan adına göre sıralı ilk n hata; bkz.
+// core.ValidationResult.LimitErrors). Büyük payload'larda yanıt ve log
+// boyutunu sınırlar. 0 veya negatif değer sınırı kapatır.
+func WithMaxErrors(n int) SchemaOption {
+	return func(vs *ValidationSchema) {
+		vs.maxErrors = n
+	}
+}
+
+// nestedKey, alt şema çalıştırmalarını işaretleyen context anahtarıdır.
+type nestedKey struct{}
+
+// nested, ctx'i alt şema çalıştırması olarak işaretler.
+func nested(ctx context.Context) context.Context {
+	return context.WithValue(ctx, nestedKey{}, true)
+}
+
+// isNested, ctx'in bir alt şema çalıştırmasına ait olup olmadığını bildirir.
+func isNested(ctx context.Context) bool {
+	v, _ := ctx.Value(nestedKey{}).(bool)
+	return v
+}
+
+// checkStrict, şemada tanımlı olmayan alanları raporlar.
+func (vs *ValidationSchema) checkStrict(data map[string]any, result *core.ValidationResult) {
+	var known map[string]bool
+	for field := range data {
+		if _, ok := vs.shape[field]; ok {
+			continue
+		}
+		if known == nil {
+			known = make(map[string]bool)
+			vs.knownFields(known, map[*ValidationSchema]bool{})
+		}
+		if !known[field] {
+			result.AddRuleError(field, i18n.KeyUnknownField, field)
+		}
+	}
+}
+
+// knownFields, koşullu, feature ve birleşim dallarındaki alanlar dahil
+// şemanın tanıdığı tüm üst seviye alanları known'a ekler. seen, döngüsel
+// dal tanımlarında aynı şemanın yeniden dolaşılmasını önler.
+func (vs *ValidationSchema) knownFields(known map[string]bool, seen map[*ValidationSchema]bool) {
+	if seen[vs] {
+		return
+	}
+	seen[vs] = true
+	add := func(schema core.Schema) {
+		if sub, ok := schema.(*ValidationSchema); ok {
+			sub.knownFields(known, seen)
+		} else if provider, ok := schema.(core.ShapeProvider); ok {
+			for field := range provider.GetShape() {
+				known[field] = true
+			}
+		}
+	}
+	for field := range vs.shape {
+		known[field] = true
+	}
+	for _, rule := range vs.conditionalRules {
+		add(rule.callback())
+	}
+	for _, rule := range vs.featureRules {
+		add(rule.callback())
+	}
+	for _, c := range vs.compositions {
+		for _, schema := range c.schemas {
+			add(schema)
+		}
+	}
+}
+
+// coerceData, string değerleri alan tiplerine göre çevrilmiş bir kopya
+// döndürür. Çevrilecek değer yoksa data olduğu gibi döner.
+func (vs *ValidationSchema) coerceData(data map[string]any) map[string]any {
+	var coerced map[string]any
+	for field, typ := range vs.shape {
+		s, ok := data[field].(string)
+		if !ok {
+			continue
+		}
+		desc := core.DescribeType(typ)
+		if desc == nil || (desc.Type != "number" && desc.Type != "boolean" && desc.Type != "array") {
+			continue
+		}
+		if coerced == nil {
+			coerced = make(map[string]any, len(data))
+			for k, v := range data {
+				coerced[k] = v
+			}
+		}
+		coerced[field] = core.CoerceStrings([]string{s}, desc)
+	}
+	if coerced == nil {
+		return data
+	}
+	return coerced
+}
@@ -0,0 +1,336 @@
+package validation
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/biyonik/go-fluent-validator/core"
+)
+
+//
+// -----------------------------------------------------------------------------
+// Artımlı Doğrulama Oturumu (Session)
+// -----------------------------------------------------------------------------
+// Bu dosya, çok adımlı formlar ve sihirbazlar için bir veriyi bir kez tam
+// doğrulayıp sonraki her alan güncellemesinde yalnızca etkilenen parçaları
+// yeniden çalıştıran ValidationSession yapısını içerir.
+//
+// Bir alan güncellendiğinde yeniden çalıştırılanlar:
+//   - Alanın kendisi
+//   - Tetikleyici alanı veya alt şeması bu alanı içeren When(...) dalları
+//   - Bu alan için tanımlı (sağlayıcılı) TransitionRule kuralları
+//   - Bu alanı bildiren CrossValidateFields doğrulayıcıları ve alan
+//     bildirmeyen tüm CrossValidate doğrulayıcıları
+//
+// Diğer parçaların sonuçları önbellekten kullanılır.
+//
+// Feature kuralları (WithFeature), AllOf/AnyOf/Not birleşimleri, dış kaynaklı
+// (asenkron) alan kontrolleri, payload sınırları (WithGlobalStringMax,
+// WithMaxTotalBytes) ile Strict ve Coerce modları artımlı çalıştırılamaz. Bu
+// özelliklerden birini kullanan şemalarda oturum önbellek kullanmaz; her
+// Update ve Result çağrısı verinin tamamını Validate ile yeniden doğrular.
+// Böylece oturumun sonucu hiçbir zaman Validate'in sonucundan farklı olmaz.
+//
+// Metadata:
+// @author    Ahmet ALTUN
+// @github    github.com/biyonik
+// @linkedin  linkedin.com/in/biyonik
+// @email     ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// ValidationSession
+// -----------------------------------------------------------------------------
+// Bir şema ve veri için önbelleğe alınmış doğrulama durumunu tutar.
+// Eşzamanlı kullanım için güvenli değildir; her form/oturum için ayrı bir
+// ValidationSession oluşturulmalıdır.
+type ValidationSession struct {
+	schema       *ValidationSchema
+	data         map[string]any                    // Ham veri
+	transformed  map[string]any                    // Dönüştürülmüş alan değerleri
+	fieldResults map[string]*core.ValidationResult // Alan → o alanın doğrulama sonucu
+	conditionals []*core.ValidationResult          // When dallarının son sonuçları (nil: koşul sağlanmadı)
+	transitions  []error                           // TransitionRule hataları (nil: hata yok)
+	crossErrors  []crossError                      // Çapraz doğrulayıcı sonuçları
+	full         bool                              // Artımlı çalıştırılamayan şema: her seferinde Validate
+}
+
+// crossError, bir çapraz doğrulayıcının önbelleğe alınmış hatalarıdır.
+type crossError struct {
+	rule   string
+	errors []*FieldError
+}
+
+// Session
+// -----------------------------------------------------------------------------
+// data için tam doğrulama yapar ve sonuçları önbelleğe alan bir oturum döner.
+// Artımlı çalıştırılamayan kurallar içeren şemalarda oturum her çağrıda tam
+// doğrulama yapar (bkz. dosya başı).
+//
+// Parametreler:
+//   - data: Başlangıç verisi (kopyalanır)
+//
+// Dönüş:
+//   - core.Session
+//
+// Örnek:
+//
+//	session := schema.Session(map[string]any{})
+//	res := session.Update("email", "user@example.com")
+//	fmt.Println(res.Errors())
+func (vs *ValidationSchema) Session(data map[string]any) core.Session {
+	s := &ValidationSession{
+		schema:       vs,
+		data:         make(map[string]any, len(data)),
+		transformed:  make(map[string]any, len(vs.shape)),
+		fieldResults: make(map[string]*core.ValidationResult, len(vs.shape)),
+		conditionals: make([]*core.ValidationResult, len(vs.conditionalRules)),
+		transitions:  make([]error, len(vs.transitionRules)),
+		crossErrors:  make([]crossError, len(vs.crossValidators)),
+		full:         !vs.incremental(),
+	}
+	for k, v := range data {
+		s.data[k] = v
+	}
+	if s.full {
+		return s
+	}
+
+	for _, field := range sortedKeys(vs.shape) {
+		s.validateField(field)
+	}
+	for i := range vs.conditionalRules {
+		s.runConditional(i)
+	}
+	for i := range vs.transitionRules {
+		s.runTransition(i)
+	}
+	for i := range vs.crossValidators {
+		s.runCross(i)
+	}
+
+	return s
+}
+
+// Update
+// -----------------------------------------------------------------------------
+// Bir alanın değerini değiştirir, yalnızca etkilenen kuralları yeniden
+// çalıştırır ve güncel sonucu döndürür.
+func (s *ValidationSession) Update(field string, value any) *core.ValidationResult {
+	vs := s.schema
+	s.data[field] = value
+	if s.full {
+		return s.Result()
+	}
+
+	if _, ok := vs.shape[field]; ok {
+		s.validateField(field)
+	}
+
+	for i, rule := range vs.conditionalRules {
+		if rule.field == field || s.conditionalDependsOn(i, field) {
+			s.runConditional(i)
+		}
+	}
+
+	for i, rule := range vs.transitionRules {
+		if rule.field == field {
+			s.runTransition(i)
+		}
+	}
+
+	for i, cv := range vs.crossValidators {
+		if cv.dependsOn(field) {
+			s.runCross(i)
+		}
+	}
+
+	return s.Result()
+}
+
+// Result
+// -----------------------------------------------------------------------------
+// Önbellekteki sonuçlardan güncel ValidationResult'ı oluşturur. Hata yoksa
+// ValidData, Validate ile aynı şekilde dönüştürülmüş veriyi içerir. Hata
+// sınırı (WithMaxErrors), dil (WithLocale) ve kayıt adımları Validate'teki
+// gibi uygulanır.
+func (s *ValidationSession) Result() *core.ValidationResult {
+	vs := s.schema
+	if s.full {
+		return vs.Validate(s.Data())
+	}
+	result := core.NewResult()
+	s.collectErrors(result, true)
+	result.LimitErrors(vs.maxErrors)
+
+	data := s.currentData()
+	result.SetTransformedData(data)
+	if result.HasErrors() {
+		result.SetOldInput(vs.oldInput(s.data))
+	} else {
+		result.SetValidData(data)
+	}
+	vs.finalize(context.Background(), result)
+
+	return result
+}
+
+// Data
+// -----------------------------------------------------------------------------
+// Oturumdaki ham verinin bir kopyasını döndürür.
+func (s *ValidationSession) Data() map[string]any {
+	data := make(map[string]any, len(s.data))
+	for k, v := range s.data {
+		data[k] = v
+	}
+	return data
+}
+
+// incremental, şemanın oturumda alan bazında (artımlı) doğrulanabilir olup
+// olmadığını döndürür. Oturumun yeniden çalıştıramadığı adımlardan birini
+// kullanan şemalar için false döner.
+func (vs *ValidationSchema) incremental() bool {
+	if len(vs.featureRules) > 0 || len(vs.compositions) > 0 ||
+		vs.globalStringMax > 0 || vs.maxTotalBytes > 0 || vs.strict || vs.coerce {
+		return false
+	}
+	for _, typ := range vs.shape {
+		if _, ok := typ.(core.AsyncValidatable); !ok {
+			continue
+		}
+		if opt, ok := typ.(core.AsyncOptional); !ok || opt.HasAsyncRules() {
+			return false
+		}
+	}
+	return true
+}
+
+// validateField, tek bir alanı Validate ile aynı varlık kurallarıyla
+// (Optional, Nullable) yeniden dönüştürür ve doğrular.
+func (s *ValidationSession) validateField(field string) {
+	typ := s.schema.shape[field]
+	fieldResult := core.NewResult()
+
+	s.fieldResults[field] = fieldResult
+
+	value, keep, err := s.schema.transformField(field, s.data)
+	switch {
+	case err != nil:
+		delete(s.transformed, field)
+		fieldResult.AddError(field, fmt.Sprintf("Dönüşüm hatası: %s", err.Error()))
+	default:
+		if keep {
+			s.transformed[field] = value
+		} else {
+			delete(s.transformed, field)
+		}
+		if !core.SkipValue(typ, s.transformed, field) {
+			s.schema.validateValue(field, typ, value, fieldResult)
+		}
+	}
+}
+
+// runConditional, i numaralı When dalını yeniden değerlendirir.
+func (s *ValidationSession) runConditional(i int) {
+	rule := s.schema.conditionalRules[i]
+	s.conditionals[i] = nil
+
+	val, exists := s.transformed[rule.field]
+	if exists && val == rule.expectedValue {
+		s.conditionals[i] = rule.callback().Validate(s.data)
+	}
+}
+
+// conditionalDependsOn, i numaralı aktif When dalının alt şemasının field
+// alanını içerip içermediğini döndürür.
+func (s *ValidationSession) conditionalDependsOn(i int, field string) bool {
+	if s.conditionals[i] == nil {
+		return false
+	}
+	provider, ok := s.schema.conditionalRules[i].callback().(core.ShapeProvider)
+	if !ok {
+		return true
+	}
+	_, ok = provider.GetShape()[field]
+	return ok
+}
+
+// runTransition, i numaralı sağlayıcılı geçiş kuralını yeniden çalıştırır.
+func (s *ValidationSession) runTransition(i int) {
+	rule := s.schema.transitionRules[i]
+	s.transitions[i] = nil
+
+	if rule.current == nil || s.fieldResults[rule.field].HasFieldErrors(rule.field) {
+		return
+	}
+
+	data := s.currentData()
+	if from, ok := rule.current(data); ok {
+		s.transitions[i] = rule.evaluate(from, data)
+	}
+}
+
+// runCross, i numaralı çapraz doğrulayıcıyı yeniden çalıştırır.
+func (s *ValidationSession) runCross(i int) {
+	cv := s.schema.crossValidators[i]
+	s.crossErrors[i] = crossError{}
+
+	if len(cv.fields) > 0 {
+		gate := core.NewResult()
+		s.collectErrors(gate, false)
+		if cv.blocked(gate.Errors()) {
+			return
+		}
+	}
+
+	s.crossErrors[i] = crossError{rule: cv.rule, errors: cv.evaluate(s.currentData())}
+}
+
+// collectErrors, önbellekteki hataları result'a ekler. withCross false ise
+// çapraz doğrulayıcı hataları dahil edilmez.
+func (s *ValidationSession) collectErrors(result *core.ValidationResult, withCross bool) {
+	fields := make([]string, 0, len(s.fieldResults))
+	for field := range s.fieldResults {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	for _, field := range fields {
+		result.Merge(s.fieldResults[field])
+	}
+	for _, sub := range s.conditionals {
+		if sub != nil {
+			result.Merge(sub)
+		}
+	}
+	for i, err := range s.transitions {
+		if err != nil {
+			result.AddErrorFrom(s.schema.transitionRules[i].field, "transition", err)
+		}
+	}
+	if !withCross {
+		return
+	}
+	for _, ce := range s.crossErrors {
+		for _, fe := range ce.errors {
+			fe.addTo(result, ce.rule)
+		}
+	}
+}
+
+// currentData, dönüştürülmüş alan değerlerini geçerli When dallarının
+// verisiyle birleştirerek döndürür.
+func (s *ValidationSession) currentData() map[string]any {
+	data := make(map[string]any, len(s.transformed))
+	for k, v := range s.transformed {
+		data[k] = v
+	}
+	for _, sub := range s.conditionals {
+		if sub != nil && !sub.HasErrors() {
+			for k, v := range sub.ValidData() {
+				data[k] = v
+			}
+		}
+	}
+	return data
+}
@@ -0,0 +1,1116 @@
+package validation
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/biyonik/go-fluent-validator/core"
+	"github.com/biyonik/go-fluent-validator/rules"
+	"github.com/biyonik/go-fluent-validator/types"
+)
+
+//
+// -----------------------------------------------------------------------------
+// Şema Anlık Görüntüsü (Snapshot / Serileştirme)
+// -----------------------------------------------------------------------------
+// Derlenmiş bir şemanın bildirimsel (declarative) kısmını JSON'a çevirip geri
+// yükleyebilmeyi sağlar. Böylece şemalar Redis gibi önbelleklerde saklanabilir,
+// edge worker'lara taşınabilir veya sürümler arasında diff'lenebilir.
+//
+// Anlık görüntü, Describe() çıktısının sürümlü bir zarfıdır; geri yüklerken
+// her kural ilgili fluent metoda (Min, Email, OneOf...) eşlenir. Fonksiyon
+// içeren parçalar (Custom, CrossValidate, OnChange, TransitionRule, BINLookup,
+// kullanıcı tanımlı tipler) serileştirilemez; bu durumda ErrNotDeclarative
+// döner.
+//
+// Bilinen sınırlar:
+//   - StripTags'e verilen izinli etiketler saklanmaz; geri yüklenen şema tüm
+//     etiketleri temizler. FilterEmoji her zaman FilterEmoji(true) olarak
+//     yüklenir.
+//   - JSON'dan dönen sayılar float64'tür; When(...) koşullarında sayısal
+//     eşitlik değerleri float64 olarak karşılaştırılır.
+//   - Şema seçenekleri (WithStats, WithSeverityPolicy, payload sınırları)
+//     anlık görüntüye dahil değildir; yalnızca auto_trim, strict, coerce,
+//     locale ve max_errors saklanır.
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// SnapshotVersion, üretilen anlık görüntü biçiminin sürümüdür.
+const SnapshotVersion = 1
+
+// ErrNotDeclarative, şemanın serileştirilemeyen (fonksiyon içeren) bir parça
+// içerdiğini belirtir.
+var ErrNotDeclarative = errors.New("şema bildirimsel olmayan kurallar içeriyor")
+
+// schemaSnapshot, serileştirilen anlık görüntü zarfıdır.
+type schemaSnapshot struct {
+	Version int                     `json:"version"`
+	Schema  *core.SchemaDescription `json:"schema"`
+}
+
+// MarshalJSON
+// -----------------------------------------------------------------------------
+// Şemanın bildirimsel anlık görüntüsünü JSON olarak üretir. Çıktı
+// deterministiktir (map anahtarları sıralıdır); aynı şema her zaman aynı
+// baytları üretir. Şema serileştirilemeyen parçalar içeriyorsa
+// ErrNotDeclarative sarmalayan bir hata döner.
+func (vs *ValidationSchema) MarshalJSON() ([]byte, error) {
+	desc := vs.Describe()
+	if _, err := FromDescription(desc); err != nil {
+		return nil, err
+	}
+	return json.Marshal(schemaSnapshot{Version: SnapshotVersion, Schema: desc})
+}
+
+// UnmarshalJSON
+// -----------------------------------------------------------------------------
+// MarshalJSON ile üretilmiş anlık görüntüyü şemaya yükler. Şemanın alanları,
+// koşullu dalları ve isimli kuralları değiştirilir; Make'e verilmiş çalışma
+// zamanı seçenekleri (WithStats, WithSeverityPolicy vb.) korunur.
+//
+// Örnek:
+//
+//	schema := validation.Make(validation.WithStats(stats))
+//	if err := json.Unmarshal(cached, schema); err != nil {
+//	    return err
+//	}
+func (vs *ValidationSchema) UnmarshalJSON(data []byte) error {
+	var snapshot schemaSnapshot
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return err
+	}
+	if snapshot.Version != SnapshotVersion {
+		return fmt.Errorf("desteklenmeyen şema anlık görüntüsü sürümü: %d", snapshot.Version)
+	}
+	if snapshot.Schema == nil {
+		return errors.New("şema anlık görüntüsü boş")
+	}
+
+	built, err := FromDescription(snapshot.Schema)
+	if err != nil {
+		return err
+	}
+	vs.shape = built.shape
+	vs.conditionalRules = built.conditionalRules
+	vs.featureRules = built.featureRules
+	vs.compositions = built.compositions
+	vs.crossValidators = built.crossValidators
+	vs.transitionRules = nil
+	vs.discriminator = ""
+	vs.autoTrim = built.autoTrim
+	return nil
+}
+
+// MarshalBinary, encoding.BinaryMarshaler implementasyonu; MarshalJSON ile
+// aynı baytları üretir.
+func (vs *ValidationSchema) MarshalBinary() ([]byte, error) {
+	return vs.MarshalJSON()
+}
+
+// UnmarshalBinary, encoding.BinaryUnmarshaler implementasyonu.
+func (vs *ValidationSchema) UnmarshalBinary(data []byte) error {
+	return vs.UnmarshalJSON(data)
+}
+
+// FromDescription
+// -----------------------------------------------------------------------------
+// Bir şema tanımından (Describe() çıktısı veya elle hazırlanmış bildirimsel
+// tanım) çalıştırılabilir bir ValidationSchema oluşturur.
+//
+// Parametreler:
+//   - desc: Şema tanımı
+//   - opts: Make ile aynı şema seçenekleri
+//
+// Dönüş:
+//   - *ValidationSchema
+//   - error: Bilinmeyen tip/kural veya bildirimsel olmayan bir parça varsa
+func FromDescription(desc *core.SchemaDescription, opts ...SchemaOption) (*ValidationSchema, error) {
+	vs := Make(opts...)
+	if desc == nil {
+		return vs, nil
+	}
+	if desc.CrossValidators > 0 {
+		return nil, fmt.Errorf("%w: %d isimsiz çapraz alan doğrulayıcısı", ErrNotDeclarative, desc.CrossValidators)
+	}
+
+	shape := make(map[string]core.Type, len(desc.Fields))
+	for _, field := range sortedKeys(desc.Fields) {
+		typ, err := buildType(field, desc.Fields[field])
+		if err != nil {
+			return nil, err
+		}
+		shape[field] = typ
+	}
+	vs.Shape(shape)
+
+	for _, cond := range desc.Conditionals {
+		sub, err := FromDescription(cond.Schema)
+		if err != nil {
+			return nil, err
+		}
+		vs.When(cond.Field, cond.Equals, func() core.Schema { return sub })
+	}
+	for _, feature := range desc.Features {
+		sub, err := FromDescription(feature.Schema)
+		if err != nil {
+			return nil, err
+		}
+		vs.WithFeature(feature.Feature, func() core.Schema { return sub })
+	}
+
+	for _, c := range desc.Compositions {
+		if err := vs.restoreComposition(c); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, rule := range desc.Rules {
+		field, _ := paramString(rule.Params, "field")
+		other, _ := paramString(rule.Params, "other")
+		switch rule.Name {
+		case "same":
+			vs.Same(field, other)
+		case "different":
+			vs.Different(field, other)
+		case "require_any_of":
+			vs.RequireAnyOf(paramStrings(rule.Params, "fields")...)
+		case "require_all_or_none":
+			vs.RequireAllOrNone(paramStrings(rule.Params, "fields")...)
+		default:
+			return nil, fmt.Errorf("%w: şema kuralı %q", ErrNotDeclarative, rule.Name)
+		}
+	}
+
+	for _, option := range desc.Options {
+		name, value, _ := strings.Cut(option, ":")
+		switch name {
+		case "auto_trim":
+			vs.autoTrim = true
+		case "strict":
+			vs.strict = true
+		case "coerce":
+			vs.coerce = true
+		case "locale":
+			vs.locale = value
+		case "max_errors":
+			n, err := strconv.Atoi(value)
+			if err != nil {
+				return nil, fmt.Errorf("geçersiz şema seçeneği: %q", option)
+			}
+			vs.maxErrors = n
+		default:
+			return nil, fmt.Errorf("bilinmeyen şema seçeneği: %q", option)
+		}
+	}
+	return vs, nil
+}
+
+// restoreComposition, bir AllOf/AnyOf/OneOf/Not tanımını şemaya geri ekler.
+func (vs *ValidationSchema) restoreComposition(desc core.CompositionDescription) error {
+	c := composition{kind: desc.Kind}
+	switch desc.Kind {
+	case compositionAllOf, compositionAnyOf, compositionOneOf:
+	case compositionNot:
+		if len(desc.Schemas) != 1 {
+			return fmt.Errorf("not birleşimi tek şema almalı, %d alındı", len(desc.Schemas))
+		}
+	default:
+		return fmt.Errorf("bilinmeyen şema birleşimi: %q", desc.Kind)
+	}
+	for _, sub := range desc.Schemas {
+		schema, err := FromDescription(sub)
+		if err != nil {
+			return err
+		}
+		c.schemas = append(c.schemas, schema)
+	}
+	vs.compositions = append(vs.compositions, c)
+	return nil
+}
+
+// baseSetter, BaseType'ı embed eden tiplerin ortak ayar metotlarıdır.
+type baseSetter interface {
+	core.Type
+	SetRequired()
+	SetOptional()
+	SetNullable()
+	SetLabel(label string)
+	SetSeverity(severity core.Severity)
+	SetDescription(text string)
+	AddExample(value any)
+	SetDeprecated(reason string)
+	SetDefault(value any)
+	SetNoTrim()
+	SetSensitive()
+	SetImmutable()
+	SetOnlyIncrease()
+	SetOnlyDecrease()
+}
+
+// buildType, tek bir tip tanımından tipi oluşturur. path hata mesajlarında
+// kullanılır.
+func buildType(path string, desc *core.TypeDescription) (core.Type, error) {
+	if desc == nil {
+		return nil, fmt.Errorf("%s: tip tanımı boş", path)
+	}
+	if desc.CustomRules > 0 {
+		return nil, fmt.Errorf("%w: %s: %d özel kural", ErrNotDeclarative, path, desc.CustomRules)
+	}
+
+	var (
+		typ core.Type
+		err error
+	)
+	switch desc.Type {
+	case "string":
+		typ, err = buildString(path, desc)
+	case "number":
+		typ, err = buildNumber(path, desc)
+	case "boolean":
+		typ, err = buildSimple(path, desc, Boolean())
+	case "date":
+		typ, err = buildDate(path, desc)
+	case "uuid":
+		typ, err = buildUuid(path, desc)
+	case "iban":
+		typ, err = buildIban(path, desc)
+	case "email":
+		typ, err = buildEmail(path, desc)
+	case "credit_card":
+		typ, err = buildCreditCard(path, desc)
+	case "file":
+		typ, err = buildFile(path, desc)
+	case "image":
+		typ, err = buildImage(path, desc)
+	case "json_patch":
+		typ, err = buildJSONPatch(path, desc)
+	case "object":
+		typ, err = buildObject(path, desc)
+	case "array":
+		typ, err = buildArray(path, desc)
+	case "map":
+		typ, err = buildMap(path, desc)
+	case "any":
+		typ, err = buildSimple(path, desc, Any())
+	case "literal":
+		typ, err = buildLiteral(path, desc)
+	case "custom":
+		return nil, fmt.Errorf("%w: %s: kullanıcı tanımlı tip", ErrNotDeclarative, path)
+	case "lazy":
+		return nil, fmt.Errorf("%w: %s: özyinelemeli Lazy tipi", ErrNotDeclarative, path)
+	case "union":
+		typ, err = buildUnion(path, desc)
+	default:
+		return nil, fmt.Errorf("%s: bilinmeyen tip %q", path, desc.Type)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	applyBase(typ.(baseSetter), desc)
+	return typ, nil
+}
+
+// applyBase, tüm tiplerde ortak olan alan bilgilerini uygular.
+func applyBase(b baseSetter, desc *core.TypeDescription) {
+	if desc.Required {
+		b.SetRequired()
+	}
+	if desc.Optional {
+		b.SetOptional()
+	}
+	if desc.Nullable {
+		b.SetNullable()
+	}
+	if desc.Label != "" {
+		b.SetLabel(desc.Label)
+	}
+	if desc.Default != nil {
+		b.SetDefault(desc.Default)
+	}
+	if desc.Severity != "" {
+		b.SetSeverity(core.Severity(desc.Severity))
+	}
+	if desc.Description != "" {
+		b.SetDescription(desc.Description)
+	}
+	for _, example := range desc.Examples {
+		b.AddExample(example)
+	}
+	if desc.Deprecated {
+		b.SetDeprecated(desc.DeprecationReason)
+	}
+	if desc.NoTrim {
+		b.SetNoTrim()
+	}
+	if desc.Sensitive {
+		b.SetSensitive()
+	}
+}
+
+// applyChangeRule, değişiklik kurallarını (immutable, only_increase,
+// only_decrease) uygular. Kural bir değişiklik kuralı değilse false döner.
+func applyChangeRule(path string, b baseSetter, rule core.RuleDescription) (bool, error) {
+	switch rule.Name {
+	case "immutable":
+		b.SetImmutable()
+	case "only_increase":
+		b.SetOnlyIncrease()
+	case "only_decrease":
+		b.SetOnlyDecrease()
+	case "on_change":
+		return true, fmt.Errorf("%w: %s: OnChange", ErrNotDeclarative, path)
+	default:
+		return false, nil
+	}
+	return true, nil
+}
+
+// unknownRule, tip için tanınmayan bir kural hatası üretir.
+func unknownRule(path, typeName string, rule core.RuleDescription) error {
+	return fmt.Errorf("%s: %s tipi için bilinmeyen kural %q", path, typeName, rule.Name)
+}
+
+// buildSimple, yalnızca ortak ve değişiklik kurallarına sahip tipleri kurar.
+func buildSimple(path string, desc *core.TypeDescription, typ baseSetter) (core.Type, error) {
+	for _, rule := range desc.Rules {
+		handled, err := applyChangeRule(path, typ, rule)
+		if err != nil {
+			return nil, err
+		}
+		if !handled {
+			return nil, unknownRule(path, desc.Type, rule)
+		}
+	}
+	return typ, nil
+}
+
+// advancedStringRules, AdvancedStringType gerektiren kurallar ve dönüşümlerdir.
+var advancedStringRules = map[string]bool{
+	"turkish_chars": true, "domain": true, "charset": true,
+	"domain_resolvable": true, "has_mx": true, "has_txt": true,
+	"escape_html": true, "sanitize_filename": true, "filter_emoji": true,
+}
+
+// buildString, string tanımından StringType veya (gelişmiş kurallar varsa)
+// AdvancedStringType oluşturur.
+func buildString(path string, desc *core.TypeDescription) (core.Type, error) {
+	var (
+		typ core.Type
+		s   *types.StringType
+		adv *types.AdvancedStringType
+	)
+	advanced := false
+	for _, rule := range desc.Rules {
+		advanced = advanced || advancedStringRules[rule.Name]
+	}
+	for _, name := range desc.Transforms {
+		advanced = advanced || advancedStringRules[name]
+	}
+	if advanced {
+		adv = AdvancedString()
+		s, typ = &adv.StringType, adv
+	} else {
+		s = String()
+		typ = s
+	}
+
+	personName := false
+	for _, name := range desc.Transforms {
+		switch name {
+		case "trim":
+			s.Trim()
+		case "strip_tags":
+			s.StripTags()
+		case "collapse_whitespace":
+			s.PersonName()
+			personName = true
+		case "title_case":
+			s.TitleCase()
+		case "escape_html":
+			adv.EscapeHTML()
+		case "sanitize_filename":
+			adv.SanitizeFilename()
+		case "filter_emoji":
+			adv.FilterEmoji(true)
+		default:
+			return nil, fmt.Errorf("%w: %s: %q dönüşümü", ErrNotDeclarative, path, name)
+		}
+	}
+
+	for _, rule := range desc.Rules {
+		if handled, err := applyChangeRule(path, typ.(baseSetter), rule); handled || err != nil {
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		p := rule.Params
+		switch rule.Name {
+		case "min":
+			s.Min(paramInt(p, "value"))
+		case "max":
+			s.Max(paramInt(p, "value"))
+		case "email":
+			s.Email()
+		case "no_private_hosts":
+			s.NoPrivateHosts()
+		case "resolve_hosts":
+			s.ResolveHosts()
+		case "url_schemes":
+			s.Schemes(paramStrings(p, "values")...)
+		case "url_ports":
+			s.Ports(paramInts(p, "values")...)
+		case "pem":
+			blockType, _ := paramString(p, "block_type")
+			s.PEM(blockType, pemOption(p))
+		case "ssh_public_key":
+			s.SSHPublicKey(paramStrings(p, "types")...)
+		case "json_pointer":
+			s.JSONPointer()
+		case "json_path":
+			s.JSONPath()
+		case "url":
+			s.URL()
+		case "one_of":
+			s.OneOf(paramStrings(p, "values"))
+		case "password":
+			s.Password(passwordOption(p))
+		case "ip":
+			s.IP(paramInt(p, "version"))
+		case "ip_not_private":
+			s.NotPrivate()
+		case "ip_not_loopback":
+			s.NotLoopback()
+		case "ip_public_only":
+			s.PublicOnly()
+		case "phone":
+			country, _ := paramString(p, "country")
+			s.Phone(country)
+		case "alpha":
+			s.Alpha()
+		case "alphanumeric":
+			s.Alphanumeric()
+		case "alpha_unicode":
+			s.AlphaUnicode()
+		case "alphanumeric_unicode":
+			s.AlphaNumericUnicode()
+		case "alpha_space":
+			s.AlphaSpace()
+		case "person_name":
+			if !personName {
+				s.PersonName()
+			}
+		case "numeric":
+			s.Numeric()
+		case "starts_with":
+			value, _ := paramString(p, "value")
+			s.StartsWith(value)
+		case "ends_with":
+			value, _ := paramString(p, "value")
+			s.EndsWith(value)
+		case "contains":
+			value, _ := paramString(p, "value")
+			s.Contains(value)
+		case "regex":
+			pattern, _ := paramString(p, "pattern")
+			s.Regex(pattern)
+		case "mac":
+			s.MAC()
+		case "hex":
+			s.Hex()
+		case "base64":
+			s.Base64()
+		case "rrule":
+			s.RRule()
+		case "honeypot":
+			s.Honeypot()
+		case "e164":
+			s.E164()
+		case "did":
+			s.DID()
+		case "urn":
+			s.URN()
+		case "national_id":
+			country, _ := paramString(p, "country")
+			s.NationalID(country)
+		case "license_plate":
+			country, _ := paramString(p, "country")
+			s.LicensePlate(country)
+		case "mersis":
+			s.MERSIS()
+		case "tax_office":
+			s.TaxOfficeCode()
+		case "sgk_number":
+			s.SGKNumber()
+		case "checksum":
+			algorithm, _ := paramString(p, "algorithm")
+			s.Checksum(algorithm)
+		case "otp_code":
+			s.OTPCode(paramInt(p, "length"))
+		case "header_value":
+			s.HeaderValue()
+		case "header_filename":
+			s.ContentDispositionFilename()
+		case "idempotency_key":
+			s.IdempotencyKey()
+		case "csv_of":
+			elem, err := buildType(path+"[]", desc.Elements)
+			if err != nil {
+				return nil, err
+			}
+			s.CSVOf(elem)
+		case "transition":
+			s.Transition(paramTransitions(p, "transitions"))
+		case "turkish_chars":
+			allow, _ := paramBool(p, "allow")
+			adv.TurkishChars(allow)
+		case "domain":
+			allow, _ := paramBool(p, "allow_subdomain")
+			adv.Domain(allow)
+		case "charset":
+			set, _ := paramString(p, "value")
+			adv.CharSet(set)
+		case "domain_resolvable":
+			adv.DomainResolvable()
+		case "has_mx":
+			adv.HasMX()
+		case "has_txt":
+			prefix, _ := paramString(p, "value")
+			adv.HasTXT(prefix)
+		default:
+			return nil, unknownRule(path, desc.Type, rule)
+		}
+	}
+	return typ, nil
+}
+
+// pemOption, "pem" kuralının parametrelerini geri yükler. Tanımda olmayan
+// ayarlar rules.DefaultPEMRules varsayılanlarında kalır.
+func pemOption(p map[string]any) types.PEMOption {
+	return func(r *rules.PEMRules) {
+		if v, ok := paramNumber(p, "min_rsa_bits"); ok {
+			r.MinRSABits = int(v)
+		}
+		if v, ok := paramNumber(p, "min_ec_bits"); ok {
+			r.MinECBits = int(v)
+		}
+		if keyTypes := paramStrings(p, "key_types"); len(keyTypes) > 0 {
+			r.KeyTypes = keyTypes
+		}
+		if v, _ := paramBool(p, "check_expiry"); v {
+			r.CheckExpiry = true
+			margin, _ := paramString(p, "expiry_margin")
+			r.ExpiryMargin, _ = time.ParseDuration(margin)
+		}
+	}
+}
+
+// passwordOption, "password" kuralının parametrelerini tek bir PasswordOption
+// olarak geri yükler. Tanımda olmayan ayarlar Password() varsayılanlarında kalır.
+func passwordOption(p map[string]any) types.PasswordOption {
+	return func(r *rules.PasswordRules) {
+		if v, ok := paramNumber(p, "min_length"); ok {
+			r.MinLength = int(v)
+		}
+		if v, ok := paramNumber(p, "max_length"); ok {
+			r.MaxLength = int(v)
+		}
+		if v, ok := paramNumber(p, "max_bytes"); ok {
+			r.MaxBytes = int(v)
+		}
+		if v, ok := paramBool(p, "require_uppercase"); ok {
+			r.RequireUppercase = v
+		}
+		if v, ok := paramBool(p, "require_lowercase"); ok {
+			r.RequireLowercase = v
+		}
+		if v, ok := paramBool(p, "require_numeric"); ok {
+			r.RequireNumeric = v
+		}
+		if v, ok := paramBool(p, "require_letter"); ok {
+			r.RequireLetter = v
+		}
+		if v, ok := paramBool(p, "require_special"); ok {
+			r.RequireSpecial = v
+		}
+		if v, ok := paramString(p, "special_chars"); ok {
+			r.SpecialChars = v
+		}
+		if v, ok := paramNumber(p, "min_unique_chars"); ok {
+			r.MinUniqueChars = int(v)
+		}
+		if v, ok := paramNumber(p, "max_repeating_chars"); ok {
+			r.MaxRepeatingChars = int(v)
+		}
+		if v, ok := paramBool(p, "disallow_common"); ok {
+			r.DisallowCommon = v
+		}
+		if v, ok := paramBool(p, "disallow_keyboard"); ok {
+			r.DisallowKeyboard = v
+		}
+		if v, ok := paramNumber(p, "min_entropy"); ok {
+			r.MinEntropy = v
+		}
+		if v := paramStrings(p, "not_similar_to"); len(v) > 0 {
+			r.NotSimilarTo = v
+		}
+	}
+}
+
+// buildNumber, sayı tanımından NumberType oluşturur.
+func buildNumber(path string, desc *core.TypeDescription) (core.Type, error) {
+	n := Number()
+	for _, rule := range desc.Rules {
+		if handled, err := applyChangeRule(path, n, rule); handled || err != nil {
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+		p := rule.Params
+		switch rule.Name {
+		case "integer":
+			n.Integer()
+		case "min":
+			v, _ := paramNumber(p, "value")
+			n.Min(v)
+		case "max":
+			v, _ := paramNumber(p, "value")
+			n.Max(v)
+		case "positive":
+			n.Positive()
+		case "negative":
+			n.Negative()
+		case "multiple_of":
+			v, _ := paramNumber(p, "value")
+			n.MultipleOf(v)
+		case "between":
+			lo, _ := paramNumber(p, "min")
+			hi, _ := paramNumber(p, "max")
+			n.Between(lo, hi)
+		default:
+			return nil, unknownRule(path, desc.Type, rule)
+		}
+	}
+	return n, nil
+}
+
+// buildDate, tarih tanımından DateType oluşturur.
+func buildDate(path string, desc *core.TypeDescription) (core.Type, error) {
+	d := Date()
+	for _, rule := range desc.Rules {
+		if handled, err := applyChangeRule(path, d, rule); handled || err != nil {
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+		switch rule.Name {
+		case "format":
+			layout, _ := paramString(rule.Params, "layout")
+			d.Format(layout)
+		case "min":
+			value, _ := paramString(rule.Params, "value")
+			d.Min(value)
+		case "max":
+			value, _ := paramString(rule.Params, "value")
+			d.Max(value)
+		default:
+			return nil, unknownRule(path, desc.Type, rule)
+		}
+	}
+	return d, nil
+}
+
+// buildFile, dosya tanımından FileType oluşturur.
+func buildFile(path string, desc *core.TypeDescription) (core.Type, error) {
+	f := File()
+	rest, err := applyFileRules(path, f, desc)
+	if err != nil {
+		return nil, err
+	}
+	return buildSimple(path, &core.TypeDescription{Type: desc.Type, Rules: rest}, f)
+}
+
+// buildImage, görsel tanımından ImageType oluşturur.
+func buildImage(path string, desc *core.TypeDescription) (core.Type, error) {
+	img := Image()
+	fileRules, err := applyFileRules(path, &img.FileType, desc)
+	if err != nil {
+		return nil, err
+	}
+	var rest []core.RuleDescription
+	for _, rule := range fileRules {
+		p := rule.Params
+		switch rule.Name {
+		case "formats":
+			img.Formats(paramStrings(p, "values")...)
+		case "min_width":
+			img.MinWidth(paramInt(p, "value"))
+		case "max_width":
+			img.MaxWidth(paramInt(p, "value"))
+		case "min_height":
+			img.MinHeight(paramInt(p, "value"))
+		case "max_height":
+			img.MaxHeight(paramInt(p, "value"))
+		case "max_megapixels":
+			mp, _ := paramNumber(p, "value")
+			img.MaxMegapixels(mp)
+		case "aspect_ratio":
+			img.AspectRatio(paramInt(p, "width"), paramInt(p, "height"))
+		default:
+			rest = append(rest, rule)
+		}
+	}
+	return buildSimple(path, &core.TypeDescription{Type: desc.Type, Rules: rest}, img)
+}
+
+// applyFileRules, dosya kurallarını ve dönüşümlerini f'ye uygular; dosyaya ait
+// olmayan kuralları döndürür.
+func applyFileRules(path string, f *types.FileType, desc *core.TypeDescription) ([]core.RuleDescription, error) {
+	for _, name := range desc.Transforms {
+		if name != "sanitize_filename" {
+			return nil, fmt.Errorf("%w: %s: %q dönüşümü", ErrNotDeclarative, path, name)
+		}
+		f.SanitizeFilename()
+	}
+	var rest []core.RuleDescription
+	for _, rule := range desc.Rules {
+		switch rule.Name {
+		case "max_size":
+			size, _ := paramNumber(rule.Params, "value")
+			f.MaxSize(int64(size))
+		case "mime_types":
+			f.MIMETypes(paramStrings(rule.Params, "values")...)
+		case "extensions":
+			f.Extensions(paramStrings(rule.Params, "values")...)
+		default:
+			rest = append(rest, rule)
+		}
+	}
+	return rest, nil
+}
+
+// buildUuid, UUID tanımından UuidType oluşturur.
+func buildUuid(path string, desc *core.TypeDescription) (core.Type, error) {
+	u := Uuid()
+	var rest []core.RuleDescription
+	for _, rule := range desc.Rules {
+		if rule.Name == "version" {
+			u.Version(paramInt(rule.Params, "value"))
+			continue
+		}
+		rest = append(rest, rule)
+	}
+	return buildSimple(path, &core.TypeDescription{Type: desc.Type, Rules: rest}, u)
+}
+
+// buildIban, IBAN tanımından IbanType oluşturur.
+func buildIban(path string, desc *core.TypeDescription) (core.Type, error) {
+	i := Iban()
+	var rest []core.RuleDescription
+	for _, rule := range desc.Rules {
+		if rule.Name == "country" {
+			code, _ := paramString(rule.Params, "value")
+			i.Country(code)
+			continue
+		}
+		rest = append(rest, rule)
+	}
+	return buildSimple(path, &core.TypeDescription{Type: desc.Type, Rules: rest}, i)
+}
+
+// buildEmail, e-posta tanımından EmailType oluşturur.
+func buildEmail(path string, desc *core.TypeDescription) (core.Type, error) {
+	e := Email()
+	var rest []core.RuleDescription
+	for _, rule := range desc.Rules {
+		switch rule.Name {
+		case "domain_in":
+			e.DomainIn(paramStrings(rule.Params, "values")...)
+		case "domain_not_in":
+			e.NotDomainIn(paramStrings(rule.Params, "values")...)
+		case "reject_free_providers":
+			e.RejectFreeProviders()
+		case "reject_role_accounts":
+			e.RejectRoleAccounts()
+		case "domain_resolvable":
+			e.DomainResolvable()
+		case "has_mx":
+			e.HasMX()
+		case "has_txt":
+			prefix, _ := paramString(rule.Params, "value")
+			e.HasTXT(prefix)
+		default:
+			rest = append(rest, rule)
+		}
+	}
+	return buildSimple(path, &core.TypeDescription{Type: desc.Type, Rules: rest}, e)
+}
+
+// buildCreditCard, kart tanımından CreditCardType oluşturur. BIN sorgusu
+// fonksiyon içerdiğinden bildirimsel değildir.
+func buildCreditCard(path string, desc *core.TypeDescription) (core.Type, error) {
+	c := CreditCard()
+	for _, rule := range desc.Rules {
+		switch rule.Name {
+		case "card_type":
+			cardType, _ := paramString(rule.Params, "value")
+			c.Type(cardType)
+		case "bin_lookup":
+			return nil, fmt.Errorf("%w: %s: BINLookup", ErrNotDeclarative, path)
+		default:
+			return nil, unknownRule(path, desc.Type, rule)
+		}
+	}
+	return c, nil
+}
+
+// buildObject, nesne tanımından alt alanlarıyla birlikte ObjectType (Ref
+// tanımlarında RefType) oluşturur.
+func buildObject(path string, desc *core.TypeDescription) (core.Type, error) {
+	if desc.Discriminator != "" || len(desc.Variants) > 0 {
+		return nil, fmt.Errorf("%s: ayrık birleşim yalnızca dizi elemanlarında desteklenir", path)
+	}
+	if desc.Ref != "" {
+		// Alanlar kayıtlı şemadan gelir; açılmış Fields yeniden kurulmaz.
+		return buildSimple(path, &core.TypeDescription{Type: desc.Type, Rules: desc.Rules}, Ref(desc.Ref))
+	}
+	o := Object()
+	shape := make(map[string]core.Type, len(desc.Fields))
+	for _, name := range sortedKeys(desc.Fields) {
+		typ, err := buildType(path+"."+name, desc.Fields[name])
+		if err != nil {
+			return nil, err
+		}
+		shape[name] = typ
+	}
+	o.Shape(shape)
+	if _, err := buildSimple(path, &core.TypeDescription{Type: desc.Type, Rules: desc.Rules}, o); err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+// buildUnion, birleşim tanımından alternatifleri aynı sırayla kurarak
+// UnionType oluşturur.
+func buildUnion(path string, desc *core.TypeDescription) (core.Type, error) {
+	alternatives := make([]core.Type, len(desc.Union))
+	for i, alternative := range desc.Union {
+		typ, err := buildType(fmt.Sprintf("%s|%d", path, i), alternative)
+		if err != nil {
+			return nil, err
+		}
+		alternatives[i] = typ
+	}
+	return buildSimple(path, &core.TypeDescription{Type: desc.Type, Rules: desc.Rules}, Union(alternatives...))
+}
+
+// buildJSONPatch, JSON Patch tanımından hedef şemasıyla JSONPatchType oluşturur.
+func buildJSONPatch(path string, desc *core.TypeDescription) (core.Type, error) {
+	var target core.Schema
+	if desc.Fields != nil {
+		shape := make(map[string]core.Type, len(desc.Fields))
+		for _, name := range sortedKeys(desc.Fields) {
+			typ, err := buildType(path+"."+name, desc.Fields[name])
+			if err != nil {
+				return nil, err
+			}
+			shape[name] = typ
+		}
+		target = Make().Shape(shape)
+	}
+	return buildSimple(path, &core.TypeDescription{Type: desc.Type, Rules: desc.Rules}, JSONPatch(target))
+}
+
+// buildArray, dizi tanımından eleman şeması ve varyantlarıyla ArrayType oluşturur.
+func buildArray(path string, desc *core.TypeDescription) (core.Type, error) {
+	a := Array()
+	for _, rule := range desc.Rules {
+		switch rule.Name {
+		case "min":
+			a.Min(paramInt(rule.Params, "value"))
+		case "max":
+			a.Max(paramInt(rule.Params, "value"))
+		case "not_empty":
+			a.NotEmpty()
+		case "unique":
+			a.Unique()
+		case "contains":
+			a.Contains(rule.Params["value"])
+		case "no_overlap":
+			start, _ := paramString(rule.Params, "start")
+			end, _ := paramString(rule.Params, "end")
+			a.NoOverlap(start, end)
+		default:
+			if _, err := buildSimple(path, &core.TypeDescription{Type: desc.Type, Rules: []core.RuleDescription{rule}}, a); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	elements := desc.Elements
+	switch {
+	case elements == nil:
+	case elements.Discriminator != "":
+		variants := make(map[string]core.Type, len(elements.Variants))
+		for _, kind := range sortedKeys(elements.Variants) {
+			typ, err := buildType(path+"[]."+kind, elements.Variants[kind])
+			if err != nil {
+				return nil, err
+			}
+			variants[kind] = typ
+		}
+		a.ElementsBy(elements.Discriminator, variants)
+	default:
+		typ, err := buildType(path+"[]", elements)
+		if err != nil {
+			return nil, err
+		}
+		a.Elements(typ)
+	}
+	return a, nil
+}
+
+// buildLiteral, literal tanımından sabitiyle LiteralType oluşturur.
+func buildLiteral(path string, desc *core.TypeDescription) (core.Type, error) {
+	rule := desc.Rule("literal")
+	if rule == nil {
+		return nil, fmt.Errorf("%s: literal değeri belirtilmemiş", path)
+	}
+	return Literal(rule.Params["value"]), nil
+}
+
+// buildMap, map tanımından anahtar ve değer şemalarıyla MapType oluşturur.
+func buildMap(path string, desc *core.TypeDescription) (core.Type, error) {
+	m := Map()
+	for _, rule := range desc.Rules {
+		switch rule.Name {
+		case "min_keys":
+			m.MinKeys(paramInt(rule.Params, "value"))
+		case "max_keys":
+			m.MaxKeys(paramInt(rule.Params, "value"))
+		default:
+			if _, err := buildSimple(path, &core.TypeDescription{Type: desc.Type, Rules: []core.RuleDescription{rule}}, m); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if desc.Keys != nil {
+		typ, err := buildType(path+"{}", desc.Keys)
+		if err != nil {
+			return nil, err
+		}
+		m.Keys(typ)
+	}
+	if desc.Values != nil {
+		typ, err := buildType(path+".*", desc.Values)
+		if err != nil {
+			return nil, err
+		}
+		m.Values(typ)
+	}
+	return m, nil
+}
+
+// sortedKeys, map anahtarlarını deterministik sırayla döndürür.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// paramNumber, parametreyi sayı olarak okur. JSON'dan gelen float64 ve
+// kod içinde tanımlanan tamsayı değerler desteklenir.
+func paramNumber(p map[string]any, key string) (float64, bool) {
+	v, ok := p[key]
+	if !ok {
+		return 0, false
+	}
+	return core.ToFloat64(v)
+}
+
+// paramInt, parametreyi tamsayı olarak okur; yoksa 0 döner.
+func paramInt(p map[string]any, key string) int {
+	v, _ := paramNumber(p, key)
+	return int(v)
+}
+
+// paramString, parametreyi string olarak okur.
+func paramString(p map[string]any, key string) (string, bool) {
+	v, ok := p[key].(string)
+	return v, ok
+}
+
+// paramBool, parametreyi bool olarak okur.
+func paramBool(p map[string]any, key string) (bool, bool) {
+	v, ok := p[key].(bool)
+	return v, ok
+}
+
+// paramStrings, parametreyi string listesi olarak okur ([]string veya JSON'dan
+// gelen []any).
+func paramStrings(p map[string]any, key string) []string {
+	switch v := p[key].(type) {
+	case []string:
+		return v
+	case []any:
+		out := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				out = append(out, s)
+			}
+		}
+		return out
+	}
+	return nil
+}
+
+// paramInts, parametreyi tamsayı listesi olarak okur ([]int veya JSON'dan
+// gelen []any).
+func paramInts(p map[string]any, key string) []int {
+	switch v := p[key].(type) {
+	case []int:
+		return v
+	case []any:
+		out := make([]int, 0, len(v))
+		for _, item := range v {
+			if n, ok := core.ToFloat64(item); ok {
+				out = append(out, int(n))
+			}
+		}
+		return out
+	}
+	return nil
+}
+
+// paramTransitions, durum geçiş tablosunu okur (map[string][]string veya JSON'dan
+// gelen map[string]any).
+func paramTransitions(p map[string]any, key string) map[string][]string {
+	switch v := p[key].(type) {
+	case map[string][]string:
+		return v
+	case map[string]any:
+		out := make(map[string][]string, len(v))
+		for from, to := range v {
+			out[from] = paramStrings(map[string]any{"to": to}, "to")
+		}
+		return out
+	}
+	return nil
+}
@@ -0,0 +1,204 @@
+package validation
+
+import (
+	"context"
+	"regexp"
+	"sync"
+
+	"github.com/biyonik/go-fluent-validator/core"
+)
+
+//
+// -----------------------------------------------------------------------------
+// Doğrulama Hata İstatistikleri
+// -----------------------------------------------------------------------------
+// Bu dosya, hangi alanların ve kuralların ne sıklıkla başarısız olduğunu harici
+// bir izleme aracına ihtiyaç duymadan saymak için StatsCollector yapısını ve
+// ilgili şema seçeneklerini içerir. Ürün ekipleri hangi form alanlarının
+// kullanıcıların kafasını karıştırdığını, API ekipleri ise hangi istemcilerin
+// hatalı veri gönderdiğini (WithFailureHook ile) bu sayede görebilir.
+//
+// Dizi indeksleri alan yollarında normalize edilir ("items[3].sku" →
+// "items[].sku"); böylece sayaç sayısı veri boyutuyla büyümez.
+//
+// Metadata:
+// @author    Ahmet ALTUN
+// @github    github.com/biyonik
+// @linkedin  linkedin.com/in/biyonik
+// @email     ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// Alias'lar (kullanıcı dostu API için)
+type Failure = core.Failure
+
+// arrayIndexPattern, alan yollarındaki dizi indekslerini bulur.
+var arrayIndexPattern = regexp.MustCompile(`\[\d+\]`)
+
+// defaultStats, Stats() ile erişilen paket seviyesindeki toplayıcıdır.
+var defaultStats = NewStatsCollector()
+
+// StatsCollector
+// -----------------------------------------------------------------------------
+// Doğrulama sonuçlarından alan ve kural bazlı hata sayaçları toplar.
+// Eşzamanlı kullanım için güvenlidir.
+type StatsCollector struct {
+	mu          sync.Mutex
+	validations int
+	failed      int
+	fields      map[string]int
+	rules       map[string]map[string]int
+}
+
+// StatsSnapshot, StatsCollector'ın belirli bir andaki kopyasıdır.
+type StatsSnapshot struct {
+	// Validations, kaydedilen toplam doğrulama sayısıdır.
+	Validations int `json:"validations"`
+
+	// Failed, en az bir hata içeren doğrulama sayısıdır.
+	Failed int `json:"failed"`
+
+	// Fields, alan başına toplam hata sayısıdır.
+	Fields map[string]int `json:"fields"`
+
+	// Rules, alan → kural → hata sayısı eşlemesidir.
+	Rules map[string]map[string]int `json:"rules"`
+}
+
+// NewStatsCollector
+// -----------------------------------------------------------------------------
+// Boş bir StatsCollector oluşturur.
+func NewStatsCollector() *StatsCollector {
+	return &StatsCollector{
+		fields: make(map[string]int),
+		rules:  make(map[string]map[string]int),
+	}
+}
+
+// Stats
+// -----------------------------------------------------------------------------
+// WithStats(nil) ile işaretlenen şemaların kayıt yaptığı paket seviyesindeki
+// toplayıcıyı döndürür.
+//
+// Örnek:
+//
+//	schema := validation.Make(validation.WithStats(nil))
+//	...
+//	snapshot := validation.Stats().Snapshot()
+//	fmt.Println(snapshot.Fields["email"])
+func Stats() *StatsCollector {
+	return defaultStats
+}
+
+// Record
+// -----------------------------------------------------------------------------
+// Bir doğrulama sonucunu sayaçlara ekler.
+func (c *StatsCollector) Record(result *core.ValidationResult) {
+	failures := result.Failures()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.validations++
+	if len(failures) == 0 {
+		return
+	}
+	c.failed++
+
+	for _, failure := range failures {
+		field := normalizeFieldPath(failure.Field)
+		c.fields[field]++
+		if c.rules[field] == nil {
+			c.rules[field] = make(map[string]int)
+		}
+		c.rules[field][failure.Rule]++
+	}
+}
+
+// Snapshot
+// -----------------------------------------------------------------------------
+// Sayaçların bağımsız bir kopyasını döndürür.
+func (c *StatsCollector) Snapshot() StatsSnapshot {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	snapshot := StatsSnapshot{
+		Validations: c.validations,
+		Failed:      c.failed,
+		Fields:      make(map[string]int, len(c.fields)),
+		Rules:       make(map[string]map[string]int, len(c.rules)),
+	}
+	for field, count := range c.fields {
+		snapshot.Fields[field] = count
+	}
+	for field, rules := range c.rules {
+		copied := make(map[string]int, len(rules))
+		for rule, count := range rules {
+			copied[rule] = count
+		}
+		snapshot.Rules[field] = copied
+	}
+	return snapshot
+}
+
+// Reset
+// -----------------------------------------------------------------------------
+// Tüm sayaçları sıfırlar.
+func (c *StatsCollector) Reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.validations = 0
+	c.failed = 0
+	c.fields = make(map[string]int)
+	c.rules = make(map[string]map[string]int)
+}
+
+// WithStats
+// -----------------------------------------------------------------------------
+// Şemanın her doğrulama sonucunu collector'a kaydetmesini sağlar. nil
+// verilirse paket seviyesindeki Stats() toplayıcısı kullanılır.
+func WithStats(collector *StatsCollector) SchemaOption {
+	if collector == nil {
+		collector = defaultStats
+	}
+	return func(vs *ValidationSchema) {
+		vs.stats = collector
+	}
+}
+
+// WithFailureHook
+// -----------------------------------------------------------------------------
+// Her başarısız kural için hook'u çağırır. ValidateCtx ile verilen context
+// hook'a iletilir; böylece istemci kimliği gibi istek bilgileriyle birlikte
+// harici metrik sistemlerine kayıt yapılabilir.
+//
+// Örnek:
+//
+//	validation.Make(validation.WithFailureHook(func(ctx context.Context, f validation.Failure) {
+//	    metrics.Inc("validation_failure", f.Field, f.Rule, clientID(ctx))
+//	}))
+func WithFailureHook(hook func(ctx context.Context, failure core.Failure)) SchemaOption {
+	return func(vs *ValidationSchema) {
+		vs.failureHooks = append(vs.failureHooks, hook)
+	}
+}
+
+// record, sonucu tanımlı toplayıcıya ve hook'lara iletir.
+func (vs *ValidationSchema) record(ctx context.Context, result *core.ValidationResult) {
+	if vs.stats != nil {
+		vs.stats.Record(result)
+	}
+	if len(vs.failureHooks) == 0 {
+		return
+	}
+	for _, failure := range result.Failures() {
+		for _, hook := range vs.failureHooks {
+			hook(ctx, failure)
+		}
+	}
+}
+
+// normalizeFieldPath, alan yolundaki dizi indekslerini kaldırır.
+func normalizeFieldPath(field string) string {
+	return arrayIndexPattern.ReplaceAllString(field, "[]")
+}
@@ -0,0 +1,124 @@
+package validation
+
+import (
+	"github.com/biyonik/go-fluent-validator/core"
+	"github.com/biyonik/go-fluent-validator/i18n"
+)
+
+//
+// -----------------------------------------------------------------------------
+// Çok Adımlı (Wizard) Şemalar
+// -----------------------------------------------------------------------------
+// Bu dosya, ödeme (checkout) veya kayıt (onboarding) gibi birden fazla adımda
+// doldurulan formları modellemek için StepSchema yapısını içerir.
+//
+// Her adım kendi şemasıyla tanımlanır. ValidateStep(n, data) çağrıldığında
+// 1..n arasındaki tüm adımlar doğrulanır; böylece kullanıcı önceki adımları
+// atlayarak ilerleyemez. Son olarak Validate(data), tüm adımları birlikte
+// yeniden doğrular.
+//
+// Metadata:
+// @author    Ahmet ALTUN
+// @github    github.com/biyonik
+// @linkedin  linkedin.com/in/biyonik
+// @email     ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// StepSchema
+// -----------------------------------------------------------------------------
+// Sıralı adım şemalarını bir arada tutar. Adımlar 1'den başlayarak
+// numaralandırılır.
+type StepSchema struct {
+	steps []core.Schema
+}
+
+// Steps
+// -----------------------------------------------------------------------------
+// Verilen şemaları sırasıyla adım olarak kullanan yeni bir StepSchema oluşturur.
+//
+// Parametreler:
+//   - steps: Adım şemaları (ilk şema 1. adımdır)
+//
+// Dönüş:
+//   - *StepSchema
+//
+// Örnek:
+//
+//	wizard := validation.Steps(
+//	    validation.Make().Shape(map[string]validation.Type{
+//	        "email": validation.String().Required().Email(),
+//	    }),
+//	    validation.Make().Shape(map[string]validation.Type{
+//	        "address": validation.String().Required(),
+//	    }),
+//	)
+//	res := wizard.ValidateStep(2, data) // email + address
+func Steps(steps ...core.Schema) *StepSchema {
+	return &StepSchema{steps: steps}
+}
+
+// StepCount
+// -----------------------------------------------------------------------------
+// Tanımlı adım sayısını döndürür.
+func (s *StepSchema) StepCount() int {
+	return len(s.steps)
+}
+
+// ValidateStep
+// -----------------------------------------------------------------------------
+// 1..step arasındaki tüm adımları doğrular ve sonuçlarını birleştirir.
+// Hata yoksa ValidData, bu adımların tamamının ValidData'sını içerir.
+//
+// Parametreler:
+//   - step: Doğrulanacak son adım (1 tabanlı)
+//   - data: Şu ana kadar toplanmış veri
+//
+// Dönüş:
+//   - *core.ValidationResult
+//
+// Geçersiz bir adım numarası verilirse "_step" alanına hata eklenir.
+func (s *StepSchema) ValidateStep(step int, data map[string]any) *core.ValidationResult {
+	if step < 1 || step > len(s.steps) {
+		result := core.NewResult()
+		result.AddRuleError("_step", i18n.KeyInvalidStep, step, len(s.steps))
+		return result
+	}
+	return s.validateUpTo(step, data)
+}
+
+// Validate
+// -----------------------------------------------------------------------------
+// Son gönderim öncesi tüm adımları birlikte yeniden doğrular. Önceki adımlarda
+// doğrulanmış verinin sonradan değiştirilmiş olması ihtimaline karşı
+// kullanılmalıdır.
+func (s *StepSchema) Validate(data map[string]any) *core.ValidationResult {
+	return s.validateUpTo(len(s.steps), data)
+}
+
+// validateUpTo, ilk n adımı çalıştırır; hataları ve ValidData'yı birleştirir.
+func (s *StepSchema) validateUpTo(n int, data map[string]any) *core.ValidationResult {
+	result := core.NewResult()
+	validData := make(map[string]any)
+	transformedData := make(map[string]any)
+
+	for _, step := range s.steps[:n] {
+		stepResult := step.Validate(data)
+		for k, v := range stepResult.TransformedData() {
+			transformedData[k] = v
+		}
+		if stepResult.HasErrors() {
+			result.Merge(stepResult)
+			continue
+		}
+		for k, v := range stepResult.ValidData() {
+			validData[k] = v
+		}
+	}
+
+	result.SetTransformedData(transformedData)
+	if !result.HasErrors() {
+		result.SetValidData(validData)
+	}
+
+	return result
+}
@@ -0,0 +1,427 @@
+package validation
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/biyonik/go-fluent-validator/core"
+)
+
+//
+// -----------------------------------------------------------------------------
+// Struct Etiketleriyle Doğrulama
+// -----------------------------------------------------------------------------
+// İstek gövdelerini zaten struct olarak modelleyen servislerde şemayı elle
+// map[string]Type olarak kurmadan, alan etiketlerinden doğrulama yapılmasını
+// sağlar:
+//
+//	type SignupRequest struct {
+//	    Name    string   `json:"name" label:"Ad Soyad" validate:"required|min:3|max:50"`
+//	    Email   string   `json:"email" validate:"required|email"`
+//	    Age     int      `json:"age" validate:"min:18"`
+//	    Role    string   `json:"role" validate:"oneof:admin,editor,viewer"`
+//	    Address *Address `json:"address" validate:"required"`
+//	}
+//	res := validation.Struct(req)
+//
+// Etiketler şema tanımına (core.SchemaDescription) çevrilir ve FromDescription
+// ile derlenir; bu yüzden validate etiketinde Describe() çıktısındaki kural
+// adları (email, url, regex, national_id, checksum, one_of, between...)
+// kullanılabilir. Kurallar "|" ile ayrılır, parametreler ":" sonrasında
+// virgülle verilir. Tek parametre "value" olarak, one_of listesi "values"
+// olarak, between "min,max" olarak geçirilir. "trim", "strip_tags" gibi
+// dönüşüm adları da aynı etikette kullanılabilir. "optional" ve "nullable"
+// bayrakları Optional() ve Nullable() ile aynı anlamı taşır.
+//
+// Alan adları json etiketinden (yoksa Go alan adından) alınır; json:"-" veya
+// validate:"-" ile işaretlenen alanlar atlanır. Go tipleri şöyle eşlenir:
+// string → String, tamsayılar → Number().Integer(), ondalıklar → Number,
+// bool → Boolean, time.Time → Date, struct → Object, slice/array → Array.
+// Pointer alanlar nil ise gönderilmemiş sayılır. Derlenen şemalar struct
+// tipine göre önbelleğe alınır. Kendine başvuran struct'lar (örn.
+// Children []*Category) şemaya açılamadığından hata döndürür; bu yapılar için
+// şema Lazy veya Ref ile elle kurulmalıdır.
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// structSchemas, struct tipine göre derlenmiş şemaların önbelleğidir.
+var structSchemas sync.Map // reflect.Type → structSchemaEntry
+
+// structSchemaEntry, önbellekteki derleme sonucudur.
+type structSchemaEntry struct {
+	schema *ValidationSchema
+	err    error
+}
+
+// Struct
+// -----------------------------------------------------------------------------
+// v'yi (struct veya struct pointer'ı) validate, label ve json etiketlerinden
+// üretilen şemayla doğrular. Sonuç Schema.Validate ile aynıdır; ValidData
+// json alan adlarıyla döner. Etiketler derlenemezse hata "_payload" alanına
+// eklenir; hatayı ayrıca almak için StructSchema kullanılabilir.
+func Struct(v any) *core.ValidationResult {
+	schema, err := StructSchema(v)
+	if err != nil {
+		result := core.NewResult()
+		result.AddErrorRule(payloadField, "validate", err.Error())
+		return result
+	}
+	data, _ := normalizeValue(reflect.ValueOf(v), jsonFieldName).(map[string]any)
+	return schema.Validate(data)
+}
+
+// StructSchema
+// -----------------------------------------------------------------------------
+// v'nin tipinden etiketlere göre şemayı derler (veya önbellekten döndürür).
+// Dönen şema diğer şemalar gibi Describe, MarshalJSON ve ValidateCtx ile
+// kullanılabilir.
+func StructSchema(v any) (*ValidationSchema, error) {
+	rt := reflect.TypeOf(v)
+	for rt != nil && rt.Kind() == reflect.Pointer {
+		rt = rt.Elem()
+	}
+	if rt == nil || rt.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("validation.Struct: struct bekleniyordu, %v alındı", reflect.TypeOf(v))
+	}
+
+	return compileStruct(rt, &structSchemas, func(fields map[string]*core.TypeDescription) (*core.SchemaDescription, error) {
+		return &core.SchemaDescription{Fields: fields}, nil
+	}, applyStructTag)
+}
+
+// structTagFunc, bir alanın validate etiketini tip tanımına uygular.
+type structTagFunc func(desc *core.TypeDescription, tag string) error
+
+// compileStruct, rt'nin alanlarını apply ile çözüp build ile şema tanımına
+// çevirir ve derlenen şemayı cache'e yazar (veya cache'ten döndürür).
+func compileStruct(rt reflect.Type, cache *sync.Map, build func(map[string]*core.TypeDescription) (*core.SchemaDescription, error), apply structTagFunc) (*ValidationSchema, error) {
+	if cached, ok := cache.Load(rt); ok {
+		entry := cached.(structSchemaEntry)
+		return entry.schema, entry.err
+	}
+
+	var entry structSchemaEntry
+	fields := make(map[string]*core.TypeDescription)
+	if entry.err = describeStructFields(rt, "", fields, apply, make(map[reflect.Type]bool)); entry.err == nil {
+		var desc *core.SchemaDescription
+		if desc, entry.err = build(fields); entry.err == nil {
+			entry.schema, entry.err = FromDescription(desc)
+		}
+	}
+	cache.Store(rt, entry)
+	return entry.schema, entry.err
+}
+
+// describeStructFields, struct alanlarının tanımlarını out'a yazar. Gömülü
+// struct'lar StructSource ile aynı şekilde üst seviyeye açılır. visiting,
+// açılmakta olan struct tiplerini tutar; aynı tipe yeniden ulaşılırsa döngü
+// hatası döner.
+func describeStructFields(rt reflect.Type, path string, out map[string]*core.TypeDescription, apply structTagFunc, visiting map[reflect.Type]bool) error {
+	if visiting[rt] {
+		name := strings.TrimSuffix(path, ".")
+		if name == "" {
+			name = rt.Name()
+		}
+		return fmt.Errorf("%s: %v tipi kendine başvuruyor; döngüsel struct'lar için Lazy veya Ref kullanın", name, rt)
+	}
+	visiting[rt] = true
+	defer delete(visiting, rt)
+
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		tag, tagged := field.Tag.Lookup("validate")
+		if tag == "-" {
+			continue
+		}
+
+		if field.Anonymous && field.Tag.Get("json") == "" {
+			embedded := field.Type
+			for embedded.Kind() == reflect.Pointer {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				if err := describeStructFields(embedded, path, out, apply, visiting); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+
+		names := jsonFieldName(field)
+		if len(names) == 0 {
+			continue
+		}
+		name := names[0]
+		desc, err := describeStructType(field.Type, path+name, apply, visiting)
+		if err != nil {
+			return err
+		}
+		if desc == nil {
+			if tagged {
+				return fmt.Errorf("%s%s: %v tipi doğrulanamaz", path, name, field.Type)
+			}
+			continue
+		}
+
+		desc.Label = field.Tag.Get("label")
+		if err := apply(desc, tag); err != nil {
+			return fmt.Errorf("%s%s: %w", path, name, err)
+		}
+		out[name] = desc
+	}
+	return nil
+}
+
+// describeStructType, bir Go tipini kuralsız tip tanımına çevirir. Eşlenemeyen
+// tiplerde (string dışı anahtarlı map, interface, func, chan) nil döner.
+func describeStructType(rt reflect.Type, path string, apply structTagFunc, visiting map[reflect.Type]bool) (*core.TypeDescription, error) {
+	for rt.Kind() == reflect.Pointer {
+		rt = rt.Elem()
+	}
+	if rt == reflect.TypeOf(time.Time{}) {
+		return &core.TypeDescription{Type: "date"}, nil
+	}
+
+	switch rt.Kind() {
+	case reflect.String:
+		return &core.TypeDescription{Type: "string"}, nil
+	case reflect.Bool:
+		return &core.TypeDescription{Type: "boolean"}, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &core.TypeDescription{Type: "number", Rules: []core.RuleDescription{{Name: "integer"}}}, nil
+	case reflect.Float32, reflect.Float64:
+		return &core.TypeDescription{Type: "number"}, nil
+	case reflect.Struct:
+		fields := make(map[string]*core.TypeDescription)
+		if err := describeStructFields(rt, path+".", fields, apply, visiting); err != nil {
+			return nil, err
+		}
+		return &core.TypeDescription{Type: "object", Fields: fields}, nil
+	case reflect.Slice, reflect.Array:
+		if rt.Elem().Kind() == reflect.Uint8 {
+			return nil, nil
+		}
+		elements, err := describeStructType(rt.Elem(), path+"[]", apply, visiting)
+		if err != nil {
+			return nil, err
+		}
+		return &core.TypeDescription{Type: "array", Elements: elements}, nil
+	case reflect.Map:
+		if rt.Key().Kind() != reflect.String {
+			return nil, nil
+		}
+		values, err := describeStructType(rt.Elem(), path+".*", apply, visiting)
+		if err != nil {
+			return nil, err
+		}
+		return &core.TypeDescription{Type: "map", Values: values}, nil
+	}
+	return nil, nil
+}
+
+// structTransforms, validate etiketinde kural yerine dönüşüm olarak işlenen
+// adlardır.
+var structTransforms = map[string]bool{
+	"trim": true, "strip_tags": true, "title_case": true,
+	"escape_html": true, "sanitize_filename": true, "filter_emoji": true,
+}
+
+// structRuleAliases, yaygın etiket adlarını şema kural adlarına eşler.
+var structRuleAliases = map[string]string{
+	"oneof": "one_of",
+	"in":    "one_of",
+}
+
+// structParamKeys, tek parametreli kuralların "value" dışındaki parametre
+// adlarıdır.
+var structParamKeys = map[string]string{
+	"ip":            "version",
+	"phone":         "country",
+	"regex":         "pattern",
+	"otp_code":      "length",
+	"national_id":   "country",
+	"license_plate": "country",
+	"checksum":      "algorithm",
+	"pem":           "block_type",
+	"format":        "layout",
+}
+
+// structNumericRules, parametresi sayı olarak okunan kurallardır (tarih
+// tiplerinde min/max string kalır).
+var structNumericRules = map[string]bool{
+	"min": true, "max": true, "multiple_of": true, "between": true, "ip": true, "otp_code": true,
+	"min_keys": true, "max_keys": true,
+}
+
+// applyStructTag, "required|min:3|email" biçimindeki etiketi desc'e uygular.
+func applyStructTag(desc *core.TypeDescription, tag string) error {
+	for _, part := range strings.Split(tag, "|") {
+		if err := applyStructRule(desc, part); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// applyStructRule, "min:3" biçimindeki tek bir kuralı (veya "required",
+// "trim" gibi bayrakları) desc'e uygular. Boş kurallar yok sayılır.
+func applyStructRule(desc *core.TypeDescription, part string) error {
+	part = strings.TrimSpace(part)
+	if part == "" {
+		return nil
+	}
+	name, arg, hasArg := strings.Cut(part, ":")
+	if alias, ok := structRuleAliases[name]; ok {
+		name = alias
+	}
+
+	switch {
+	case name == "required":
+		desc.Required = true
+		return nil
+	case name == "optional":
+		desc.Optional = true
+		return nil
+	case name == "nullable":
+		desc.Nullable = true
+		return nil
+	case structTransforms[name]:
+		desc.Transforms = append(desc.Transforms, name)
+		return nil
+	case !hasArg:
+		desc.AddRule(name, nil)
+		return nil
+	}
+
+	numeric := structNumericRules[name] && desc.Type != "date"
+	switch name {
+	case "one_of":
+		values := strings.Split(arg, ",")
+		items := make([]any, len(values))
+		for i, v := range values {
+			items[i] = strings.TrimSpace(v)
+		}
+		desc.AddRule(name, map[string]any{"values": items})
+	case "between":
+		lo, hi, ok := strings.Cut(arg, ",")
+		loValue, errLo := strconv.ParseFloat(strings.TrimSpace(lo), 64)
+		hiValue, errHi := strconv.ParseFloat(strings.TrimSpace(hi), 64)
+		if !ok || errLo != nil || errHi != nil {
+			return fmt.Errorf("between: geçersiz parametre %q", arg)
+		}
+		desc.AddRule(name, map[string]any{"min": loValue, "max": hiValue})
+	default:
+		key := "value"
+		if k, ok := structParamKeys[name]; ok {
+			key = k
+		}
+		var value any = arg
+		if numeric {
+			n, err := strconv.ParseFloat(arg, 64)
+			if err != nil {
+				return fmt.Errorf("%s: sayısal parametre bekleniyordu, %q alındı", name, arg)
+			}
+			value = n
+		}
+		desc.AddRule(name, map[string]any{key: value})
+	}
+	return nil
+}
+
+// StructTag
+// -----------------------------------------------------------------------------
+// Bir tip tanımını validate etiketi olarak yazar; Struct'ın etiket
+// ayrıştırmasının tersidir. Etikette ifade edilemeyen kurallar (birden fazla
+// parametreli kurallar, "|" içeren değerler, "," içeren one_of değerleri)
+// atlanır.
+//
+// Örnek:
+//
+//	validation.StructTag(desc) // "required|trim|min:3|email"
+func StructTag(desc *core.TypeDescription) string {
+	var parts []string
+	if desc.Required {
+		parts = append(parts, "required")
+	}
+	if desc.Optional {
+		parts = append(parts, "optional")
+	}
+	if desc.Nullable {
+		parts = append(parts, "nullable")
+	}
+	for _, transform := range desc.Transforms {
+		if structTransforms[transform] {
+			parts = append(parts, transform)
+		}
+	}
+	for _, rule := range desc.Rules {
+		if part, ok := structTagRule(desc, rule); ok {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, "|")
+}
+
+// structTagRule, tek bir kuralı etiket parçasına çevirir.
+func structTagRule(desc *core.TypeDescription, rule core.RuleDescription) (string, bool) {
+	p := rule.Params
+	switch {
+	case len(p) == 0:
+		return rule.Name, true
+	case rule.Name == "one_of":
+		values := paramStrings(p, "values")
+		if len(values) == 0 || len(values) != reflect.ValueOf(p["values"]).Len() {
+			return "", false
+		}
+		for _, v := range values {
+			if strings.ContainsAny(v, ",|") {
+				return "", false
+			}
+		}
+		return rule.Name + ":" + strings.Join(values, ","), true
+	case rule.Name == "between":
+		lo, okLo := paramNumber(p, "min")
+		hi, okHi := paramNumber(p, "max")
+		if !okLo || !okHi {
+			return "", false
+		}
+		return rule.Name + ":" + formatTagNumber(lo) + "," + formatTagNumber(hi), true
+	case len(p) != 1:
+		return "", false
+	}
+
+	key := "value"
+	if k, ok := structParamKeys[rule.Name]; ok {
+		key = k
+	}
+	if structNumericRules[rule.Name] && desc.Type != "date" {
+		n, ok := paramNumber(p, key)
+		if !ok {
+			return "", false
+		}
+		return rule.Name + ":" + formatTagNumber(n), true
+	}
+	value, ok := paramString(p, key)
+	if !ok || value == "" || strings.Contains(value, "|") {
+		return "", false
+	}
+	return rule.Name + ":" + value, true
+}
+
+// formatTagNumber, sayıyı etikette kullanılacak en kısa biçimde yazar.
+func formatTagNumber(n float64) string {
+	return strconv.FormatFloat(n, 'f', -1, 64)
+}
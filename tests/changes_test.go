@@ -0,0 +1,157 @@
+// -----------------------------------------------------------------------------
+// Change (Diff-Aware) Validation Tests
+// -----------------------------------------------------------------------------
+// Bu dosya, güncelleme uç noktaları için eski ve yeni değeri birlikte gören
+// ValidateChanges akışını ve değişiklik kurallarını test eder.
+//
+// Metadata:
+// @author   Ahmet ALTUN
+// @github   github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email    ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+package tests
+
+import (
+	"fmt"
+	"testing"
+
+	validation "github.com/biyonik/go-fluent-validator"
+)
+
+// TestSchema_ValidateChanges tests Immutable, OnlyIncrease and OnChange rules
+func TestSchema_ValidateChanges(t *testing.T) {
+	schema := validation.Make().Shape(map[string]validation.Type{
+		"id":      validation.Uuid().Immutable(),
+		"version": validation.Number().Integer().OnlyIncrease(),
+		"status": validation.String().OneOf([]string{"draft", "published"}).
+			OnChange(func(oldValue, newValue string) error {
+				if oldValue == "published" && newValue == "draft" {
+					return fmt.Errorf("published posts cannot go back to draft")
+				}
+				return nil
+			}),
+	}).(*validation.ValidationSchema)
+
+	stored := map[string]any{
+		"id":      "550e8400-e29b-41d4-a716-446655440000",
+		"version": 3,
+		"status":  "published",
+	}
+
+	tests := []struct {
+		name       string
+		newData    map[string]any
+		wantFields []string
+	}{
+		{
+			"valid update",
+			map[string]any{"id": "550e8400-e29b-41d4-a716-446655440000", "version": float64(4), "status": "published"},
+			nil,
+		},
+		{
+			"immutable id changed",
+			map[string]any{"id": "a0eebc99-9c0b-4ef9-bb6d-00c04fd430c8", "version": 4},
+			[]string{"id"},
+		},
+		{
+			"version decreased",
+			map[string]any{"version": 2},
+			[]string{"version"},
+		},
+		{
+			"forbidden transition",
+			map[string]any{"status": "draft"},
+			[]string{"status"},
+		},
+		{
+			"omitted fields are unchanged",
+			map[string]any{},
+			nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := schema.ValidateChanges(stored, tt.newData)
+			if len(tt.wantFields) == 0 {
+				if result.HasErrors() {
+					t.Errorf("unexpected errors: %v", result.Errors())
+				}
+				return
+			}
+			for _, f := range tt.wantFields {
+				if _, ok := result.Errors()[f]; !ok {
+					t.Errorf("expected error on %q, got %v", f, result.Errors())
+				}
+			}
+		})
+	}
+
+	// Normal Validate değişiklik kurallarını çalıştırmamalı
+	if result := schema.Validate(map[string]any{"version": 1}); result.HasErrors() {
+		t.Errorf("Validate must ignore change rules: %v", result.Errors())
+	}
+}
+
+// TestSchema_TransitionRules tests String().Transition and schema-level TransitionRule
+func TestSchema_TransitionRules(t *testing.T) {
+	transitions := map[string][]string{
+		"draft":     {"published"},
+		"published": {"archived"},
+	}
+
+	t.Run("type level", func(t *testing.T) {
+		schema := validation.Make().Shape(map[string]validation.Type{
+			"status": validation.String().Transition(transitions),
+		}).(*validation.ValidationSchema)
+		stored := map[string]any{"status": "draft"}
+
+		if res := schema.ValidateChanges(stored, map[string]any{"status": "published"}); res.HasErrors() {
+			t.Errorf("draft -> published should be allowed, got %v", res.Errors())
+		}
+		if res := schema.ValidateChanges(stored, map[string]any{"status": "draft"}); res.HasErrors() {
+			t.Errorf("unchanged status should be allowed, got %v", res.Errors())
+		}
+		if res := schema.ValidateChanges(stored, map[string]any{"status": "archived"}); len(res.Errors()["status"]) == 0 {
+			t.Error("draft -> archived should be rejected")
+		}
+	})
+
+	t.Run("schema level with provider", func(t *testing.T) {
+		current := map[string]string{"1": "published"}
+		schema := validation.Make().Shape(map[string]validation.Type{
+			"id":     validation.String().Required(),
+			"status": validation.String().Required(),
+		}).(*validation.ValidationSchema).TransitionRule("status", transitions, func(data map[string]any) (string, bool) {
+			id, _ := data["id"].(string)
+			status, ok := current[id]
+			return status, ok
+		})
+
+		if res := schema.Validate(map[string]any{"id": "1", "status": "archived"}); res.HasErrors() {
+			t.Errorf("published -> archived should be allowed, got %v", res.Errors())
+		}
+		if res := schema.Validate(map[string]any{"id": "1", "status": "draft"}); len(res.Errors()["status"]) == 0 {
+			t.Error("published -> draft should be rejected")
+		}
+		if res := schema.Validate(map[string]any{"id": "2", "status": "draft"}); res.HasErrors() {
+			t.Errorf("unknown record should skip the check, got %v", res.Errors())
+		}
+	})
+
+	t.Run("schema level from stored data", func(t *testing.T) {
+		schema := validation.Make().Shape(map[string]validation.Type{
+			"status": validation.String(),
+		}).(*validation.ValidationSchema).TransitionRule("status", transitions, nil)
+
+		if res := schema.Validate(map[string]any{"status": "archived"}); res.HasErrors() {
+			t.Errorf("Validate without provider should not check transitions, got %v", res.Errors())
+		}
+		res := schema.ValidateChanges(map[string]any{"status": "published"}, map[string]any{"status": "draft"})
+		if len(res.Errors()["status"]) == 0 {
+			t.Error("published -> draft should be rejected")
+		}
+	})
+}
@@ -0,0 +1,271 @@
+// -----------------------------------------------------------------------------
+// Code Generation Tests
+// -----------------------------------------------------------------------------
+// Bu dosya, codegen paketinin şemalardan ürettiği TypeScript arayüzlerini ve
+// Zod şemalarını test eder.
+//
+// Metadata:
+// @author   Ahmet ALTUN
+// @github   github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email    ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+package tests
+
+import (
+	"strings"
+	"testing"
+
+	validation "github.com/biyonik/go-fluent-validator"
+	"github.com/biyonik/go-fluent-validator/codegen"
+	"github.com/biyonik/go-fluent-validator/core"
+)
+
+// codegenSchema builds the schema shared by the code generation tests
+func codegenSchema() *validation.ValidationSchema {
+	schema := validation.Make()
+	schema.Shape(map[string]validation.Type{
+		"email":  validation.String().Required().Email().Label("E-mail"),
+		"name":   validation.String().Min(3).Max(50).Regex(`^[a-z]+/[0-9]+$`),
+		"age":    validation.Number().Integer().Min(18),
+		"role":   validation.String().OneOf([]string{"admin", "viewer"}),
+		"tags":   validation.Array().Max(3).Elements(validation.String()),
+		"type":   validation.String().Required(),
+		"x-meta": validation.Object(),
+		"items": validation.Array().ElementsBy("kind", map[string]core.Type{
+			"book": validation.Object().Shape(map[string]validation.Type{"isbn": validation.String().Required()}),
+			"song": validation.Object().Shape(map[string]validation.Type{"seconds": validation.Number().Positive()}),
+		}),
+	})
+	schema.When("type", "company", func() core.Schema {
+		return validation.Make().Shape(map[string]validation.Type{
+			"tax_id": validation.String().Required(),
+		})
+	})
+	return schema
+}
+
+// TestCodegen_TypeScript tests interface generation with JSDoc constraints
+func TestCodegen_TypeScript(t *testing.T) {
+	out := codegen.TypeScriptInterface("SignupRequest", codegenSchema())
+
+	for _, want := range []string{
+		"export interface SignupRequest {\n",
+		"  /**\n   * E-mail\n   * @format email\n   */\n  email: string;\n",
+		"  /**\n   * @minLength 3\n   * @maxLength 50\n   * @pattern ^[a-z]+/[0-9]+$\n   */\n  name?: string;\n",
+		"   * @integer\n   * @minimum 18\n   */\n  age?: number;\n",
+		"  role?: \"admin\" | \"viewer\";\n",
+		"  /** @maxItems 3 */\n  tags?: string[];\n",
+		"  items?: ({\n    isbn: string;\n    kind: \"book\";\n  } | {\n",
+		"  tax_id?: string;\n",
+		"  type: string;\n  \"x-meta\"?: Record<string, unknown>;\n}\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output is missing %q:\n%s", want, out)
+		}
+	}
+}
+
+// TestCodegen_Zod tests Zod schema generation and conditional refinements
+func TestCodegen_Zod(t *testing.T) {
+	out := codegen.ZodSchema("SignupRequest", codegenSchema())
+
+	for _, want := range []string{
+		"export const SignupRequestSchema = z.object({\n",
+		`  email: z.string().email().describe("E-mail"),`,
+		`  name: z.string().min(3).max(50).regex(/^[a-z]+\/[0-9]+$/).optional(),`,
+		`  age: z.number().int().min(18).optional(),`,
+		`  role: z.enum(["admin", "viewer"]).optional(),`,
+		`  tags: z.array(z.string()).max(3).optional(),`,
+		`  items: z.array(z.discriminatedUnion("kind", [`,
+		`seconds: z.number().gt(0).optional(),`,
+		`    if (fields["type"] === "company") {`,
+		`      for (const key of ["tax_id"]) {`,
+		"export type SignupRequest = z.infer<typeof SignupRequestSchema>;\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output is missing %q:\n%s", want, out)
+		}
+	}
+}
+
+// TestCodegen_Generate tests file generation, ordering and input checks
+func TestCodegen_Generate(t *testing.T) {
+	schemas := map[string]*validation.ValidationSchema{
+		"Signup": codegenSchema(),
+		"Login":  validation.Make(),
+	}
+
+	out, err := codegen.Generate(codegen.Zod, schemas)
+	if err != nil {
+		t.Fatalf("Generate: %v", err)
+	}
+	src := string(out)
+	if !strings.HasPrefix(src, "// Code generated") || !strings.Contains(src, `import { z } from "zod";`) {
+		t.Errorf("missing header or import:\n%s", src)
+	}
+	if strings.Index(src, "LoginSchema") > strings.Index(src, "SignupSchema") {
+		t.Error("declarations should be sorted by name")
+	}
+	if again, _ := codegen.Generate(codegen.Zod, schemas); string(again) != src {
+		t.Error("output should be deterministic")
+	}
+
+	if out, _ := codegen.Generate(codegen.TypeScript, schemas); strings.Contains(string(out), "import") {
+		t.Errorf("TypeScript output should not import zod:\n%s", out)
+	}
+	if _, err := codegen.Generate("flow", schemas); err == nil {
+		t.Error("unknown target should fail")
+	}
+	if _, err := codegen.Generate(codegen.TypeScript, map[string]*validation.ValidationSchema{"sign-up": validation.Make()}); err == nil {
+		t.Error("invalid declaration name should fail")
+	}
+}
+
+// generatedSignup mirrors the struct GoStruct emits for goCodegenSchema
+type generatedSignup struct {
+	Age    *int64   `json:"age,omitempty" validate:"min:18"`
+	Email  string   `json:"email" label:"E-mail" validate:"required|trim|email"`
+	Ratio  *float64 `json:"ratio,omitempty" validate:"between:0,1.5"`
+	Role   *string  `json:"role,omitempty" validate:"one_of:admin,viewer"`
+	UserID *string  `json:"user_id,omitempty" validate:"min:3|max:20"`
+}
+
+// goCodegenSchema builds the schema used by the Go struct generation test
+func goCodegenSchema() *validation.ValidationSchema {
+	schema := validation.Make()
+	schema.Shape(map[string]validation.Type{
+		"email":   validation.String().Required().Trim().Email().Label("E-mail"),
+		"user_id": validation.String().Min(3).Max(20),
+		"age":     validation.Number().Integer().Min(18),
+		"ratio":   validation.Number().Between(0, 1.5),
+		"role":    validation.String().OneOf([]string{"admin", "viewer"}),
+	})
+	return schema
+}
+
+// TestCodegen_GoStruct tests Go struct generation and its validate tags
+func TestCodegen_GoStruct(t *testing.T) {
+	out := codegen.GoStruct("Signup", goCodegenSchema())
+	for _, want := range []string{
+		"type Signup struct {\n",
+		"\tAge    *int64   `json:\"age,omitempty\" validate:\"min:18\"`\n",
+		"\tEmail  string   `json:\"email\" label:\"E-mail\" validate:\"required|trim|email\"`\n",
+		"\tRatio  *float64 `json:\"ratio,omitempty\" validate:\"between:0,1.5\"`\n",
+		"\tRole   *string  `json:\"role,omitempty\" validate:\"one_of:admin,viewer\"`\n",
+		"\tUserID *string  `json:\"user_id,omitempty\" validate:\"min:3|max:20\"`\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output is missing %q:\n%s", want, out)
+		}
+	}
+
+	age, role := int64(12), "root"
+	res := validation.Struct(generatedSignup{Email: "ada", Age: &age, Role: &role})
+	for _, field := range []string{"email", "age", "role"} {
+		if len(res.Errors()[field]) == 0 {
+			t.Errorf("generated tags should reject %s: %v", field, res.Errors())
+		}
+	}
+
+	nested := validation.Make()
+	nested.Shape(map[string]validation.Type{
+		"born_at": validation.Date().Required(),
+		"address": validation.Object().Shape(map[string]validation.Type{"city": validation.String().Required()}),
+		"lines":   validation.Array().Elements(validation.Object().Shape(map[string]validation.Type{"sku": validation.String()})),
+		"meta":    validation.Object(),
+	})
+	file, err := codegen.GenerateGo("dto", map[string]*validation.ValidationSchema{"Order": nested})
+	if err != nil {
+		t.Fatalf("GenerateGo: %v", err)
+	}
+	for _, want := range []string{
+		"package dto\n\nimport \"time\"\n",
+		"\tAddress *OrderAddress",
+		"\tBornAt  time.Time ",
+		"\tLines   []OrderLinesItem ",
+		"\tMeta    map[string]any ",
+		"type OrderAddress struct {\n\tCity string `json:\"city\" validate:\"required\"`\n}\n",
+		"type OrderLinesItem struct {\n\tSKU *string `json:\"sku,omitempty\"`\n}\n",
+	} {
+		if !strings.Contains(string(file), want) {
+			t.Errorf("file is missing %q:\n%s", want, file)
+		}
+	}
+
+	if _, err := codegen.GenerateGo("dto", map[string]*validation.ValidationSchema{"order": nested}); err == nil {
+		t.Error("unexported struct name should fail")
+	}
+}
+
+// contractSchema builds the schema shared by the CUE and protobuf tests
+func contractSchema() *validation.ValidationSchema {
+	schema := validation.Make()
+	schema.Shape(map[string]validation.Type{
+		"email":   validation.String().Required().Email().Max(100),
+		"age":     validation.Number().Integer().Min(18).Max(130),
+		"price":   validation.Number().Positive(),
+		"role":    validation.String().OneOf([]string{"admin", "viewer"}),
+		"tags":    validation.Array().Max(3).Unique().Elements(validation.String().Min(2)),
+		"user_id": validation.String().Min(3),
+		"x-meta":  validation.Object(),
+		"address": validation.Object().Shape(map[string]validation.Type{"city": validation.String().Required()}),
+	})
+	return schema
+}
+
+// TestCodegen_CUE tests CUE definition generation
+func TestCodegen_CUE(t *testing.T) {
+	file, err := codegen.GenerateCUE("contracts", map[string]*validation.ValidationSchema{"Signup": contractSchema()})
+	if err != nil {
+		t.Fatalf("GenerateCUE: %v", err)
+	}
+	for _, want := range []string{
+		"package contracts\n\nimport (\n\t\"list\"\n\t\"strings\"\n)\n",
+		"#Signup: {\n\taddress?: {\n\t\tcity: string\n\t}\n",
+		"\tage?: int & >=18 & <=130\n",
+		"\temail: string & =~\"^[^@\\\\s]+@[^@\\\\s]+\\\\.[^@\\\\s]+$\" & strings.MaxRunes(100)\n",
+		"\tprice?: number & >0\n",
+		"\trole?: \"admin\" | \"viewer\"\n",
+		"\ttags?: [...string & strings.MinRunes(2)] & list.MaxItems(3) & list.UniqueItems()\n",
+		"\t\"x-meta\"?: {...}\n",
+	} {
+		if !strings.Contains(string(file), want) {
+			t.Errorf("file is missing %q:\n%s", want, file)
+		}
+	}
+
+	if _, err := codegen.GenerateCUE("contracts", map[string]*validation.ValidationSchema{"#Signup": contractSchema()}); err == nil {
+		t.Error("invalid definition name should fail")
+	}
+}
+
+// TestCodegen_Proto tests proto3 generation with protovalidate options
+func TestCodegen_Proto(t *testing.T) {
+	file, err := codegen.GenerateProto("acme.contracts.v1", map[string]*validation.ValidationSchema{"Signup": contractSchema()})
+	if err != nil {
+		t.Fatalf("GenerateProto: %v", err)
+	}
+	for _, want := range []string{
+		"syntax = \"proto3\";\n\npackage acme.contracts.v1;\n",
+		"import \"buf/validate/validate.proto\";\nimport \"google/protobuf/struct.proto\";\n",
+		"  Address address = 1;\n",
+		"  optional int64 age = 2 [(buf.validate.field).int64 = {gte: 18, lte: 130}];\n",
+		"  string email = 3 [(buf.validate.field).required = true, (buf.validate.field).string = {email: true, max_len: 100}];\n",
+		"  optional double price = 4 [(buf.validate.field).double = {gt: 0}];\n",
+		"  optional string role = 5 [(buf.validate.field).string = {in: [\"admin\", \"viewer\"]}];\n",
+		"  repeated string tags = 6 [(buf.validate.field).repeated = {max_items: 3, unique: true, items: {string: {min_len: 2}}}];\n",
+		"  optional string user_id = 7 [(buf.validate.field).string = {min_len: 3}, json_name = \"user_id\"];\n",
+		"  google.protobuf.Struct x_meta = 8 [json_name = \"x-meta\"];\n",
+		"  message Address {\n    string city = 1 [(buf.validate.field).required = true];\n  }\n}\n",
+	} {
+		if !strings.Contains(string(file), want) {
+			t.Errorf("file is missing %q:\n%s", want, file)
+		}
+	}
+
+	if _, err := codegen.GenerateProto("acme-contracts", nil); err == nil {
+		t.Error("invalid package name should fail")
+	}
+}
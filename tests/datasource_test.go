@@ -0,0 +1,434 @@
+// -----------------------------------------------------------------------------
+// DataSource Tests
+// -----------------------------------------------------------------------------
+// Bu dosya, map dışındaki girdileri (form, header, struct, protobuf mesajı)
+// core.DataSource adaptörleri üzerinden doğrulamayı test eder.
+//
+// Metadata:
+// @author   Ahmet ALTUN
+// @github   github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email    ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+package tests
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	validation "github.com/biyonik/go-fluent-validator"
+	"github.com/biyonik/go-fluent-validator/core"
+)
+
+// TestDataSource_Form tests url.Values input
+func TestDataSource_Form(t *testing.T) {
+	schema := validation.Make().Shape(map[string]validation.Type{
+		"email": validation.String().Required().Email(),
+		"tags":  validation.Array().Min(2),
+	}).(*validation.ValidationSchema)
+
+	res := schema.ValidateSource(validation.FormSource(url.Values{
+		"email": {"user@example.com"},
+		"tags":  {"go", "api"},
+	}))
+	if res.HasErrors() {
+		t.Fatalf("unexpected errors: %v", res.Errors())
+	}
+
+	res = schema.ValidateSource(validation.FormSource(url.Values{"tags": {"go"}}))
+	if len(res.Errors()["email"]) == 0 || len(res.Errors()["tags"]) == 0 {
+		t.Errorf("expected email and tags errors, got %v", res.Errors())
+	}
+}
+
+// TestSchema_ValidateValues tests query string coercion into declared types
+func TestSchema_ValidateValues(t *testing.T) {
+	schema := validation.Make().Shape(map[string]validation.Type{
+		"page":   validation.Number().Integer().Min(1),
+		"draft":  validation.Boolean(),
+		"since":  validation.Date(),
+		"ids":    validation.Array().Elements(validation.Number().Integer()),
+		"status": validation.Array().Elements(validation.String().OneOf([]string{"open", "closed"})),
+	}).(*validation.ValidationSchema)
+
+	res := schema.ValidateValues(url.Values{
+		"page":   {"2"},
+		"draft":  {"true"},
+		"since":  {"2024-05-01"},
+		"ids":    {"1,2, 3"},
+		"status": {"open", "closed"},
+	})
+	if res.HasErrors() {
+		t.Fatalf("unexpected errors: %v", res.Errors())
+	}
+	data := res.ValidData()
+	if data["page"] != int64(2) || data["draft"] != true {
+		t.Errorf("scalars should be coerced, got %#v", data)
+	}
+	if ids, _ := data["ids"].([]any); len(ids) != 3 || ids[2] != int64(3) {
+		t.Errorf("comma separated ids = %#v", data["ids"])
+	}
+	if status, _ := data["status"].([]any); len(status) != 2 {
+		t.Errorf("repeated status = %#v", data["status"])
+	}
+
+	res = schema.ValidateValues(url.Values{"page": {"zero"}, "draft": {"maybe"}, "ids": {"1,x"}})
+	for _, field := range []string{"page", "draft", "ids"} {
+		if !res.HasFieldErrors(field) && !res.HasFieldErrors(field+"[1]") {
+			t.Errorf("expected error on %s, got %v", field, res.Errors())
+		}
+	}
+}
+
+// TestSchema_ValidateXML tests generic and mapped XML payloads
+func TestSchema_ValidateXML(t *testing.T) {
+	schema := validation.Make().Shape(map[string]validation.Type{
+		"id":    validation.Number().Integer().Required(),
+		"email": validation.String().Required().Email(),
+		"tags":  validation.Array().Min(1).Elements(validation.String()),
+		"address": validation.Object().Shape(map[string]validation.Type{
+			"city": validation.String().Required(),
+			"zip":  validation.Number().Integer(),
+		}),
+	}).(*validation.ValidationSchema)
+
+	res := schema.ValidateXML(strings.NewReader(`<?xml version="1.0"?>
+		<user id="7">
+			<email>ada@example.com</email>
+			<tags><tag>go</tag><tag>xml</tag></tags>
+			<address><city>Ankara</city><zip>06100</zip></address>
+		</user>`), nil)
+	if res.HasErrors() {
+		t.Fatalf("unexpected errors: %v", res.Errors())
+	}
+	data := res.ValidData()
+	if data["id"] != int64(7) {
+		t.Errorf("id = %#v", data["id"])
+	}
+	if tags, _ := data["tags"].([]any); len(tags) != 2 || tags[1] != "xml" {
+		t.Errorf("tags = %#v", data["tags"])
+	}
+	if address, _ := data["address"].(map[string]any); address["zip"] != int64(6100) {
+		t.Errorf("address = %#v", data["address"])
+	}
+
+	soap := `<soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/">
+		<soap:Body>
+			<m:CreateUser xmlns:m="urn:users" m:ref="42">
+				<m:Contact><m:Mail>ada@example.com</m:Mail></m:Contact>
+				<m:Label>go</m:Label>
+				<m:City>İzmir</m:City>
+			</m:CreateUser>
+		</soap:Body>
+	</soap:Envelope>`
+	res = schema.ValidateXML(strings.NewReader(soap), core.XMLMapping{
+		"id":           "@ref",
+		"email":        "Contact/Mail",
+		"tags":         "Label",
+		"address.city": "City",
+	})
+	if res.HasErrors() {
+		t.Fatalf("unexpected errors: %v", res.Errors())
+	}
+	data = res.ValidData()
+	if data["id"] != int64(42) || data["email"] != "ada@example.com" {
+		t.Errorf("mapped scalars = %#v", data)
+	}
+	if tags, _ := data["tags"].([]any); len(tags) != 1 {
+		t.Errorf("single element should become an array, got %#v", data["tags"])
+	}
+
+	res = schema.ValidateXML(strings.NewReader(`<user id="x"><email>nope</email></user>`), nil)
+	for _, field := range []string{"id", "email"} {
+		if !res.HasFieldErrors(field) {
+			t.Errorf("expected error on %s, got %v", field, res.Errors())
+		}
+	}
+
+	for _, body := range []string{`<user><email>`, `<a/><b/>`, ``} {
+		res = schema.ValidateXML(strings.NewReader(body), nil)
+		if got := res.Errors()["_payload"]; len(got) != 1 || got[0] != "payload must be a valid XML document" {
+			t.Errorf("%q: _payload = %v", body, got)
+		}
+	}
+}
+
+// TestDataSource_Header tests case-insensitive http.Header input
+func TestDataSource_Header(t *testing.T) {
+	schema := validation.Make().Shape(map[string]validation.Type{
+		"x-request-id": validation.Uuid().Required(),
+	}).(*validation.ValidationSchema)
+
+	header := http.Header{}
+	header.Set("X-Request-Id", "550e8400-e29b-41d4-a716-446655440000")
+	if res := schema.ValidateSource(validation.HeaderSource(header)); res.HasErrors() {
+		t.Errorf("unexpected errors: %v", res.Errors())
+	}
+}
+
+// TestDataSource_Struct tests struct input with json tags and nesting
+func TestDataSource_Struct(t *testing.T) {
+	type address struct {
+		City string `json:"city"`
+	}
+	type signup struct {
+		Email    string    `json:"email"`
+		Age      uint      `json:"age"`
+		Password string    `json:"-"`
+		Address  *address  `json:"address"`
+		Items    []address `json:"items"`
+		Nickname string
+	}
+
+	schema := validation.Make().Shape(map[string]validation.Type{
+		"email":    validation.String().Required().Email(),
+		"age":      validation.Number().Min(18),
+		"Nickname": validation.String().Min(2),
+		"address": validation.Object().Shape(map[string]validation.Type{
+			"city": validation.String().Required(),
+		}),
+		"items": validation.Array().Elements(validation.Object().Shape(map[string]validation.Type{
+			"city": validation.String().Required(),
+		})),
+	}).(*validation.ValidationSchema)
+
+	src := validation.StructSource(&signup{
+		Email:    "user@example.com",
+		Age:      30,
+		Password: "secret",
+		Address:  &address{City: "Istanbul"},
+		Items:    []address{{City: "Ankara"}, {}},
+		Nickname: "bo",
+	})
+
+	if _, ok := src.Get("password"); ok {
+		t.Error(`json:"-" fields must be skipped`)
+	}
+	if city, _ := src.Get("address.city"); city != "Istanbul" {
+		t.Errorf("Get(address.city) = %v, want Istanbul", city)
+	}
+
+	res := schema.ValidateSource(src)
+	if len(res.Errors()["items[1].city"]) == 0 {
+		t.Errorf("expected items[1].city error, got %v", res.Errors())
+	}
+	if len(res.Errors()) != 1 {
+		t.Errorf("only items[1].city should fail, got %v", res.Errors())
+	}
+}
+
+// protoUser mimics a protoc-gen-go generated message
+type protoUser struct {
+	state         struct{}
+	UserName      string              `protobuf:"bytes,1,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	Age           int32               `protobuf:"varint,2,opt,name=age,proto3" json:"age,omitempty"`
+	Contact       isProtoUser_Contact `protobuf_oneof:"contact"`
+	unknownFields []byte
+}
+
+type isProtoUser_Contact interface{ isProtoUser_Contact() }
+
+type protoUser_Email struct {
+	Email string `protobuf:"bytes,3,opt,name=email,proto3,oneof"`
+}
+
+func (*protoUser_Email) isProtoUser_Contact() {}
+
+// TestDataSource_Proto tests generated protobuf message input
+func TestDataSource_Proto(t *testing.T) {
+	schema := validation.Make().Shape(map[string]validation.Type{
+		"user_name": validation.String().Required().Min(3),
+		"age":       validation.Number().Min(18),
+		"email":     validation.String().Required().Email(),
+	}).(*validation.ValidationSchema)
+
+	msg := &protoUser{UserName: "john", Age: 20, Contact: &protoUser_Email{Email: "john@example.com"}}
+	src := validation.ProtoSource(msg)
+
+	if res := schema.ValidateSource(src); res.HasErrors() {
+		t.Fatalf("unexpected errors: %v", res.Errors())
+	}
+	if name, _ := src.Get("userName"); name != "john" {
+		t.Errorf("json name alias should resolve, got %v", name)
+	}
+
+	msg.Contact = nil
+	if res := schema.ValidateSource(validation.ProtoSource(msg)); len(res.Errors()["email"]) == 0 {
+		t.Errorf("unset oneof should be missing, got %v", res.Errors())
+	}
+}
+
+type structTagAddress struct {
+	City string `json:"city" validate:"required|min:2"`
+	Zip  string `json:"zip" validate:"regex:^[0-9]{5}$"`
+}
+
+type StructTagAudit struct {
+	CreatedBy string `json:"created_by" validate:"required"`
+}
+
+type structTagSignup struct {
+	StructTagAudit
+	Name     string            `json:"name" label:"Full name" validate:"required|trim|min:3|max:50"`
+	Email    string            `json:"email" validate:"required|email"`
+	Age      int               `json:"age" validate:"between:18,130"`
+	Role     string            `json:"role" validate:"oneof:admin,editor,viewer"`
+	Address  *structTagAddress `json:"address" validate:"required"`
+	Tags     []string          `json:"tags" validate:"max:2"`
+	Internal string            `json:"-" validate:"required"`
+	Nickname string            `json:"nickname,omitempty"`
+	Secret   string            `validate:"-"`
+}
+
+// TestStruct tests validation driven by validate/label/json tags
+func TestStruct(t *testing.T) {
+	valid := structTagSignup{
+		StructTagAudit: StructTagAudit{CreatedBy: "system"},
+		Name:           "  Ada Lovelace ",
+		Email:          "ada@example.com",
+		Age:            36,
+		Role:           "admin",
+		Address:        &structTagAddress{City: "London", Zip: "12345"},
+		Tags:           []string{"math"},
+	}
+	res := validation.Struct(&valid)
+	if res.HasErrors() {
+		t.Fatalf("unexpected errors: %v", res.Errors())
+	}
+	if got := res.ValidData()["name"]; got != "Ada Lovelace" {
+		t.Errorf("name should be trimmed, got %q", got)
+	}
+
+	invalid := structTagSignup{
+		Name:  "Al",
+		Email: "not-an-email",
+		Age:   12,
+		Role:  "root",
+		Tags:  []string{"a", "b", "c"},
+	}
+	res = validation.Struct(invalid)
+	for _, field := range []string{"created_by", "name", "email", "age", "role", "address", "tags"} {
+		if !res.HasFieldErrors(field) {
+			t.Errorf("expected error on %q, got %v", field, res.Errors())
+		}
+	}
+	if res.HasFieldErrors("Internal") || res.HasFieldErrors("nickname") {
+		t.Errorf("skipped or optional fields should not fail: %v", res.Errors())
+	}
+	if msg := res.Errors()["name"][0]; !strings.Contains(msg, "Full name") {
+		t.Errorf("label tag should be used, got %q", msg)
+	}
+
+	invalid.Address = &structTagAddress{City: "L", Zip: "abc"}
+	res = validation.Struct(invalid)
+	if !res.HasFieldErrors("address.city") || !res.HasFieldErrors("address.zip") {
+		t.Errorf("nested struct rules should apply, got %v", res.Errors())
+	}
+}
+
+// TestStruct_InvalidTags tests that tag errors are reported
+func TestStruct_InvalidTags(t *testing.T) {
+	type badRule struct {
+		Name string `json:"name" validate:"no_such_rule"`
+	}
+	type badParam struct {
+		Age int `json:"age" validate:"min:many"`
+	}
+
+	if _, err := validation.StructSchema(badRule{}); err == nil {
+		t.Error("unknown rule should fail")
+	}
+	if _, err := validation.StructSchema(badParam{}); err == nil {
+		t.Error("non-numeric parameter should fail")
+	}
+	if _, err := validation.StructSchema("text"); err == nil {
+		t.Error("non-struct value should fail")
+	}
+	if res := validation.Struct(badRule{Name: "x"}); !res.HasErrors() {
+		t.Error("Struct should report tag errors in the result")
+	}
+}
+
+type structCategory struct {
+	Name     string            `json:"name" validate:"required"`
+	Children []*structCategory `json:"children"`
+}
+
+// TestStruct_Recursive tests that self-referential structs fail instead of recursing forever
+func TestStruct_Recursive(t *testing.T) {
+	if _, err := validation.StructSchema(structCategory{}); err == nil || !strings.Contains(err.Error(), "children[]") {
+		t.Errorf("recursive struct should fail with the field path, got %v", err)
+	}
+	if res := validation.Struct(structCategory{Name: "root"}); !res.HasFieldErrors("_payload") {
+		t.Errorf("Struct should report the cycle in the result, got %v", res.Errors())
+	}
+	if _, err := validation.PlaygroundSchema(&structCategory{}); err == nil {
+		t.Error("PlaygroundSchema should reject recursive structs")
+	}
+
+	type route struct {
+		From structTagAddress `json:"from" validate:"required"`
+		To   structTagAddress `json:"to" validate:"required"`
+	}
+	if _, err := validation.StructSchema(route{}); err != nil {
+		t.Errorf("reusing a struct type in sibling fields is not a cycle, got %v", err)
+	}
+}
+
+type playgroundSignup struct {
+	Email    string   `json:"email" validate:"required,email,max=100"`
+	Age      int      `json:"age" validate:"omitempty,gte=18,lte=130"`
+	Role     string   `json:"role" validate:"oneof=admin editor"`
+	Password string   `json:"password" validate:"required,min=8"`
+	Confirm  string   `json:"confirm" validate:"eqfield=Password"`
+	Tags     []string `json:"tags" validate:"max=2,dive,min=2"`
+	TraceID  string   `json:"trace_id" validate:"omitempty,uuid4"`
+}
+
+// TestPlaygroundStruct tests go-playground/validator tag translation
+func TestPlaygroundStruct(t *testing.T) {
+	valid := playgroundSignup{
+		Email:    "ada@example.com",
+		Age:      36,
+		Role:     "admin",
+		Password: "secret123",
+		Confirm:  "secret123",
+		Tags:     []string{"go", "math"},
+		TraceID:  "9b2f8c1e-3a4d-4f6b-8c7d-1e2f3a4b5c6d",
+	}
+	if res := validation.PlaygroundStruct(valid); res.HasErrors() {
+		t.Fatalf("unexpected errors: %v", res.Errors())
+	}
+
+	invalid := playgroundSignup{
+		Email:    "not-an-email",
+		Age:      12,
+		Role:     "root",
+		Password: "secret123",
+		Confirm:  "other",
+		Tags:     []string{"x"},
+		TraceID:  "not-a-uuid",
+	}
+	res := validation.PlaygroundStruct(&invalid)
+	for _, field := range []string{"email", "age", "role", "confirm", "tags[0]", "trace_id"} {
+		if !res.HasFieldErrors(field) {
+			t.Errorf("expected error on %q, got %v", field, res.Errors())
+		}
+	}
+
+	type unsupported struct {
+		Name string `json:"name" validate:"required_if=Role admin"`
+	}
+	type alternation struct {
+		Code string `json:"code" validate:"alpha|numeric"`
+	}
+	if _, err := validation.PlaygroundSchema(unsupported{}); err == nil {
+		t.Error("required_if should fail")
+	}
+	if _, err := validation.PlaygroundSchema(alternation{}); err == nil {
+		t.Error("\"|\" alternatives should fail")
+	}
+}
@@ -0,0 +1,393 @@
+// -----------------------------------------------------------------------------
+// Schema Describe Tests
+// -----------------------------------------------------------------------------
+// Bu dosya, şemaların doğrulama çalıştırmadan kendi yapılarını raporladığı
+// Describe() çıktısını test eder.
+//
+// Metadata:
+// @author   Ahmet ALTUN
+// @github   github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email    ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+package tests
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+
+	validation "github.com/biyonik/go-fluent-validator"
+	"github.com/biyonik/go-fluent-validator/core"
+)
+
+// TestSchema_Describe tests the structured schema description
+func TestSchema_Describe(t *testing.T) {
+	schema := validation.Make(validation.WithAutoTrim()).Shape(map[string]validation.Type{
+		"email": validation.String().Required().Trim().Email().Max(100).Label("E-mail"),
+		"age":   validation.Number().Integer().Min(18),
+		"tags":  validation.Array().Max(5).Elements(validation.String().Min(2)),
+		"address": validation.Object().Shape(map[string]validation.Type{
+			"city": validation.String().Required(),
+		}),
+	}).When("type", "corporate", func() core.Schema {
+		return validation.Make().Shape(map[string]validation.Type{
+			"tax_number": validation.String().Required(),
+		})
+	}).CrossValidate(func(data map[string]any) error { return nil }).(*validation.ValidationSchema)
+
+	desc := schema.Describe()
+
+	email := desc.Fields["email"]
+	if email == nil || email.Type != "string" || !email.Required || email.Label != "E-mail" {
+		t.Fatalf("unexpected email description: %+v", email)
+	}
+	if !email.HasRule("email") {
+		t.Error("email rule missing")
+	}
+	if r := email.Rule("max"); r == nil || r.Params["value"] != 100 {
+		t.Errorf("max rule: got %+v", r)
+	}
+	if len(email.Transforms) != 1 || email.Transforms[0] != "trim" {
+		t.Errorf("transforms: got %v", email.Transforms)
+	}
+
+	age := desc.Fields["age"]
+	if !age.HasRule("integer") || age.Rule("min").Params["value"] != float64(18) {
+		t.Errorf("unexpected age description: %+v", age)
+	}
+
+	tags := desc.Fields["tags"]
+	if tags.Elements == nil || tags.Elements.Type != "string" || !tags.Elements.HasRule("min") {
+		t.Errorf("unexpected tags elements: %+v", tags.Elements)
+	}
+
+	if city := desc.Fields["address"].Fields["city"]; city == nil || !city.Required {
+		t.Errorf("unexpected nested field: %+v", city)
+	}
+
+	if len(desc.Conditionals) != 1 || desc.Conditionals[0].Field != "type" {
+		t.Fatalf("unexpected conditionals: %+v", desc.Conditionals)
+	}
+	if _, ok := desc.Conditionals[0].Schema.Fields["tax_number"]; !ok {
+		t.Error("conditional sub-schema field missing")
+	}
+	if desc.CrossValidators != 1 {
+		t.Errorf("cross validators: got %d", desc.CrossValidators)
+	}
+	if len(desc.Options) != 1 || desc.Options[0] != "auto_trim" {
+		t.Errorf("options: got %v", desc.Options)
+	}
+
+	if _, err := json.Marshal(desc); err != nil {
+		t.Errorf("description must be JSON serializable: %v", err)
+	}
+}
+
+// TestSchema_DescribeConfigOptions tests that Config modes appear in Options and survive FromDescription
+func TestSchema_DescribeConfigOptions(t *testing.T) {
+	schema := validation.MakeWith(validation.Config{Strict: true, Coerce: true, Locale: "tr", MaxErrors: 5})
+	schema.Shape(map[string]validation.Type{"age": validation.Number()})
+
+	desc := schema.Describe()
+	want := []string{"strict", "coerce", "locale:tr", "max_errors:5"}
+	if !reflect.DeepEqual(desc.Options, want) {
+		t.Errorf("options = %v, want %v", desc.Options, want)
+	}
+
+	restored, err := validation.FromDescription(desc)
+	if err != nil {
+		t.Fatalf("FromDescription: %v", err)
+	}
+	if got := restored.Describe().Options; !reflect.DeepEqual(got, want) {
+		t.Errorf("restored options = %v, want %v", got, want)
+	}
+}
+
+// TestSchema_DescribeMetadata tests documentation metadata on types
+func TestSchema_DescribeMetadata(t *testing.T) {
+	schema := validation.Make().Shape(map[string]validation.Type{
+		"email": validation.String().Email().Required().
+			Describe("Primary contact address").
+			Example("jane@example.com"),
+		"username": validation.AdvancedString().
+			Describe("Legacy login name").
+			Deprecated("use email instead"),
+		"age": validation.Number().Integer().Example(30).Example(42),
+	}).(*validation.ValidationSchema)
+
+	desc := schema.Describe()
+
+	email := desc.Fields["email"]
+	if email.Description != "Primary contact address" || len(email.Examples) != 1 || email.Deprecated {
+		t.Errorf("unexpected email metadata: %+v", email)
+	}
+
+	username := desc.Fields["username"]
+	if !username.Deprecated || username.DeprecationReason != "use email instead" || username.Description != "Legacy login name" {
+		t.Errorf("unexpected username metadata: %+v", username)
+	}
+
+	if age := desc.Fields["age"]; len(age.Examples) != 2 || age.Examples[1] != 42 {
+		t.Errorf("unexpected age examples: %v", age.Examples)
+	}
+
+	out, err := json.Marshal(username)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var raw map[string]any
+	_ = json.Unmarshal(out, &raw)
+	if raw["deprecated"] != true || raw["description"] != "Legacy login name" {
+		t.Errorf("metadata should be serialized, got %s", out)
+	}
+
+	if res := schema.Validate(map[string]any{"email": "jane@example.com", "username": "jane"}); res.HasErrors() {
+		t.Errorf("metadata must not affect validation, got %v", res.Errors())
+	}
+}
+
+// TestSchema_ToJSONSchema tests the draft 2020-12 export of fields, nesting and conditionals
+func TestSchema_ToJSONSchema(t *testing.T) {
+	schema := validation.Make().Shape(map[string]validation.Type{
+		"name":  validation.String().Required().Min(2).Max(50).Label("Full name"),
+		"email": validation.String().Required().Email(),
+		"role":  validation.String().OneOf([]string{"admin", "user"}),
+		"code":  validation.String().StartsWith("TR").Regex(`^[A-Z0-9]+$`),
+		"age":   validation.Number().Integer().Min(18).Max(120),
+		"price": validation.Number().Positive().MultipleOf(0.01),
+		"birth": validation.Date(),
+		"tags":  validation.Array().Min(1).Max(5).Unique().Elements(validation.String().Max(20)),
+		"address": validation.Object().Shape(map[string]validation.Type{
+			"city": validation.String().Required(),
+		}),
+		"password": validation.String().Password().Describe("Login password"),
+		"type":     validation.String().Required(),
+	}).(*validation.ValidationSchema)
+	schema.When("type", "business", func() validation.Schema {
+		return validation.Make().Shape(map[string]validation.Type{
+			"tax_id": validation.String().Required(),
+		})
+	})
+
+	doc := schema.ToJSONSchema()
+	raw, err := json.Marshal(doc)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	var got struct {
+		Schema     string                    `json:"$schema"`
+		Type       string                    `json:"type"`
+		Required   []string                  `json:"required"`
+		Properties map[string]map[string]any `json:"properties"`
+		AllOf      []map[string]any          `json:"allOf"`
+	}
+	if err := json.Unmarshal(raw, &got); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if got.Schema != validation.JSONSchemaDialect || got.Type != "object" {
+		t.Errorf("header = %q %q", got.Schema, got.Type)
+	}
+	if want := []string{"email", "name", "type"}; !reflect.DeepEqual(got.Required, want) {
+		t.Errorf("required = %v, want %v", got.Required, want)
+	}
+
+	checks := []struct {
+		field, key string
+		want       any
+	}{
+		{"name", "minLength", 2.0},
+		{"name", "maxLength", 50.0},
+		{"name", "title", "Full name"},
+		{"email", "format", "email"},
+		{"role", "enum", []any{"admin", "user"}},
+		{"age", "type", "integer"},
+		{"age", "minimum", 18.0},
+		{"price", "exclusiveMinimum", 0.0},
+		{"price", "multipleOf", 0.01},
+		{"birth", "format", "date"},
+		{"tags", "minItems", 1.0},
+		{"tags", "uniqueItems", true},
+		{"tags", "items", map[string]any{"type": "string", "maxLength": 20.0}},
+		{"address", "required", []any{"city"}},
+		{"password", "writeOnly", true},
+		{"password", "description", "Login password"},
+		{"code", "allOf", []any{map[string]any{"pattern": "^TR"}, map[string]any{"pattern": "^[A-Z0-9]+$"}}},
+	}
+	for _, c := range checks {
+		if value := got.Properties[c.field][c.key]; !reflect.DeepEqual(value, c.want) {
+			t.Errorf("%s.%s = %#v, want %#v", c.field, c.key, value, c.want)
+		}
+	}
+
+	if len(got.AllOf) != 1 {
+		t.Fatalf("allOf = %v", got.AllOf)
+	}
+	cond, _ := json.Marshal(got.AllOf[0])
+	if want := `{"if":{"properties":{"type":{"const":"business"}},"required":["type"]},"then":{"properties":{"tax_id":{"type":"string"}},"required":["tax_id"]}}`; string(cond) != want {
+		t.Errorf("conditional = %s", cond)
+	}
+}
+
+// TestFromJSONSchema tests building schemas from JSON Schema documents
+func TestFromJSONSchema(t *testing.T) {
+	doc := []byte(`{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"type": "object",
+		"required": ["email", "items"],
+		"properties": {
+			"email":  {"type": "string", "format": "email", "maxLength": 100, "title": "E-mail"},
+			"code":   {"type": "string", "pattern": "^[A-Z]{3}$"},
+			"status": {"enum": ["draft", "sent"]},
+			"since":  {"type": ["string", "null"], "format": "date"},
+			"items": {
+				"type": "array",
+				"minItems": 1,
+				"items": {"$ref": "#/$defs/item"}
+			},
+			"shape": {
+				"type": "array",
+				"items": {"oneOf": [
+					{"type": "object", "required": ["kind", "r"], "properties": {"kind": {"const": "circle"}, "r": {"type": "number", "exclusiveMinimum": 0}}},
+					{"type": "object", "required": ["kind", "w"], "properties": {"kind": {"const": "square"}, "w": {"type": "number"}}}
+				]}
+			}
+		},
+		"$defs": {
+			"item": {
+				"type": "object",
+				"required": ["sku"],
+				"properties": {
+					"sku": {"type": "string"},
+					"qty": {"type": "integer", "minimum": 1}
+				}
+			}
+		}
+	}`)
+	schema, err := validation.FromJSONSchema(doc)
+	if err != nil {
+		t.Fatalf("FromJSONSchema: %v", err)
+	}
+
+	res := schema.Validate(map[string]any{
+		"email":  "ada@example.com",
+		"code":   "ABC",
+		"status": "sent",
+		"since":  "2024-05-01",
+		"items":  []any{map[string]any{"sku": "A-1", "qty": 2}},
+		"shape":  []any{map[string]any{"kind": "circle", "r": 1.5}},
+	})
+	if res.HasErrors() {
+		t.Fatalf("unexpected errors: %v", res.Errors())
+	}
+
+	res = schema.Validate(map[string]any{
+		"email":  "nope",
+		"code":   "abc",
+		"status": "archived",
+		"items":  []any{map[string]any{"qty": 0}},
+		"shape":  []any{map[string]any{"kind": "circle", "r": -1}},
+	})
+	for _, field := range []string{"email", "code", "status", "items[0].sku", "items[0].qty", "shape[0].r"} {
+		if !res.HasFieldErrors(field) {
+			t.Errorf("expected error on %s, got %v", field, res.Errors())
+		}
+	}
+	if desc := schema.Describe().Fields["email"]; desc.Label != "E-mail" || !desc.Required {
+		t.Errorf("email description = %+v", desc)
+	}
+
+	unsupported := []string{
+		`{"type": "object", "properties": {"a": {"type": "string", "not": {"const": "x"}}}}`,
+		`{"type": "object", "properties": {"a": {"type": "number", "exclusiveMinimum": 5}}}`,
+		`{"type": "object", "properties": {"a": {"$ref": "#/$defs/a"}}, "$defs": {"a": {"type": "array", "items": {"$ref": "#/$defs/a"}}}}`,
+		`{"type": "object", "properties": {"a": {"$ref": "other.json#/a"}}}`,
+	}
+	for _, raw := range unsupported {
+		if _, err := validation.FromJSONSchema([]byte(raw)); !errors.Is(err, validation.ErrUnsupportedJSONSchema) {
+			t.Errorf("%s: err = %v, want ErrUnsupportedJSONSchema", raw, err)
+		}
+	}
+}
+
+// TestFromJSONSchema_OneOf tests importing root-level oneOf alternatives
+func TestFromJSONSchema_OneOf(t *testing.T) {
+	doc := []byte(`{
+		"type": "object",
+		"required": ["amount"],
+		"properties": {"amount": {"type": "number", "exclusiveMinimum": 0}},
+		"oneOf": [
+			{"required": ["card"], "properties": {"card": {"type": "string", "minLength": 12}}},
+			{"required": ["iban"], "properties": {"iban": {"type": "string", "pattern": "^TR"}}}
+		]
+	}`)
+	schema, err := validation.FromJSONSchema(doc)
+	if err != nil {
+		t.Fatalf("FromJSONSchema: %v", err)
+	}
+
+	res := schema.Validate(map[string]any{"amount": 5, "iban": "TR12"})
+	if res.HasErrors() {
+		t.Fatalf("unexpected errors: %v", res.Errors())
+	}
+	if res.ValidData()["iban"] != "TR12" {
+		t.Errorf("matching branch data should be kept, got %v", res.ValidData())
+	}
+
+	res = schema.Validate(map[string]any{"amount": 5, "card": "123456789012", "iban": "TR12"})
+	if len(res.Errors()["_payload"]) != 1 {
+		t.Errorf("matching both alternatives should fail, got %v", res.Errors())
+	}
+	res = schema.Validate(map[string]any{"amount": 5, "card": "123"})
+	if len(res.Errors()["_payload"]) != 1 || !res.HasFieldErrors("card") {
+		t.Errorf("matching no alternative should report the closest branch, got %v", res.Errors())
+	}
+
+	raw, _ := json.Marshal(schema.ToJSONSchema())
+	again, err := validation.FromJSONSchema(raw)
+	if err != nil {
+		t.Fatalf("re-import failed: %v", err)
+	}
+	if got := again.Describe().Compositions; len(got) != 1 || got[0].Kind != "one_of" || len(got[0].Schemas) != 2 {
+		t.Errorf("oneOf should survive the round trip, got %+v", got)
+	}
+}
+
+// TestFromJSONSchema_RoundTrip tests that exported schemas import back
+func TestFromJSONSchema_RoundTrip(t *testing.T) {
+	schema := validation.Make().Shape(map[string]validation.Type{
+		"name": validation.String().Required().Min(2).Alpha(),
+		"type": validation.String().Required(),
+		"code": validation.String().StartsWith("TR").Regex(`^[A-Z0-9]+$`),
+		"tags": validation.Array().Unique().Elements(validation.String().Max(20)),
+	}).(*validation.ValidationSchema)
+	schema.When("type", "business", func() validation.Schema {
+		return validation.Make().Shape(map[string]validation.Type{
+			"tax_id": validation.String().Required(),
+		})
+	})
+
+	raw, err := json.Marshal(schema.ToJSONSchema())
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	imported, err := validation.FromJSONSchema(raw)
+	if err != nil {
+		t.Fatalf("FromJSONSchema: %v", err)
+	}
+
+	inputs := []map[string]any{
+		{"name": "Ada", "type": "person"},
+		{"name": "Ada", "type": "business"},
+		{"name": "A1", "type": "person", "code": "TR9", "tags": []any{"go", "go"}},
+		{"name": "Ada", "type": "person", "code": "XX9"},
+	}
+	for _, data := range inputs {
+		want, got := schema.Validate(data).Errors(), imported.Validate(data).Errors()
+		if !reflect.DeepEqual(want, got) {
+			t.Errorf("%v: errors = %v, want %v", data, got, want)
+		}
+	}
+}
@@ -0,0 +1,655 @@
+// -----------------------------------------------------------------------------
+// HTTP Integration Tests
+// -----------------------------------------------------------------------------
+// Bu dosya, httpvalidate paketindeki net/http middleware'lerini ve
+// yanıt/şablon yardımcılarını test eder.
+//
+// Metadata:
+// @author   Ahmet ALTUN
+// @github   github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email    ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+package tests
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"html/template"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"log/slog"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	validation "github.com/biyonik/go-fluent-validator"
+	"github.com/biyonik/go-fluent-validator/core"
+	"github.com/biyonik/go-fluent-validator/httpvalidate"
+	"github.com/biyonik/go-fluent-validator/rules"
+)
+
+// TestHTTP_LocaleMiddleware tests per-request error localization from Accept-Language
+func TestHTTP_LocaleMiddleware(t *testing.T) {
+	schema := validation.Make().Shape(map[string]validation.Type{
+		"email": validation.String().Required().Email(),
+		"name":  validation.String().Custom(func(string) error { return nil }),
+	}).(*validation.ValidationSchema)
+	var got string
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = schema.ValidateCtx(r.Context(), map[string]any{"email": "nope"}).Errors()["email"][0]
+	})
+
+	mw := httpvalidate.LocaleMiddleware(httpvalidate.SupportedLocales("en", "tr", "de"), httpvalidate.FallbackLocale("en"))
+	cases := map[string]string{
+		"tr-TR,tr;q=0.9,en;q=0.8": "email alanı geçerli bir e-posta adresi olmalıdır",
+		"de;q=0.7, fr;q=0.9":      "email muss eine gültige E-Mail-Adresse sein",
+		"ja":                      "email must be a valid email address",
+	}
+	for header, want := range cases {
+		req := httptest.NewRequest(http.MethodPost, "/", nil)
+		req.Header.Set("Accept-Language", header)
+		rec := httptest.NewRecorder()
+		mw(handler).ServeHTTP(rec, req)
+		if got != want {
+			t.Errorf("Accept-Language %q: got %q, want %q", header, got, want)
+		}
+		if rec.Header().Get("Vary") != "Accept-Language" || rec.Header().Get("Content-Language") == "" {
+			t.Errorf("Accept-Language %q: missing negotiation headers %v", header, rec.Header())
+		}
+	}
+
+	// Rota bazında geçersiz kılma dıştaki seçimi ezer.
+	route := mw(httpvalidate.LocaleMiddleware(httpvalidate.ForceLocale("tr"))(handler))
+	req := httptest.NewRequest(http.MethodPost, "/tr/", nil)
+	req.Header.Set("Accept-Language", "de")
+	rec := httptest.NewRecorder()
+	route.ServeHTTP(rec, req)
+	if got != cases["tr-TR,tr;q=0.9,en;q=0.8"] || rec.Header().Get("Content-Language") != "tr" {
+		t.Errorf("ForceLocale should override the negotiated locale, got %q", got)
+	}
+
+	// Context dili olmadan global aktif dil kullanılır.
+	if msg := schema.Validate(map[string]any{"email": "nope"}).Errors()["email"][0]; msg != cases["ja"] {
+		t.Errorf("Validate should use the active locale, got %q", msg)
+	}
+}
+
+// TestHTTP_WriteErrors tests Accept-based content negotiation of error responses
+func TestHTTP_WriteErrors(t *testing.T) {
+	schema := validation.Make().Shape(map[string]validation.Type{
+		"email": validation.String().Required().Email(),
+		"nick":  validation.String().Min(3).Severity(validation.SeverityWarning),
+	})
+	result := schema.Validate(map[string]any{"email": "a<b", "nick": "x"})
+
+	cases := []struct {
+		accept, contentType, body string
+	}{
+		{"", "application/json", `{"valid":false,"errors":{"email":["email must be a valid email address"]},"warnings":{"nick":["nick must be at least 3 characters long"]}}`},
+		{"application/problem+json", "application/json", `"valid":false`},
+		{"text/xml;q=0.9, text/plain;q=0.5", "application/xml", `<validation valid="false"><error field="email" rule="email">email must be a valid email address</error><warning field="nick">nick must be at least 3 characters long</warning></validation>`},
+		{"application/soap+xml", "application/xml", `<?xml version="1.0"`},
+		{"text/plain, application/json;q=0.2", "text/plain", "email: email must be a valid email address\nnick (warning): nick must be at least 3 characters long\n"},
+		{"image/png", "application/json", `"errors"`},
+	}
+	for _, tc := range cases {
+		req := httptest.NewRequest(http.MethodPost, "/", nil)
+		if tc.accept != "" {
+			req.Header.Set("Accept", tc.accept)
+		}
+		rec := httptest.NewRecorder()
+		if err := httpvalidate.WriteErrors(rec, req, result); err != nil {
+			t.Fatalf("Accept %q: %v", tc.accept, err)
+		}
+		if rec.Code != http.StatusUnprocessableEntity {
+			t.Errorf("Accept %q: status = %d", tc.accept, rec.Code)
+		}
+		if got := rec.Header().Get("Content-Type"); got != tc.contentType+"; charset=utf-8" {
+			t.Errorf("Accept %q: Content-Type = %q, want %q", tc.accept, got, tc.contentType)
+		}
+		if !strings.Contains(rec.Body.String(), tc.body) {
+			t.Errorf("Accept %q: body = %q, want it to contain %q", tc.accept, rec.Body.String(), tc.body)
+		}
+	}
+
+	if out, _ := json.Marshal(schema.Validate(map[string]any{"email": "a@b.co"})); string(out) != `{"valid":true,"errors":{}}` {
+		t.Errorf("valid result JSON = %s", out)
+	}
+}
+
+// TestHTTP_TemplateFuncs tests html/template helpers bound to a result
+func TestHTTP_TemplateFuncs(t *testing.T) {
+	const src = `{{if hasError "email"}}<p>{{firstError "email"}}</p>{{end}}` +
+		`<input class="{{errorClass "email"}}" value="{{old "email"}}">` +
+		`<input class="{{errorClass "name" "has-error"}}" value="{{old "name"}}">` +
+		`{{range errors "email"}}[{{.}}]{{end}}`
+	base := template.Must(template.New("form").Funcs(httpvalidate.TemplateFuncs(nil)).Parse(src))
+
+	render := func(funcs template.FuncMap) string {
+		tmpl := template.Must(base.Clone())
+		var sb strings.Builder
+		if err := tmpl.Funcs(funcs).Execute(&sb, nil); err != nil {
+			t.Fatal(err)
+		}
+		return sb.String()
+	}
+
+	if got := render(httpvalidate.TemplateFuncs(nil)); got != `<input class="" value=""><input class="" value="">` {
+		t.Errorf("nil result render = %q", got)
+	}
+
+	schema := validation.Make().Shape(map[string]validation.Type{
+		"email": validation.String().Required().Email(),
+		"name":  validation.String().Min(3),
+	})
+	res := schema.Validate(map[string]any{"email": "<x>", "name": "Al"})
+	want := `<p>email must be a valid email address</p>` +
+		`<input class="is-invalid" value="&lt;x&gt;">` +
+		`<input class="has-error" value="Al">` +
+		`[email must be a valid email address]`
+	if got := render(httpvalidate.TemplateFuncs(res)); got != want {
+		t.Errorf("render =\n%q\nwant\n%q", got, want)
+	}
+}
+
+// TestHTTP_CookieCSRF tests CSRF token verification in the form validation pass
+func TestHTTP_CookieCSRF(t *testing.T) {
+	schema := validation.Make().Shape(map[string]validation.Type{
+		"_token": validation.CSRFToken(httpvalidate.CookieCSRF("csrf_token")).Label("Form token"),
+		"email":  validation.String().Required().Email(),
+	}).(*validation.ValidationSchema)
+	request := func(cookie string) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/", nil)
+		if cookie != "" {
+			req.AddCookie(&http.Cookie{Name: "csrf_token", Value: cookie})
+		}
+		return req
+	}
+	validate := func(req *http.Request, form map[string]any) []string {
+		return schema.ValidateCtx(httpvalidate.ContextWithRequest(req.Context(), req), form).Errors()["_token"]
+	}
+
+	if errs := validate(request("abc123"), map[string]any{"_token": "abc123", "email": "a@b.co"}); len(errs) != 0 {
+		t.Errorf("matching token should pass, got %v", errs)
+	}
+	const want = "Form token is invalid or has expired, please reload the form"
+	for name, tc := range map[string]struct {
+		cookie string
+		form   map[string]any
+	}{
+		"mismatch":      {"abc123", map[string]any{"_token": "abc124"}},
+		"missing token": {"abc123", map[string]any{}},
+		"no cookie":     {"", map[string]any{"_token": "abc123"}},
+	} {
+		if errs := validate(request(tc.cookie), tc.form); len(errs) != 1 || errs[0] != want {
+			t.Errorf("%s: got %v", name, errs)
+		}
+	}
+
+	if errs := schema.Validate(map[string]any{"_token": "abc123"}).Errors()["_token"]; len(errs) != 1 {
+		t.Errorf("validation without a request should reject the token, got %v", errs)
+	}
+	if _, err := json.Marshal(schema); err == nil {
+		t.Error("schemas with CSRF verifiers should not be declarative")
+	}
+}
+
+// TestHTTP_ValidateRequest tests combined body, query and header validation
+func TestHTTP_ValidateRequest(t *testing.T) {
+	body := validation.Make().Shape(map[string]validation.Type{
+		"email": validation.String().Required().Email(),
+	})
+	query := validation.Make().Shape(map[string]validation.Type{
+		"page":   validation.Number().Integer().Min(1),
+		"draft":  validation.Boolean(),
+		"ids":    validation.Array().Elements(validation.Number().Integer()),
+		"filter": validation.String(),
+	})
+	headers := validation.Make().Shape(map[string]validation.Type{
+		"X-Api-Key": validation.String().Required().Min(8),
+	})
+
+	r := httptest.NewRequest(http.MethodPost, "/users?page=2&draft=true&ids=1&ids=2&filter=new",
+		strings.NewReader(`{"email":"ada@example.com"}`))
+	r.Header.Set("X-Api-Key", "secret-key")
+	res := httpvalidate.ValidateRequest(r, body, query, headers)
+	if res.HasErrors() {
+		t.Fatalf("unexpected errors: %v", res.Errors())
+	}
+	q := res.ValidData()["query"].(map[string]any)
+	if q["page"] != int64(2) || q["draft"] != true {
+		t.Errorf("query values should be coerced, got %#v", q)
+	}
+	if ids, _ := q["ids"].([]any); len(ids) != 2 || ids[1] != int64(2) {
+		t.Errorf("repeated query values should become an array, got %#v", q["ids"])
+	}
+	if got := res.ValidData()["body"].(map[string]any)["email"]; got != "ada@example.com" {
+		t.Errorf("body email = %v", got)
+	}
+
+	r = httptest.NewRequest(http.MethodPost, "/users?page=zero", strings.NewReader(`{}`))
+	res = httpvalidate.ValidateRequest(r, body, query, headers)
+	for _, field := range []string{"body.email", "query.page", "header.X-Api-Key"} {
+		if !res.HasFieldErrors(field) {
+			t.Errorf("expected error on %q, got %v", field, res.Errors())
+		}
+	}
+
+	r = httptest.NewRequest(http.MethodPost, "/users", strings.NewReader(`{"email":`))
+	if res := httpvalidate.ValidateRequest(r, body, nil, nil); !res.HasFieldErrors("body") {
+		t.Errorf("malformed body should be reported on body, got %v", res.Errors())
+	}
+}
+
+// TestHTTP_Middleware tests request validation middleware and ValidData injection
+func TestHTTP_Middleware(t *testing.T) {
+	schema := validation.Make().Shape(map[string]validation.Type{
+		"email": validation.String().Required().Email(),
+		"age":   validation.Number().Integer().Min(18),
+	})
+	var got map[string]any
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = httpvalidate.ValidData(r)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	route := httpvalidate.Middleware(schema)(handler)
+
+	serve := func(route http.Handler, r *http.Request) *httptest.ResponseRecorder {
+		got = nil
+		rec := httptest.NewRecorder()
+		route.ServeHTTP(rec, r)
+		return rec
+	}
+
+	rec := serve(route, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"email":"ada@example.com","age":30}`)))
+	if rec.Code != http.StatusNoContent || got["email"] != "ada@example.com" || got["age"] != int64(30) {
+		t.Fatalf("valid request: code=%d data=%#v", rec.Code, got)
+	}
+
+	rec = serve(route, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"age":12}`)))
+	if rec.Code != http.StatusUnprocessableEntity || got != nil {
+		t.Fatalf("invalid request should stop with 422, got %d", rec.Code)
+	}
+	var body struct {
+		Errors map[string][]string `json:"errors"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil || len(body.Errors["email"]) == 0 || len(body.Errors["age"]) == 0 {
+		t.Errorf("error response = %s", rec.Body.String())
+	}
+
+	rec = serve(route, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"email"`)))
+	if rec.Code != http.StatusUnprocessableEntity || !strings.Contains(rec.Body.String(), "_payload") {
+		t.Errorf("malformed JSON: code=%d body=%s", rec.Code, rec.Body.String())
+	}
+
+	query := httpvalidate.Middleware(schema, httpvalidate.FromQuery())(handler)
+	if rec := serve(query, httptest.NewRequest(http.MethodGet, "/?email=ada@example.com&age=21", nil)); rec.Code != http.StatusNoContent || got["age"] != int64(21) {
+		t.Errorf("query source: code=%d data=%#v", rec.Code, got)
+	}
+
+	form := httpvalidate.Middleware(schema, httpvalidate.FromForm(), httpvalidate.OnError(func(w http.ResponseWriter, r *http.Request, res *validation.ValidationResult) {
+		w.WriteHeader(http.StatusBadRequest)
+	}))(handler)
+	r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader("email=bad"))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if rec := serve(form, r); rec.Code != http.StatusBadRequest {
+		t.Errorf("custom error handler should be used, got %d", rec.Code)
+	}
+}
+
+// TestHTTP_MiddlewareBodyGuards tests content type, size and JSON syntax checks
+func TestHTTP_MiddlewareBodyGuards(t *testing.T) {
+	evaluated := false
+	schema := validation.Make().Shape(map[string]validation.Type{
+		"email": validation.String().Required().Custom(func(value string) error {
+			evaluated = true
+			return nil
+		}),
+	})
+	opts := []httpvalidate.Option{httpvalidate.RequireContentType("application/json", "application/*+json"), httpvalidate.MaxBodyBytes(64)}
+	request := func(contentType, body string) *http.Request {
+		r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		if contentType != "" {
+			r.Header.Set("Content-Type", contentType)
+		}
+		return r
+	}
+
+	tests := []struct {
+		name        string
+		contentType string
+		body        string
+		want        string
+	}{
+		{"wrong type", "text/plain", `{"email":"a"}`, "content type must be one of: application/json and application/*+json"},
+		{"missing type", "", `{"email":"a"}`, "content type must be one of: application/json and application/*+json"},
+		{"too large", "application/json", `{"email":"` + strings.Repeat("a", 100) + `"}`, "payload must not exceed 64 bytes"},
+		{"syntax", "application/json", "{\n  \"email\": ,\n}", "payload is not valid JSON: syntax error at line 2, column 12"},
+		{"truncated", "application/json", `{"email"`, "payload is not valid JSON: syntax error at line 1, column 9"},
+		{"not an object", "application/json", `["a"]`, "payload must be a valid JSON object"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			evaluated = false
+			res := httpvalidate.Validate(request(tt.contentType, tt.body), schema, opts...)
+			if errs := res.Errors()["_payload"]; len(errs) != 1 || errs[0] != tt.want {
+				t.Errorf("errors = %v, want %q", res.Errors(), tt.want)
+			}
+			if evaluated {
+				t.Error("schema should not be evaluated against a rejected body")
+			}
+		})
+	}
+
+	res := httpvalidate.Validate(request("application/merge-patch+json; charset=utf-8", `{"email":"a"}`), schema, opts...)
+	if res.HasErrors() || !evaluated {
+		t.Errorf("suffixed JSON type should be accepted: %v", res.Errors())
+	}
+	if res := httpvalidate.Validate(httptest.NewRequest(http.MethodGet, "/", nil), schema, opts...); res.Errors()["email"] == nil {
+		t.Errorf("bodyless request should skip the content type check, got %v", res.Errors())
+	}
+
+	res = httpvalidate.ValidateRequest(request("application/json", `{"email": }`), schema, nil, nil)
+	if errs := res.Errors()["body"]; len(errs) != 1 || errs[0] != "payload is not valid JSON: syntax error at line 1, column 11" {
+		t.Errorf("ValidateRequest body error = %v", res.Errors())
+	}
+}
+
+// TestHTTP_DefaultBodyLimit tests the default JSON body size limit
+func TestHTTP_DefaultBodyLimit(t *testing.T) {
+	schema := validation.Make().Shape(map[string]validation.Type{
+		"email": validation.String().Required(),
+	})
+	body := `{"email":"` + strings.Repeat("a", int(httpvalidate.DefaultMaxBodyBytes)) + `"}`
+	request := func() *http.Request {
+		r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		r.ContentLength = -1 // chunked: sınır okuma sırasında uygulanmalı
+		return r
+	}
+	want := fmt.Sprintf("payload must not exceed %d bytes", httpvalidate.DefaultMaxBodyBytes)
+
+	if errs := httpvalidate.Validate(request(), schema).Errors()["_payload"]; len(errs) != 1 || errs[0] != want {
+		t.Errorf("Validate errors = %v, want %q", errs, want)
+	}
+	if errs := httpvalidate.ValidateRequest(request(), schema, nil, nil).Errors()["body"]; len(errs) != 1 || errs[0] != want {
+		t.Errorf("ValidateRequest errors = %v, want %q", errs, want)
+	}
+	var tooLarge *http.MaxBytesError
+	if _, err := httpvalidate.DecodeJSON(strings.NewReader(body)); !errors.As(err, &tooLarge) {
+		t.Errorf("DecodeJSON should return *http.MaxBytesError, got %v", err)
+	}
+	if res := httpvalidate.Validate(request(), schema, httpvalidate.MaxBodyBytes(0)); res.HasErrors() {
+		t.Errorf("MaxBodyBytes(0) should remove the limit, got %v", res.Errors())
+	}
+}
+
+// TestHTTP_RequestID tests request ID propagation into error responses, headers and logs
+func TestHTTP_RequestID(t *testing.T) {
+	schema := validation.Make().Shape(map[string]validation.Type{
+		"email": validation.String().Required().Email(),
+	}).(*validation.ValidationSchema)
+	var logs bytes.Buffer
+	var handlerID string
+	route := httpvalidate.Middleware(schema, httpvalidate.Logger(slog.New(slog.NewJSONHandler(&logs, nil))))(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			handlerID = core.RequestIDFromContext(r.Context())
+		}))
+
+	r := httptest.NewRequest(http.MethodPost, "/signup", strings.NewReader(`{"email":"bad"}`))
+	r.Header.Set("X-Request-ID", "req-42")
+	rec := httptest.NewRecorder()
+	route.ServeHTTP(rec, r)
+
+	var body struct {
+		RequestID string `json:"request_id"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil || body.RequestID != "req-42" {
+		t.Errorf("response body = %s", rec.Body.String())
+	}
+	if got := rec.Header().Get("X-Request-ID"); got != "req-42" {
+		t.Errorf("response header = %q", got)
+	}
+	var entry struct {
+		Msg       string   `json:"msg"`
+		RequestID string   `json:"request_id"`
+		Path      string   `json:"path"`
+		Failures  []string `json:"failures"`
+	}
+	if err := json.Unmarshal(logs.Bytes(), &entry); err != nil || entry.RequestID != "req-42" || entry.Path != "/signup" ||
+		len(entry.Failures) != 1 || entry.Failures[0] != "email:email" {
+		t.Errorf("log entry = %s", logs.String())
+	}
+	if strings.Contains(logs.String(), "bad") {
+		t.Errorf("log should not contain field values: %s", logs.String())
+	}
+
+	logs.Reset()
+	rec = httptest.NewRecorder()
+	route.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"email":"ada@example.com"}`)))
+	if generated := rec.Header().Get("X-Request-ID"); generated == "" || handlerID != generated {
+		t.Errorf("generated id = %q, handler saw %q", generated, handlerID)
+	}
+	if logs.Len() != 0 {
+		t.Errorf("successful requests should not be logged: %s", logs.String())
+	}
+
+	custom := httpvalidate.Middleware(schema, httpvalidate.RequestIDHeader("X-Correlation-ID"))(http.NotFoundHandler())
+	r = httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{}`))
+	r.Header.Set("X-Correlation-ID", "corr-7")
+	rec = httptest.NewRecorder()
+	custom.ServeHTTP(rec, r)
+	if rec.Header().Get("X-Correlation-ID") != "corr-7" || !strings.Contains(rec.Body.String(), `"request_id":"corr-7"`) {
+		t.Errorf("custom header: header=%q body=%s", rec.Header().Get("X-Correlation-ID"), rec.Body.String())
+	}
+
+	res := schema.ValidateCtx(core.ContextWithRequestID(context.Background(), "ctx-1"), map[string]any{})
+	if out, _ := xml.Marshal(res); !strings.Contains(string(out), `request_id="ctx-1"`) {
+		t.Errorf("XML = %s", out)
+	}
+	if text, _ := res.MarshalText(); !strings.HasPrefix(string(text), "request_id: ctx-1\n") {
+		t.Errorf("text = %s", text)
+	}
+}
+
+// multipartRequest builds a multipart POST request with the given form values and files
+func multipartRequest(t *testing.T, values map[string]string, files map[string][]string) *http.Request {
+	t.Helper()
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	for key, value := range values {
+		_ = w.WriteField(key, value)
+	}
+	for key, list := range files {
+		for i := 0; i+1 < len(list); i += 2 {
+			part, err := w.CreateFormFile(key, list[i])
+			if err != nil {
+				t.Fatalf("CreateFormFile: %v", err)
+			}
+			part.Write([]byte(list[i+1]))
+		}
+	}
+	w.Close()
+	r := httptest.NewRequest(http.MethodPost, "/", &body)
+	r.Header.Set("Content-Type", w.FormDataContentType())
+	return r
+}
+
+// TestHTTP_FileUpload tests File() rules on multipart uploads read with FromForm
+func TestHTTP_FileUpload(t *testing.T) {
+	png := "\x89PNG\r\n\x1a\n" + strings.Repeat("\x00", 64)
+	schema := validation.Make().Shape(map[string]validation.Type{
+		"title":  validation.String().Required(),
+		"avatar": validation.File().Required().MaxSize(1024).MIMETypes("image/*").Extensions("png", ".jpg").SanitizeFilename().Label("Avatar"),
+		"docs":   validation.Array().Elements(validation.File().Extensions("pdf")),
+	}).(*validation.ValidationSchema)
+	validate := func(r *http.Request) *core.ValidationResult {
+		return httpvalidate.Validate(r, schema, httpvalidate.FromForm())
+	}
+
+	res := validate(multipartRequest(t, map[string]string{"title": "Profil"}, map[string][]string{
+		"avatar": {"Çiçek Fotoğrafı.PNG", png},
+		"docs":   {"a.pdf", "%PDF-1.4", "b.pdf", "%PDF-1.4"},
+	}))
+	if res.HasErrors() {
+		t.Fatalf("unexpected errors: %v", res.Errors())
+	}
+	avatar, _ := res.ValidData()["avatar"].(*multipart.FileHeader)
+	if avatar == nil || avatar.Filename != "CicekFotografi.PNG" {
+		t.Errorf("sanitized avatar = %#v", avatar)
+	}
+	if docs, _ := res.ValidData()["docs"].([]any); len(docs) != 2 {
+		t.Errorf("docs = %#v", res.ValidData()["docs"])
+	}
+
+	tests := []struct {
+		name  string
+		files map[string][]string
+		field string
+		want  string
+	}{
+		{"missing", nil, "avatar", "Avatar is required"},
+		{"spoofed type", map[string][]string{"avatar": {"a.png", "<html><body>hi</body></html>"}}, "avatar", "Avatar must be a file of type: image/*"},
+		{"extension", map[string][]string{"avatar": {"a.gif", png}}, "avatar", "Avatar must have one of the following extensions: png, .jpg"},
+		{"too large", map[string][]string{"avatar": {"a.png", png + strings.Repeat("x", 2048)}}, "avatar", "Avatar must not be larger than 1 KB"},
+		{"element", map[string][]string{"avatar": {"a.png", png}, "docs": {"a.exe", "MZ"}}, "docs[0]", "docs[0] must have one of the following extensions: pdf"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := validate(multipartRequest(t, map[string]string{"title": "x"}, tt.files))
+			if errs := res.Errors()[tt.field]; len(errs) != 1 || errs[0] != tt.want {
+				t.Errorf("errors = %v, want %q", res.Errors(), tt.want)
+			}
+		})
+	}
+
+	if res := schema.Validate(map[string]any{"title": "x", "avatar": "a.png"}); res.Errors()["avatar"][0] != "Avatar must be an uploaded file" {
+		t.Errorf("non-file value: %v", res.Errors())
+	}
+	restored, err := validation.FromDescription(schema.Describe())
+	if err != nil {
+		t.Fatalf("FromDescription: %v", err)
+	}
+	if got := restored.Describe().Fields["avatar"]; len(got.Rules) != 3 || len(got.Transforms) != 1 {
+		t.Errorf("restored avatar = %+v", got)
+	}
+}
+
+// encodePNG returns a blank PNG image of the given size
+func encodePNG(t *testing.T, width, height int) string {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, image.NewGray(image.Rect(0, 0, width, height))); err != nil {
+		t.Fatalf("png.Encode: %v", err)
+	}
+	return buf.String()
+}
+
+// TestHTTP_MultipartMiddleware tests one-pass multipart handling in Middleware
+func TestHTTP_MultipartMiddleware(t *testing.T) {
+	schema := validation.Make().Shape(map[string]validation.Type{
+		"title":  validation.String().Required().Trim(),
+		"avatar": validation.File().Required().Extensions("png").SanitizeFilename(),
+		"docs":   validation.Array().Elements(validation.File().Extensions("pdf")),
+	})
+
+	var (
+		title  any
+		avatar *multipart.FileHeader
+		docs   []*multipart.FileHeader
+	)
+	handler := httpvalidate.Middleware(schema, httpvalidate.MaxBodyBytes(4096))(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		title = httpvalidate.ValidData(r)["title"]
+		avatar = httpvalidate.ValidFile(r, "avatar")
+		docs = httpvalidate.ValidFiles(r, "docs")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, multipartRequest(t, map[string]string{"title": "  Profil  "}, map[string][]string{
+		"avatar": {"Yeni Resim.png", "png"},
+		"docs":   {"a.pdf", "%PDF-1.4", "b.pdf", "%PDF-1.4"},
+	}))
+	if rec.Code != http.StatusNoContent {
+		t.Fatalf("status = %d, body = %s", rec.Code, rec.Body)
+	}
+	if title != "Profil" || avatar == nil || avatar.Filename != "YeniResim.png" || len(docs) != 2 {
+		t.Errorf("handler got title=%#v avatar=%#v docs=%d", title, avatar, len(docs))
+	}
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, multipartRequest(t, map[string]string{"title": "x"}, map[string][]string{
+		"avatar": {"a.png", strings.Repeat("x", 8192)},
+	}))
+	if rec.Code != http.StatusUnprocessableEntity || !strings.Contains(rec.Body.String(), "payload must not exceed 4096 bytes") {
+		t.Errorf("oversized request: %d %s", rec.Code, rec.Body)
+	}
+
+	r := multipartRequest(t, map[string]string{"title": "x"}, map[string][]string{"avatar": {"a.png", strings.Repeat("x", 8192)}})
+	r.ContentLength = -1
+	res := httpvalidate.Validate(r, schema, httpvalidate.MaxBodyBytes(4096))
+	if errs := res.Errors()["_payload"]; len(errs) != 1 || errs[0] != "payload must not exceed 4096 bytes" {
+		t.Errorf("streamed oversized request: %v", res.Errors())
+	}
+}
+
+// TestHTTP_ImageUpload tests Image() format, dimension and aspect ratio rules
+func TestHTTP_ImageUpload(t *testing.T) {
+	var jpg bytes.Buffer
+	_ = jpeg.Encode(&jpg, image.NewGray(image.Rect(0, 0, 160, 90)), nil)
+	// VP8X header for a 1280x720 WebP canvas
+	webp := "RIFF\x16\x00\x00\x00WEBPVP8X\x0a\x00\x00\x00\x00\x00\x00\x00\xff\x04\x00\xcf\x02\x00"
+
+	schema := validation.Make().Shape(map[string]validation.Type{
+		"cover": validation.Image().Required().Formats("PNG", "jpg", "webp").
+			MinWidth(100).MaxWidth(2000).MaxHeight(1200).MaxMegapixels(1.5).AspectRatio(16, 9).Label("Cover"),
+	}).(*validation.ValidationSchema)
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{"png", encodePNG(t, 320, 180), ""},
+		{"jpeg", jpg.String(), ""},
+		{"webp", webp, ""},
+		{"gif format", "GIF89a\x10\x00\x09\x00\x00\x00\x00;", "Cover must be an image of type: png, jpeg, webp"},
+		{"not an image", "plain text", "Cover must be a valid image"},
+		{"too narrow", encodePNG(t, 64, 36), "Cover must be at least 100 pixels wide"},
+		{"aspect ratio", encodePNG(t, 400, 400), "Cover must have an aspect ratio of 16:9"},
+		{"megapixels", encodePNG(t, 1920, 1080), "Cover must not exceed 1.5 megapixels"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := multipartRequest(t, nil, map[string][]string{"cover": {"cover.bin", tt.content}})
+			res := httpvalidate.Validate(r, schema, httpvalidate.FromForm())
+			if tt.want == "" {
+				if res.HasErrors() {
+					t.Fatalf("unexpected errors: %v", res.Errors())
+				}
+				return
+			}
+			if errs := res.Errors()["cover"]; len(errs) == 0 || errs[0] != tt.want {
+				t.Errorf("errors = %v, want %q", res.Errors(), tt.want)
+			}
+		})
+	}
+
+	if cfg, err := rules.DecodeImageConfig(strings.NewReader(webp)); err != nil || cfg.Width != 1280 || cfg.Height != 720 {
+		t.Errorf("webp config = %+v, %v", cfg, err)
+	}
+	restored, err := validation.FromDescription(schema.Describe())
+	if err != nil {
+		t.Fatalf("FromDescription: %v", err)
+	}
+	if got := restored.Describe().Fields["cover"]; got.Type != "image" || len(got.Rules) != 6 {
+		t.Errorf("restored cover = %+v", got)
+	}
+}
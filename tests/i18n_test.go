@@ -0,0 +1,368 @@
+// -----------------------------------------------------------------------------
+// i18n Formatting Tests
+// -----------------------------------------------------------------------------
+// Bu dosya, Translator'ın mesaj biçimlendirme davranışlarını (RTL yön
+// yalıtımı, yerel biçimlendirme vb.) test eder.
+//
+// Metadata:
+// @author   Ahmet ALTUN
+// @github   github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email    ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+package tests
+
+import (
+	"context"
+	"encoding/binary"
+	"strings"
+	"testing"
+
+	validation "github.com/biyonik/go-fluent-validator"
+	"github.com/biyonik/go-fluent-validator/i18n"
+)
+
+// TestI18n_RTLIsolation tests bidi isolation of interpolated values for RTL locales
+func TestI18n_RTLIsolation(t *testing.T) {
+	i18n.AddMessages("ar", i18n.Messages{
+		i18n.KeyMin:       "يجب أن يكون %s على الأقل %v",
+		i18n.KeyMinLength: "يجب أن يحتوي %s على %d أحرف على الأقل",
+	})
+	i18n.SetLocale("ar")
+	defer i18n.SetLocale("en")
+
+	got := i18n.Get(i18n.KeyMin, "age", 18)
+	want := "يجب أن يكون " + i18n.Isolate("age") + " على الأقل " + i18n.Isolate("18")
+	if got != want {
+		t.Errorf("Get() = %q, want %q", got, want)
+	}
+
+	if got := i18n.Get(i18n.KeyMinLength, "name", 3); got != "يجب أن يحتوي "+i18n.Isolate("name")+" على "+i18n.Isolate("3")+" أحرف على الأقل" {
+		t.Errorf("verbs must be preserved inside isolation, got %q", got)
+	}
+
+	i18n.SetBidiIsolation(false)
+	defer i18n.SetBidiIsolation(true)
+	if got := i18n.Get(i18n.KeyMin, "age", 18); got != "يجب أن يكون age على الأقل 18" {
+		t.Errorf("isolation should be disabled, got %q", got)
+	}
+
+	i18n.SetLocale("en")
+	i18n.SetBidiIsolation(true)
+	if got := i18n.Get(i18n.KeyMin, "age", 18); got != "age must be at least 18" {
+		t.Errorf("LTR locales must not be isolated, got %q", got)
+	}
+
+	for locale, want := range map[string]bool{"ar-EG": true, "he_IL": true, "fa": true, "tr": false, "en-US": false} {
+		if got := i18n.IsRTL(locale); got != want {
+			t.Errorf("IsRTL(%q) = %v, want %v", locale, got, want)
+		}
+	}
+}
+
+// TestI18n_LocaleFormatter tests locale-aware number and date interpolation
+func TestI18n_LocaleFormatter(t *testing.T) {
+	i18n.SetValueFormatter(i18n.LocaleFormatter)
+	defer i18n.SetValueFormatter(nil)
+	defer i18n.SetLocale("en")
+
+	i18n.SetLocale("de")
+	if got := i18n.Get(i18n.KeyMin, "Betrag", 1234.5); !strings.Contains(got, "1.234,5") {
+		t.Errorf("de number should use 1.234,5, got %q", got)
+	}
+
+	i18n.SetLocale("en")
+	if got := i18n.Get(i18n.KeyMin, "amount", 1234567); !strings.Contains(got, "1,234,567") {
+		t.Errorf("en number should use 1,234,567, got %q", got)
+	}
+
+	schema := validation.Make().Shape(map[string]validation.Type{
+		"start": validation.Date().Min("2024-03-01"),
+	})
+	i18n.SetLocale("tr")
+	res := schema.Validate(map[string]any{"start": "2024-01-15"})
+	if msgs := res.Errors()["start"]; len(msgs) == 0 || !strings.Contains(msgs[0], "01.03.2024") {
+		t.Errorf("tr date bound should render as 01.03.2024, got %v", msgs)
+	}
+
+	i18n.SetValueFormatter(nil)
+	res = schema.Validate(map[string]any{"start": "2024-01-15"})
+	if msgs := res.Errors()["start"]; len(msgs) == 0 || !strings.Contains(msgs[0], "2024-03-01") {
+		t.Errorf("without formatter the raw bound should be kept, got %v", msgs)
+	}
+}
+
+// TestI18n_GettextPO tests loading validator messages from a .po catalog
+func TestI18n_GettextPO(t *testing.T) {
+	po := `# Portuguese translation
+msgid ""
+msgstr ""
+"Content-Type: text/plain; charset=UTF-8\n"
+
+msgctxt "validation.required"
+msgid "%s is required"
+msgstr "%s é obrigatório"
+
+# msgid matched by English source text
+msgid "%s must be a valid email address"
+msgstr ""
+"%s deve ser um "
+"endereço de e-mail válido"
+
+#, fuzzy
+msgid "validation.min"
+msgstr "%s não revisado"
+`
+	if err := i18n.LoadPO("pt", strings.NewReader(po)); err != nil {
+		t.Fatalf("LoadPO failed: %v", err)
+	}
+	defer i18n.SetLocale("en")
+	i18n.SetLocale("pt")
+
+	if got := i18n.Get(i18n.KeyRequired, "Nome"); got != "Nome é obrigatório" {
+		t.Errorf("msgctxt entry not applied, got %q", got)
+	}
+	if got := i18n.Get(i18n.KeyEmail, "E-mail"); got != "E-mail deve ser um endereço de e-mail válido" {
+		t.Errorf("msgid text entry not applied, got %q", got)
+	}
+	if got := i18n.Get(i18n.KeyMin, "x", 3); strings.Contains(got, "revisado") {
+		t.Errorf("fuzzy entry should be skipped, got %q", got)
+	}
+
+	if err := i18n.LoadPO("pt", strings.NewReader("msgid \"unterminated\n")); err == nil {
+		t.Error("malformed catalog should return an error")
+	}
+}
+
+// TestI18n_GettextMO tests loading a compiled .mo catalog
+func TestI18n_GettextMO(t *testing.T) {
+	orig := []string{"validation.required", "validation.email\x04ignored"}
+	trans := []string{"%s è obbligatorio", "%s deve essere un'email valida"}
+
+	header := 28
+	tables := 2 * 8 * len(orig)
+	data := make([]byte, header+tables)
+	binary.LittleEndian.PutUint32(data[0:], 0x950412de)
+	binary.LittleEndian.PutUint32(data[8:], uint32(len(orig)))
+	binary.LittleEndian.PutUint32(data[12:], uint32(header))
+	binary.LittleEndian.PutUint32(data[16:], uint32(header+tables/2))
+	for i, pair := range [][]string{orig, trans} {
+		for j, str := range pair {
+			pos := header + i*tables/2 + j*8
+			binary.LittleEndian.PutUint32(data[pos:], uint32(len(str)))
+			binary.LittleEndian.PutUint32(data[pos+4:], uint32(len(data)))
+			data = append(data, str...)
+		}
+	}
+
+	if err := i18n.LoadMO("it", data); err != nil {
+		t.Fatalf("LoadMO failed: %v", err)
+	}
+	defer i18n.SetLocale("en")
+	i18n.SetLocale("it")
+
+	if got := i18n.Get(i18n.KeyRequired, "Nome"); got != "Nome è obbligatorio" {
+		t.Errorf("got %q", got)
+	}
+	if got := i18n.Get(i18n.KeyEmail, "Email"); got != "Email deve essere un'email valida" {
+		t.Errorf("context entry not applied, got %q", got)
+	}
+
+	if err := i18n.LoadMO("it", []byte("not a catalog")); err == nil {
+		t.Error("invalid .mo data should return an error")
+	}
+}
+
+// TestI18n_WritePOT tests exporting a gettext template
+func TestI18n_WritePOT(t *testing.T) {
+	var sb strings.Builder
+	if err := i18n.WritePOT(&sb); err != nil {
+		t.Fatal(err)
+	}
+	pot := sb.String()
+	if !strings.Contains(pot, "msgctxt \"validation.required\"\nmsgid \"%s is required\"") {
+		t.Errorf("template should contain keyed entries, got:\n%.300s", pot)
+	}
+
+	if err := i18n.LoadPO("xx", strings.NewReader(pot)); err != nil {
+		t.Errorf("generated template should parse: %v", err)
+	}
+}
+
+// TestI18n_Placeholders tests named {values} and {other} placeholders
+func TestI18n_Placeholders(t *testing.T) {
+	defer i18n.SetLocale("en")
+
+	schema := validation.Make().Shape(map[string]validation.Type{
+		"color": validation.String().OneOf([]string{"red", "green", "blue", "cyan", "magenta", "yellow", "black", "white"}),
+		"size":  validation.String().OneOf([]string{"s", "m", "l"}),
+	})
+	res := schema.Validate(map[string]any{"color": "pink", "size": "xl"})
+	if got := res.Errors()["color"][0]; !strings.HasSuffix(got, "red, green, blue, cyan, magenta, and 3 more") {
+		t.Errorf("long list should be capped, got %q", got)
+	}
+	if got := res.Errors()["size"][0]; !strings.HasSuffix(got, "s, m, and l") {
+		t.Errorf("short list should be joined, got %q", got)
+	}
+
+	i18n.SetLocale("tr")
+	res = schema.Validate(map[string]any{"size": "xl"})
+	if got := res.Errors()["size"][0]; !strings.HasSuffix(got, "s, m ve l") {
+		t.Errorf("tr list should use 've', got %q", got)
+	}
+	i18n.SetLocale("en")
+
+	i18n.AddMessages("en", i18n.Messages{i18n.KeyOneOf: "{attribute} accepts {values}"})
+	defer i18n.AddMessages("en", i18n.Messages{i18n.KeyOneOf: "%s must be one of: %s"})
+	res = schema.Validate(map[string]any{"size": "xl"})
+	if got := res.Errors()["size"][0]; got != "size accepts s, m, and l" {
+		t.Errorf("custom message placeholders not filled, got %q", got)
+	}
+
+	passwords := validation.Make().Shape(map[string]validation.Type{
+		"password":         validation.String().Label("Password"),
+		"password_confirm": validation.String().Label("Confirmation"),
+		"old_password":     validation.String(),
+	}).(*validation.ValidationSchema)
+	passwords.Same("password_confirm", "password").Different("password", "old_password")
+
+	res = passwords.Validate(map[string]any{"password": "s3cret", "password_confirm": "secret", "old_password": "s3cret"})
+	if got := res.Errors()["password_confirm"]; len(got) != 1 || got[0] != "Confirmation must match Password" {
+		t.Errorf("Same error = %v", got)
+	}
+	if got := res.Errors()["password"]; len(got) != 1 || got[0] != "Password must be different from old_password" {
+		t.Errorf("Different error = %v", got)
+	}
+	if failures := res.Failures(); len(failures) != 2 || failures[0].Rule != "different" || failures[1].Rule != "same" {
+		t.Errorf("failures should carry same/different rules, got %+v", failures)
+	}
+
+	res = passwords.Validate(map[string]any{"password": "n3w", "password_confirm": "n3w", "old_password": "old"})
+	if res.HasErrors() {
+		t.Errorf("matching passwords should pass, got %v", res.Errors())
+	}
+}
+
+// TestI18n_LocalizeCrossField tests that cross-field, group and change rule messages follow the request locale
+func TestI18n_LocalizeCrossField(t *testing.T) {
+	schema := validation.Make().Shape(map[string]validation.Type{
+		"password":         validation.String(),
+		"password_confirm": validation.String(),
+		"email":            validation.String(),
+		"phone":            validation.String(),
+		"id":               validation.Number().Immutable(),
+	}).(*validation.ValidationSchema)
+	schema.Same("password_confirm", "password").RequireAnyOf("email", "phone")
+
+	ctx := i18n.ContextWithLocale(context.Background(), "tr")
+	res := schema.ValidateCtx(ctx, map[string]any{"password": "a", "password_confirm": "b"})
+	want := map[string]string{
+		"password_confirm": i18n.GetIn("tr", i18n.KeySame, "password_confirm", i18n.Attrs{"attribute": "password_confirm", "other": "password"}),
+		"email":            i18n.GetIn("tr", i18n.KeyRequireAnyOf, "email", i18n.Attrs{"attribute": "email", "values": "phone"}),
+	}
+	for field, msg := range want {
+		if got := res.Errors()[field]; len(got) != 1 || got[0] != msg {
+			t.Errorf("%s = %v, want %q", field, got, msg)
+		}
+	}
+	if failures := res.Failures(); failures[0].Rule != "require_any_of" {
+		t.Errorf("localized errors should keep their rule names, got %+v", failures)
+	}
+
+	res = schema.ValidateChanges(map[string]any{"id": 1}, map[string]any{"id": 2, "email": "a@b.co"})
+	res.Localize("tr")
+	if got, want := res.Errors()["id"], i18n.GetIn("tr", i18n.KeyImmutable, "id"); len(got) != 1 || got[0] != want {
+		t.Errorf("id = %v, want %q", got, want)
+	}
+}
+
+// TestI18n_OneOfParams tests localized one_of rendering and the structured values param
+func TestI18n_OneOfParams(t *testing.T) {
+	schema := validation.Make().Shape(map[string]validation.Type{
+		"size": validation.String().OneOf([]string{"s", "m", "l"}),
+		"name": validation.String().Required(),
+	})
+
+	res := schema.Validate(map[string]any{"size": "xl"})
+	if got := res.Errors()["size"][0]; strings.Contains(got, "[") || !strings.HasSuffix(got, "s, m, and l") {
+		t.Errorf("allowed values should be joined, not slice formatted, got %q", got)
+	}
+	res.Localize("de")
+	if got := res.Errors()["size"][0]; !strings.HasSuffix(got, "s, m und l") {
+		t.Errorf("localized result should re-join values, got %q", got)
+	}
+
+	for _, f := range res.Failures() {
+		switch f.Field {
+		case "size":
+			values, ok := f.Params["values"].([]any)
+			if f.Rule != "one_of" || !ok || len(values) != 3 || values[2] != "l" {
+				t.Errorf("one_of failure should expose allowed values, got %+v", f)
+			}
+		case "name":
+			if f.Params != nil {
+				t.Errorf("failures without named params should have nil Params, got %v", f.Params)
+			}
+		}
+	}
+}
+
+// TestI18n_MatchLocale tests Accept-Language negotiation against loaded locales
+func TestI18n_MatchLocale(t *testing.T) {
+	cases := []struct {
+		header    string
+		supported []string
+		want      string
+		ok        bool
+	}{
+		{"de-CH, fr;q=0.8, en;q=0.5", nil, "de", true},
+		{"fr;q=0.4, TR;q=0.9", nil, "tr", true},
+		{"en_US", nil, "en", true},
+		{"pt;q=1", []string{"en", "pt-BR"}, "pt-BR", true},
+		{"de;q=0, es", []string{"de", "es"}, "es", true},
+		{"*, qq-ZZ", nil, "", false},
+		{"", nil, "", false},
+	}
+	for _, tc := range cases {
+		got, ok := i18n.MatchLocale(tc.header, tc.supported...)
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("MatchLocale(%q) = %q, %v; want %q, %v", tc.header, got, ok, tc.want, tc.ok)
+		}
+	}
+
+	if got := i18n.GetIn("de", i18n.KeyRequired, "Email"); got != "Email ist erforderlich" {
+		t.Errorf("GetIn should ignore the active locale, got %q", got)
+	}
+	if i18n.GetLocale() != "en" {
+		t.Errorf("GetIn must not change the active locale, got %q", i18n.GetLocale())
+	}
+}
+
+// TestI18n_ValueLengthLimit tests that oversized message arguments are truncated with an ellipsis
+func TestI18n_ValueLengthLimit(t *testing.T) {
+	huge := strings.Repeat("ğ", 1<<20)
+
+	got := i18n.Get(i18n.KeyOneOf, huge, "x, y")
+	want := strings.Repeat("ğ", i18n.DefaultValueLengthLimit) + i18n.Ellipsis + " must be one of: x, y"
+	if got != want {
+		t.Errorf("oversized argument should be truncated, got %d bytes", len(got))
+	}
+
+	i18n.AddMessages("en", i18n.Messages{i18n.KeyOneOf: "{attribute} accepts {values}"})
+	defer i18n.AddMessages("en", i18n.Messages{i18n.KeyOneOf: "%s must be one of: %s"})
+	got = i18n.Get(i18n.KeyOneOf, i18n.Attrs{"attribute": "size", "values": i18n.ValueList{"s", huge}})
+	if len(got) > 1024 || !strings.HasSuffix(got, i18n.Ellipsis) {
+		t.Errorf("placeholder values should be truncated, got %d bytes", len(got))
+	}
+
+	i18n.SetValueLengthLimit(4)
+	defer i18n.SetValueLengthLimit(i18n.DefaultValueLengthLimit)
+	if got := i18n.Get(i18n.KeyMin, "quantity", 12345678); got != "quan… must be at least 1234…" {
+		t.Errorf("custom limit should apply to every argument, got %q", got)
+	}
+	i18n.SetValueLengthLimit(0)
+	if got := i18n.Get(i18n.KeyMin, "quantity", 12345678); got != "quantity must be at least 12345678" {
+		t.Errorf("zero limit should disable truncation, got %q", got)
+	}
+}
@@ -1,10 +1,19 @@
 package tests
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
 	v "github.com/biyonik/go-fluent-validator"
+	"github.com/biyonik/go-fluent-validator/rules"
+	"github.com/biyonik/go-fluent-validator/types"
 )
 
 // TestUuidValidation tests UUID validation
@@ -204,6 +213,59 @@ func TestCreditCardCustomValidation(t *testing.T) {
 	}
 }
 
+// TestCreditCardBINLookup tests the BIN lookup hook with kind and country restrictions
+func TestCreditCardBINLookup(t *testing.T) {
+	bins := map[string]types.CardMeta{
+		"453201": {Brand: "visa", Kind: types.CardKindCredit, Country: "TR"},
+		"411111": {Brand: "visa", Kind: types.CardKindDebit, Country: "US"},
+	}
+	var looked []string
+	lookup := func(bin string) (types.CardMeta, error) {
+		looked = append(looked, bin)
+		if bin == "555555" {
+			time.Sleep(200 * time.Millisecond)
+		}
+		meta, ok := bins[bin]
+		if !ok {
+			return types.CardMeta{}, errors.New("unknown bin")
+		}
+		return meta, nil
+	}
+
+	schema := v.Make().Shape(map[string]v.Type{
+		"card": v.CreditCard().BINLookup(lookup).AllowKinds(types.CardKindCredit).AllowCountries("tr"),
+	}).(*v.ValidationSchema)
+
+	if res := schema.Validate(map[string]any{"card": "4532 0151 1283 0366"}); res.HasErrors() {
+		t.Errorf("allowed card should pass, got %v", res.Errors())
+	}
+	if len(looked) != 1 || looked[0] != "453201" {
+		t.Errorf("lookup should receive the 6 digit BIN, got %v", looked)
+	}
+
+	res := schema.Validate(map[string]any{"card": "4111111111111111"})
+	if msgs := res.Errors()["card"]; len(msgs) != 2 {
+		t.Errorf("debit card from US should fail kind and country checks, got %v", msgs)
+	}
+
+	looked = nil
+	if res := schema.Validate(map[string]any{"card": "4111111111111112"}); !res.HasErrors() || len(looked) != 0 {
+		t.Errorf("invalid numbers must fail without a lookup, got %v (lookups %v)", res.Errors(), looked)
+	}
+
+	res = schema.Validate(map[string]any{"card": "6011111111111117"})
+	if failures := res.Failures(); len(failures) != 1 || failures[0].Rule != "card_lookup" {
+		t.Errorf("lookup errors should be reported, got %+v", failures)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	res = schema.ValidateCtx(ctx, map[string]any{"card": "5555555555554444"})
+	if failures := res.Failures(); len(failures) != 1 || failures[0].Rule != "card_lookup" {
+		t.Errorf("cancelled lookup should not be awaited, got %+v", failures)
+	}
+}
+
 // TestDateValidation tests date validation
 func TestDateValidation(t *testing.T) {
 	tests := []struct {
@@ -352,6 +414,127 @@ func TestObjectCustomValidation(t *testing.T) {
 	}
 }
 
+// TestMapValidation tests map validation with key and value schemas
+func TestMapValidation(t *testing.T) {
+	schema := v.Make().Shape(map[string]v.Type{
+		"translations": v.Map().
+			Keys(v.String().Regex(`^[a-z]{2}$`)).
+			Values(v.String().Required().Max(10)).
+			MinKeys(1).
+			MaxKeys(3).
+			Required(),
+	})
+
+	result := schema.Validate(map[string]any{
+		"translations": map[string]any{"en": "Hello", "tr": "Merhaba"},
+	})
+	if result.HasErrors() {
+		t.Errorf("Expected no error but got: %v", result.Errors())
+	}
+
+	result = schema.Validate(map[string]any{
+		"translations": map[string]any{"en": "Hello", "EN-us": "Hi", "de": "Guten Morgen!"},
+	})
+	if msgs := result.Errors()["translations"]; len(msgs) != 1 || !strings.Contains(msgs[0], "EN-us") {
+		t.Errorf("Expected invalid key error but got: %v", result.Errors())
+	}
+	if _, ok := result.Errors()["translations.de"]; !ok {
+		t.Errorf("Expected value error at translations.de but got: %v", result.Errors())
+	}
+	if _, ok := result.Errors()["translations.EN-us"]; ok {
+		t.Error("Values of invalid keys should not be validated")
+	}
+
+	result = schema.Validate(map[string]any{"translations": map[string]any{}})
+	if !result.HasErrors() {
+		t.Error("Expected min keys error but got none")
+	}
+	result = schema.Validate(map[string]any{
+		"translations": map[string]any{"en": "a", "tr": "b", "de": "c", "fr": "d"},
+	})
+	if !result.HasErrors() {
+		t.Error("Expected max keys error but got none")
+	}
+	result = schema.Validate(map[string]any{"translations": "en"})
+	if !result.HasErrors() {
+		t.Error("Expected object type error but got none")
+	}
+
+	flags := v.Make().Shape(map[string]v.Type{"flags": v.Map().Values(v.Boolean())}).(*v.ValidationSchema)
+	doc := flags.ToJSONSchema()
+	property := doc["properties"].(map[string]any)["flags"].(map[string]any)
+	if property["type"] != "object" || property["additionalProperties"].(map[string]any)["type"] != "boolean" {
+		t.Errorf("Unexpected JSON Schema for map: %v", property)
+	}
+
+	data, err := json.Marshal(schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	restored := v.Make()
+	if err := json.Unmarshal(data, restored); err != nil {
+		t.Fatal(err)
+	}
+	result = restored.Validate(map[string]any{"translations": map[string]any{"eng": "Hello"}})
+	if !result.HasErrors() {
+		t.Error("Expected restored map schema to validate keys")
+	}
+}
+
+// TestAnyAndLiteral tests passthrough and constant value fields
+func TestAnyAndLiteral(t *testing.T) {
+	schema := v.Make().Shape(map[string]v.Type{
+		"version": v.Literal(2).Required(),
+		"kind":    v.Literal("invoice").Default(),
+		"data": v.Any().Required().TransformWith(func(value any) (any, error) {
+			if m, ok := value.(map[string]any); ok {
+				delete(m, "secret")
+			}
+			return value, nil
+		}),
+		"meta": v.Any().Custom(func(value any) error {
+			if _, ok := value.(map[string]any); !ok {
+				return errors.New("meta must be an object")
+			}
+			return nil
+		}),
+	}).(*v.ValidationSchema)
+
+	result := schema.Validate(map[string]any{
+		"version": 2.0,
+		"data":    map[string]any{"id": 7, "secret": "x"},
+	})
+	if result.HasErrors() {
+		t.Errorf("Expected no error but got: %v", result.Errors())
+	}
+	valid := result.ValidData()
+	if valid["kind"] != "invoice" {
+		t.Errorf("Literal default should fill the constant, got %v", valid["kind"])
+	}
+	if data := valid["data"].(map[string]any); data["id"] != 7 || data["secret"] != nil {
+		t.Errorf("Any should pass the transformed value through, got %v", data)
+	}
+
+	result = schema.Validate(map[string]any{"version": "2", "kind": "receipt", "meta": "x"})
+	errs := result.Errors()
+	for _, field := range []string{"version", "kind", "data", "meta"} {
+		if _, ok := errs[field]; !ok {
+			t.Errorf("Expected error for %s but got: %v", field, errs)
+		}
+	}
+	if msg := errs["kind"][0]; !strings.Contains(msg, "invoice") {
+		t.Errorf("Literal error should mention the constant, got %q", msg)
+	}
+
+	property := schema.ToJSONSchema()["properties"].(map[string]any)
+	if property["version"].(map[string]any)["const"] != 2 {
+		t.Errorf("Literal should export as const, got %v", property["version"])
+	}
+	if len(property["meta"].(map[string]any)) != 0 {
+		t.Errorf("Any should export as an empty schema, got %v", property["meta"])
+	}
+}
+
 // TestCrossValidationTiming tests that cross-validation runs even when field validation fails
 func TestCrossValidationTiming(t *testing.T) {
 	schema := v.Make().Shape(map[string]v.Type{
@@ -434,3 +617,295 @@ func TestDomainValidation(t *testing.T) {
 		})
 	}
 }
+
+// TestCaptchaVerification tests provider token verification with scores and timeouts
+func TestCaptchaVerification(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("secret") != "s3cret" {
+			json.NewEncoder(w).Encode(map[string]any{"success": false, "error-codes": []string{"invalid-input-secret"}})
+			return
+		}
+		switch r.FormValue("response") {
+		case "human":
+			json.NewEncoder(w).Encode(map[string]any{"success": true, "score": 0.9, "action": "signup", "remoteip": r.FormValue("remoteip")})
+		case "bot":
+			json.NewEncoder(w).Encode(map[string]any{"success": true, "score": 0.1, "action": "signup"})
+		case "slow":
+			time.Sleep(200 * time.Millisecond)
+			json.NewEncoder(w).Encode(map[string]any{"success": true})
+		default:
+			json.NewEncoder(w).Encode(map[string]any{"success": false})
+		}
+	}))
+	defer server.Close()
+
+	var sentIP string
+	verifier := rules.Recaptcha("s3cret",
+		rules.WithCaptchaEndpoint(server.URL),
+		rules.WithCaptchaTimeout(50*time.Millisecond),
+		rules.WithCaptchaRemoteIP(func(context.Context) string { sentIP = "203.0.113.7"; return sentIP }),
+	)
+	schema := v.Make().Shape(map[string]v.Type{
+		"captcha": v.Captcha(verifier).MinScore(0.5).Action("signup"),
+	}).(*v.ValidationSchema)
+
+	if res := schema.ValidateCtx(context.Background(), map[string]any{"captcha": "human"}); res.HasErrors() {
+		t.Errorf("human token should pass, got %v", res.Errors())
+	}
+	if sentIP == "" {
+		t.Error("remote IP should be sent to the provider")
+	}
+	for _, token := range []any{"bot", "forged", "slow", "", nil} {
+		res := schema.ValidateCtx(context.Background(), map[string]any{"captcha": token})
+		if got := res.Errors()["captcha"]; len(got) != 1 || got[0] != "captcha verification failed, please try again" {
+			t.Errorf("token %v should fail, got %v", token, res.Errors())
+		}
+	}
+
+	wrongAction := v.Make().Shape(map[string]v.Type{"captcha": v.Captcha(verifier).Action("login")})
+	if !wrongAction.Validate(map[string]any{"captcha": "human"}).HasErrors() {
+		t.Error("action mismatch should fail")
+	}
+	badSecret := v.Make().Shape(map[string]v.Type{"captcha": v.Captcha(rules.Turnstile("wrong", rules.WithCaptchaEndpoint(server.URL)))})
+	if !badSecret.Validate(map[string]any{"captcha": "human"}).HasErrors() {
+		t.Error("invalid secret should fail")
+	}
+}
+
+// TestEmailValidation tests the dedicated email type
+func TestEmailValidation(t *testing.T) {
+	tests := []struct {
+		name      string
+		email     string
+		typ       *types.EmailType
+		shouldErr bool
+	}{
+		{"Valid email", "user@example.com", v.Email(), false},
+		{"Invalid email", "not-an-email", v.Email(), true},
+		{"Allowed domain", "jane@acme.com", v.Email().DomainIn("acme.com"), false},
+		{"Allowed subdomain", "jane@eu.acme.com", v.Email().DomainIn("acme.com"), false},
+		{"Foreign domain", "jane@other.com", v.Email().DomainIn("acme.com"), true},
+		{"Lookalike domain", "jane@notacme.com", v.Email().DomainIn("acme.com"), true},
+		{"Denied domain", "jane@Gmail.com", v.Email().NotDomainIn("gmail.com"), true},
+		{"Local part rule", "jane+promo@acme.com", v.Email().LocalPart(func(local string) error {
+			if strings.Contains(local, "+") {
+				return errors.New("alias addresses are not accepted")
+			}
+			return nil
+		}), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := v.Make().Shape(map[string]v.Type{"email": tt.typ}).Validate(map[string]any{"email": tt.email})
+			if result.HasErrors() != tt.shouldErr {
+				t.Errorf("email %q: errors = %v, shouldErr = %v", tt.email, result.Errors(), tt.shouldErr)
+			}
+		})
+	}
+
+	result := v.Make().Shape(map[string]v.Type{"email": v.Email().Required()}).
+		Validate(map[string]any{"email": "  Jane.Doe@ACME.Com "})
+	if got := result.ValidData()["email"]; got != "Jane.Doe@acme.com" {
+		t.Errorf("normalized email = %q, want %q", got, "Jane.Doe@acme.com")
+	}
+
+	var domain string
+	v.Make().Shape(map[string]v.Type{"email": v.Email().Domain(func(d string) error {
+		domain = d
+		return nil
+	})}).Validate(map[string]any{"email": "jane@Example.ORG"})
+	if domain != "example.org" {
+		t.Errorf("Domain callback received %q", domain)
+	}
+}
+
+// TestEmailFreeProvidersAndRoleAccounts tests B2B email restrictions
+func TestEmailFreeProvidersAndRoleAccounts(t *testing.T) {
+	schema := v.Make().Shape(map[string]v.Type{
+		"email": v.Email(types.RejectFreeProviders(), types.RejectRoleAccounts()).Required(),
+	})
+	tests := []struct {
+		email     string
+		shouldErr bool
+	}{
+		{"jane.doe@acme.com", false},
+		{"jane.doe@gmail.com", true},
+		{"jane@mail.yahoo.com", true},
+		{"info@acme.com", true},
+		{"Support+EU@acme.com", true},
+		{"information@acme.com", false},
+	}
+	for _, tt := range tests {
+		result := schema.Validate(map[string]any{"email": tt.email})
+		if result.HasErrors() != tt.shouldErr {
+			t.Errorf("email %q: errors = %v, shouldErr = %v", tt.email, result.Errors(), tt.shouldErr)
+		}
+	}
+
+	providers, roles := rules.FreeEmailProviders(), rules.RoleAccounts()
+	defer func() {
+		rules.SetFreeEmailProviders(providers)
+		rules.SetRoleAccounts(roles)
+	}()
+	rules.AddFreeEmailProviders("freemail.example")
+	rules.AddRoleAccounts("hello")
+	if !rules.IsFreeEmailProvider("FreeMail.example") || !rules.IsRoleAccount("hello") {
+		t.Error("added list entries should be recognized")
+	}
+}
+
+// fakeDNS is an in-memory rules.DNSResolver counting lookups
+type fakeDNS struct {
+	calls int
+	hosts map[string][]string
+	mx    map[string][]*net.MX
+	txt   map[string][]string
+}
+
+func (f *fakeDNS) result(records int, name string) error {
+	f.calls++
+	if name == "timeout.example" {
+		return &net.DNSError{Err: "i/o timeout", Name: name, IsTimeout: true}
+	}
+	if records == 0 {
+		return &net.DNSError{Err: "no such host", Name: name, IsNotFound: true}
+	}
+	return nil
+}
+
+func (f *fakeDNS) LookupHost(_ context.Context, host string) ([]string, error) {
+	return f.hosts[host], f.result(len(f.hosts[host]), host)
+}
+
+func (f *fakeDNS) LookupMX(_ context.Context, name string) ([]*net.MX, error) {
+	return f.mx[name], f.result(len(f.mx[name]), name)
+}
+
+func (f *fakeDNS) LookupTXT(_ context.Context, name string) ([]string, error) {
+	return f.txt[name], f.result(len(f.txt[name]), name)
+}
+
+// TestDNSRecordRules tests async DNS existence checks on Email and AdvancedString
+func TestDNSRecordRules(t *testing.T) {
+	dns := &fakeDNS{
+		hosts: map[string][]string{"acme.com": {"93.184.216.34"}, "nomail.com": {"93.184.216.35"}},
+		mx: map[string][]*net.MX{
+			"acme.com":   {{Host: "mx1.acme.com.", Pref: 10}},
+			"nomail.com": {{Host: ".", Pref: 0}},
+		},
+		txt: map[string][]string{"acme.com": {"v=spf1 -all", "acme-verify=abc123"}},
+	}
+	rules.SetDNSResolver(dns)
+	defer rules.SetDNSResolver(nil)
+
+	ctx := context.Background()
+	email := v.Make().Shape(map[string]v.Type{
+		"email": v.Email().Required().HasMX(),
+	}).(*v.ValidationSchema)
+	if res := email.ValidateCtx(ctx, map[string]any{"email": "jane@acme.com"}); res.HasErrors() {
+		t.Errorf("unexpected errors: %v", res.Errors())
+	}
+	for _, addr := range []string{"jane@nomail.com", "jane@missing.com", "jane@timeout.example"} {
+		if res := email.ValidateCtx(ctx, map[string]any{"email": addr}); !res.HasFieldErrors("email") {
+			t.Errorf("%s should be rejected", addr)
+		}
+	}
+
+	domain := v.Make().Shape(map[string]v.Type{
+		"domain": v.AdvancedString().Required().Domain(true).DomainResolvable().HasTXT("acme-verify=abc"),
+	}).(*v.ValidationSchema)
+	if res := domain.ValidateCtx(ctx, map[string]any{"domain": "acme.com"}); res.HasErrors() {
+		t.Errorf("unexpected errors: %v", res.Errors())
+	}
+	res := domain.ValidateCtx(ctx, map[string]any{"domain": "nomail.com"})
+	if errs := res.Errors()["domain"]; len(errs) != 1 {
+		t.Errorf("missing TXT record should fail once, got %v", errs)
+	}
+
+	calls := dns.calls
+	domain.ValidateCtx(ctx, map[string]any{"domain": "acme.com"})
+	email.ValidateCtx(ctx, map[string]any{"email": "jane@missing.com"})
+	if dns.calls != calls {
+		t.Errorf("cached lookups should not hit the resolver, got %d new calls", dns.calls-calls)
+	}
+	email.ValidateCtx(ctx, map[string]any{"email": "jane@timeout.example"})
+	if dns.calls != calls+1 {
+		t.Error("transient DNS errors should not be cached")
+	}
+
+	restored, err := v.FromDescription(domain.Describe())
+	if err != nil {
+		t.Fatalf("FromDescription: %v", err)
+	}
+	if res := restored.ValidateCtx(ctx, map[string]any{"domain": "nomail.com"}); !res.HasFieldErrors("domain") {
+		t.Error("restored schema should keep DNS rules")
+	}
+}
+
+// TestJSONPatch tests RFC 6902 structure checks and target schema verification
+func TestJSONPatch(t *testing.T) {
+	user := v.Make().Shape(map[string]v.Type{
+		"name":    v.String().Required().Max(10),
+		"age":     v.Number().Integer().Min(18),
+		"tags":    v.Array().Elements(v.String().Max(5)),
+		"address": v.Object().Shape(map[string]v.Type{"city": v.String().Min(2)}),
+	})
+	patch := v.JSONPatch(user)
+
+	res := patch.ValidateJSON([]byte(`[
+		{"op": "replace", "path": "/name", "value": "Ada"},
+		{"op": "add", "path": "/tags/-", "value": "go"},
+		{"op": "remove", "path": "/age"},
+		{"op": "copy", "from": "/name", "path": "/address/city"},
+		{"op": "test", "path": "/age", "value": 30}
+	]`))
+	if res.HasErrors() {
+		t.Fatalf("unexpected errors: %v", res.Errors())
+	}
+
+	tests := []struct {
+		name  string
+		body  string
+		field string
+		want  string
+	}{
+		{"not an array", `{"op": "add"}`, "", " must be a valid JSON Patch document"},
+		{"bad json", `[{"op"`, "_payload", "payload must be a valid JSON object"},
+		{"unknown op", `[{"op": "merge", "path": "/name"}]`, "[0].op", "[0].op must be one of: add, remove, replace, move, copy, test"},
+		{"bad pointer", `[{"op": "remove", "path": "name"}]`, "[0].path", "[0].path must be a valid JSON Pointer"},
+		{"missing value", `[{"op": "add", "path": "/name"}]`, "[0].value", "[0].value is required"},
+		{"missing from", `[{"op": "move", "path": "/name"}]`, "[0].from", "[0].from is required"},
+		{"move into child", `[{"op": "move", "from": "/address", "path": "/address/city"}]`, "[0].from", "[0].from cannot be moved into one of its children"},
+		{"unknown path", `[{"op": "replace", "path": "/email", "value": "x"}]`, "[0].path", "[0].path refers to a location that does not exist"},
+		{"bad array index", `[{"op": "add", "path": "/tags/01", "value": "x"}]`, "[0].path", "[0].path refers to a location that does not exist"},
+		{"invalid value", `[{"op": "replace", "path": "/age", "value": 12}]`, "[0].value", "[0].value must be at least 18"},
+		{"invalid element", `[{"op": "add", "path": "/tags/0", "value": "toolong"}]`, "[0].value", "[0].value must be at most 5 characters long"},
+		{"nested value", `[{"op": "replace", "path": "/address/city", "value": "x"}]`, "[0].value", "[0].value must be at least 2 characters long"},
+		{"remove required", `[{"op": "remove", "path": "/name"}]`, "[0].path", "[0].path refers to a required field that cannot be removed"},
+		{"replace root", `[{"op": "replace", "path": "", "value": {"age": 30}}]`, "[0].value.name", "name is required"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := patch.ValidateJSON([]byte(tt.body))
+			if errs := res.Errors()[tt.field]; len(errs) == 0 || errs[0] != tt.want {
+				t.Errorf("errors = %v, want %q at %q", res.Errors(), tt.want, tt.field)
+			}
+		})
+	}
+
+	schema := v.Make().Shape(map[string]v.Type{
+		"ops": v.JSONPatch(nil).Required(),
+	})
+	if res := schema.Validate(map[string]any{"ops": []any{map[string]any{"op": "remove", "path": "/anything"}}}); res.HasErrors() {
+		t.Errorf("structure-only patch: %v", res.Errors())
+	}
+	restored, err := v.FromDescription(v.Make().Shape(map[string]v.Type{"ops": patch}).(*v.ValidationSchema).Describe())
+	if err != nil {
+		t.Fatalf("FromDescription: %v", err)
+	}
+	res = restored.Validate(map[string]any{"ops": []any{map[string]any{"op": "replace", "path": "/age", "value": json.Number("12")}}})
+	if !res.HasFieldErrors("ops[0].value") {
+		t.Errorf("restored patch should keep the target schema: %v", res.Errors())
+	}
+}
@@ -25,6 +25,7 @@
 package tests
 
 import (
+	"strings"
 	"testing"
 
 	validation "github.com/biyonik/go-fluent-validator"
@@ -425,6 +426,71 @@ func TestArrayType_TypeMismatch(t *testing.T) {
 	}
 }
 
+// TestArrayType_ElementResults tests per-index results for partially valid arrays
+func TestArrayType_ElementResults(t *testing.T) {
+	schema := validation.Make().Shape(map[string]validation.Type{
+		"batch": validation.String().Required(),
+		"users": validation.Array().Elements(
+			validation.Object().Shape(map[string]validation.Type{
+				"name":  validation.String().Required().Trim(),
+				"email": validation.String().Required().Email(),
+			}),
+		),
+	})
+
+	result := schema.Validate(map[string]any{
+		"users": []any{
+			map[string]any{"name": " John ", "email": "john@example.com"},
+			map[string]any{"name": "Jane", "email": "not-an-email"},
+			map[string]any{"email": "bob@example.com"},
+			map[string]any{"name": "Alice", "email": "alice@example.com"},
+		},
+	})
+
+	if !result.HasErrors() {
+		t.Fatal("expected errors for batch and invalid rows")
+	}
+
+	elements := result.Elements("users")
+	if len(elements) != 4 {
+		t.Fatalf("Elements() returned %d results, want 4", len(elements))
+	}
+
+	wantValid := []bool{true, false, false, true}
+	for i, want := range wantValid {
+		if got := elements[i].Valid(); got != want {
+			t.Errorf("element %d Valid() = %v, want %v (errors: %v)", i, got, want, elements[i].Errors)
+		}
+	}
+
+	if len(elements[1].Errors["users[1].email"]) == 0 {
+		t.Errorf("element 1 should report users[1].email, got %v", elements[1].Errors)
+	}
+	if len(elements[2].Errors["users[2].name"]) == 0 {
+		t.Errorf("element 2 should report users[2].name, got %v", elements[2].Errors)
+	}
+	if len(result.Errors()["batch"]) == 0 {
+		t.Error("unrelated field errors should still be reported")
+	}
+
+	row, ok := result.ValidDataAt("users", 0)
+	if !ok {
+		t.Fatal("ValidDataAt(users, 0) should be valid")
+	}
+	if name := row.(map[string]any)["name"]; name != "John" {
+		t.Errorf("ValidDataAt should return transformed value, got name %q", name)
+	}
+	if _, ok := result.ValidDataAt("users", 1); ok {
+		t.Error("ValidDataAt(users, 1) should be invalid")
+	}
+	if _, ok := result.ValidDataAt("users", 10); ok {
+		t.Error("ValidDataAt out of range should be invalid")
+	}
+	if valid := result.ValidElements("users"); len(valid) != 2 {
+		t.Errorf("ValidElements() returned %d rows, want 2", len(valid))
+	}
+}
+
 // -----------------------------------------------------------------------------
 // Benchmark Tests
 // -----------------------------------------------------------------------------
@@ -508,3 +574,92 @@ func BenchmarkArrayValidation_NestedObjects(b *testing.B) {
 		schema.Validate(data)
 	}
 }
+
+// TestArrayType_ElementsBy tests discriminated element schemas
+func TestArrayType_ElementsBy(t *testing.T) {
+	schema := validation.Make().Shape(map[string]validation.Type{
+		"blocks": validation.Array().ElementsBy("type", map[string]validation.Type{
+			"image": validation.Object().Shape(map[string]validation.Type{
+				"type": validation.String(),
+				"src":  validation.String().Required().URL(),
+			}),
+			"text": validation.Object().Shape(map[string]validation.Type{
+				"type": validation.String(),
+				"body": validation.String().Required().Trim(),
+			}),
+		}),
+	}).(*validation.ValidationSchema)
+
+	result := schema.Validate(map[string]any{
+		"blocks": []any{
+			map[string]any{"type": "text", "body": " Hello "},
+			map[string]any{"type": "image", "src": "not a url"},
+			map[string]any{"type": "video", "src": "https://example.com/v.mp4"},
+			map[string]any{"body": "untyped"},
+			"plain",
+		},
+	})
+
+	errs := result.Errors()
+	if len(errs["blocks[0].body"]) != 0 {
+		t.Errorf("valid text block reported errors: %v", errs["blocks[0].body"])
+	}
+	if len(errs["blocks[1].src"]) == 0 {
+		t.Error("image block should be validated with the image schema")
+	}
+	if msgs := errs["blocks[2].type"]; len(msgs) != 1 || !strings.Contains(msgs[0], "image and text") || !strings.Contains(msgs[0], "video") {
+		t.Errorf("unknown kind should list allowed kinds, got %v", msgs)
+	}
+	if len(errs["blocks[3].type"]) == 0 {
+		t.Error("missing discriminator should be reported")
+	}
+	if len(errs["blocks[4]"]) == 0 {
+		t.Error("non-object element should be reported")
+	}
+
+	if row, ok := result.ValidDataAt("blocks", 0); !ok || row.(map[string]any)["body"] != "Hello" {
+		t.Errorf("text block should be transformed by its variant, got %v", row)
+	}
+
+	desc := schema.Describe().Fields["blocks"].Elements
+	if desc == nil || desc.Discriminator != "type" || len(desc.Variants) != 2 || desc.Variants["image"].Fields["src"] == nil {
+		t.Errorf("unexpected elements description: %+v", desc)
+	}
+}
+
+// TestArrayType_NoOverlap tests range overlap detection between elements
+func TestArrayType_NoOverlap(t *testing.T) {
+	shift := func(start, end any) map[string]any { return map[string]any{"start": start, "end": end} }
+	schema := validation.Make().Shape(map[string]validation.Type{
+		"shifts": validation.Array().Label("Shift").NoOverlap("start", "end"),
+	})
+
+	tests := []struct {
+		name       string
+		shifts     []any
+		wantFields []string
+	}{
+		{"adjacent ranges", []any{shift("09:00", "12:00"), shift("12:00", "15:00")}, nil},
+		{"unsorted overlap", []any{shift("13:00", "17:00"), shift("08:00", "10:00"), shift("09:30", "11:00")}, []string{"shifts[2]"}},
+		{"nested range", []any{shift(0, 100), shift(10, 20), shift(50, 60)}, []string{"shifts[1]", "shifts[2]"}},
+		{"numeric tiers", []any{shift(0, 10), shift(10, 50), shift(50, 1000)}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := schema.Validate(map[string]any{"shifts": tt.shifts})
+			for _, field := range tt.wantFields {
+				if !result.HasFieldErrors(field) {
+					t.Errorf("expected error on %s, got %v", field, result.Errors())
+				}
+			}
+			if len(result.Errors()) != len(tt.wantFields) {
+				t.Errorf("errors = %v, want fields %v", result.Errors(), tt.wantFields)
+			}
+		})
+	}
+
+	result := schema.Validate(map[string]any{"shifts": []any{shift("08:00", "10:00"), shift("09:00", "11:00")}})
+	if msg := result.Errors()["shifts[1]"]; len(msg) != 1 || !strings.Contains(msg[0], "Shift[0]") {
+		t.Errorf("message should name the conflicting element, got %v", msg)
+	}
+}
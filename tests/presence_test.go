@@ -0,0 +1,156 @@
+// -----------------------------------------------------------------------------
+// Presence Tests
+// -----------------------------------------------------------------------------
+// Bu dosya, Optional() ve Nullable() işaretlerinin eksik anahtar ile açık null
+// değeri nasıl ayırdığını (PATCH semantiği) test eder.
+//
+// Metadata:
+// @author   Ahmet ALTUN
+// @github   github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email    ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+package tests
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	validation "github.com/biyonik/go-fluent-validator"
+)
+
+// patchSchema, PATCH isteklerinde kullanılan tipik bir şema döndürür.
+func patchSchema() *validation.ValidationSchema {
+	return validation.Make().Shape(map[string]validation.Type{
+		"name":     validation.String().Required().Min(2).Optional(),
+		"nickname": validation.String().Required().Min(2).Nullable(),
+		"address": validation.Object().Optional().Shape(map[string]validation.Type{
+			"city": validation.String().Required().Optional(),
+			"note": validation.String().Min(3).Nullable(),
+		}),
+	}).(*validation.ValidationSchema)
+}
+
+// TestOptional_AbsentKey tests that an absent optional key is valid and omitted.
+func TestOptional_AbsentKey(t *testing.T) {
+	res := patchSchema().Validate(map[string]any{"nickname": "bob"})
+	if res.HasErrors() {
+		t.Fatalf("expected no errors, got %v", res.Errors())
+	}
+	data := res.ValidData()
+	for _, field := range []string{"name", "address"} {
+		if _, ok := data[field]; ok {
+			t.Errorf("%s should be omitted from ValidData, got %v", field, data)
+		}
+	}
+
+	res = patchSchema().Validate(map[string]any{"name": nil, "nickname": "bob"})
+	if len(res.Errors()["name"]) == 0 {
+		t.Errorf("explicit null on an optional required field should fail, got %v", res.Errors())
+	}
+	res = patchSchema().Validate(map[string]any{"name": "x", "nickname": "bob"})
+	if len(res.Errors()["name"]) == 0 {
+		t.Error("a sent optional value should still be validated")
+	}
+}
+
+// TestNullable_ExplicitNull tests that an explicit null is valid and preserved.
+func TestNullable_ExplicitNull(t *testing.T) {
+	res := patchSchema().Validate(map[string]any{"nickname": nil})
+	if res.HasErrors() {
+		t.Fatalf("expected no errors, got %v", res.Errors())
+	}
+	value, ok := res.ValidData()["nickname"]
+	if !ok || value != nil {
+		t.Errorf("null should be preserved in ValidData, got %v", res.ValidData())
+	}
+
+	res = patchSchema().Validate(map[string]any{})
+	if len(res.Errors()["nickname"]) == 0 {
+		t.Errorf("absent key on a nullable required field should fail, got %v", res.Errors())
+	}
+}
+
+// TestOptionalNullable_Nested tests presence semantics inside objects.
+func TestOptionalNullable_Nested(t *testing.T) {
+	res := patchSchema().Validate(map[string]any{
+		"nickname": "bob",
+		"address":  map[string]any{"note": nil},
+	})
+	if res.HasErrors() {
+		t.Fatalf("expected no errors, got %v", res.Errors())
+	}
+	address, _ := res.ValidData()["address"].(map[string]any)
+	if want := map[string]any{"note": nil}; !reflect.DeepEqual(address, want) {
+		t.Errorf("address = %v, want %v", address, want)
+	}
+
+	res = patchSchema().Validate(map[string]any{
+		"nickname": "bob",
+		"address":  map[string]any{"city": nil, "note": "ab"},
+	})
+	for _, field := range []string{"address.city", "address.note"} {
+		if len(res.Errors()[field]) == 0 {
+			t.Errorf("expected an error for %s, got %v", field, res.Errors())
+		}
+	}
+}
+
+// TestOptionalNullable_JSONSchema tests the JSON Schema export and import.
+func TestOptionalNullable_JSONSchema(t *testing.T) {
+	raw, err := json.Marshal(patchSchema().ToJSONSchema())
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	var got struct {
+		Required   []string                  `json:"required"`
+		Properties map[string]map[string]any `json:"properties"`
+	}
+	if err := json.Unmarshal(raw, &got); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if want := []string{"nickname"}; !reflect.DeepEqual(got.Required, want) {
+		t.Errorf("required = %v, want %v", got.Required, want)
+	}
+	if want := []any{"string", "null"}; !reflect.DeepEqual(got.Properties["nickname"]["type"], want) {
+		t.Errorf("nickname type = %v, want %v", got.Properties["nickname"]["type"], want)
+	}
+
+	imported, err := validation.FromJSONSchema(raw)
+	if err != nil {
+		t.Fatalf("FromJSONSchema: %v", err)
+	}
+	if res := imported.Validate(map[string]any{"nickname": nil}); res.HasErrors() {
+		t.Errorf("imported schema should accept null, got %v", res.Errors())
+	}
+}
+
+// TestOptionalNullable_Session tests that sessions apply the same presence rules.
+func TestOptionalNullable_Session(t *testing.T) {
+	schema := patchSchema()
+	data := map[string]any{"nickname": nil}
+	if res := schema.Validate(data); res.HasErrors() {
+		t.Fatalf("Validate: expected no errors, got %v", res.Errors())
+	}
+
+	session := schema.Session(data)
+	res := session.Result()
+	if res.HasErrors() {
+		t.Fatalf("Session: expected no errors, got %v", res.Errors())
+	}
+	if _, ok := res.ValidData()["name"]; ok {
+		t.Errorf("absent optional field should be omitted, got %v", res.ValidData())
+	}
+	if value, ok := res.ValidData()["nickname"]; !ok || value != nil {
+		t.Errorf("null should be preserved, got %v", res.ValidData())
+	}
+
+	if res := session.Update("name", nil); len(res.Errors()["name"]) == 0 {
+		t.Errorf("explicit null on an optional required field should fail, got %v", res.Errors())
+	}
+	if res := schema.Session(map[string]any{}).Result(); len(res.Errors()["nickname"]) == 0 {
+		t.Errorf("absent key on a nullable required field should fail, got %v", res.Errors())
+	}
+}
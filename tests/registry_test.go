@@ -0,0 +1,311 @@
+// -----------------------------------------------------------------------------
+// Schema Registry & Hot Reload Tests
+// -----------------------------------------------------------------------------
+// Bu dosya, Registry'nin şema saklama davranışını ve WatchDir / WatchSource
+// ile şemaların çalışırken yeniden yüklenmesini test eder.
+//
+// Metadata:
+// @author   Ahmet ALTUN
+// @github   github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email    ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	validation "github.com/biyonik/go-fluent-validator"
+)
+
+// snapshotOf, verilen alanlarla kurulan şemanın anlık görüntüsünü döndürür.
+func snapshotOf(t *testing.T, shape map[string]validation.Type) []byte {
+	t.Helper()
+	schema := validation.Make()
+	schema.Shape(shape)
+	data, err := schema.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+// TestRegistry_WatchDir tests reloading schema files from a directory
+func TestRegistry_WatchDir(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name string, data []byte) {
+		if err := os.WriteFile(filepath.Join(dir, name), data, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("user.json", snapshotOf(t, map[string]validation.Type{"name": validation.String().Min(3)}))
+	write("notes.txt", []byte("ignored"))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	reloads := make(chan []string, 4)
+	failures := make(chan error, 4)
+	registry := validation.NewRegistry()
+	err := registry.WatchDir(ctx, dir,
+		validation.WithPollInterval(10*time.Millisecond),
+		validation.WithReloadHook(func(names []string) { reloads <- names }),
+		validation.WithReloadErrorHandler(func(err error) {
+			select {
+			case failures <- err:
+			default:
+			}
+		}),
+	)
+	if err != nil {
+		t.Fatalf("WatchDir failed: %v", err)
+	}
+	<-reloads
+
+	if names := registry.Names(); !reflect.DeepEqual(names, []string{"user"}) {
+		t.Fatalf("Names() = %v", names)
+	}
+	if res, ok := registry.Validate("user", map[string]any{"name": "Al"}); !ok || !res.HasErrors() {
+		t.Error("initial schema should require 3 characters")
+	}
+
+	write("user.json", snapshotOf(t, map[string]validation.Type{"name": validation.String().Min(2)}))
+	write("order.json", snapshotOf(t, map[string]validation.Type{"total": validation.Number().Positive()}))
+	select {
+	case names := <-reloads:
+		if !reflect.DeepEqual(names, []string{"order", "user"}) {
+			t.Errorf("reload hook names = %v", names)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("changes were not picked up")
+	}
+	if res, _ := registry.Validate("user", map[string]any{"name": "Al"}); res.HasErrors() {
+		t.Errorf("updated schema should accept 2 characters, got %v", res.Errors())
+	}
+
+	before, _ := registry.Get("user")
+	write("user.json", []byte(`{"version":1,"schema":{"fields":{"name":{"type":"vector"}}}}`))
+	select {
+	case err := <-failures:
+		if err == nil {
+			t.Error("expected a reload error")
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("broken schema should be reported")
+	}
+	if after, _ := registry.Get("user"); after != before {
+		t.Error("broken schema must not replace the last good one")
+	}
+
+	if err := os.Remove(filepath.Join(dir, "order.json")); err != nil {
+		t.Fatal(err)
+	}
+	write("user.json", snapshotOf(t, map[string]validation.Type{"name": validation.String()}))
+	select {
+	case names := <-reloads:
+		if !reflect.DeepEqual(names, []string{"order", "user"}) {
+			t.Errorf("reload hook names = %v", names)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("removal was not picked up")
+	}
+	if _, ok := registry.Get("order"); ok {
+		t.Error("removed file should unregister its schema")
+	}
+}
+
+// TestRegistry_WatchSource tests the initial load of a config service source
+func TestRegistry_WatchSource(t *testing.T) {
+	registry := validation.NewRegistry()
+	down := errors.New("config service unavailable")
+	err := registry.WatchSource(context.Background(), func(context.Context) (map[string][]byte, error) {
+		return nil, down
+	})
+	if !errors.Is(err, down) {
+		t.Errorf("initial load error should be returned, got %v", err)
+	}
+
+	registry.Register("manual", validation.Make())
+	registry.Unregister("manual")
+	if len(registry.Names()) != 0 {
+		t.Errorf("Unregister should remove the schema, got %v", registry.Names())
+	}
+}
+
+// TestRegistry_OpenAPI tests OpenAPI 3.1 component generation from registered schemas
+func TestRegistry_OpenAPI(t *testing.T) {
+	registry := validation.NewRegistry()
+	user := validation.Make()
+	user.Shape(map[string]validation.Type{
+		"email": validation.String().Required().Email().Label("E-mail address"),
+		"age":   validation.Number().Integer().Min(18).Label("Age").Describe("Age in years"),
+	})
+	tag := validation.Make()
+	tag.Shape(map[string]validation.Type{
+		"name": validation.String().Required().Regex(`^[a-z]+: yes$`),
+	})
+	registry.Register("CreateUser", user)
+	registry.Register("Tag", tag)
+
+	components := registry.OpenAPIComponents()
+	schemas, _ := components["schemas"].(map[string]any)
+	if len(schemas) != 2 {
+		t.Fatalf("schemas = %v", schemas)
+	}
+	userDoc, _ := schemas["CreateUser"].(map[string]any)
+	if _, ok := userDoc["$schema"]; ok {
+		t.Error("component schemas should not carry $schema")
+	}
+	props, _ := userDoc["properties"].(map[string]any)
+	email, _ := props["email"].(map[string]any)
+	age, _ := props["age"].(map[string]any)
+	if email["description"] != "E-mail address" || age["description"] != "Age in years" {
+		t.Errorf("descriptions = %v / %v", email["description"], age["description"])
+	}
+
+	raw, err := registry.OpenAPIJSON()
+	if err != nil {
+		t.Fatalf("OpenAPIJSON: %v", err)
+	}
+	var doc struct {
+		Components struct {
+			Schemas map[string]map[string]any `json:"schemas"`
+		} `json:"components"`
+	}
+	if err := json.Unmarshal(raw, &doc); err != nil || doc.Components.Schemas["Tag"]["type"] != "object" {
+		t.Errorf("OpenAPIJSON = %s (%v)", raw, err)
+	}
+
+	yaml, err := registry.OpenAPIYAML()
+	if err != nil {
+		t.Fatalf("OpenAPIYAML: %v", err)
+	}
+	want := `components:
+  schemas:
+    CreateUser:
+      properties:
+        age:
+          description: Age in years
+          minimum: 18
+          title: Age
+          type: integer
+        email:
+          description: E-mail address
+          format: email
+          title: E-mail address
+          type: string
+      required:
+        - email
+      type: object
+    Tag:
+      properties:
+        name:
+          pattern: "^[a-z]+: yes$"
+          type: string
+      required:
+        - name
+      type: object
+`
+	if string(yaml) != want {
+		t.Errorf("OpenAPIYAML =\n%s\nwant\n%s", yaml, want)
+	}
+}
+
+// TestRef tests validation through named schema references
+func TestRef(t *testing.T) {
+	address := validation.Make()
+	address.Shape(map[string]validation.Type{
+		"city": validation.String().Required().Min(2),
+		"zip":  validation.String().Regex(`^[0-9]{5}$`),
+	})
+	validation.Register("RefTestAddress", address)
+
+	order := validation.Make()
+	order.Shape(map[string]validation.Type{
+		"shipping": validation.Ref("RefTestAddress").Required(),
+		"stops":    validation.Array().Elements(validation.Ref("RefTestAddress")),
+		"gift":     validation.Ref("RefTestMissing"),
+	})
+
+	res := order.Validate(map[string]any{
+		"shipping": map[string]any{"city": "Izmir", "zip": "35000"},
+	})
+	if res.HasErrors() {
+		t.Fatalf("unexpected errors: %v", res.Errors())
+	}
+	if shipping, _ := res.ValidData()["shipping"].(map[string]any); shipping["zip"] != "35000" {
+		t.Errorf("referenced fields should be in valid data, got %v", res.ValidData())
+	}
+
+	res = order.Validate(map[string]any{
+		"stops": []any{map[string]any{"city": "X", "zip": "abc"}},
+		"gift":  map[string]any{},
+	})
+	for _, field := range []string{"shipping", "stops[0].city", "stops[0].zip", "gift"} {
+		if !res.HasFieldErrors(field) {
+			t.Errorf("expected error on %q, got %v", field, res.Errors())
+		}
+	}
+
+	desc := order.Describe()
+	if shipping := desc.Fields["shipping"]; shipping.Ref != "RefTestAddress" || shipping.Fields["city"] == nil {
+		t.Errorf("reference should be expanded in Describe: %+v", shipping)
+	}
+}
+
+// TestRef_Cycle tests self-referencing schemas and cycle detection
+func TestRef_Cycle(t *testing.T) {
+	category := validation.Make()
+	category.Shape(map[string]validation.Type{
+		"name":     validation.String().Required(),
+		"children": validation.Array().Elements(validation.Ref("RefTestCategory")),
+	})
+	validation.Register("RefTestCategory", category)
+
+	res := category.Validate(map[string]any{
+		"name": "root",
+		"children": []any{
+			map[string]any{"name": "child", "children": []any{map[string]any{}}},
+		},
+	})
+	if !res.HasFieldErrors("children[0].children[0].name") {
+		t.Errorf("nested reference should be validated, got %v", res.Errors())
+	}
+
+	desc := category.Describe()
+	inner := desc.Fields["children"].Elements.Fields["children"].Elements
+	if inner.Ref != "RefTestCategory" || inner.Fields != nil {
+		t.Errorf("cyclic reference should stop expanding: %+v", inner)
+	}
+
+	doc := category.ToJSONSchema()
+	defs, _ := doc["$defs"].(map[string]any)
+	if defs["RefTestCategory"] == nil {
+		t.Fatalf("cyclic reference should be exported to $defs: %v", doc)
+	}
+	body, _ := json.Marshal(doc)
+	if !json.Valid(body) || !strings.Contains(string(body), `"$ref":"#/$defs/RefTestCategory"`) {
+		t.Errorf("expected $ref in %s", body)
+	}
+
+	data, err := category.MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	restored := validation.Make()
+	if err := json.Unmarshal(data, restored); err != nil {
+		t.Fatalf("restore: %v", err)
+	}
+	if res := restored.Validate(map[string]any{"name": "root", "children": []any{map[string]any{}}}); !res.HasFieldErrors("children[0].name") {
+		t.Errorf("restored reference should validate, got %v", res.Errors())
+	}
+}
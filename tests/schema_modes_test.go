@@ -0,0 +1,299 @@
+// -----------------------------------------------------------------------------
+// Schema Mode Tests
+// -----------------------------------------------------------------------------
+// Bu dosya, ValidationSchema'nın standart Validate dışındaki çalışma modlarını
+// (yalnızca normalizasyon, tek alan doğrulama, kısmi başarı vb.) test eder.
+//
+// Metadata:
+// @author   Ahmet ALTUN
+// @github   github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email    ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+package tests
+
+import (
+	"reflect"
+	"testing"
+
+	validation "github.com/biyonik/go-fluent-validator"
+)
+
+// TestSchema_Sanitize tests that Sanitize only runs the transform chain
+func TestSchema_Sanitize(t *testing.T) {
+	schema := validation.Make().Shape(map[string]validation.Type{
+		"name":   validation.String().Required().Trim().Min(10),
+		"bio":    validation.String().StripTags(),
+		"status": validation.String().Default("draft"),
+		"birth":  validation.Date(),
+	}).(*validation.ValidationSchema)
+
+	cleaned, result := schema.Sanitize(map[string]any{
+		"name":  "  Jo  ",
+		"bio":   "<b>Hello</b>",
+		"birth": "not-a-date",
+	})
+
+	// Min(10) kuralı çalışmamalı, sadece tarih dönüşüm hatası beklenir
+	if _, ok := result.Errors()["name"]; ok {
+		t.Errorf("validation rules must not run: %v", result.Errors())
+	}
+	if _, ok := result.Errors()["birth"]; !ok {
+		t.Errorf("expected transform error for birth, got %v", result.Errors())
+	}
+
+	if cleaned["name"] != "Jo" {
+		t.Errorf("name: got %q, want %q", cleaned["name"], "Jo")
+	}
+	if cleaned["bio"] != "Hello" {
+		t.Errorf("bio: got %q, want %q", cleaned["bio"], "Hello")
+	}
+	if cleaned["status"] != "draft" {
+		t.Errorf("status: got %q, want default %q", cleaned["status"], "draft")
+	}
+	if _, ok := cleaned["birth"]; ok {
+		t.Error("field with transform error should be absent from cleaned data")
+	}
+}
+
+// TestSchema_ValidateField tests single and multi field validation without cross validators
+func TestSchema_ValidateField(t *testing.T) {
+	crossCalled := false
+	schema := validation.Make(validation.WithAutoTrim()).Shape(map[string]validation.Type{
+		"email":    validation.String().Required().Email(),
+		"username": validation.String().Required().Min(3),
+		"age":      validation.Number().Required().Min(18),
+	}).CrossValidate(func(data map[string]any) error {
+		crossCalled = true
+		return nil
+	}).(*validation.ValidationSchema)
+
+	t.Run("single valid field", func(t *testing.T) {
+		res := schema.ValidateField("email", "  user@example.com ")
+		if res.HasErrors() {
+			t.Fatalf("unexpected errors: %v", res.Errors())
+		}
+		if got := res.ValidData()["email"]; got != "user@example.com" {
+			t.Errorf("ValidData[email] = %v, want trimmed value", got)
+		}
+		if _, ok := res.ValidData()["age"]; ok {
+			t.Error("ValidData should only contain the validated field")
+		}
+	})
+
+	t.Run("single invalid field", func(t *testing.T) {
+		res := schema.ValidateField("email", "not-an-email")
+		if len(res.Errors()["email"]) == 0 {
+			t.Error("expected email error")
+		}
+		if len(res.Errors()) != 1 {
+			t.Errorf("errors should be scoped to email, got %v", res.Errors())
+		}
+	})
+
+	t.Run("multiple fields report independently", func(t *testing.T) {
+		res := schema.ValidateFields(map[string]any{
+			"email":    "bad",
+			"username": "ab",
+			"age":      10,
+		}, "email", "username")
+
+		if len(res.Errors()["email"]) == 0 || len(res.Errors()["username"]) == 0 {
+			t.Errorf("expected email and username errors, got %v", res.Errors())
+		}
+		if _, ok := res.Errors()["age"]; ok {
+			t.Error("age was not requested and should not be validated")
+		}
+	})
+
+	t.Run("unknown field is ignored", func(t *testing.T) {
+		if res := schema.ValidateField("nickname", "x"); res.HasErrors() {
+			t.Errorf("unexpected errors: %v", res.Errors())
+		}
+	})
+
+	if crossCalled {
+		t.Error("cross validators must not run in ValidateField")
+	}
+}
+
+// TestSchema_Session tests incremental revalidation with cached results
+func TestSchema_Session(t *testing.T) {
+	passwordChecks := 0
+	schema := validation.Make()
+	schema.Shape(map[string]validation.Type{
+		"email":            validation.String().Required().Email(),
+		"password":         validation.String().Required().Min(8),
+		"password_confirm": validation.String().Required(),
+		"account_type":     validation.String().OneOf([]string{"personal", "business"}),
+	})
+	schema.CrossValidateFields([]string{"password", "password_confirm"}, func(data map[string]any) error {
+		passwordChecks++
+		if data["password"] != data["password_confirm"] {
+			return validation.NewFieldError("password_confirm", "passwords do not match")
+		}
+		return nil
+	}).When("account_type", "business", func() validation.Schema {
+		return validation.Make().Shape(map[string]validation.Type{
+			"company": validation.String().Required(),
+		})
+	})
+
+	session := schema.Session(map[string]any{"account_type": "personal"})
+	if errs := session.Result().Errors(); len(errs["email"]) == 0 || len(errs["password"]) == 0 {
+		t.Fatalf("initial result should contain required errors, got %v", errs)
+	}
+
+	session.Update("email", "user@example.com")
+	if checks := passwordChecks; checks != 0 {
+		t.Errorf("password check should be gated while password is invalid, ran %d times", checks)
+	}
+	if errs := session.Result().Errors(); len(errs["email"]) != 0 {
+		t.Errorf("email error should be cleared, got %v", errs["email"])
+	}
+
+	session.Update("password", "secret123")
+	res := session.Update("password_confirm", "secret124")
+	if len(res.Errors()["password_confirm"]) == 0 {
+		t.Errorf("expected mismatch on password_confirm, got %v", res.Errors())
+	}
+
+	before := passwordChecks
+	session.Update("email", "other@example.com")
+	if passwordChecks != before {
+		t.Error("updating email must not rerun the password cross validator")
+	}
+
+	res = session.Update("account_type", "business")
+	if len(res.Errors()["company"]) == 0 {
+		t.Errorf("business account should require company, got %v", res.Errors())
+	}
+
+	session.Update("password_confirm", "secret123")
+	res = session.Update("company", "Acme")
+	if res.HasErrors() {
+		t.Fatalf("expected valid session, got %v", res.Errors())
+	}
+	if res.ValidData()["company"] != "Acme" || res.ValidData()["email"] != "other@example.com" {
+		t.Errorf("unexpected ValidData: %v", res.ValidData())
+	}
+
+	full := schema.Validate(session.Data())
+	if full.HasErrors() {
+		t.Errorf("session and Validate disagree: %v", full.Errors())
+	}
+}
+
+// TestSchema_Session_FullFallback tests that sessions on schemas with
+// compositions or strict mode agree with Validate
+func TestSchema_Session_FullFallback(t *testing.T) {
+	contact := validation.AnyOf(
+		validation.Make().Shape(map[string]validation.Type{"email": validation.String().Required().Email()}),
+		validation.Make().Shape(map[string]validation.Type{"phone": validation.String().Required()}),
+	)
+	contact.Shape(map[string]validation.Type{"name": validation.String().Required()})
+
+	session := contact.Session(map[string]any{"name": "Ada"})
+	if res := session.Result(); !res.HasErrors() || len(res.ValidData()) != 0 {
+		t.Errorf("session must not accept data that Validate rejects, got %v", res.ValidData())
+	}
+	res := session.Update("phone", "555")
+	if res.HasErrors() || res.ValidData()["name"] != "Ada" {
+		t.Errorf("expected valid session, got %v", res.Errors())
+	}
+
+	strict := validation.Make(validation.WithStrict()).Shape(map[string]validation.Type{
+		"name": validation.String().Required(),
+	}).(*validation.ValidationSchema)
+	data := map[string]any{"name": "Ada", "extra": 1}
+	got, want := strict.Session(data).Result().HasErrors(), strict.Validate(data).HasErrors()
+	if got != want || !want {
+		t.Errorf("strict session errors = %v, Validate errors = %v", got, want)
+	}
+}
+
+// TestSchema_Session_MatchesValidate tests that incremental session results apply locale and error limits
+func TestSchema_Session_MatchesValidate(t *testing.T) {
+	schema := validation.Make(validation.WithLocale("tr"), validation.WithMaxErrors(1)).Shape(map[string]validation.Type{
+		"name":  validation.String().Required(),
+		"email": validation.String().Required().Email(),
+	}).(*validation.ValidationSchema)
+	data := map[string]any{"email": "not-an-email"}
+
+	want := schema.Validate(data).Errors()
+	got := schema.Session(data).Result().Errors()
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Session().Result() = %v, Validate = %v", got, want)
+	}
+	if len(got) != 1 {
+		t.Errorf("WithMaxErrors(1) should apply to sessions, got %v", got)
+	}
+}
+
+// TestSchema_ValidateLenient tests partial ValidData on failing input
+func TestSchema_ValidateLenient(t *testing.T) {
+	schema := validation.Make().Shape(map[string]validation.Type{
+		"title": validation.String().Required().Trim(),
+		"email": validation.String().Email(),
+		"tags":  validation.Array().Elements(validation.String().Min(2)),
+		"address": validation.Object().Shape(map[string]validation.Type{
+			"city": validation.String().Required(),
+		}),
+	}).(*validation.ValidationSchema)
+
+	res := schema.ValidateLenient(map[string]any{
+		"title":   " Draft post ",
+		"email":   "not-an-email",
+		"tags":    []any{"go", "x"},
+		"address": map[string]any{},
+	})
+
+	if !res.HasErrors() {
+		t.Fatal("expected errors")
+	}
+
+	valid := res.ValidData()
+	if valid["title"] != "Draft post" {
+		t.Errorf("title should be kept and transformed, got %v", valid["title"])
+	}
+	for _, field := range []string{"email", "tags", "address"} {
+		if _, ok := valid[field]; ok {
+			t.Errorf("%s failed and should be absent from ValidData", field)
+		}
+	}
+
+	strict := schema.Validate(map[string]any{"title": "Draft post", "email": "bad"})
+	if len(strict.ValidData()) != 0 {
+		t.Error("Validate must keep all-or-nothing ValidData")
+	}
+}
+
+// TestResult_TransformedData tests that normalized input is exposed on failure
+func TestResult_TransformedData(t *testing.T) {
+	schema := validation.Make().Shape(map[string]validation.Type{
+		"name":   validation.String().Required().Trim().Min(10),
+		"status": validation.String().Default("draft"),
+	}).(*validation.ValidationSchema)
+
+	res := schema.Validate(map[string]any{"name": "  Bob  "})
+	if !res.HasErrors() {
+		t.Fatal("expected min length error")
+	}
+	if len(res.ValidData()) != 0 {
+		t.Error("ValidData must stay empty on failure")
+	}
+
+	transformed := res.TransformedData()
+	if transformed["name"] != "Bob" {
+		t.Errorf("TransformedData[name] = %q, want %q", transformed["name"], "Bob")
+	}
+	if transformed["status"] != "draft" {
+		t.Errorf("TransformedData[status] = %v, want default applied", transformed["status"])
+	}
+
+	scoped := schema.ValidateField("name", " Bob ")
+	if scoped.TransformedData()["name"] != "Bob" {
+		t.Errorf("ValidateField should expose transformed value, got %v", scoped.TransformedData())
+	}
+}
@@ -0,0 +1,369 @@
+// -----------------------------------------------------------------------------
+// Schema Option Tests
+// -----------------------------------------------------------------------------
+// Bu dosya, Make() fonksiyonuna verilen şema seçeneklerinin (WithAutoTrim vb.)
+// davranışını test eder.
+//
+// Metadata:
+// @author   Ahmet ALTUN
+// @github   github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email    ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+package tests
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	validation "github.com/biyonik/go-fluent-validator"
+	"github.com/biyonik/go-fluent-validator/core"
+	"github.com/biyonik/go-fluent-validator/types"
+)
+
+// TestSchema_AutoTrim tests that WithAutoTrim trims every string field
+func TestSchema_AutoTrim(t *testing.T) {
+	schema := validation.Make(validation.WithAutoTrim()).Shape(map[string]validation.Type{
+		"name":     validation.String().Required().Min(2),
+		"password": validation.String().Required().NoTrim(),
+		"address": validation.Object().Shape(map[string]validation.Type{
+			"city": validation.String().Required(),
+		}),
+		"tags": validation.Array().Elements(validation.String()),
+	})
+
+	input := map[string]any{
+		"name":     "  John  ",
+		"password": "  secret  ",
+		"address":  map[string]any{"city": "  Istanbul "},
+		"tags":     []any{" go ", "validation  "},
+	}
+
+	result := schema.Validate(input)
+	if result.HasErrors() {
+		t.Fatalf("unexpected errors: %v", result.Errors())
+	}
+
+	data := result.ValidData()
+	if data["name"] != "John" {
+		t.Errorf("name: got %q, want %q", data["name"], "John")
+	}
+	if data["password"] != "  secret  " {
+		t.Errorf("password should not be trimmed, got %q", data["password"])
+	}
+	if city := data["address"].(map[string]any)["city"]; city != "Istanbul" {
+		t.Errorf("address.city: got %q, want %q", city, "Istanbul")
+	}
+	if tags := data["tags"].([]any); tags[0] != "go" || tags[1] != "validation" {
+		t.Errorf("tags: got %v", tags)
+	}
+
+	// Girdi verisi değiştirilmemeli
+	if input["name"] != "  John  " {
+		t.Errorf("input data was mutated: %q", input["name"])
+	}
+}
+
+// TestSchema_AutoTrim_RequiredWhitespace tests that whitespace-only values fail required
+func TestSchema_AutoTrim_RequiredWhitespace(t *testing.T) {
+	schema := validation.Make(validation.WithAutoTrim()).Shape(map[string]validation.Type{
+		"name": validation.String().Required(),
+	})
+
+	result := schema.Validate(map[string]any{"name": "   "})
+	if !result.HasErrors() {
+		t.Error("expected required error for whitespace-only value")
+	}
+
+	// Seçenek verilmezse boşluklar korunur
+	plain := validation.Make().Shape(map[string]validation.Type{
+		"name": validation.String().Required(),
+	})
+	if plain.Validate(map[string]any{"name": "   "}).HasErrors() {
+		t.Error("without WithAutoTrim whitespace should be kept as-is")
+	}
+}
+
+// TestSchema_WithFeature tests feature-flagged rule groups
+func TestSchema_WithFeature(t *testing.T) {
+	schema := validation.Make().Shape(map[string]validation.Type{
+		"email": validation.String().Required().Email(),
+	}).(*validation.ValidationSchema).WithFeature("strict_kyc", func() validation.Schema {
+		return validation.Make().Shape(map[string]validation.Type{
+			"national_id": validation.String().Required().Trim(),
+		})
+	})
+
+	data := map[string]any{"email": "user@example.com"}
+
+	if res := schema.Validate(data); res.HasErrors() {
+		t.Errorf("feature groups must not run without context, got %v", res.Errors())
+	}
+
+	off := validation.ContextWithFeatures(context.Background(), validation.FeatureSet{"strict_kyc": false})
+	if res := schema.ValidateCtx(off, data); res.HasErrors() {
+		t.Errorf("disabled feature must not run, got %v", res.Errors())
+	}
+
+	on := validation.ContextWithFeatures(context.Background(), validation.FeatureFunc(func(feature string) bool {
+		return feature == "strict_kyc"
+	}))
+	res := schema.ValidateCtx(on, data)
+	if len(res.Errors()["national_id"]) == 0 {
+		t.Errorf("enabled feature should require national_id, got %v", res.Errors())
+	}
+
+	res = schema.ValidateCtx(on, map[string]any{"email": "user@example.com", "national_id": " 123 "})
+	if res.HasErrors() {
+		t.Fatalf("unexpected errors: %v", res.Errors())
+	}
+	if res.ValidData()["national_id"] != "123" {
+		t.Errorf("feature group ValidData should be merged, got %v", res.ValidData())
+	}
+
+	if desc := schema.Describe(); len(desc.Features) != 1 || desc.Features[0].Feature != "strict_kyc" {
+		t.Errorf("Describe should report feature groups, got %+v", desc.Features)
+	}
+}
+
+// TestSchema_SeverityPolicy tests warning/info fields and the severity policy hook
+func TestSchema_SeverityPolicy(t *testing.T) {
+	shape := func() map[string]validation.Type {
+		return map[string]validation.Type{
+			"email":    validation.String().Required().Email(),
+			"phone":    validation.String().Phone("tr").Severity(validation.SeverityWarning),
+			"nickname": validation.String().Min(3).Severity(validation.SeverityInfo),
+		}
+	}
+	data := map[string]any{"email": "user@example.com", "phone": "abc", "nickname": "x"}
+
+	lenient := validation.Make().Shape(shape()).(*validation.ValidationSchema)
+	res := lenient.Validate(data)
+	if res.HasErrors() {
+		t.Fatalf("warning/info fields must not block by default, got %v", res.Errors())
+	}
+	if len(res.Warnings()["phone"]) == 0 {
+		t.Errorf("expected phone warning, got %v", res.Warnings())
+	}
+	if len(res.Infos()["nickname"]) == 0 {
+		t.Errorf("expected nickname info, got %v", res.Infos())
+	}
+	if res.ValidData()["phone"] != "abc" {
+		t.Error("non-blocking fields should stay in ValidData")
+	}
+
+	strict := validation.Make(validation.WithSeverityPolicy(core.BlockAtOrAbove(validation.SeverityWarning))).Shape(shape())
+	res = strict.Validate(data)
+	if len(res.Errors()["phone"]) == 0 {
+		t.Errorf("strict policy should block warnings, got %v", res.Errors())
+	}
+	if _, ok := res.Errors()["nickname"]; ok {
+		t.Error("strict policy should still let info through")
+	}
+
+	if desc := lenient.Describe(); desc.Fields["phone"].Severity != "warning" || desc.Fields["email"].Severity != "" {
+		t.Errorf("Describe should expose non-default severity, got %+v", desc.Fields["phone"])
+	}
+}
+
+// TestSchema_PayloadGuards tests global string and payload size caps
+func TestSchema_PayloadGuards(t *testing.T) {
+	calls := 0
+	schema := validation.Make(validation.WithGlobalStringMax(20)).Shape(map[string]validation.Type{
+		"name": validation.String().Required().Custom(func(string) error {
+			calls++
+			return nil
+		}),
+		"tags":  validation.Array().Elements(validation.String()),
+		"title": validation.String().Required(),
+	})
+
+	res := schema.Validate(map[string]any{
+		"name":  strings.Repeat("a", 21),
+		"tags":  []any{"ok", strings.Repeat("b", 50)},
+		"title": "ğüşıöç ğüşıöç ğüşıöç", // 20 karakter, 26+ bayt
+	})
+	if calls != 0 {
+		t.Error("field rules should not run for oversized values")
+	}
+	if msgs := res.Errors()["name"]; len(msgs) != 1 {
+		t.Errorf("oversized name should report exactly one error, got %v", msgs)
+	}
+	if len(res.Errors()["tags[1]"]) == 0 {
+		t.Errorf("nested oversized string should be reported at its path, got %v", res.Errors())
+	}
+	if len(res.Errors()["title"]) != 0 {
+		t.Errorf("limit should count characters, not bytes: %v", res.Errors()["title"])
+	}
+
+	sized := validation.Make(validation.WithMaxTotalBytes(64)).Shape(map[string]validation.Type{
+		"bio": validation.String().Required(),
+	})
+	res = sized.Validate(map[string]any{"bio": strings.Repeat("x", 100)})
+	if len(res.Errors()["_payload"]) == 0 || len(res.Errors()["bio"]) != 0 {
+		t.Errorf("oversized payload should stop before field rules, got %v", res.Errors())
+	}
+	if res := sized.Validate(map[string]any{"bio": "short"}); res.HasErrors() {
+		t.Errorf("small payload should pass, got %v", res.Errors())
+	}
+}
+
+// TestSchema_Budget tests that WithBudget and ValidateCtx deadlines stop
+// long-running validation and report a _payload error
+func TestSchema_Budget(t *testing.T) {
+	items := make([]any, 1000)
+	for i := range items {
+		items[i] = "item"
+	}
+	calls := 0
+	schema := validation.Make(validation.WithBudget(5 * time.Millisecond)).Shape(map[string]validation.Type{
+		"items": validation.Array().Elements(validation.String().Custom(func(string) error {
+			calls++
+			time.Sleep(time.Millisecond)
+			return nil
+		})),
+	})
+
+	res := schema.Validate(map[string]any{"items": items})
+	if calls == 0 || calls == len(items) {
+		t.Errorf("element loop should stop part way, ran %d of %d", calls, len(items))
+	}
+	if len(res.Errors()["_payload"]) != 1 {
+		t.Errorf("expected a single deadline error, got %v", res.Errors())
+	}
+	if len(res.ValidData()) != 0 {
+		t.Error("interrupted validation should not produce valid data")
+	}
+	if n := len(res.Elements("items")); n == 0 || n >= len(items) {
+		t.Errorf("element results should cover only validated items, got %d", n)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	plain := validation.Make().Shape(map[string]validation.Type{
+		"name": validation.String().Required(),
+	}).(*validation.ValidationSchema)
+	if res := plain.ValidateCtx(ctx, map[string]any{"name": "ok"}); len(res.Errors()["_payload"]) != 1 {
+		t.Errorf("cancelled context should report a deadline error, got %v", res.Errors())
+	}
+	if res := plain.Validate(map[string]any{"name": "ok"}); res.HasErrors() {
+		t.Errorf("schema without budget should pass, got %v", res.Errors())
+	}
+}
+
+// TestSchema_Config tests global and per-schema configuration
+func TestSchema_Config(t *testing.T) {
+	shape := map[string]validation.Type{
+		"name":  validation.String().Required().Min(3),
+		"age":   validation.Number().Min(18),
+		"admin": validation.Boolean(),
+	}
+
+	strict := validation.MakeWith(validation.Config{Strict: true, Coerce: true, AutoTrim: true})
+	strict.Shape(shape)
+	res := strict.Validate(map[string]any{"name": "  Ada  ", "age": "42", "admin": "true", "role": "root"})
+	if msgs := res.Errors()["role"]; len(msgs) != 1 || res.Failures()[0].Rule != "unknown_field" {
+		t.Errorf("unknown field should be rejected, got %v", res.Errors())
+	}
+	if len(res.Errors()) != 1 {
+		t.Errorf("coerced and trimmed fields should pass, got %v", res.Errors())
+	}
+	res = strict.Validate(map[string]any{"name": "Ada", "age": "42", "admin": "1"})
+	if res.HasErrors() || res.ValidData()["admin"] != true {
+		t.Errorf("string values should be coerced, got %v %v", res.Errors(), res.ValidData())
+	}
+
+	variants := validation.MakeWith(validation.Config{Strict: true}, validation.WithMaxErrors(2))
+	variants.Shape(map[string]validation.Type{"kind": validation.String().Required()})
+	variants.When("kind", "card", func() validation.Schema {
+		return validation.MakeWith(validation.Config{Strict: true}).Shape(map[string]validation.Type{
+			"number": validation.String().Required(),
+		})
+	})
+	if res := variants.Validate(map[string]any{"kind": "card", "number": "4111"}); res.HasErrors() {
+		t.Errorf("branch fields should be known and nested schemas should not apply strict, got %v", res.Errors())
+	}
+	res = variants.Validate(map[string]any{"kind": "card", "a": 1, "b": 2, "c": 3})
+	if n := len(res.Failures()); n != 2 {
+		t.Errorf("MaxErrors should cap failures at 2, got %d: %v", n, res.Errors())
+	}
+
+	localized := validation.MakeWith(validation.Config{Locale: "tr"})
+	localized.Shape(map[string]validation.Type{"name": validation.String().Required()})
+	if got := localized.Validate(map[string]any{}).Errors()["name"][0]; !strings.Contains(got, "zorunlu") {
+		t.Errorf("schema locale should apply, got %q", got)
+	}
+
+	validation.SetDefaultConfig(validation.Config{MaxErrors: 1})
+	defer validation.SetDefaultConfig(validation.Config{})
+	global := validation.Make().Shape(shape)
+	if n := len(global.Validate(map[string]any{"age": 1}).Failures()); n != 1 {
+		t.Errorf("global MaxErrors should apply to Make, got %d", n)
+	}
+	own := validation.MakeWith(validation.Config{}).Shape(shape)
+	if n := len(own.Validate(map[string]any{"age": 1}).Failures()); n != 2 {
+		t.Errorf("MakeWith should not inherit the global config, got %d", n)
+	}
+}
+
+// TestTypeOptions tests functional options on type constructors
+func TestTypeOptions(t *testing.T) {
+	required := []validation.StringOption{validation.WithRequired(), validation.WithMax(5)}
+	schema := validation.Make(validation.WithStrict()).Shape(map[string]validation.Type{
+		"code":  validation.String(required...).Min(2),
+		"age":   validation.Number(validation.WithMin(18), validation.WithLabel("Yaş")),
+		"tags":  validation.Array(validation.WithMax(2), validation.WithElements(validation.String(validation.WithMin(2)))),
+		"flags": validation.Map(validation.WithValues(validation.Boolean()), validation.WithMin(1)),
+		"role":  validation.String(validation.WithDefault("member")),
+		"user":  validation.Object(validation.WithShape(map[string]validation.Type{"id": validation.Number(validation.WithRequired())})),
+	})
+
+	res := schema.Validate(map[string]any{
+		"code":  "abc",
+		"age":   20,
+		"tags":  []any{"go"},
+		"flags": map[string]any{"beta": true},
+		"user":  map[string]any{"id": 1},
+	})
+	if res.HasErrors() {
+		t.Fatalf("expected no errors, got %v", res.Errors())
+	}
+	if res.ValidData()["role"] != "member" {
+		t.Errorf("WithDefault should apply, got %v", res.ValidData()["role"])
+	}
+
+	res = schema.Validate(map[string]any{
+		"code":  "toolong",
+		"age":   12,
+		"tags":  []any{"go", "x", "rust"},
+		"flags": map[string]any{},
+		"user":  map[string]any{},
+		"extra": 1,
+	})
+	for _, field := range []string{"code", "age", "tags", "tags[1]", "flags", "user.id", "extra"} {
+		if _, ok := res.Errors()[field]; !ok {
+			t.Errorf("expected an error for %s, got %v", field, res.Errors())
+		}
+	}
+	if msg := res.Errors()["age"][0]; !strings.Contains(msg, "Yaş") {
+		t.Errorf("WithLabel should apply, got %q", msg)
+	}
+	if res := schema.Validate(map[string]any{"age": 20}); len(res.Errors()["code"]) == 0 {
+		t.Error("WithRequired should apply")
+	}
+	formats := validation.Make().Shape(map[string]validation.Type{
+		"id":    validation.Uuid(validation.WithRequired()),
+		"iban":  validation.Iban(validation.WithRequired()),
+		"card":  validation.CreditCard(validation.WithRequired()),
+		"email": validation.Email(validation.WithRequired(), types.RejectFreeProviders()),
+	})
+	res = formats.Validate(map[string]any{"email": "ali@gmail.com"})
+	for _, field := range []string{"id", "iban", "card", "email"} {
+		if len(res.Errors()[field]) == 0 {
+			t.Errorf("expected an error for %s, got %v", field, res.Errors())
+		}
+	}
+}
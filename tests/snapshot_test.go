@@ -0,0 +1,209 @@
+// -----------------------------------------------------------------------------
+// Schema Snapshot Tests
+// -----------------------------------------------------------------------------
+// Bu dosya, şemaların bildirimsel anlık görüntüsünün (MarshalJSON /
+// MarshalBinary) üretilmesini ve geri yüklenmesini test eder.
+//
+// Metadata:
+// @author   Ahmet ALTUN
+// @github   github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email    ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+package tests
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+
+	validation "github.com/biyonik/go-fluent-validator"
+	"github.com/biyonik/go-fluent-validator/types"
+)
+
+// snapshotSchema, anlık görüntü testlerinde kullanılan zengin bir şemadır.
+func snapshotSchema() *validation.ValidationSchema {
+	schema := validation.Make(validation.WithAutoTrim())
+	schema.Shape(map[string]validation.Type{
+		"email":    validation.String().Required().Email().Max(100).Label("E-mail").Describe("Login address"),
+		"name":     validation.String().PersonName().TitleCase(),
+		"password": validation.String().NoTrim().Password(types.WithMinLength(10)),
+		"slug":     validation.AdvancedString().CharSet("alphanumeric").SanitizeFilename(),
+		"status":   validation.String().OneOf([]string{"draft", "published"}).Transition(map[string][]string{"draft": {"published"}}),
+		"age":      validation.Number().Integer().Between(18, 120).Default(30),
+		"joined":   validation.Date().Format("2006-01-02").Min("2020-01-01").Immutable(),
+		"id":       validation.Uuid().Version(4),
+		"iban":     validation.Iban().Country("TR").Severity(validation.SeverityWarning),
+		"tags":     validation.Array().Max(3).Unique().Elements(validation.String().Min(2)),
+		"address": validation.Object().Shape(map[string]validation.Type{
+			"city": validation.String().Required(),
+		}),
+		"kind":         validation.String().Required(),
+		"confirmation": validation.String(),
+		"work_email":   validation.Email().DomainIn("acme.com").NotDomainIn("legacy.acme.com"),
+	})
+	schema.When("kind", "company", func() validation.Schema {
+		return validation.Make().Shape(map[string]validation.Type{
+			"tax_no": validation.String().Required().Numeric(),
+		})
+	})
+	schema.Same("confirmation", "password")
+	return schema
+}
+
+// TestSchema_Snapshot tests that declarative schemas survive a round trip
+func TestSchema_Snapshot(t *testing.T) {
+	original := snapshotSchema()
+
+	data, err := original.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary failed: %v", err)
+	}
+	again, _ := snapshotSchema().MarshalBinary()
+	if string(data) != string(again) {
+		t.Error("snapshots of identical schemas should be byte-identical")
+	}
+
+	restored := validation.Make()
+	if err := restored.UnmarshalBinary(data); err != nil {
+		t.Fatalf("UnmarshalBinary failed: %v", err)
+	}
+	if !reflect.DeepEqual(jsonOf(t, original.Describe()), jsonOf(t, restored.Describe())) {
+		t.Errorf("restored description differs:\nwant %s\ngot  %s", jsonOf(t, original.Describe()), jsonOf(t, restored.Describe()))
+	}
+
+	inputs := []map[string]any{
+		{"email": " a@b.co ", "name": " ada   lovelace ", "kind": "company", "tax_no": "12a", "age": 17, "tags": []any{"go", "go"}},
+		{"email": "x@y.io", "password": "Str0ng!Pass#2024", "confirmation": "other", "slug": "my file", "address": map[string]any{}},
+		{"email": "x@y.io", "status": "archived", "joined": "2019-05-01", "id": "not-a-uuid", "iban": "TR00", "work_email": "Jane@Legacy.ACME.com"},
+	}
+	for i, input := range inputs {
+		want, got := original.Validate(input), restored.Validate(input)
+		if !reflect.DeepEqual(want.Errors(), got.Errors()) || !reflect.DeepEqual(want.Warnings(), got.Warnings()) {
+			t.Errorf("input %d: errors differ\nwant %v\ngot  %v", i, want.Errors(), got.Errors())
+		}
+		if !reflect.DeepEqual(want.TransformedData(), got.TransformedData()) {
+			t.Errorf("input %d: transformed data differs\nwant %v\ngot  %v", i, want.TransformedData(), got.TransformedData())
+		}
+	}
+
+	viaJSON := validation.Make()
+	if err := json.Unmarshal(data, viaJSON); err != nil || len(viaJSON.GetShape()) != len(original.GetShape()) {
+		t.Errorf("json.Unmarshal should restore the schema, err=%v", err)
+	}
+}
+
+// TestSchema_SnapshotNotDeclarative tests that function-based rules are rejected
+func TestSchema_SnapshotNotDeclarative(t *testing.T) {
+	schemas := map[string]validation.Schema{
+		"custom": validation.Make().Shape(map[string]validation.Type{
+			"name": validation.String().Custom(func(string) error { return nil }),
+		}),
+		"cross": validation.Make().Shape(map[string]validation.Type{
+			"a": validation.String(),
+		}).CrossValidate(func(map[string]any) error { return nil }),
+		"bin": validation.Make().Shape(map[string]validation.Type{
+			"card": validation.CreditCard().BINLookup(func(string) (types.CardMeta, error) { return types.CardMeta{}, nil }),
+		}),
+		"payment": validation.CardPayment(),
+	}
+	for name, schema := range schemas {
+		if _, err := json.Marshal(schema); !errors.Is(err, validation.ErrNotDeclarative) {
+			t.Errorf("%s: expected ErrNotDeclarative, got %v", name, err)
+		}
+	}
+
+	if err := validation.Make().UnmarshalBinary([]byte(`{"version":99,"schema":{"fields":{}}}`)); err == nil {
+		t.Error("unknown snapshot versions should be rejected")
+	}
+	if err := validation.Make().UnmarshalBinary([]byte(`{"version":1,"schema":{"fields":{"x":{"type":"vector"}}}}`)); err == nil {
+		t.Error("unknown types should be rejected")
+	}
+}
+
+// jsonOf, karşılaştırma için değeri JSON string'ine çevirir.
+func jsonOf(t *testing.T, v any) string {
+	t.Helper()
+	out, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(out)
+}
+
+// TestFromConfig tests building schemas from YAML and JSON declarations
+func TestFromConfig(t *testing.T) {
+	yamlConfig := `
+# Kayıt formu
+email:
+  type: string
+  label: "E-mail"
+  rules: [required, email, "max:100"]
+age: {type: integer, rules: ["min:18"]}
+plan:
+  type: string
+  rules:
+    - one_of: [free, pro]
+address:
+  type: object
+  fields:
+    city: {type: string, rules: required}
+tags:
+  type: array
+  rules: [max: 2]
+  elements: {type: string, rules: ["min:2"]}
+`
+	jsonConfig := `{
+		"email": {"type": "string", "label": "E-mail", "rules": ["required", "email", "max:100"]},
+		"age": {"type": "integer", "rules": ["min:18"]},
+		"plan": {"type": "string", "rules": ["one_of:free,pro"]},
+		"address": {"type": "object", "fields": {"city": {"type": "string", "rules": "required"}}},
+		"tags": {"type": "array", "rules": ["max:2"], "elements": {"type": "string", "rules": ["min:2"]}}
+	}`
+
+	fromYAML, err := validation.FromConfig([]byte(yamlConfig))
+	if err != nil {
+		t.Fatalf("FromConfig(yaml) failed: %v", err)
+	}
+	fromJSON, err := validation.FromConfig([]byte(jsonConfig))
+	if err != nil {
+		t.Fatalf("FromConfig(json) failed: %v", err)
+	}
+	if jsonOf(t, fromYAML.Describe()) != jsonOf(t, fromJSON.Describe()) {
+		t.Errorf("YAML and JSON declarations should be equivalent:\nyaml %s\njson %s", jsonOf(t, fromYAML.Describe()), jsonOf(t, fromJSON.Describe()))
+	}
+
+	res := fromYAML.Validate(map[string]any{
+		"age":     17.5,
+		"plan":    "gold",
+		"address": map[string]any{},
+		"tags":    []any{"go", "x", "rust"},
+	})
+	for _, field := range []string{"email", "age", "plan", "address.city", "tags"} {
+		if !res.HasFieldErrors(field) {
+			t.Errorf("expected an error on %s, got %v", field, res.Errors())
+		}
+	}
+	if res := fromYAML.Validate(map[string]any{
+		"email": "ada@example.com", "age": 30, "plan": "pro",
+		"address": map[string]any{"city": "Ankara"}, "tags": []any{"go"},
+	}); res.HasErrors() {
+		t.Errorf("valid input rejected: %v", res.Errors())
+	}
+
+	invalid := map[string]string{
+		"unknown key":  "email: {type: string, min: 3}",
+		"missing type": "email: {rules: [required]}",
+		"unknown rule": "email: {type: string, rules: [shiny]}",
+		"unknown type": "email: vector",
+		"block scalar": "email:\n  type: |\n    string",
+		"bad indent":   "email:\n    type: string\n  label: x",
+	}
+	for name, config := range invalid {
+		if _, err := validation.FromConfig([]byte(config)); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
@@ -0,0 +1,88 @@
+// -----------------------------------------------------------------------------
+// Failure Stats Tests
+// -----------------------------------------------------------------------------
+// Bu dosya, alan/kural bazlı hata istatistiklerini, Failures() çıktısını ve
+// WithFailureHook kancasını test eder.
+//
+// Metadata:
+// @author   Ahmet ALTUN
+// @github   github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email    ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+package tests
+
+import (
+	"context"
+	"testing"
+
+	validation "github.com/biyonik/go-fluent-validator"
+)
+
+type clientKey struct{}
+
+// TestResult_Failures tests that errors carry the rule that produced them
+func TestResult_Failures(t *testing.T) {
+	schema := validation.Make().Shape(map[string]validation.Type{
+		"email": validation.String().Required().Email(),
+		"age":   validation.Number().Min(18),
+	})
+
+	failures := schema.Validate(map[string]any{"email": "bad", "age": 10}).Failures()
+	if len(failures) != 2 {
+		t.Fatalf("Failures() returned %d entries, want 2: %v", len(failures), failures)
+	}
+	if failures[0].Field != "age" || failures[0].Rule != "min" {
+		t.Errorf("unexpected first failure: %+v", failures[0])
+	}
+	if failures[1].Field != "email" || failures[1].Rule != "email" {
+		t.Errorf("unexpected second failure: %+v", failures[1])
+	}
+}
+
+// TestStats_Collector tests per field/rule counters and the failure hook
+func TestStats_Collector(t *testing.T) {
+	collector := validation.NewStatsCollector()
+	var hooked []string
+
+	schema := validation.Make(
+		validation.WithStats(collector),
+		validation.WithFailureHook(func(ctx context.Context, f validation.Failure) {
+			client, _ := ctx.Value(clientKey{}).(string)
+			hooked = append(hooked, client+":"+f.Field+":"+f.Rule)
+		}),
+	).Shape(map[string]validation.Type{
+		"email": validation.String().Required().Email(),
+		"items": validation.Array().Elements(validation.Object().Shape(map[string]validation.Type{
+			"sku": validation.String().Required(),
+		})),
+	}).(*validation.ValidationSchema)
+
+	schema.Validate(map[string]any{"email": "user@example.com"})
+	schema.Validate(map[string]any{"email": "bad"})
+	schema.Validate(map[string]any{"items": []any{map[string]any{}, map[string]any{}}})
+
+	ctx := context.WithValue(context.Background(), clientKey{}, "mobile")
+	schema.ValidateCtx(ctx, map[string]any{"email": "bad"})
+
+	snap := collector.Snapshot()
+	if snap.Validations != 4 || snap.Failed != 3 {
+		t.Errorf("got validations=%d failed=%d, want 4 and 3", snap.Validations, snap.Failed)
+	}
+	if snap.Rules["email"]["email"] != 2 || snap.Rules["email"]["required"] != 1 {
+		t.Errorf("unexpected email rule counts: %v", snap.Rules["email"])
+	}
+	if snap.Fields["items[].sku"] != 2 {
+		t.Errorf("array indexes should be normalized, got %v", snap.Fields)
+	}
+
+	if last := hooked[len(hooked)-1]; last != "mobile:email:email" {
+		t.Errorf("hook should receive context and failure, got %q", last)
+	}
+
+	collector.Reset()
+	if snap := collector.Snapshot(); snap.Validations != 0 || len(snap.Fields) != 0 {
+		t.Errorf("Reset should clear counters, got %+v", snap)
+	}
+}
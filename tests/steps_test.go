@@ -0,0 +1,87 @@
+// -----------------------------------------------------------------------------
+// Multi-Step (Wizard) Schema Tests
+// -----------------------------------------------------------------------------
+// Bu dosya, Steps(...) ile oluşturulan çok adımlı şemaların adım kapılamasını
+// (gating), ValidData birikimini ve son doğrulamayı test eder.
+//
+// Metadata:
+// @author   Ahmet ALTUN
+// @github   github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email    ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+package tests
+
+import (
+	"testing"
+
+	validation "github.com/biyonik/go-fluent-validator"
+	"github.com/biyonik/go-fluent-validator/i18n"
+)
+
+// TestSteps_ValidateStep tests step gating and accumulated ValidData
+func TestSteps_ValidateStep(t *testing.T) {
+	wizard := validation.Steps(
+		validation.Make().Shape(map[string]validation.Type{
+			"email": validation.String().Required().Email(),
+		}),
+		validation.Make().Shape(map[string]validation.Type{
+			"address": validation.String().Required().Trim(),
+		}),
+		validation.Make().Shape(map[string]validation.Type{
+			"card": validation.String().Required(),
+		}),
+	)
+
+	if wizard.StepCount() != 3 {
+		t.Fatalf("StepCount() = %d, want 3", wizard.StepCount())
+	}
+
+	tests := []struct {
+		name       string
+		step       int
+		data       map[string]any
+		wantFields []string
+	}{
+		{"first step valid", 1, map[string]any{"email": "user@example.com"}, nil},
+		{"second step requires first", 2, map[string]any{"address": "Main St"}, []string{"email"}},
+		{"second step valid", 2, map[string]any{"email": "user@example.com", "address": " Main St "}, nil},
+		{"final step missing card", 3, map[string]any{"email": "user@example.com", "address": "Main St"}, []string{"card"}},
+		{"invalid step", 4, map[string]any{}, []string{"_step"}},
+		{"zero step", 0, map[string]any{}, []string{"_step"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := wizard.ValidateStep(tt.step, tt.data)
+			if len(tt.wantFields) == 0 && res.HasErrors() {
+				t.Fatalf("unexpected errors: %v", res.Errors())
+			}
+			for _, field := range tt.wantFields {
+				if len(res.Errors()[field]) == 0 {
+					t.Errorf("expected error on %q, got %v", field, res.Errors())
+				}
+			}
+		})
+	}
+
+	res := wizard.ValidateStep(2, map[string]any{"email": "user@example.com", "address": " Main St "})
+	if res.ValidData()["email"] != "user@example.com" || res.ValidData()["address"] != "Main St" {
+		t.Errorf("ValidData should accumulate steps 1..2, got %v", res.ValidData())
+	}
+	if _, ok := res.ValidData()["card"]; ok {
+		t.Error("ValidData should not contain fields of later steps")
+	}
+
+	final := wizard.Validate(map[string]any{"email": "changed", "address": "Main St", "card": "4111"})
+	if len(final.Errors()["email"]) == 0 {
+		t.Errorf("final validation should re-check earlier steps, got %v", final.Errors())
+	}
+
+	res = wizard.ValidateStep(4, map[string]any{})
+	res.Localize("tr")
+	if got, want := res.Errors()["_step"], i18n.GetIn("tr", i18n.KeyInvalidStep, 4, 3); len(got) != 1 || got[0] != want {
+		t.Errorf("_step error should be localizable, got %v, want %q", got, want)
+	}
+}
@@ -29,12 +29,39 @@
 package tests
 
 import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/pem"
+	"errors"
 	"fmt"
+	"io"
+	"log"
+	"math"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"reflect"
+	"regexp"
+	"slices"
 	"strings"
 	"testing"
+	"time"
 
 	validation "github.com/biyonik/go-fluent-validator"
+	"github.com/biyonik/go-fluent-validator/httpvalidate"
 	"github.com/biyonik/go-fluent-validator/i18n"
+	"github.com/biyonik/go-fluent-validator/rules"
+	"github.com/biyonik/go-fluent-validator/rules/nationalid"
+	"github.com/biyonik/go-fluent-validator/types"
 )
 
 // TestStringType_Required tests required field validation
@@ -533,3 +560,1196 @@ func TestStringType_ChainedValidation(t *testing.T) {
 		})
 	}
 }
+
+// TestStringType_RegexLimits tests input caps and timeouts for custom patterns
+func TestStringType_RegexLimits(t *testing.T) {
+	schema := validation.Make().Shape(map[string]validation.Type{
+		"code":  validation.String().Regex(`^(a|b)*c$`),
+		"short": validation.String().Regex(`^[a-z]+$`).RegexOptions(rules.RegexOptions{MaxInputLength: 8}),
+	})
+
+	res := schema.Validate(map[string]any{"code": "ababc", "short": "abcdefgh"})
+	if res.HasErrors() {
+		t.Fatalf("inputs within limits should pass, got %v", res.Errors())
+	}
+
+	oversized := strings.Repeat("ab", rules.DefaultRegexMaxInputLength) + "c"
+	if res := schema.Validate(map[string]any{"code": oversized}); res.HasErrors() {
+		t.Errorf("regex input length should not be capped by default, got %v", res.Errors())
+	}
+
+	defer rules.SetRegexOptions(rules.GetRegexOptions())
+	rules.SetRegexOptions(rules.RegexOptions{MaxInputLength: rules.DefaultRegexMaxInputLength})
+	res = schema.Validate(map[string]any{
+		"code":  oversized,
+		"short": "abcdefghi",
+	})
+	if len(res.Errors()["code"]) != 1 || len(res.Errors()["short"]) != 1 {
+		t.Errorf("oversized inputs should be rejected before matching, got %v", res.Errors())
+	}
+	if failures := res.Failures(); len(failures) == 0 || failures[0].Rule != "max_length" {
+		t.Errorf("oversized input should be reported as max_length, got %+v", failures)
+	}
+
+	long := strings.Repeat("ab", 1<<20) + "c"
+	_, err := rules.MatchRegex(regexp.MustCompile(`^(a|b)*c$`), long, rules.RegexOptions{Timeout: time.Nanosecond})
+	if err != rules.ErrRegexTimeout {
+		t.Errorf("expected ErrRegexTimeout, got %v", err)
+	}
+	matched, err := rules.MatchRegex(regexp.MustCompile(`^(a|b)*c$`), long, rules.RegexOptions{Timeout: 5 * time.Second})
+	if err != nil || !matched {
+		t.Errorf("expected match within timeout, got %v, %v", matched, err)
+	}
+
+	rules.SetRegexOptions(rules.RegexOptions{MaxInputLength: 4})
+	if rules.ValidateCharSet("abcde", "alpha") {
+		t.Error("char set validation should honour the global input cap")
+	}
+}
+
+// TestStringType_UnicodeAlpha tests Unicode-aware letter validators
+func TestStringType_UnicodeAlpha(t *testing.T) {
+	tests := []struct {
+		name      string
+		typ       validation.Type
+		value     string
+		wantError bool
+	}{
+		{"ascii alpha rejects umlaut", validation.String().Alpha(), "Müller", true},
+		{"unicode alpha accepts umlaut", validation.String().AlphaUnicode(), "Müller", false},
+		{"unicode alpha accepts turkish", validation.String().AlphaUnicode(), "Çağlaİı", false},
+		{"unicode alpha accepts decomposed", validation.String().AlphaUnicode(), "Jose\u0301", false},
+		{"unicode alpha rejects digits", validation.String().AlphaUnicode(), "Müller2", true},
+		{"unicode alnum accepts digits", validation.String().AlphaNumericUnicode(), "Straße12", false},
+		{"unicode alnum rejects space", validation.String().AlphaNumericUnicode(), "Straße 12", true},
+		{"alpha space accepts names", validation.String().AlphaSpace(), "Ana María Ñúñez", false},
+		{"alpha space rejects trailing space", validation.String().AlphaSpace(), "Ana ", true},
+		{"alpha space rejects hyphen", validation.String().AlphaSpace(), "Jean-Luc", true},
+		{"charset unicode alpha", validation.AdvancedString().CharSet("unicode_alpha"), "Ålesund", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schema := validation.Make().Shape(map[string]validation.Type{"name": tt.typ})
+			result := schema.Validate(map[string]any{"name": tt.value})
+			if result.HasErrors() != tt.wantError {
+				t.Errorf("%q: got error = %v, want error = %v (%v)", tt.value, result.HasErrors(), tt.wantError, result.Errors())
+			}
+		})
+	}
+}
+
+// TestStringType_PersonName tests person name validation and normalization
+func TestStringType_PersonName(t *testing.T) {
+	valid := []string{"Ana María", "O'Neil", "D’Angelo", "Jean-Luc Picard", "Dr. Çağla Yılmaz", "J.R.R. Tolkien", "李小龍"}
+	invalid := []string{"R2-D2", "Ana_María", "-Ana", "John  <script>", "Anna--Lena", "'Ana"}
+
+	schema := validation.Make().Shape(map[string]validation.Type{
+		"name": validation.String().PersonName().Required(),
+	})
+	for _, name := range valid {
+		if res := schema.Validate(map[string]any{"name": name}); res.HasErrors() {
+			t.Errorf("%q should be valid, got %v", name, res.Errors())
+		}
+	}
+	for _, name := range invalid {
+		if res := schema.Validate(map[string]any{"name": name}); !res.HasErrors() {
+			t.Errorf("%q should be invalid", name)
+		}
+	}
+
+	normalized := validation.Make().Shape(map[string]validation.Type{
+		"name": validation.String().PersonName().TitleCase(),
+	})
+	res := normalized.Validate(map[string]any{"name": "  jean-luc   o'neil \t van der Berg "})
+	if got := res.ValidData()["name"]; got != "Jean-Luc O'Neil Van Der Berg" {
+		t.Errorf("normalized name = %q", got)
+	}
+
+	res = schema.Validate(map[string]any{"name": strings.Repeat("a", 101)})
+	if failures := res.Failures(); len(failures) != 1 || failures[0].Rule != "max_length" {
+		t.Errorf("default length bound should apply, got %+v", failures)
+	}
+	capped := validation.Make().Shape(map[string]validation.Type{
+		"name": validation.String().PersonName().Max(200),
+	})
+	if res := capped.Validate(map[string]any{"name": strings.Repeat("a", 150)}); res.HasErrors() {
+		t.Errorf("explicit Max should override the default bound, got %v", res.Errors())
+	}
+}
+
+// TestStringType_RRule tests RFC 5545 recurrence rule validation
+func TestStringType_RRule(t *testing.T) {
+	schema := validation.Make().Shape(map[string]validation.Type{
+		"recurrence": validation.String().RRule(),
+	})
+
+	valid := []string{
+		"FREQ=DAILY",
+		"RRULE:FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE,FR",
+		"FREQ=MONTHLY;BYDAY=-1FR;COUNT=12",
+		"FREQ=YEARLY;BYMONTH=1;BYMONTHDAY=1;UNTIL=20301231T235959Z",
+		"FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1;WKST=MO",
+	}
+	invalid := map[string]string{
+		"INTERVAL=2":                        "missing FREQ",
+		"FREQ=FORTNIGHTLY":                  "unknown FREQ",
+		"FREQ=DAILY;COUNT=5;UNTIL=20300101": "UNTIL with COUNT",
+		"FREQ=WEEKLY;BYDAY=1MO":             "ordinal BYDAY in WEEKLY",
+		"FREQ=WEEKLY;BYDAY=XX":              "unknown weekday",
+		"FREQ=MONTHLY;BYMONTHDAY=0":         "zero month day",
+		"FREQ=WEEKLY;BYMONTHDAY=15":         "BYMONTHDAY in WEEKLY",
+		"FREQ=MONTHLY;BYWEEKNO=20":          "BYWEEKNO outside YEARLY",
+		"FREQ=DAILY;INTERVAL=0":             "zero interval",
+		"FREQ=DAILY;BYSETPOS=1":             "BYSETPOS alone",
+		"FREQ=DAILY;FREQ=WEEKLY":            "duplicate part",
+		"FREQ=DAILY;UNTIL=2030-01-01":       "malformed UNTIL",
+		"FREQ=DAILY;COLOR=RED":              "unknown part",
+	}
+
+	for _, rule := range valid {
+		if res := schema.Validate(map[string]any{"recurrence": rule}); res.HasErrors() {
+			t.Errorf("%q should be valid, got %v", rule, res.Errors())
+		}
+	}
+	for rule, reason := range invalid {
+		res := schema.Validate(map[string]any{"recurrence": rule})
+		if failures := res.Failures(); len(failures) != 1 || failures[0].Rule != "rrule" {
+			t.Errorf("%q should fail (%s), got %+v", rule, reason, failures)
+		}
+	}
+
+	if err := rules.ValidateRRule("FREQ=DAILY;COUNT=5;UNTIL=20300101"); err == nil || !strings.Contains(err.Error(), "UNTIL") {
+		t.Errorf("ValidateRRule should explain the violation, got %v", err)
+	}
+}
+
+// TestStringType_StructuredIdentifiers tests E164, DID, URN and OTPCode validators
+func TestStringType_StructuredIdentifiers(t *testing.T) {
+	schema := validation.Make().Shape(map[string]validation.Type{
+		"phone": validation.String().E164(),
+		"did":   validation.String().DID(),
+		"urn":   validation.String().URN(),
+		"otp":   validation.String().OTPCode(6),
+	})
+
+	valid := map[string][]string{
+		"phone": {"+905551112233", "+14155552671"},
+		"did":   {"did:web:example.com", "did:key:z6MkhaXgBZDvotDkL5257faiztiGiC2QtKLGpbnnEGta2doK", "did:example:123:456", "did:example:abc%20def"},
+		"urn":   {"urn:isbn:0451450523", "urn:ietf:rfc:2648", "URN:uuid:6e8bc430-9c3a-11d9-9669-0800200c9a66", "urn:example:a123,z456?+abc#frag"},
+		"otp":   {"123456", "000000"},
+	}
+	invalid := map[string][]string{
+		"phone": {"05551112233", "+0123456", "+1234567890123456", "+90 555 111 22 33"},
+		"did":   {"did:Web:example.com", "did:web:", "did:web:example.com:", "did:web:ex ample", "web:example.com"},
+		"urn":   {"urn:urn:foo", "urn:-isbn:123", "urn:isbn:", "urn:isbn:a b", "isbn:0451450523"},
+		"otp":   {"12345", "1234567", "12a456"},
+	}
+
+	for field, values := range valid {
+		for _, value := range values {
+			if res := schema.Validate(map[string]any{field: value}); res.HasErrors() {
+				t.Errorf("%s %q should be valid, got %v", field, value, res.Errors())
+			}
+		}
+	}
+	for field, values := range invalid {
+		for _, value := range values {
+			if res := schema.Validate(map[string]any{field: value}); len(res.Errors()[field]) == 0 {
+				t.Errorf("%s %q should be invalid", field, value)
+			}
+		}
+	}
+
+	res := schema.Validate(map[string]any{"otp": "12"})
+	if msgs := res.Errors()["otp"]; len(msgs) != 1 || msgs[0] != "otp must be a 6-digit code" {
+		t.Errorf("unexpected OTP message: %v", msgs)
+	}
+}
+
+// TestStringType_HeaderSafety tests HeaderValue and ContentDispositionFilename
+func TestStringType_HeaderSafety(t *testing.T) {
+	schema := validation.Make().Shape(map[string]validation.Type{
+		"value":    validation.String().HeaderValue(),
+		"filename": validation.String().ContentDispositionFilename(),
+	})
+
+	for _, value := range []string{"en-US", "max-age=3600, public", "text/html;\tcharset=utf-8"} {
+		if res := schema.Validate(map[string]any{"value": value}); res.HasErrors() {
+			t.Errorf("%q should be a safe header value, got %v", value, res.Errors())
+		}
+	}
+	for _, value := range []string{"ok\r\nSet-Cookie: session=x", "a\nb", "nul\x00", "çay"} {
+		res := schema.Validate(map[string]any{"value": value})
+		if failures := res.Failures(); len(failures) != 1 || failures[0].Rule != "header_value" {
+			t.Errorf("%q should be rejected, got %+v", value, failures)
+		}
+	}
+
+	for _, name := range []string{"report.pdf", "Q3 results (final).xlsx"} {
+		if res := schema.Validate(map[string]any{"filename": name}); res.HasErrors() {
+			t.Errorf("%q should be a safe filename, got %v", name, res.Errors())
+		}
+	}
+	for _, name := range []string{`a".pdf`, "../etc/passwd", `dir\file`, "a;b.txt", "%2e%2e", "..", " lead.txt", "rapor.pdf\r\n", "şube.pdf", strings.Repeat("a", 256)} {
+		if res := schema.Validate(map[string]any{"filename": name}); len(res.Errors()["filename"]) == 0 {
+			t.Errorf("%q should be rejected", name)
+		}
+	}
+}
+
+// TestStringType_Honeypot tests that bot trap fields must stay empty
+func TestStringType_Honeypot(t *testing.T) {
+	schema := validation.Make().Shape(map[string]validation.Type{
+		"email":   validation.String().Email(),
+		"website": validation.String().Honeypot(),
+	})
+
+	for _, value := range []any{nil, ""} {
+		if res := schema.Validate(map[string]any{"website": value}); res.HasErrors() {
+			t.Errorf("empty honeypot %v should pass, got %v", value, res.Errors())
+		}
+	}
+	for _, value := range []any{"http://spam.example", " ", 1} {
+		res := schema.Validate(map[string]any{"website": value, "email": "bad"})
+		if got := res.Errors()["website"]; len(got) != 1 || got[0] != "website must be left empty" {
+			t.Errorf("filled honeypot %v should fail, got %v", value, res.Errors())
+		}
+		if _, leaked := res.OldInput()["website"]; leaked {
+			t.Error("honeypot values must not be kept as old input")
+		}
+	}
+}
+
+// TestStringType_TOTP tests time-based one-time password verification
+func TestStringType_TOTP(t *testing.T) {
+	const secret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ" // RFC 6238 "12345678901234567890"
+	key, err := rules.DecodeTOTPSecret(strings.ToLower(secret))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// RFC 6238 Ek B test vektörleri (SHA1)
+	for unix, want := range map[int64]string{59: "94287082", 1111111109: "07081804", 2000000000: "69279037"} {
+		if got := rules.GenerateTOTP(key, time.Unix(unix, 0), 8); got != want {
+			t.Errorf("GenerateTOTP(%d) = %s, want %s", unix, got, want)
+		}
+	}
+	if !rules.VerifyTOTP(secret, "287082", time.Unix(59, 0), 0) || !rules.VerifyTOTP(secret, "287082", time.Unix(75, 0), 1) {
+		t.Error("6-digit code should verify within skew")
+	}
+	if rules.VerifyTOTP(secret, "287082", time.Unix(125, 0), 1) {
+		t.Error("codes outside the skew window should be rejected")
+	}
+
+	var provided string
+	schema := validation.Make().Shape(map[string]validation.Type{
+		"code": validation.String().Required().TOTP(func(ctx context.Context) (string, error) {
+			if provided == "" {
+				return "", errors.New("no secret")
+			}
+			return provided, nil
+		}, 1),
+		"backup": validation.String().OTPFormat(8),
+	}).(*validation.ValidationSchema)
+	provided = secret
+	current := rules.GenerateTOTP(key, time.Now(), 6)
+	if res := schema.ValidateCtx(context.Background(), map[string]any{"code": current, "backup": "12345678"}); res.HasErrors() {
+		t.Errorf("current code should pass, got %v", res.Errors())
+	}
+
+	wrong := "000000"
+	if wrong == current {
+		wrong = "111111"
+	}
+	for _, code := range []string{wrong, "12345", "abcdef"} {
+		res := schema.ValidateCtx(context.Background(), map[string]any{"code": code})
+		if got := res.Errors()["code"]; len(got) != 1 || got[0] != "code is not a valid authentication code" {
+			t.Errorf("code %q should fail, got %v", code, res.Errors())
+		}
+	}
+	provided = ""
+	if !schema.Validate(map[string]any{"code": current}).HasErrors() {
+		t.Error("secret provider errors should reject the code")
+	}
+	if res := schema.Validate(map[string]any{"backup": "1234"}); !res.HasFieldErrors("backup") {
+		t.Error("OTPFormat should check the code length")
+	}
+}
+
+// TestStringType_PasswordPresets tests named password policies and org-wide defaults
+func TestStringType_PasswordPresets(t *testing.T) {
+	check := func(name string, typ validation.Type, password string, wantError bool) {
+		t.Helper()
+		res := validation.Make().Shape(map[string]validation.Type{"password": typ}).Validate(map[string]any{"password": password})
+		if res.HasErrors() != wantError {
+			t.Errorf("%s %q: errors = %v, want error = %v", name, password, res.Errors(), wantError)
+		}
+	}
+
+	cases := []struct {
+		name     string
+		policy   rules.PasswordRules
+		password string
+		wantErr  bool
+	}{
+		{"nist", rules.PasswordNIST(), "correct horse battery", false},
+		{"nist", rules.PasswordNIST(), "short", true},
+		{"nist", rules.PasswordNIST(), "qwertyuiop", true},
+		{"owasp", rules.PasswordOWASP(), "plain lowercase phrase", false},
+		{"owasp", rules.PasswordOWASP(), "elevenchars", true},
+		{"pci", rules.PasswordPCI(), "bluewhale2024", false},
+		{"pci", rules.PasswordPCI(), "123456789012", true},
+		{"pci", rules.PasswordPCI(), "nodigitsatall", true},
+	}
+	for _, tc := range cases {
+		check(tc.name, validation.String().Password(types.WithPasswordRules(tc.policy)), tc.password, tc.wantErr)
+	}
+	check("owasp+override", validation.String().Password(types.WithPasswordRules(rules.PasswordOWASP()), types.WithMinLength(30)), "plain lowercase phrase", true)
+
+	validation.SetDefaultPasswordRules(rules.PasswordOWASP())
+	defer validation.SetDefaultPasswordRules(rules.DefaultPasswordRules())
+	check("default owasp", validation.String().Password(), "plain lowercase phrase", false)
+	check("default owasp", validation.String().Password(), "MyP@ss1", true)
+}
+
+func TestStringType_PasswordSimilarity(t *testing.T) {
+	used := map[string]bool{"correct horse battery": true}
+	schema := validation.Make().Shape(map[string]validation.Type{
+		"username": validation.String().Required(),
+		"email":    validation.String().Required(),
+		"password": validation.String().Password(
+			types.WithPasswordRules(rules.PasswordNIST()),
+			types.WithNotSimilarTo("username", "email"),
+			types.WithHistoryChecker(func(ctx context.Context, pw string) (bool, error) {
+				return used[pw], nil
+			}),
+		),
+	}).(*validation.ValidationSchema)
+
+	cases := []struct {
+		password string
+		wantErr  bool
+	}{
+		{"purple elephant tower", false},
+		{"jdoe1985secret", true},        // kullanıcı adını içeriyor
+		{"johnsmith!!", true},           // e-postanın yerel kısmına çok benziyor
+		{"correct horse battery", true}, // daha önce kullanılmış
+	}
+	for _, tc := range cases {
+		res := schema.ValidateCtx(context.Background(), map[string]any{
+			"username": "jdoe1985",
+			"email":    "john.smith@example.com",
+			"password": tc.password,
+		})
+		if res.HasFieldErrors("password") != tc.wantErr {
+			t.Errorf("password %q: errors = %v, want error = %v", tc.password, res.Errors(), tc.wantErr)
+		}
+	}
+
+	if !rules.IsSimilarPassword("Jd0e1985", "jdoe1985") || rules.IsSimilarPassword("purple elephant", "jdoe") {
+		t.Error("IsSimilarPassword returned unexpected result")
+	}
+
+	failing := validation.String().Password(types.WithHistoryChecker(func(context.Context, string) (bool, error) {
+		return false, errors.New("db down")
+	}))
+	res := validation.Make().Shape(map[string]validation.Type{"password": failing}).(*validation.ValidationSchema).
+		ValidateCtx(context.Background(), map[string]any{"password": "MyStr0ng!Pass#42"})
+	if !res.HasFieldErrors("password") {
+		t.Error("history checker error should reject the password")
+	}
+
+	desc := validation.String().Password(types.WithNotSimilarTo("username")).Introspect()
+	if rule := desc.Rule("password"); rule == nil || rule.Params["not_similar_to"] == nil {
+		t.Errorf("not_similar_to missing from description: %+v", desc.Rules)
+	}
+}
+
+func TestPasswordEntropy_Unicode(t *testing.T) {
+	approx := func(name string, got, want float64) {
+		t.Helper()
+		if math.Abs(got-want) > 0.01 {
+			t.Errorf("%s: entropy = %.2f, want %.2f", name, got, want)
+		}
+	}
+
+	// ASCII şifreler önceki hesapla aynı kalmalı: 9 × log2(26+26+10+32)
+	approx("ascii", rules.PasswordEntropy("Passw0rd!"), 9*math.Log2(94))
+	// Bayt değil karakter sayılır: 6 × log2(33)
+	approx("cyrillic", rules.PasswordEntropy("пароль"), 6*math.Log2(33))
+	approx("cyrillic mixed", rules.PasswordEntropy("Пароль2024!"), 11*math.Log2(33+33+10+32))
+	approx("emoji", rules.PasswordEntropy("😀🐱🚀🎉"), 4*math.Log2(100))
+	// ZWJ ve varyasyon seçicileri uzunluğa eklenmez
+	approx("zwj sequence", rules.PasswordEntropy("👨‍👩‍👧"), 3*math.Log2(100))
+	approx("empty", rules.PasswordEntropy(""), 0)
+
+	policy := rules.PasswordNIST()
+	policy.MinLength = 1
+	policy.MinEntropy = 40
+	schema := validation.Make().Shape(map[string]validation.Type{
+		"password": validation.String().Password(types.WithPasswordRules(policy)),
+	})
+	if res := schema.Validate(map[string]any{"password": "😀😀😀😀"}); !res.HasErrors() {
+		t.Error("4 emoji should not reach 40 bits of entropy")
+	}
+	if res := schema.Validate(map[string]any{"password": "Сложный-Пароль-7"}); res.HasErrors() {
+		t.Errorf("long Cyrillic passphrase rejected: %v", res.Errors())
+	}
+}
+
+func TestStringType_PasswordByteLimit(t *testing.T) {
+	validate := func(typ validation.Type, password string) *validation.ValidationResult {
+		return validation.Make().Shape(map[string]validation.Type{"password": typ}).Validate(map[string]any{"password": password})
+	}
+	phrase := strings.Repeat("пароль ", 6) // 42 karakter, 78 bayt
+
+	// Uzunluk karakter olarak ölçülür; bayt sınırı yoksa yalnızca uyarı verilir.
+	res := validate(validation.String().Password(types.WithPasswordRules(rules.PasswordNIST())), phrase)
+	if res.HasErrors() {
+		t.Fatalf("42-character passphrase rejected: %v", res.Errors())
+	}
+	if len(res.Warnings()["password"]) != 1 {
+		t.Errorf("expected bcrypt truncation warning, got %v", res.Warnings())
+	}
+
+	res = validate(validation.String().Password(types.WithPasswordRules(rules.PasswordNIST()), types.WithMaxBytes(rules.BcryptMaxBytes)), phrase)
+	if !res.HasFieldErrors("password") || res.HasWarnings() {
+		t.Errorf("MaxBytes(72) should reject a 78-byte password without warning: errors=%v warnings=%v", res.Errors(), res.Warnings())
+	}
+
+	// Varsayılan politika 72 baytı zorunlu kılar; kısa çok baytlı şifreler karakter sayısıyla ölçülür.
+	if res := validate(validation.String().Password(), "Çğüşa1!X"); res.HasErrors() {
+		t.Errorf("8-character password rejected by default rules: %v", res.Errors())
+	}
+	if res := validate(validation.String().Password(), "Çğüş1!"); !res.HasErrors() {
+		t.Error("6-character password should fail MinLength(8) even though it is 10 bytes")
+	}
+}
+
+func TestStringType_CSVOf(t *testing.T) {
+	schema := validation.Make().Shape(map[string]validation.Type{
+		"ids": validation.String().Required().Max(3).CSVOf(validation.Number().Integer().Positive()),
+	}).(*validation.ValidationSchema)
+
+	res := schema.Validate(map[string]any{"ids": "4, 8,15"})
+	if res.HasErrors() {
+		t.Fatalf("unexpected errors: %v", res.Errors())
+	}
+	if got, want := res.ValidData()["ids"], []any{4.0, 8.0, 15.0}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ValidData ids = %#v, want %#v", got, want)
+	}
+
+	res = schema.Validate(map[string]any{"ids": "1,x,-2"})
+	if !res.HasFieldErrors("ids[1]") || !res.HasFieldErrors("ids[2]") || res.HasFieldErrors("ids[0]") {
+		t.Errorf("expected element errors on ids[1] and ids[2], got %v", res.Errors())
+	}
+
+	if res := schema.Validate(map[string]any{"ids": "1,2,3,4"}); !res.HasFieldErrors("ids") {
+		t.Error("Max should limit the number of elements")
+	}
+	if res := schema.Validate(map[string]any{"ids": ""}); !res.HasFieldErrors("ids") {
+		t.Error("empty required list should fail")
+	}
+
+	restored, err := validation.FromDescription(schema.Describe())
+	if err != nil {
+		t.Fatalf("FromDescription failed: %v", err)
+	}
+	if res := restored.Validate(map[string]any{"ids": "1,x"}); !res.HasFieldErrors("ids[1]") {
+		t.Errorf("restored schema should validate elements, got %v", res.Errors())
+	}
+}
+
+func TestChecksumAlgorithms(t *testing.T) {
+	tests := []struct {
+		algorithm string
+		value     string
+		want      bool
+	}{
+		{"luhn", "79927398713", true},
+		{"luhn", "7992 7398 714", false},
+		{"verhoeff", "2363", true},
+		{"verhoeff", "2364", false},
+		{"verhoeff", "2336", false},
+		{"damm", "5724", true},
+		{"damm", "5742", false},
+		{"mod97", "WEST12345698765432GB82", true},
+		{"MOD97", "WEST12345698765432GB83", false},
+		{"damm", "57a4", false},
+		{"luhn", "", false},
+	}
+
+	for _, tt := range tests {
+		verify, err := rules.Checksum(tt.algorithm)
+		if err != nil {
+			t.Fatalf("Checksum(%q): %v", tt.algorithm, err)
+		}
+		if got := verify(tt.value); got != tt.want {
+			t.Errorf("%s(%q) = %v, want %v", tt.algorithm, tt.value, got, tt.want)
+		}
+	}
+
+	if _, err := rules.Checksum("crc32"); !errors.Is(err, rules.ErrUnknownChecksum) {
+		t.Errorf("unknown algorithm error = %v, want ErrUnknownChecksum", err)
+	}
+}
+
+func TestStringType_Checksum(t *testing.T) {
+	schema := validation.Make().Shape(map[string]validation.Type{
+		"code": validation.String().Required().Checksum("verhoeff"),
+	}).(*validation.ValidationSchema)
+	if res := schema.Validate(map[string]any{"code": "2363"}); res.HasErrors() {
+		t.Errorf("unexpected errors: %v", res.Errors())
+	}
+	if res := schema.Validate(map[string]any{"code": "2364"}); !res.HasFieldErrors("code") {
+		t.Error("invalid check digit should fail")
+	}
+
+	rules.RegisterChecksum("even", func(v string) bool { return len(v)%2 == 0 })
+	if res := validation.Make().Shape(map[string]validation.Type{
+		"code": validation.String().Checksum("even"),
+	}).Validate(map[string]any{"code": "abc"}); !res.HasFieldErrors("code") {
+		t.Error("registered checksum should be used")
+	}
+
+	bad := validation.Make().Shape(map[string]validation.Type{
+		"code": validation.String().Checksum("nope"),
+	})
+	if res := bad.Validate(map[string]any{"code": "1"}); !res.HasFieldErrors("code") {
+		t.Error("unknown algorithm should be reported")
+	}
+
+	restored, err := validation.FromDescription(schema.Describe())
+	if err != nil {
+		t.Fatalf("FromDescription: %v", err)
+	}
+	if res := restored.Validate(map[string]any{"code": "2364"}); !res.HasFieldErrors("code") {
+		t.Error("restored schema should keep the checksum rule")
+	}
+}
+
+func TestNationalIDValidators(t *testing.T) {
+	tests := []struct {
+		country string
+		value   string
+		want    bool
+	}{
+		{"TR", "10000000146", true},
+		{"TR", "10000000147", false},
+		{"TR", "01234567890", false},
+		{"US", "123-45-6789", true},
+		{"US", "666-45-6789", false},
+		{"US", "123-00-6789", false},
+		{"GB", "AB 12 34 56 C", true},
+		{"UK", "ab123456c", true},
+		{"GB", "QQ123456C", false},
+		{"GB", "GB123456A", false},
+		{"DE", "86095742719", true},
+		{"DE", "86095742718", false},
+		{"DE", "12345678903", false},
+		{"ES", "12345678Z", true},
+		{"ES", "12345678A", false},
+		{"ES", "X1234567L", true},
+		{"es", "Y-1234567-X", true},
+		{"FR", "12345678Z", false},
+	}
+
+	for _, tt := range tests {
+		if got := nationalid.Validate(tt.country, tt.value); got != tt.want {
+			t.Errorf("Validate(%q, %q) = %v, want %v", tt.country, tt.value, got, tt.want)
+		}
+	}
+
+	spec, err := nationalid.Lookup("US")
+	if err != nil || spec.Checksum {
+		t.Errorf("US spec = %+v, %v; want format-only", spec, err)
+	}
+	if _, err := nationalid.Lookup("FR"); !errors.Is(err, nationalid.ErrUnsupportedCountry) {
+		t.Errorf("Lookup(FR) error = %v, want ErrUnsupportedCountry", err)
+	}
+}
+
+func TestStringType_NationalID(t *testing.T) {
+	schema := validation.Make().Shape(map[string]validation.Type{
+		"dni": validation.String().Required().NationalID("ES"),
+	}).(*validation.ValidationSchema)
+	if res := schema.Validate(map[string]any{"dni": "12345678Z"}); res.HasErrors() {
+		t.Errorf("unexpected errors: %v", res.Errors())
+	}
+	if res := schema.Validate(map[string]any{"dni": "12345678A"}); !res.HasFieldErrors("dni") {
+		t.Error("wrong control letter should fail")
+	}
+
+	bad := validation.Make().Shape(map[string]validation.Type{
+		"id": validation.String().NationalID("XX"),
+	})
+	if res := bad.Validate(map[string]any{"id": "1"}); !res.HasFieldErrors("id") {
+		t.Error("unsupported country should be reported")
+	}
+
+	restored, err := validation.FromDescription(schema.Describe())
+	if err != nil {
+		t.Fatalf("FromDescription: %v", err)
+	}
+	if res := restored.Validate(map[string]any{"dni": "12345678A"}); !res.HasFieldErrors("dni") {
+		t.Error("restored schema should keep the national_id rule")
+	}
+}
+
+func TestStringType_LicensePlate(t *testing.T) {
+	tests := []struct {
+		country string
+		plate   string
+		want    bool
+	}{
+		{"TR", "34 ABC 123", true},
+		{"TR", "06-a-1234", true},
+		{"TR", "35 AB 1234", true},
+		{"TR", "82 AB 123", false},
+		{"TR", "34 ABC 1234", false},
+		{"DE", "B-MW 1234", true},
+		{"DE", "M-AB 123E", true},
+		{"DE", "B-MW 0123", false},
+		{"GB", "AB12 CDE", true},
+		{"FR", "AB-123-CD", true},
+		{"FR", "AI-123-CD", false},
+		{"ES", "1234 BCD", true},
+		{"ES", "1234 ABC", false},
+		{"IT", "AB 123 CD", true},
+	}
+	for _, tt := range tests {
+		if got := rules.IsValidLicensePlate(tt.country, tt.plate); got != tt.want {
+			t.Errorf("IsValidLicensePlate(%q, %q) = %v, want %v", tt.country, tt.plate, got, tt.want)
+		}
+	}
+
+	if err := rules.RegisterLicensePlate("US-CA", `^\d[A-Z]{3}\d{3}$`); err != nil {
+		t.Fatalf("RegisterLicensePlate: %v", err)
+	}
+	if err := rules.RegisterLicensePlate("XX", `(`); err == nil {
+		t.Error("invalid pattern should be rejected")
+	}
+
+	schema := validation.Make().Shape(map[string]validation.Type{
+		"plate": validation.String().Required().LicensePlate("us-ca"),
+	}).(*validation.ValidationSchema)
+	if res := schema.Validate(map[string]any{"plate": "7ABC123"}); res.HasErrors() {
+		t.Errorf("unexpected errors: %v", res.Errors())
+	}
+	if res := schema.Validate(map[string]any{"plate": "ABC1234"}); !res.HasFieldErrors("plate") {
+		t.Error("plate in wrong format should fail")
+	}
+	if res := validation.Make().Shape(map[string]validation.Type{
+		"plate": validation.String().LicensePlate("ZZ"),
+	}).Validate(map[string]any{"plate": "1"}); !res.HasFieldErrors("plate") {
+		t.Error("unknown country should be reported")
+	}
+
+	restored, err := validation.FromDescription(schema.Describe())
+	if err != nil {
+		t.Fatalf("FromDescription: %v", err)
+	}
+	if res := restored.Validate(map[string]any{"plate": "ABC1234"}); !res.HasFieldErrors("plate") {
+		t.Error("restored schema should keep the license_plate rule")
+	}
+}
+
+func TestStringType_TurkishBusinessNumbers(t *testing.T) {
+	if !rules.IsValidVKN("1234567890") || rules.IsValidVKN("1234567891") {
+		t.Error("VKN check digit should be verified")
+	}
+
+	schema := validation.Make().Shape(map[string]validation.Type{
+		"mersis":     validation.String().MERSIS(),
+		"tax_office": validation.String().TaxOfficeCode(),
+		"sgk":        validation.String().SGKNumber(),
+	}).(*validation.ValidationSchema)
+
+	valid := map[string]any{
+		"mersis":     "0123-4567-8900-0015",
+		"tax_office": "034251",
+		"sgk":        "2 1234 01 01 1234567 034 01 12 000",
+	}
+	if res := schema.Validate(valid); res.HasErrors() {
+		t.Fatalf("unexpected errors: %v", res.Errors())
+	}
+
+	invalid := map[string]any{
+		"mersis":     "0123456789100015",
+		"tax_office": "090001",
+		"sgk":        "21234010112345670990112000",
+	}
+	res := schema.Validate(invalid)
+	for _, field := range []string{"mersis", "tax_office", "sgk"} {
+		if !res.HasFieldErrors(field) {
+			t.Errorf("expected error on %q, got %v", field, res.Errors())
+		}
+	}
+
+	rules.SetTaxOfficeCodes([]string{"006280"})
+	defer rules.SetTaxOfficeCodes(nil)
+	if rules.IsValidTaxOfficeCode("034251") || !rules.IsValidTaxOfficeCode("006280") {
+		t.Error("loaded tax office list should be enforced")
+	}
+
+	restored, err := validation.FromDescription(schema.Describe())
+	if err != nil {
+		t.Fatalf("FromDescription: %v", err)
+	}
+	if res := restored.Validate(invalid); !res.HasFieldErrors("sgk") {
+		t.Error("restored schema should keep the sgk_number rule")
+	}
+}
+
+func TestStringType_IPPolicies(t *testing.T) {
+	schema := validation.Make().Shape(map[string]validation.Type{
+		"private":  validation.String().IP().NotPrivate(),
+		"loopback": validation.String().IP().NotLoopback(),
+		"public":   validation.String().IP().PublicOnly(),
+	}).(*validation.ValidationSchema)
+
+	res := schema.Validate(map[string]any{"private": "8.8.8.8", "loopback": "10.0.0.1", "public": "2606:4700::1111"})
+	if res.HasErrors() {
+		t.Fatalf("unexpected errors: %v", res.Errors())
+	}
+
+	for _, ip := range []string{"10.1.2.3", "192.168.0.1", "::ffff:172.16.0.1", "fd00::1"} {
+		if res := schema.Validate(map[string]any{"private": ip}); !res.HasFieldErrors("private") {
+			t.Errorf("NotPrivate should reject %s", ip)
+		}
+	}
+	if res := schema.Validate(map[string]any{"loopback": "::1"}); !res.HasFieldErrors("loopback") {
+		t.Error("NotLoopback should reject ::1")
+	}
+	for _, ip := range []string{"127.0.0.1", "169.254.169.254", "100.64.1.1", "0.0.0.0", "224.0.0.1", "192.0.2.10", "fe80::1"} {
+		if res := schema.Validate(map[string]any{"public": ip}); !res.HasFieldErrors("public") {
+			t.Errorf("PublicOnly should reject %s", ip)
+		}
+	}
+
+	blocked := netip.MustParsePrefix("203.0.114.0/24")
+	policy := validation.Make().Shape(map[string]validation.Type{
+		"ip": validation.String().IP().Policy(func(ip netip.Addr) error {
+			if blocked.Contains(ip) {
+				return errors.New("ip is blocked")
+			}
+			return nil
+		}),
+	}).(*validation.ValidationSchema)
+	res = policy.Validate(map[string]any{"ip": "203.0.114.7"})
+	if got := res.Errors()["ip"]; len(got) != 1 || got[0] != "ip is blocked" {
+		t.Errorf("policy error = %v, want [ip is blocked]", got)
+	}
+	if res := policy.Validate(map[string]any{"ip": "not-an-ip"}); len(res.Errors()["ip"]) != 1 {
+		t.Errorf("policies should not run for invalid IPs, got %v", res.Errors())
+	}
+	if _, err := validation.FromDescription(policy.Describe()); !errors.Is(err, validation.ErrNotDeclarative) {
+		t.Errorf("Policy should not be declarative, got %v", err)
+	}
+
+	restored, err := validation.FromDescription(schema.Describe())
+	if err != nil {
+		t.Fatalf("FromDescription: %v", err)
+	}
+	if res := restored.Validate(map[string]any{"public": "127.0.0.1"}); !res.HasFieldErrors("public") {
+		t.Error("restored schema should keep ip_public_only")
+	}
+}
+
+func TestStringType_URLGuard(t *testing.T) {
+	schema := validation.Make().Shape(map[string]validation.Type{
+		"hook": validation.String().URL().NoPrivateHosts().Schemes("https").Ports(443, 8443),
+	}).(*validation.ValidationSchema)
+
+	if res := schema.Validate(map[string]any{"hook": "https://hooks.example.com/in"}); res.HasErrors() {
+		t.Fatalf("unexpected errors: %v", res.Errors())
+	}
+	for _, u := range []string{
+		"https://127.0.0.1/",
+		"https://10.0.0.5:8443/",
+		"https://169.254.169.254/latest/meta-data",
+		"https://2130706433/",
+		"https://0x7f.1/",
+		"https://metadata.google.internal/",
+		"https://db.internal/",
+	} {
+		if res := schema.Validate(map[string]any{"hook": u}); !res.HasFieldErrors("hook") {
+			t.Errorf("%s should be rejected", u)
+		}
+	}
+	if res := schema.Validate(map[string]any{"hook": "http://hooks.example.com/"}); !res.HasFieldErrors("hook") {
+		t.Error("http scheme should be rejected")
+	}
+	if res := schema.Validate(map[string]any{"hook": "https://hooks.example.com:9000/"}); !res.HasFieldErrors("hook") {
+		t.Error("port 9000 should be rejected")
+	}
+
+	resolver := func(ctx context.Context, host string) ([]netip.Addr, error) {
+		switch host {
+		case "public.example.com":
+			return []netip.Addr{netip.MustParseAddr("93.184.216.34")}, nil
+		case "rebind.example.com":
+			return []netip.Addr{netip.MustParseAddr("93.184.216.34"), netip.MustParseAddr("10.0.0.1")}, nil
+		}
+		return nil, errors.New("no such host")
+	}
+	resolved := validation.Make().Shape(map[string]validation.Type{
+		"hook": validation.String().URL().ResolveHosts(resolver),
+	}).(*validation.ValidationSchema)
+	ctx := context.Background()
+	if res := resolved.ValidateCtx(ctx, map[string]any{"hook": "https://public.example.com/"}); res.HasErrors() {
+		t.Errorf("unexpected errors: %v", res.Errors())
+	}
+	for _, u := range []string{"https://rebind.example.com/", "https://missing.example.com/"} {
+		if res := resolved.ValidateCtx(ctx, map[string]any{"hook": u}); !res.HasFieldErrors("hook") {
+			t.Errorf("%s should be rejected after resolution", u)
+		}
+	}
+
+	restored, err := validation.FromDescription(schema.Describe())
+	if err != nil {
+		t.Fatalf("FromDescription: %v", err)
+	}
+	if res := restored.Validate(map[string]any{"hook": "https://hooks.example.com:9000/"}); !res.HasFieldErrors("hook") {
+		t.Error("restored schema should keep url_ports")
+	}
+}
+
+// TestStringType_VerifyCallback tests webhook callback ownership challenges
+func TestStringType_VerifyCallback(t *testing.T) {
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/echo":
+			fmt.Fprintln(w, r.URL.Query().Get("hub.challenge"))
+		case "/wrong":
+			fmt.Fprint(w, "ok")
+		case "/redirect":
+			http.Redirect(w, r, "/echo", http.StatusFound)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	srv.Config.ErrorLog = log.New(io.Discard, "", 0)
+	srv.StartTLS()
+	defer srv.Close()
+
+	verify := rules.ChallengeCallback(rules.WithChallengeClient(srv.Client()), rules.WithChallengeParam("hub.challenge"))
+	ctx := context.Background()
+	if err := verify(ctx, srv.URL+"/echo?topic=orders"); err != nil {
+		t.Errorf("echoing endpoint should be verified: %v", err)
+	}
+	if err := verify(ctx, srv.URL+"/wrong"); !errors.Is(err, rules.ErrChallengeMismatch) {
+		t.Errorf("wrong echo: got %v", err)
+	}
+	if err := verify(ctx, "http://example.com/hook"); !errors.Is(err, rules.ErrInsecureCallback) {
+		t.Errorf("http callback should be rejected, got %v", err)
+	}
+	if err := rules.ChallengeCallback()(ctx, srv.URL+"/redirect"); err == nil {
+		t.Error("default client should not trust the test certificate or follow redirects")
+	}
+	slow := rules.ChallengeCallback(rules.WithChallengeClient(srv.Client()), rules.WithChallengeTimeout(time.Nanosecond))
+	if err := slow(ctx, srv.URL+"/echo"); err == nil {
+		t.Error("timed out challenge should fail")
+	}
+
+	schema := validation.Make().Shape(map[string]validation.Type{
+		"callback_url": validation.String().Required().URL().VerifyCallback(verify).Label("Callback URL"),
+	}).(*validation.ValidationSchema)
+	if res := schema.ValidateCtx(ctx, map[string]any{"callback_url": srv.URL + "/echo"}); res.HasErrors() {
+		t.Errorf("unexpected errors: %v", res.Errors())
+	}
+	res := schema.ValidateCtx(ctx, map[string]any{"callback_url": srv.URL + "/missing"})
+	if !res.HasFieldErrors("callback_url") || res.Errors()["callback_url"][0] != "Callback URL could not be verified as a callback URL" {
+		t.Errorf("unverified callback: %v", res.Errors())
+	}
+	if desc := schema.Describe().Fields["callback_url"]; desc.CustomRules != 1 {
+		t.Errorf("callback verifier should count as a custom rule, got %d", desc.CustomRules)
+	}
+}
+
+// TestStringType_VerifyCallback_PrivateAddress tests that the default challenge
+// client refuses to connect to loopback and other non-public addresses
+func TestStringType_VerifyCallback_PrivateAddress(t *testing.T) {
+	called := false
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+		fmt.Fprint(w, r.URL.Query().Get("challenge"))
+	}))
+	defer srv.Close()
+
+	verify := rules.ChallengeCallback(rules.AllowInsecureCallback())
+	if err := verify(context.Background(), srv.URL+"/hook"); !errors.Is(err, rules.ErrPrivateHost) {
+		t.Errorf("loopback callback should be rejected with ErrPrivateHost, got %v", err)
+	}
+	if called {
+		t.Error("challenge request should not reach a loopback server")
+	}
+}
+
+// TestStringType_IdempotencyKey tests Idempotency-Key format checks and replay detection
+func TestStringType_IdempotencyKey(t *testing.T) {
+	if !rules.IsValidULID("01ARZ3NDEKTSV4RRFFQ69G5FAV") || !rules.IsValidULID("01arz3ndektsv4rrffq69g5fav") {
+		t.Error("ULID should be accepted regardless of case")
+	}
+	for _, bad := range []string{"81ARZ3NDEKTSV4RRFFQ69G5FAV", "01ARZ3NDEKTSV4RRFFQ69G5FAU", "01ARZ3NDEKTSV4RRFFQ69G5FA"} {
+		if rules.IsValidULID(bad) {
+			t.Errorf("%q should not be a ULID", bad)
+		}
+	}
+
+	used := map[string]bool{"01ARZ3NDEKTSV4RRFFQ69G5FAV": true}
+	var checked []string
+	checker := func(ctx context.Context, key string) (bool, error) {
+		checked = append(checked, key)
+		if key == "8f14e45f-ceea-467f-a9b3-9d9c4b3b0d11" {
+			return false, errors.New("store unavailable")
+		}
+		return used[key], nil
+	}
+	headers := validation.Make().Shape(map[string]validation.Type{
+		"Idempotency-Key": validation.String().Required().IdempotencyKey(checker).Label("Idempotency-Key"),
+	}).(*validation.ValidationSchema)
+
+	tests := []struct {
+		key  string
+		want string
+	}{
+		{"3f2c1b9e-8d4a-4c7e-9b1f-2a6d5e4c3b21", ""},
+		{"01BX5ZZKBKACTAV9WEVGEMMVRZ", ""},
+		{"01ARZ3NDEKTSV4RRFFQ69G5FAV", "Idempotency-Key has already been used"},
+		{"8f14e45f-ceea-467f-a9b3-9d9c4b3b0d11", "Idempotency-Key has already been used"},
+		{"order-42", "Idempotency-Key must be a UUID or ULID idempotency key of at most 255 characters"},
+	}
+	for _, tt := range tests {
+		r := httptest.NewRequest(http.MethodPost, "/payments", nil)
+		r.Header.Set("Idempotency-Key", tt.key)
+		res := httpvalidate.ValidateRequest(r, nil, nil, headers)
+		errs := res.Errors()["header.Idempotency-Key"]
+		if tt.want == "" && len(errs) > 0 || tt.want != "" && (len(errs) != 1 || errs[0] != tt.want) {
+			t.Errorf("%s: errors = %v, want %q", tt.key, res.Errors(), tt.want)
+		}
+	}
+	if slices.Contains(checked, "order-42") {
+		t.Error("malformed keys should not reach the uniqueness checker")
+	}
+
+	short := validation.Make().Shape(map[string]validation.Type{"key": validation.String().Max(20).IdempotencyKey()})
+	if res := short.Validate(map[string]any{"key": "01BX5ZZKBKACTAV9WEVGEMMVRZ"}); !res.HasFieldErrors("key") {
+		t.Error("Max should tighten the idempotency key length")
+	}
+	desc := headers.Describe().Fields["Idempotency-Key"]
+	if !desc.HasRule("idempotency_key") || desc.CustomRules != 1 {
+		t.Errorf("description = %+v", desc)
+	}
+}
+
+// selfSignedPEM creates a PEM encoded self-signed certificate for key valid between the given times
+func selfSignedPEM(t *testing.T, key any, pub any, notBefore, notAfter time.Time) string {
+	t.Helper()
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "example.com"},
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, pub, key)
+	if err != nil {
+		t.Fatalf("CreateCertificate: %v", err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+// TestStringType_PEM tests certificate and key PEM validation
+func TestStringType_PEM(t *testing.T) {
+	now := time.Now()
+	ecKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	smallRSA, _ := rsa.GenerateKey(rand.Reader, 1024)
+	_, edKey, _ := ed25519.GenerateKey(rand.Reader)
+
+	ecCert := selfSignedPEM(t, ecKey, &ecKey.PublicKey, now.Add(-time.Hour), now.Add(90*24*time.Hour))
+	expired := selfSignedPEM(t, ecKey, &ecKey.PublicKey, now.Add(-48*time.Hour), now.Add(-24*time.Hour))
+	smallCert := selfSignedPEM(t, smallRSA, &smallRSA.PublicKey, now.Add(-time.Hour), now.Add(90*24*time.Hour))
+	edCert := selfSignedPEM(t, edKey, edKey.Public(), now.Add(-time.Hour), now.Add(90*24*time.Hour))
+	pkcs8, _ := x509.MarshalPKCS8PrivateKey(ecKey)
+	ecPrivate := string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: pkcs8}))
+	rsaPrivate := string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(smallRSA)}))
+
+	schema := validation.Make().Shape(map[string]validation.Type{
+		"certificate": validation.String().Required().PEM(rules.PEMCertificate,
+			types.PEMKeyTypes("RSA", "ECDSA"), types.PEMNotExpired(30*24*time.Hour)).Label("Certificate"),
+		"private_key": validation.String().PEM(rules.PEMPrivateKey),
+	}).(*validation.ValidationSchema)
+	tests := []struct {
+		name  string
+		data  map[string]any
+		field string
+		want  string
+	}{
+		{"valid chain", map[string]any{"certificate": ecCert + ecCert, "private_key": ecPrivate}, "", ""},
+		{"expired", map[string]any{"certificate": expired}, "certificate", "Certificate must contain a certificate that is currently valid"},
+		{"small key", map[string]any{"certificate": smallCert}, "certificate", "Certificate uses a key that is too small"},
+		{"key type", map[string]any{"certificate": edCert}, "certificate", "Certificate must use one of the following key types: rsa, ecdsa"},
+		{"garbage", map[string]any{"certificate": "-----BEGIN CERTIFICATE-----\nnope\n-----END CERTIFICATE-----"}, "certificate", "Certificate must contain a valid PEM encoded certificate"},
+		{"key in cert field", map[string]any{"certificate": ecPrivate}, "certificate", "Certificate must contain a valid PEM encoded certificate"},
+		{"small PKCS1 key", map[string]any{"certificate": ecCert, "private_key": rsaPrivate}, "private_key", "private_key uses a key that is too small"},
+		{"trailing text", map[string]any{"certificate": ecCert + "junk"}, "certificate", "Certificate must contain a valid PEM encoded certificate"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := schema.Validate(tt.data)
+			if tt.field == "" {
+				if res.HasErrors() {
+					t.Fatalf("unexpected errors: %v", res.Errors())
+				}
+				return
+			}
+			if errs := res.Errors()[tt.field]; len(errs) != 1 || errs[0] != tt.want {
+				t.Errorf("errors = %v, want %q", res.Errors(), tt.want)
+			}
+		})
+	}
+
+	if !schema.Describe().Fields["private_key"].Sensitive {
+		t.Error("private key fields should be sensitive")
+	}
+	restored, err := validation.FromDescription(schema.Describe())
+	if err != nil {
+		t.Fatalf("FromDescription: %v", err)
+	}
+	if res := restored.Validate(map[string]any{"certificate": edCert}); !res.HasFieldErrors("certificate") {
+		t.Error("restored schema should keep PEM key types")
+	}
+	if err := rules.ValidatePEM(ecCert, rules.DefaultPEMRules(rules.PEMCertificate), now.Add(100*24*time.Hour)); err != nil {
+		t.Errorf("expiry should only be checked when enabled: %v", err)
+	}
+}
+
+// sshWire encodes fields as length-prefixed SSH wire format strings
+func sshWire(fields ...[]byte) string {
+	var buf []byte
+	for _, f := range fields {
+		buf = binary.BigEndian.AppendUint32(buf, uint32(len(f)))
+		buf = append(buf, f...)
+	}
+	return base64.StdEncoding.EncodeToString(buf)
+}
+
+// TestStringType_SSHPublicKey tests authorized_keys parsing, key types and sizes
+func TestStringType_SSHPublicKey(t *testing.T) {
+	edPub, _, _ := ed25519.GenerateKey(rand.Reader)
+	ecKey, _ := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	ecPoint, _ := ecKey.PublicKey.ECDH()
+	smallRSA, _ := rsa.GenerateKey(rand.Reader, 1024)
+	e := big.NewInt(int64(smallRSA.E)).Bytes()
+	n := append([]byte{0}, smallRSA.N.Bytes()...)
+
+	edLine := "ssh-ed25519 " + sshWire([]byte("ssh-ed25519"), edPub) + " deploy@ci"
+	ecLine := "ecdsa-sha2-nistp384 " + sshWire([]byte("ecdsa-sha2-nistp384"), []byte("nistp384"), ecPoint.Bytes())
+	rsaLine := "ssh-rsa " + sshWire([]byte("ssh-rsa"), e, n)
+	withOptions := `no-pty,command="echo hi there" ` + edLine
+	mismatch := "ssh-rsa " + sshWire([]byte("ssh-ed25519"), edPub)
+	truncated := "ssh-ed25519 " + sshWire([]byte("ssh-ed25519"), edPub[:16])
+
+	if key, err := rules.ParseSSHPublicKey(withOptions); err != nil || key.Type != rules.SSHKeyEd25519 || key.Bits != 256 || key.Comment != "deploy@ci" {
+		t.Errorf("ParseSSHPublicKey = %+v, %v", key, err)
+	}
+	if key, err := rules.ParseSSHPublicKey(rsaLine); err != nil || key.Bits != 1024 {
+		t.Errorf("RSA bits = %+v, %v", key, err)
+	}
+
+	schema := validation.Make().Shape(map[string]validation.Type{
+		"key":        validation.String().SSHPublicKey().Label("Deploy key"),
+		"ed25519":    validation.String().SSHPublicKey("ed25519").Label("Key"),
+		"legacy_key": validation.String().SSHPublicKey(rules.SSHKeyRSA, "ecdsa"),
+	}).(*validation.ValidationSchema)
+	tests := []struct {
+		name  string
+		data  map[string]any
+		field string
+		want  string
+	}{
+		{"ed25519 with options", map[string]any{"key": withOptions, "ed25519": edLine}, "", ""},
+		{"ecdsa", map[string]any{"key": ecLine, "legacy_key": ecLine}, "", ""},
+		{"small rsa", map[string]any{"key": rsaLine}, "key", "Deploy key must use a key of at least 2048 bits"},
+		{"disallowed type", map[string]any{"ed25519": ecLine}, "ed25519", "Key must use one of the following key types: ed25519"},
+		{"type mismatch", map[string]any{"key": mismatch}, "key", "Deploy key must be a valid SSH public key"},
+		{"truncated", map[string]any{"key": truncated}, "key", "Deploy key must be a valid SSH public key"},
+		{"garbage", map[string]any{"key": "ssh-ed25519 not-base64!"}, "key", "Deploy key must be a valid SSH public key"},
+		{"multiple lines", map[string]any{"key": edLine + "\n" + edLine}, "key", "Deploy key must be a valid SSH public key"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := schema.Validate(tt.data)
+			if tt.field == "" {
+				if res.HasErrors() {
+					t.Fatalf("unexpected errors: %v", res.Errors())
+				}
+				return
+			}
+			if errs := res.Errors()[tt.field]; len(errs) != 1 || errs[0] != tt.want {
+				t.Errorf("errors = %v, want %q", res.Errors(), tt.want)
+			}
+		})
+	}
+
+	restored, err := validation.FromDescription(schema.Describe())
+	if err != nil {
+		t.Fatalf("FromDescription: %v", err)
+	}
+	if res := restored.Validate(map[string]any{"ed25519": ecLine}); !res.HasFieldErrors("ed25519") {
+		t.Error("restored schema should keep allowed SSH key types")
+	}
+}
+
+// TestStringType_JSONPointerAndPath tests RFC 6901 pointer and RFC 9535 JSONPath syntax
+func TestStringType_JSONPointerAndPath(t *testing.T) {
+	schema := validation.Make().Shape(map[string]validation.Type{
+		"path":   validation.String().JSONPointer().Label("Path"),
+		"filter": validation.String().JSONPath().Label("Filter"),
+	}).(*validation.ValidationSchema)
+	tests := []struct {
+		field string
+		value string
+		valid bool
+	}{
+		{"path", "/users/0/name", true},
+		{"path", "/a~1b/m~0n", true},
+		{"path", "/", true},
+		{"path", "users/0", false},
+		{"path", "/a~2b", false},
+		{"path", "/trailing~", false},
+		{"filter", "$", true},
+		{"filter", "$.store.book[*].author", true},
+		{"filter", "$..author", true},
+		{"filter", "$['a', \"b\"][0, -1][1:3][::2]", true},
+		{"filter", "$[?@.price < 10 && !(@.sold || $.closed)].title", true},
+		{"filter", "$[?match(@.name, 'a.*') && length(@.tags) >= 2]", true},
+		{"filter", "store.book", false},
+		{"filter", "$.", false},
+		{"filter", "$[01]", false},
+		{"filter", "$['open]", false},
+		{"filter", "$[?@.price = 10]", false},
+		{"filter", "$[?1]", false},
+		{"filter", "$.a ", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.field+" "+tt.value, func(t *testing.T) {
+			res := schema.Validate(map[string]any{tt.field: tt.value})
+			if res.HasFieldErrors(tt.field) == tt.valid {
+				t.Errorf("valid = %v, errors = %v", tt.valid, res.Errors())
+			}
+		})
+	}
+
+	res := schema.Validate(map[string]any{"path": "x", "filter": "x"})
+	if got := res.Errors()["filter"]; len(got) != 1 || got[0] != "Filter must be a valid JSONPath expression" {
+		t.Errorf("filter errors = %v", got)
+	}
+	restored, err := validation.FromDescription(schema.Describe())
+	if err != nil {
+		t.Fatalf("FromDescription: %v", err)
+	}
+	if res := restored.Validate(map[string]any{"path": "x"}); !res.HasFieldErrors("path") {
+		t.Error("restored schema should keep json_pointer rule")
+	}
+}
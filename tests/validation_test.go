@@ -22,12 +22,17 @@
 package tests
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"reflect"
+	"sort"
 	"strings"
 	"testing"
 	"time"
 
 	validation "github.com/biyonik/go-fluent-validator"
+	"github.com/biyonik/go-fluent-validator/core"
 )
 
 // -----------------------------------------------------------------------------
@@ -273,6 +278,117 @@ func TestSchema_CrossValidation_MultipleFields(t *testing.T) {
 	}
 }
 
+// TestSchema_CrossValidateFields tests field-scoped cross validation
+func TestSchema_CrossValidateFields(t *testing.T) {
+	calls := 0
+	schema := validation.Make().Shape(map[string]validation.Type{
+		"price":    validation.Number().Required().Positive(),
+		"discount": validation.Number().Required(),
+		"note":     validation.String().Max(5),
+	}).(*validation.ValidationSchema).CrossValidateFields([]string{"price", "discount"}, func(data map[string]any) error {
+		calls++
+		price, _ := data["price"].(float64)
+		discount, _ := data["discount"].(float64)
+		if discount > price {
+			return fmt.Errorf("discount cannot exceed price")
+		}
+		return nil
+	}).CrossValidateFields([]string{"price", "discount"}, func(data map[string]any) error {
+		if data["discount"] == float64(0) {
+			return validation.NewFieldError("discount", "discount must not be zero")
+		}
+		return nil
+	})
+
+	tests := []struct {
+		name      string
+		data      map[string]any
+		wantField string
+		wantCalls int
+	}{
+		{"valid", map[string]any{"price": 100.0, "discount": 10.0}, "", 1},
+		{"error reported on first field", map[string]any{"price": 10.0, "discount": 50.0}, "price", 1},
+		{"field error targets its own field", map[string]any{"price": 10.0, "discount": 0.0}, "discount", 1},
+		{"skipped when declared field failed", map[string]any{"price": -5.0, "discount": 50.0}, "price", 0},
+		{"unrelated field error does not skip", map[string]any{"price": 10.0, "discount": 50.0, "note": "too long"}, "price", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls = 0
+			result := schema.Validate(tt.data)
+
+			if calls != tt.wantCalls {
+				t.Errorf("cross validator calls = %d, want %d", calls, tt.wantCalls)
+			}
+			if _, ok := result.Errors()["_cross_validation"]; ok {
+				t.Errorf("unexpected _cross_validation error: %v", result.Errors())
+			}
+			if tt.wantField == "" && result.HasErrors() {
+				t.Errorf("unexpected errors: %v", result.Errors())
+			}
+			if tt.wantField != "" && len(result.Errors()[tt.wantField]) == 0 {
+				t.Errorf("expected error on %q, got %v", tt.wantField, result.Errors())
+			}
+		})
+	}
+}
+
+// TestSchema_FieldGroups tests RequireAnyOf and RequireAllOrNone group rules
+func TestSchema_FieldGroups(t *testing.T) {
+	schema := validation.Make().Shape(map[string]validation.Type{
+		"email":  validation.Email(),
+		"phone":  validation.String().Label("Phone number"),
+		"street": validation.String(),
+		"city":   validation.String(),
+		"zip":    validation.String(),
+	}).(*validation.ValidationSchema)
+	schema.RequireAnyOf("email", "phone")
+	schema.RequireAllOrNone("street", "city", "zip")
+
+	tests := []struct {
+		name       string
+		data       map[string]any
+		wantFields []string
+	}{
+		{"one contact method", map[string]any{"phone": "555 0100"}, nil},
+		{"no contact method", map[string]any{"email": " "}, []string{"email", "phone"}},
+		{"invalid email skips group rule", map[string]any{"email": "nope"}, []string{"email"}},
+		{"full address", map[string]any{"email": "a@b.co", "street": "Main", "city": "Ankara", "zip": "06100"}, nil},
+		{"partial address", map[string]any{"email": "a@b.co", "street": "Main"}, []string{"city", "zip"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := schema.Validate(tt.data)
+			var got []string
+			for field := range result.Errors() {
+				got = append(got, field)
+			}
+			sort.Strings(got)
+			if !reflect.DeepEqual(got, tt.wantFields) {
+				t.Errorf("error fields = %v, want %v (%v)", got, tt.wantFields, result.Errors())
+			}
+		})
+	}
+
+	result := schema.Validate(map[string]any{"email": "a@b.co", "street": "Main"})
+	if msg := result.Errors()["city"][0]; msg != "city is required together with street" {
+		t.Errorf("unexpected message %q", msg)
+	}
+	result = schema.Validate(map[string]any{})
+	if msg := result.Errors()["email"][0]; msg != "email is required when none of Phone number are present" {
+		t.Errorf("unexpected message %q", msg)
+	}
+
+	restored, err := validation.FromDescription(schema.Describe())
+	if err != nil {
+		t.Fatalf("FromDescription failed: %v", err)
+	}
+	if got := restored.Validate(map[string]any{"city": "Ankara"}).Errors(); len(got) != 4 {
+		t.Errorf("restored schema errors = %v", got)
+	}
+}
+
 // -----------------------------------------------------------------------------
 // Conditional Validation (When) Tests
 // -----------------------------------------------------------------------------
@@ -614,3 +730,504 @@ func BenchmarkSchema_ConditionalValidation(b *testing.B) {
 		schema.Validate(data)
 	}
 }
+
+// TestSchema_Discriminated tests polymorphic payloads selected by a discriminator
+func TestSchema_Discriminated(t *testing.T) {
+	schema := validation.Discriminated("kind", map[string]validation.Schema{
+		"card": validation.Make().Shape(map[string]validation.Type{
+			"number": validation.CreditCard().Required(),
+		}),
+		"iban": validation.Make().Shape(map[string]validation.Type{
+			"iban": validation.Iban().Required(),
+		}),
+	})
+	schema.Shape(map[string]validation.Type{
+		"amount": validation.Number().Positive().Required(),
+	})
+
+	res := schema.Validate(map[string]any{"kind": "iban", "amount": 10, "iban": "TR330006100519786457841326"})
+	if res.HasErrors() {
+		t.Fatalf("valid iban payload failed: %v", res.Errors())
+	}
+	if res.ValidData()["kind"] != "iban" || res.ValidData()["iban"] == nil {
+		t.Errorf("valid data should include discriminator and variant fields, got %v", res.ValidData())
+	}
+
+	res = schema.Validate(map[string]any{"kind": "card", "amount": 10})
+	if len(res.Errors()["number"]) == 0 || len(res.Errors()["iban"]) != 0 {
+		t.Errorf("only the card variant should run, got %v", res.Errors())
+	}
+
+	res = schema.Validate(map[string]any{"kind": "crypto", "amount": -1})
+	if msgs := res.Errors()["kind"]; len(msgs) != 1 || !strings.Contains(msgs[0], "card and iban") || !strings.Contains(msgs[0], "crypto") {
+		t.Errorf("unknown kind should be reported clearly, got %v", msgs)
+	}
+	if len(res.Errors()["amount"]) == 0 {
+		t.Error("common fields should still be validated")
+	}
+
+	res = schema.Validate(map[string]any{"amount": 5})
+	if len(res.Errors()["kind"]) == 0 {
+		t.Error("missing discriminator should be reported")
+	}
+
+	desc := schema.Describe()
+	if len(desc.Conditionals) != 2 || !desc.Fields["kind"].HasRule("one_of") {
+		t.Errorf("unexpected description: %+v", desc)
+	}
+}
+
+// TestSchema_DiscriminatedUnion tests variant selection by non-string discriminator values
+func TestSchema_DiscriminatedUnion(t *testing.T) {
+	schema := validation.DiscriminatedUnion("version", map[any]validation.Schema{
+		1: validation.Make().Shape(map[string]validation.Type{
+			"name": validation.String().Required(),
+		}),
+		2: validation.Make().Shape(map[string]validation.Type{
+			"first_name": validation.String().Required(),
+			"last_name":  validation.String().Required(),
+		}),
+	})
+
+	var payload map[string]any
+	if err := json.Unmarshal([]byte(`{"version": 2, "first_name": "Ada", "last_name": "Lovelace"}`), &payload); err != nil {
+		t.Fatal(err)
+	}
+	res := schema.Validate(payload)
+	if res.HasErrors() {
+		t.Fatalf("decoded JSON number should select the variant, got %v", res.Errors())
+	}
+	if res.ValidData()["version"] != 2 || res.ValidData()["last_name"] != "Lovelace" {
+		t.Errorf("unexpected valid data: %v", res.ValidData())
+	}
+
+	res = schema.Validate(map[string]any{"version": 1})
+	if len(res.Errors()["name"]) == 0 || len(res.Errors()["first_name"]) != 0 {
+		t.Errorf("only the v1 variant should run, got %v", res.Errors())
+	}
+
+	res = schema.Validate(map[string]any{"version": 3})
+	if msgs := res.Errors()["version"]; len(msgs) != 1 || !strings.Contains(msgs[0], "1 and 2") {
+		t.Errorf("unknown version should list allowed values, got %v", msgs)
+	}
+}
+
+// TestSchema_Union tests fields accepting one of several types
+func TestSchema_Union(t *testing.T) {
+	schema := validation.Make().Shape(map[string]validation.Type{
+		"id": validation.Union(
+			validation.Number().Integer().Positive(),
+			validation.Uuid(),
+		).Required(),
+		"tags": validation.Union(
+			validation.String().Trim().Min(2),
+			validation.Array().Elements(validation.String().Min(2)).Max(3),
+		),
+	}).(*validation.ValidationSchema)
+
+	res := schema.Validate(map[string]any{"id": 42, "tags": "  go  "})
+	if res.HasErrors() {
+		t.Fatalf("number and string alternatives should pass, got %v", res.Errors())
+	}
+	if res.ValidData()["tags"] != "go" {
+		t.Errorf("winning alternative's transform should apply, got %q", res.ValidData()["tags"])
+	}
+
+	res = schema.Validate(map[string]any{"id": "550e8400-e29b-41d4-a716-446655440000", "tags": []any{"go", "api"}})
+	if res.HasErrors() {
+		t.Fatalf("uuid and array alternatives should pass, got %v", res.Errors())
+	}
+
+	res = schema.Validate(map[string]any{"id": -1, "tags": []any{"go", "x"}})
+	if msgs := res.Errors()["id"]; len(msgs) < 2 || !strings.Contains(msgs[0], "one of 2 allowed types") {
+		t.Errorf("union error and closest branch errors expected, got %v", msgs)
+	}
+	if len(res.Errors()["tags[1]"]) == 0 {
+		t.Errorf("closest branch errors should keep their paths, got %v", res.Errors())
+	}
+
+	if res := schema.Validate(map[string]any{}); len(res.Errors()["id"]) != 1 {
+		t.Errorf("required union should report a single error, got %v", res.Errors())
+	}
+
+	doc := schema.ToJSONSchema()
+	id := doc["properties"].(map[string]any)["id"].(map[string]any)
+	if anyOf, ok := id["anyOf"].([]any); !ok || len(anyOf) != 2 {
+		t.Errorf("union should export as anyOf, got %v", id)
+	}
+
+	data, err := json.Marshal(schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	restored := validation.Make()
+	if err := json.Unmarshal(data, restored); err != nil {
+		t.Fatalf("union should survive a snapshot round trip: %v", err)
+	}
+	if res := restored.Validate(map[string]any{"id": "not-an-id"}); !res.HasFieldErrors("id") {
+		t.Error("restored union should still validate")
+	}
+}
+
+// TestSchema_Combinators tests AllOf, AnyOf and Not schema composition
+func TestSchema_Combinators(t *testing.T) {
+	identity := validation.Make().Shape(map[string]validation.Type{
+		"name": validation.String().Required().Trim(),
+	})
+	card := validation.Make().Shape(map[string]validation.Type{
+		"card": validation.CreditCard().Required(),
+	})
+	iban := validation.Make().Shape(map[string]validation.Type{
+		"iban": validation.Iban().Required(),
+	})
+	blocked := validation.Make().Shape(map[string]validation.Type{
+		"country": validation.String().Required().OneOf([]string{"XX", "YY"}),
+	})
+	schema := validation.AllOf(identity, validation.AnyOf(card, iban), validation.Not(blocked))
+	schema.Shape(map[string]validation.Type{
+		"amount": validation.Number().Positive().Required(),
+	})
+
+	res := schema.Validate(map[string]any{"name": " Ada ", "amount": 10, "iban": "TR330006100519786457841326", "country": "TR"})
+	if res.HasErrors() {
+		t.Fatalf("valid payload failed: %v", res.Errors())
+	}
+	if data := res.ValidData(); data["name"] != "Ada" || data["iban"] == nil || data["amount"] == nil {
+		t.Errorf("valid data should merge all matching branches, got %v", data)
+	}
+
+	res = schema.Validate(map[string]any{"amount": 10, "card": "1234", "country": "XX"})
+	if len(res.Errors()["name"]) == 0 {
+		t.Errorf("AllOf branch errors should be merged, got %v", res.Errors())
+	}
+	if len(res.Errors()["_payload"]) != 2 {
+		t.Errorf("expected any_of and not failures on _payload, got %v", res.Errors()["_payload"])
+	}
+	if len(res.Errors()["card"]) == 0 || len(res.Errors()["iban"]) != 0 {
+		t.Errorf("AnyOf should report the closest branch, got %v", res.Errors())
+	}
+
+	data, err := json.Marshal(schema)
+	if err != nil {
+		t.Fatalf("combinators should be declarative: %v", err)
+	}
+	restored := validation.Make()
+	if err := json.Unmarshal(data, restored); err != nil {
+		t.Fatalf("snapshot restore failed: %v", err)
+	}
+	if got := restored.Validate(map[string]any{"amount": 10, "card": "1234", "country": "XX"}); !reflect.DeepEqual(got.Errors(), res.Errors()) {
+		t.Errorf("restored schema differs:\nwant %v\ngot  %v", res.Errors(), got.Errors())
+	}
+
+	doc, _ := json.Marshal(schema.ToJSONSchema())
+	imported, err := validation.FromJSONSchema(doc)
+	if err != nil {
+		t.Fatalf("JSON Schema round trip failed: %v", err)
+	}
+	all := imported.Describe().Compositions
+	if len(all) != 1 || all[0].Kind != "all_of" || len(all[0].Schemas) != 3 {
+		t.Fatalf("unexpected imported compositions: %+v", all)
+	}
+	if got := all[0].Schemas[1].Compositions; len(got) != 1 || got[0].Kind != "any_of" || len(got[0].Schemas) != 2 {
+		t.Errorf("AnyOf should survive the JSON Schema round trip, got %+v", got)
+	}
+	if got := all[0].Schemas[2].Compositions; len(got) != 1 || got[0].Kind != "not" {
+		t.Errorf("Not should survive the JSON Schema round trip, got %+v", got)
+	}
+}
+
+// TestSchema_CardPayment tests the ready-made card payment schema
+func TestSchema_CardPayment(t *testing.T) {
+	schema := validation.CardPayment()
+	nextYear := fmt.Sprintf("12/%d", time.Now().Year()%100+1)
+
+	res := schema.Validate(map[string]any{
+		"number": "4532015112830366",
+		"expiry": nextYear,
+		"cvv":    "123",
+		"holder": "Ayşe  Yılmaz",
+	})
+	if res.HasErrors() {
+		t.Fatalf("valid payment failed: %v", res.Errors())
+	}
+	if res.ValidData()["holder"] != "Ayşe Yılmaz" {
+		t.Errorf("holder whitespace should be collapsed, got %q", res.ValidData()["holder"])
+	}
+
+	res = schema.Validate(map[string]any{
+		"number": "378282246310005",
+		"expiry": "01/20",
+		"cvv":    "123",
+		"holder": "John Smith",
+	})
+	failures := res.Failures()
+	if len(failures) != 2 || failures[0].Rule != "card_cvv" || failures[1].Rule != "card_expiry" {
+		t.Errorf("expected card_cvv and card_expiry failures, got %+v", failures)
+	}
+	if msgs := res.Errors()["cvv"]; len(msgs) != 1 || !strings.Contains(msgs[0], "4 digits") {
+		t.Errorf("amex CVV should require 4 digits, got %v", msgs)
+	}
+
+	res = schema.Validate(map[string]any{"number": "4532015112830366", "expiry": "13/30", "cvv": "12a", "holder": "John Smith"})
+	if len(res.Errors()["expiry"]) != 1 || len(res.Errors()["cvv"]) != 1 {
+		t.Errorf("malformed expiry and CVV should be reported once each, got %v", res.Errors())
+	}
+
+	sub := validation.Discriminated("method", map[string]validation.Schema{"card": validation.CardPayment()})
+	if res := sub.Validate(map[string]any{"method": "card"}); len(res.Errors()["number"]) == 0 {
+		t.Errorf("CardPayment should work as a sub-schema, got %v", res.Errors())
+	}
+}
+
+// TestSchema_OldInput tests that failed validations keep raw input without sensitive fields
+func TestSchema_OldInput(t *testing.T) {
+	schema := validation.Make(validation.WithAutoTrim(), validation.WithSensitiveFields("pin")).Shape(map[string]validation.Type{
+		"email":    validation.String().Required().Email(),
+		"password": validation.String().Required(),
+		"secret":   validation.String().Sensitive(),
+		"card":     validation.CreditCard(),
+		"pin":      validation.String(),
+		"address": validation.Object().Shape(map[string]validation.Type{
+			"city":  validation.String(),
+			"token": validation.String().Sensitive(),
+		}),
+	})
+
+	res := schema.Validate(map[string]any{
+		"email":    " not-an-email ",
+		"password": "hunter2",
+		"secret":   "s3cr3t",
+		"card":     "4532015112830366",
+		"pin":      "1234",
+		"age":      42,
+		"address":  map[string]any{"city": "İzmir", "token": "abc"},
+	})
+	want := map[string]any{
+		"email":   " not-an-email ",
+		"age":     42,
+		"address": map[string]any{"city": "İzmir"},
+	}
+	if !reflect.DeepEqual(res.OldInput(), want) {
+		t.Errorf("OldInput() = %v, want %v", res.OldInput(), want)
+	}
+	if got := res.OldValue("email"); got != " not-an-email " {
+		t.Errorf("OldValue(email) = %q", got)
+	}
+	for field, want := range map[string]string{"age": "42", "address.city": "İzmir", "address.token": "", "password": "", "missing": "", "address": ""} {
+		if got := res.OldValue(field); got != want {
+			t.Errorf("OldValue(%q) = %q, want %q", field, got, want)
+		}
+	}
+
+	if ok := schema.Validate(map[string]any{"email": "a@b.co", "password": "x"}); ok.OldInput() != nil {
+		t.Errorf("successful validations should not keep old input, got %v", ok.OldInput())
+	}
+}
+
+// TestValidateInto tests decoding ValidData into a typed struct
+func TestValidateInto(t *testing.T) {
+	type address struct {
+		City string `json:"city"`
+	}
+	type signup struct {
+		Email   string    `json:"email"`
+		Age     int       `json:"age"`
+		Joined  time.Time `json:"joined"`
+		Address address   `json:"address"`
+		Tags    []string  `json:"tags"`
+	}
+
+	schema := validation.Make().Shape(map[string]validation.Type{
+		"email":   validation.String().Required().Trim().Email(),
+		"age":     validation.Number().Integer().Min(18),
+		"joined":  validation.Date().Format("2006-01-02"),
+		"address": validation.Object().Shape(map[string]validation.Type{"city": validation.String().Required()}),
+		"tags":    validation.Array().Elements(validation.String()),
+	})
+
+	got, res := validation.ValidateInto[signup](schema, map[string]any{
+		"email":   " ada@example.com ",
+		"age":     36,
+		"joined":  "2024-03-01",
+		"address": map[string]any{"city": "London"},
+		"tags":    []any{"math", "code"},
+	})
+	if res.HasErrors() {
+		t.Fatalf("unexpected errors: %v", res.Errors())
+	}
+	want := signup{
+		Email:   "ada@example.com",
+		Age:     36,
+		Joined:  time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+		Address: address{City: "London"},
+		Tags:    []string{"math", "code"},
+	}
+	if !got.Joined.Equal(want.Joined) {
+		t.Errorf("Joined = %v, want %v", got.Joined, want.Joined)
+	}
+	got.Joined = want.Joined
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ValidateInto = %+v, want %+v", got, want)
+	}
+
+	got, res = validation.ValidateInto[signup](schema, map[string]any{"email": "x", "age": 10})
+	if !res.HasFieldErrors("email") || !reflect.DeepEqual(got, signup{}) {
+		t.Errorf("invalid data should return zero value and errors, got %+v, %v", got, res.Errors())
+	}
+
+	loose := validation.Make().Shape(map[string]validation.Type{"age": validation.Number()})
+	if _, res := validation.ValidateInto[signup](loose, map[string]any{"age": 3.5}); !res.HasFieldErrors("_payload") {
+		t.Errorf("decode errors should be reported, got %v", res.Errors())
+	}
+}
+
+// TestSchema_ValidateJSON tests validating raw JSON bytes and readers
+func TestSchema_ValidateJSON(t *testing.T) {
+	schema := validation.Make().Shape(map[string]validation.Type{
+		"id":    validation.Number().Integer().Positive(),
+		"price": validation.Number().Min(0),
+		"name":  validation.String().Required(),
+	}).(*validation.ValidationSchema)
+
+	res := schema.ValidateJSON([]byte(`{"id": 9007199254740993, "price": 12.5, "name": "Lamp"}`))
+	if res.HasErrors() {
+		t.Fatalf("unexpected errors: %v", res.Errors())
+	}
+	if got := res.ValidData()["id"]; got != int64(9007199254740993) {
+		t.Errorf("id = %#v, want exact int64", got)
+	}
+	if got := res.ValidData()["price"]; got != 12.5 {
+		t.Errorf("price = %#v, want 12.5", got)
+	}
+
+	res = schema.ValidateReader(strings.NewReader(`{"id": -1, "price": 1}`))
+	if !res.HasFieldErrors("id") || !res.HasFieldErrors("name") {
+		t.Errorf("expected id and name errors, got %v", res.Errors())
+	}
+
+	for _, body := range []string{`{"id":`, `[1, 2]`, `null`, `{"name":"a"} {"name":"b"}`} {
+		if res := schema.ValidateJSON([]byte(body)); !res.HasFieldErrors("_payload") {
+			t.Errorf("ValidateJSON(%s) should report a payload error, got %v", body, res.Errors())
+		}
+	}
+
+	res = schema.ValidateJSON([]byte(`{"id": 92233720368547758070, "price": 1, "name": "Lamp"}`))
+	if failures := res.Failures(); len(failures) != 1 || failures[0].Rule != "integer_range" {
+		t.Errorf("an int64 overflow should report integer_range, got %v", failures)
+	}
+	plain := validation.Make().Shape(map[string]validation.Type{"n": validation.Number()}).(*validation.ValidationSchema)
+	if res := plain.ValidateJSON([]byte(`{"n": 92233720368547758070}`)); res.HasErrors() {
+		t.Errorf("a large number on a non-integer field should pass, got %v", res.Errors())
+	}
+
+	var hooked int
+	localized := validation.Make(validation.WithLocale("tr"), validation.WithFailureHook(func(context.Context, core.Failure) {
+		hooked++
+	})).Shape(map[string]validation.Type{"name": validation.String()}).(*validation.ValidationSchema)
+	res = localized.ValidateJSON([]byte(`{"name":`))
+	if got, want := res.Errors()["_payload"], schema.ValidateJSON([]byte(`{"name":`)).Errors()["_payload"]; reflect.DeepEqual(got, want) {
+		t.Errorf("decode errors should be localized, got %v", got)
+	}
+	if hooked != 1 {
+		t.Errorf("decode errors should reach failure hooks, got %d calls", hooked)
+	}
+}
+
+// TestSchema_Lazy tests self-referential types built with Lazy
+func TestSchema_Lazy(t *testing.T) {
+	var comment validation.Type
+	comment = validation.Object().Shape(map[string]validation.Type{
+		"body": validation.String().Required(),
+		"replies": validation.Array().Max(2).Elements(validation.Lazy(func() validation.Type {
+			return comment
+		})),
+	})
+	schema := validation.Make()
+	schema.Shape(map[string]validation.Type{"comment": comment})
+
+	valid := map[string]any{"comment": map[string]any{
+		"body": "root",
+		"replies": []any{
+			map[string]any{"body": "a", "replies": []any{map[string]any{"body": "a.1"}}},
+		},
+	}}
+	if res := schema.Validate(valid); res.HasErrors() {
+		t.Fatalf("unexpected errors: %v", res.Errors())
+	}
+
+	invalid := map[string]any{"comment": map[string]any{
+		"body": "root",
+		"replies": []any{
+			map[string]any{"body": "a", "replies": []any{map[string]any{}, map[string]any{"body": "b"}, map[string]any{"body": "c"}}},
+		},
+	}}
+	res := schema.Validate(invalid)
+	for _, field := range []string{"comment.replies[0].replies[0].body", "comment.replies[0].replies"} {
+		if !res.HasFieldErrors(field) {
+			t.Errorf("expected error on %q, got %v", field, res.Errors())
+		}
+	}
+
+	desc := schema.Describe()
+	replies := desc.Fields["comment"].Fields["replies"].Elements
+	if replies.Type != "object" || replies.Fields["body"] == nil {
+		t.Errorf("lazy type should be expanded once, got %+v", replies)
+	}
+	if inner := replies.Fields["replies"].Elements; inner.Type != "lazy" {
+		t.Errorf("recursion should stop at the repeated lazy type, got %+v", inner)
+	}
+	if _, err := json.Marshal(schema.ToJSONSchema()); err != nil {
+		t.Errorf("ToJSONSchema: %v", err)
+	}
+}
+
+// TestSchema_ErrorsSorted tests sorted error listing and deterministic ordering.
+func TestSchema_ErrorsSorted(t *testing.T) {
+	schema := validation.Make().Shape(map[string]validation.Type{
+		"name":  validation.String().Required(),
+		"email": validation.String().Required().Email(),
+		"address": validation.Object().Required().Shape(map[string]validation.Type{
+			"zip":  validation.String().Required(),
+			"city": validation.String().Required(),
+		}),
+	})
+	res := schema.Validate(map[string]any{"email": "x", "address": map[string]any{}})
+
+	var fields []string
+	for _, e := range res.ErrorsSorted() {
+		fields = append(fields, e.Field)
+		if len(e.Messages) == 0 {
+			t.Errorf("%s: expected messages", e.Field)
+		}
+	}
+	if want := []string{"address.city", "address.zip", "email", "name"}; !reflect.DeepEqual(fields, want) {
+		t.Errorf("alphabetical order = %v, want %v", fields, want)
+	}
+
+	fields = fields[:0]
+	for _, e := range res.ErrorsSorted("name", "email", "address") {
+		fields = append(fields, e.Field)
+	}
+	if want := []string{"name", "email", "address.city", "address.zip"}; !reflect.DeepEqual(fields, want) {
+		t.Errorf("declaration order = %v, want %v", fields, want)
+	}
+
+	failing := func(v any) (any, error) { return nil, fmt.Errorf("bozuk") }
+	nested := validation.Make().Shape(map[string]validation.Type{
+		"payload": validation.Object().Shape(map[string]validation.Type{
+			"b": validation.Any().TransformWith(failing),
+			"a": validation.Any().TransformWith(failing),
+			"c": validation.Any().TransformWith(failing),
+		}),
+	})
+	first, _ := json.Marshal(nested.Validate(map[string]any{"payload": map[string]any{"a": 1, "b": 2, "c": 3}}))
+	for range 20 {
+		got, _ := json.Marshal(nested.Validate(map[string]any{"payload": map[string]any{"a": 1, "b": 2, "c": 3}}))
+		if string(got) != string(first) {
+			t.Fatalf("serialized result changed between runs:\n%s\n%s", first, got)
+		}
+	}
+	if !strings.Contains(string(first), "'a'") {
+		t.Errorf("transform errors should follow field order, got %s", first)
+	}
+}
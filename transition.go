@@ -0,0 +1,132 @@
+package validation
+
+import (
+	"github.com/biyonik/go-fluent-validator/core"
+	"github.com/biyonik/go-fluent-validator/i18n"
+	"github.com/biyonik/go-fluent-validator/rules"
+)
+
+//
+// -----------------------------------------------------------------------------
+// Şema Seviyesi Durum Geçişi Kuralları
+// -----------------------------------------------------------------------------
+// Bu dosya, "status" gibi bir alanın yalnızca izin verilen durumlar arasında
+// geçiş yapabilmesini şema seviyesinde tanımlamayı sağlar. Mevcut durum ya
+// dışarıdan verilen bir sağlayıcı (ör. veritabanı sorgusu) ile okunur ya da
+// ValidateChanges sırasında eski veriden alınır.
+//
+// Metadata:
+// @author    Ahmet ALTUN
+// @github    github.com/biyonik
+// @linkedin  linkedin.com/in/biyonik
+// @email     ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// transitionRule
+// -----------------------------------------------------------------------------
+// TransitionRule(...) ile eklenen durum geçişi kuralını temsil eder.
+type transitionRule struct {
+	field       string                                   // Durum alanı
+	transitions map[string][]string                      // İzin verilen geçişler
+	current     func(data map[string]any) (string, bool) // Mevcut durum sağlayıcısı (opsiyonel)
+}
+
+// TransitionRule
+// -----------------------------------------------------------------------------
+// field alanının yalnızca transitions haritasında izin verilen geçişleri
+// yapabilmesini sağlar.
+//
+// Parametreler:
+//   - field: Durum alanının adı
+//   - transitions: Her durum için izin verilen hedef durumlar
+//   - current: Mevcut durumu döndüren sağlayıcı. Gelen (dönüştürülmüş) veriyi
+//     alır; ikinci dönüş değeri false ise (ör. yeni kayıt) kontrol atlanır.
+//     nil verilirse kural yalnızca ValidateChanges içinde, eski verideki
+//     değer mevcut durum kabul edilerek çalışır.
+//
+// Dönüş:
+//   - core.Schema (chainable)
+//
+// Örnek:
+//
+//	schema.TransitionRule("status", map[string][]string{
+//	    "draft":     {"published"},
+//	    "published": {"archived"},
+//	}, func(data map[string]any) (string, bool) {
+//	    return repo.CurrentStatus(data["id"])
+//	})
+func (vs *ValidationSchema) TransitionRule(field string, transitions map[string][]string, current func(data map[string]any) (string, bool)) *ValidationSchema {
+	vs.transitionRules = append(vs.transitionRules, transitionRule{
+		field:       field,
+		transitions: transitions,
+		current:     current,
+	})
+	return vs
+}
+
+// validateTransitions
+// -----------------------------------------------------------------------------
+// Sağlayıcısı tanımlı geçiş kurallarını Validate akışı içinde çalıştırır.
+func (vs *ValidationSchema) validateTransitions(data map[string]any, result *core.ValidationResult) {
+	for _, rule := range vs.transitionRules {
+		if rule.current == nil {
+			continue
+		}
+		if from, ok := rule.current(data); ok {
+			rule.check(from, data, result)
+		}
+	}
+}
+
+// validateStoredTransitions
+// -----------------------------------------------------------------------------
+// Sağlayıcısı olmayan geçiş kurallarını, mevcut durumu oldData'dan alarak
+// ValidateChanges akışı içinde çalıştırır.
+func (vs *ValidationSchema) validateStoredTransitions(oldData, newData, transformedData map[string]any, result *core.ValidationResult) {
+	for _, rule := range vs.transitionRules {
+		if rule.current != nil {
+			continue
+		}
+		oldRaw, inOld := oldData[rule.field]
+		if _, inNew := newData[rule.field]; !inOld || !inNew {
+			continue
+		}
+		if typ, ok := vs.shape[rule.field]; ok {
+			transformed, err := vs.transformValue(typ, oldRaw)
+			if err != nil {
+				continue
+			}
+			oldRaw = transformed
+		}
+		if from, ok := oldRaw.(string); ok {
+			rule.check(from, transformedData, result)
+		}
+	}
+}
+
+// check
+// -----------------------------------------------------------------------------
+// Alandaki yeni değerin from durumundan izinli bir geçiş olup olmadığını
+// kontrol eder. Zaten hatalı olan alanlar atlanır.
+func (r transitionRule) check(from string, data map[string]any, result *core.ValidationResult) {
+	if len(result.Errors()[r.field]) > 0 {
+		return
+	}
+	if err := r.evaluate(from, data); err != nil {
+		result.AddErrorFrom(r.field, "transition", err)
+	}
+}
+
+// evaluate
+// -----------------------------------------------------------------------------
+// Geçiş izinli değilse hatayı döndürür. String olmayan değerler atlanır.
+func (r transitionRule) evaluate(from string, data map[string]any) error {
+	to, ok := data[r.field].(string)
+	if !ok {
+		return nil
+	}
+	if !rules.IsAllowedTransition(r.transitions, from, to) {
+		return core.NewMessageError(i18n.KeyTransition, r.field, from, to)
+	}
+	return nil
+}
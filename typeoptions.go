@@ -0,0 +1,205 @@
+package validation
+
+import (
+	"github.com/biyonik/go-fluent-validator/core"
+	"github.com/biyonik/go-fluent-validator/types"
+)
+
+//
+// -----------------------------------------------------------------------------
+// Tip Yapıcı Seçenekleri (Functional Options)
+// -----------------------------------------------------------------------------
+// Tip yapıcıları, zincirleme metotlara ek olarak fonksiyonel seçenekler kabul
+// eder. Seçenekler, şemaları programatik olarak (yapılandırmadan, döngü içinde)
+// kuran kodun kuralları değer olarak taşıyabilmesini sağlar:
+//
+//	common := []validation.StringOption{validation.WithRequired(), validation.WithMax(50)}
+//	name := validation.String(common...).Min(2)
+//	age := validation.Number(validation.WithMin(18))
+//
+// Her yapıcının kendi seçenek arayüzü vardır (StringOption, NumberOption,
+// EmailOption...);
+// bir seçenek yalnızca anlamlı olduğu tiplerin arayüzünü karşılar. Böylece
+// validation.Boolean(validation.WithMax(5)) gibi bir hata derleme zamanında
+// yakalanır. Seçenekler verildikleri sırayla, zincirleme metotlardan önce
+// uygulanır.
+//
+// Şema seçenekleri (Make(WithStrict()), WithAutoTrim...) için bkz. SchemaOption.
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// StringOption, String() yapıcısının seçenekleridir.
+type StringOption interface{ applyString(*types.StringType) }
+
+// NumberOption, Number() yapıcısının seçenekleridir.
+type NumberOption interface{ applyNumber(*types.NumberType) }
+
+// BooleanOption, Boolean() yapıcısının seçenekleridir.
+type BooleanOption interface{ applyBoolean(*types.BooleanType) }
+
+// ArrayOption, Array() yapıcısının seçenekleridir.
+type ArrayOption interface{ applyArray(*types.ArrayType) }
+
+// ObjectOption, Object() yapıcısının seçenekleridir.
+type ObjectOption interface{ applyObject(*types.ObjectType) }
+
+// MapOption, Map() yapıcısının seçenekleridir.
+type MapOption interface{ applyMap(*types.MapType) }
+
+// DateOption, Date() yapıcısının seçenekleridir.
+type DateOption interface{ applyDate(*types.DateType) }
+
+// AnyOption, Any() yapıcısının seçenekleridir.
+type AnyOption interface{ applyAny(*types.AnyType) }
+
+// LiteralOption, Literal() yapıcısının seçenekleridir.
+type LiteralOption interface{ applyLiteral(*types.LiteralType) }
+
+// EmailOption, Email() yapıcısının seçenekleridir. Ortak seçeneklerin yanında
+// types.RejectFreeProviders gibi e-postaya özel seçenekleri de kabul eder;
+// bu yüzden metodu types paketinden erişilebilir (dışa açık) tutulur.
+type EmailOption interface{ ApplyEmail(*types.EmailType) }
+
+// UuidOption, Uuid() yapıcısının seçenekleridir.
+type UuidOption interface{ applyUuid(*types.UuidType) }
+
+// IbanOption, Iban() yapıcısının seçenekleridir.
+type IbanOption interface{ applyIban(*types.IbanType) }
+
+// CreditCardOption, CreditCard() yapıcısının seçenekleridir.
+type CreditCardOption interface{ applyCreditCard(*types.CreditCardType) }
+
+// BaseOption, tüm tiplerde ortak olan ayarları uygulayan seçenektir.
+type BaseOption func(baseSetter)
+
+func (o BaseOption) applyString(s *types.StringType)         { o(s) }
+func (o BaseOption) applyNumber(n *types.NumberType)         { o(n) }
+func (o BaseOption) applyBoolean(b *types.BooleanType)       { o(b) }
+func (o BaseOption) applyArray(a *types.ArrayType)           { o(a) }
+func (o BaseOption) applyObject(ob *types.ObjectType)        { o(ob) }
+func (o BaseOption) applyMap(m *types.MapType)               { o(m) }
+func (o BaseOption) applyDate(d *types.DateType)             { o(d) }
+func (o BaseOption) applyAny(a *types.AnyType)               { o(a) }
+func (o BaseOption) applyLiteral(l *types.LiteralType)       { o(l) }
+func (o BaseOption) applyUuid(u *types.UuidType)             { o(u) }
+func (o BaseOption) applyIban(i *types.IbanType)             { o(i) }
+func (o BaseOption) applyCreditCard(c *types.CreditCardType) { o(c) }
+
+// ApplyEmail, seçeneği verilen EmailType'a uygular (bkz. EmailOption).
+func (o BaseOption) ApplyEmail(e *types.EmailType) { o(e) }
+
+// WithRequired, alanı zorunlu kılar (bkz. Required()).
+func WithRequired() BaseOption {
+	return func(b baseSetter) { b.SetRequired() }
+}
+
+// WithLabel, hata mesajlarında kullanılacak alan adını belirler (bkz. Label()).
+func WithLabel(label string) BaseOption {
+	return func(b baseSetter) { b.SetLabel(label) }
+}
+
+// WithDescription, alanın dokümantasyon açıklamasını belirler (bkz. Describe()).
+func WithDescription(text string) BaseOption {
+	return func(b baseSetter) { b.SetDescription(text) }
+}
+
+// WithDefault, değer gönderilmediğinde kullanılacak varsayılanı belirler.
+// Değerin tipi alanın tipine uygun olmalıdır.
+func WithDefault(value any) BaseOption {
+	return func(b baseSetter) { b.SetDefault(value) }
+}
+
+// WithSeverity, alanın kurallarının önem seviyesini belirler (bkz. Severity()).
+func WithSeverity(level core.Severity) BaseOption {
+	return func(b baseSetter) { b.SetSeverity(level) }
+}
+
+// BoundOption, tipin ölçüsüne göre alt veya üst sınır koyan seçenektir:
+// string'lerde karakter, sayılarda değer, dizilerde eleman, map'lerde anahtar
+// sayısı. String, dizi ve map sınırlarında küsurat atılır.
+type BoundOption struct {
+	upper bool
+	value float64
+}
+
+// WithMin, alt sınırı belirler (bkz. Min(), MinKeys()).
+func WithMin(value float64) BoundOption {
+	return BoundOption{value: value}
+}
+
+// WithMax, üst sınırı belirler (bkz. Max(), MaxKeys()).
+func WithMax(value float64) BoundOption {
+	return BoundOption{upper: true, value: value}
+}
+
+func (o BoundOption) applyString(s *types.StringType) {
+	if o.upper {
+		s.Max(int(o.value))
+	} else {
+		s.Min(int(o.value))
+	}
+}
+
+func (o BoundOption) applyNumber(n *types.NumberType) {
+	if o.upper {
+		n.Max(o.value)
+	} else {
+		n.Min(o.value)
+	}
+}
+
+func (o BoundOption) applyArray(a *types.ArrayType) {
+	if o.upper {
+		a.Max(int(o.value))
+	} else {
+		a.Min(int(o.value))
+	}
+}
+
+func (o BoundOption) applyMap(m *types.MapType) {
+	if o.upper {
+		m.MaxKeys(int(o.value))
+	} else {
+		m.MinKeys(int(o.value))
+	}
+}
+
+// arrayOption, yalnızca dizilere uygulanan seçenektir.
+type arrayOption func(*types.ArrayType)
+
+func (o arrayOption) applyArray(a *types.ArrayType) { o(a) }
+
+// WithElements, dizinin eleman şemasını belirler (bkz. Elements()).
+func WithElements(schema core.Type) ArrayOption {
+	return arrayOption(func(a *types.ArrayType) { a.Elements(schema) })
+}
+
+// objectOption, yalnızca nesnelere uygulanan seçenektir.
+type objectOption func(*types.ObjectType)
+
+func (o objectOption) applyObject(ob *types.ObjectType) { o(ob) }
+
+// WithShape, nesnenin alt alanlarını belirler (bkz. Shape()).
+func WithShape(shape map[string]core.Type) ObjectOption {
+	return objectOption(func(o *types.ObjectType) { o.Shape(shape) })
+}
+
+// mapOption, yalnızca map'lere uygulanan seçenektir.
+type mapOption func(*types.MapType)
+
+func (o mapOption) applyMap(m *types.MapType) { o(m) }
+
+// WithKeys, map anahtarlarının şemasını belirler (bkz. Keys()).
+func WithKeys(schema core.Type) MapOption {
+	return mapOption(func(m *types.MapType) { m.Keys(schema) })
+}
+
+// WithValues, map değerlerinin şemasını belirler (bkz. Values()).
+func WithValues(schema core.Type) MapOption {
+	return mapOption(func(m *types.MapType) { m.Values(schema) })
+}
@@ -37,6 +37,7 @@
 package types
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/biyonik/go-fluent-validator/core"
@@ -51,12 +52,13 @@ type AdvancedStringType struct {
 	turkishChars *bool   // Türkçe karakter içermeli mi / içermemeli mi?
 	domainCheck  *bool   // Domain doğrulaması yapılacak mı?
 	charSet      *string // Belirli bir karakter seti zorunluluğu
+	dns          dnsChecks
 }
 
 // StripTags, verilen string içindeki HTML etiketlerini (izin verilenler hariç)
 // temizler. Kullanıcı girdisini normalize etmek için sıkça kullanılır.
 func (as *AdvancedStringType) StripTags(allowedTags ...string) *AdvancedStringType {
-	as.AddTransform(func(value any) (any, error) {
+	as.AddNamedTransform("strip_tags", func(value any) (any, error) {
 		str, ok := value.(string)
 		if !ok {
 			return nil, fmt.Errorf("StripTags sadece string'lere uygulanabilir")
@@ -69,7 +71,7 @@ func (as *AdvancedStringType) StripTags(allowedTags ...string) *AdvancedStringTy
 // EscapeHTML, XSS saldırılarına karşı HTML karakterlerini güvenli hale getirir.
 // Güvenlik seviyesi yüksek projelerde mutlaka kullanılmalıdır.
 func (as *AdvancedStringType) EscapeHTML() *AdvancedStringType {
-	as.AddTransform(func(value any) (any, error) {
+	as.AddNamedTransform("escape_html", func(value any) (any, error) {
 		str, ok := value.(string)
 		if !ok {
 			return nil, fmt.Errorf("EscapeHTML sadece string'lere uygulanabilir")
@@ -82,7 +84,7 @@ func (as *AdvancedStringType) EscapeHTML() *AdvancedStringType {
 // SanitizeFilename, bir dosya adını güvenli hale getirir. Sistem çağrılarına veya
 // dosya işlemlerine zarar verebilecek karakterleri temizler.
 func (as *AdvancedStringType) SanitizeFilename() *AdvancedStringType {
-	as.AddTransform(func(value any) (any, error) {
+	as.AddNamedTransform("sanitize_filename", func(value any) (any, error) {
 		str, ok := value.(string)
 		if !ok {
 			return nil, fmt.Errorf("SanitizeFilename sadece string'lere uygulanabilir")
@@ -96,7 +98,7 @@ func (as *AdvancedStringType) SanitizeFilename() *AdvancedStringType {
 // filtreler. Chat sistemi, loglama veya özel karakter sınırlaması olan sistemlerde
 // kullanılır.
 func (as *AdvancedStringType) FilterEmoji(remove bool) *AdvancedStringType {
-	as.AddTransform(func(value any) (any, error) {
+	as.AddNamedTransform("filter_emoji", func(value any) (any, error) {
 		str, ok := value.(string)
 		if !ok {
 			return nil, fmt.Errorf("FilterEmoji sadece string'lere uygulanabilir")
@@ -121,8 +123,36 @@ func (as *AdvancedStringType) Domain(allowSubdomain bool) *AdvancedStringType {
 	return as
 }
 
+// DomainResolvable, alan adının DNS'te bir adrese çözülmesini zorunlu kılar.
+// Sorgu şemanın dış kaynaklı (asenkron) adımında ValidateCtx'e verilen
+// context ile yapılır.
+//
+// Örnek:
+//
+//	"domain": validation.AdvancedString().Required().Domain(true).
+//	    DomainResolvable().HasTXT("acme-verify="+token),
+func (as *AdvancedStringType) DomainResolvable() *AdvancedStringType {
+	as.dns.resolvable = true
+	return as
+}
+
+// HasMX, alan adının e-posta kabul eden bir MX kaydı olmasını zorunlu kılar.
+func (as *AdvancedStringType) HasMX() *AdvancedStringType {
+	as.dns.mx = true
+	return as
+}
+
+// HasTXT, alan adının prefix ile başlayan bir TXT kaydı yayımlamasını zorunlu
+// kılar (alan adı sahipliği doğrulaması için).
+func (as *AdvancedStringType) HasTXT(prefix string) *AdvancedStringType {
+	as.dns.txtPrefix = &prefix
+	return as
+}
+
 // CharSet, bu string'in belirli bir karakter setine uygun olması zorunluluğunu ayarlar.
-// Örn: "alpha", "alphanumeric", "numeric", "hex" vb.
+// Örn: "alpha", "alphanumeric", "numeric", "hex" vb. Unicode harfleri kabul
+// eden "unicode_alpha", "unicode_alphanumeric" ve "alpha_space" setleri de
+// kullanılabilir (bkz. rules.CharSetPatterns).
 func (as *AdvancedStringType) CharSet(set string) *AdvancedStringType {
 	as.charSet = &set
 	return as
@@ -134,17 +164,113 @@ func (as *AdvancedStringType) Required() *AdvancedStringType {
 	return as
 }
 
+// Optional, alanın anahtarının payload'da hiç bulunmayabileceğini belirtir;
+// eksik anahtar Required olsa bile geçerlidir ve ValidData'ya yazılmaz.
+func (as *AdvancedStringType) Optional() *AdvancedStringType {
+	as.StringType.Optional()
+	return as
+}
+
+// Nullable, alanın açıkça null gönderilebileceğini belirtir; null değer
+// Required olsa bile geçerlidir ve ValidData'da korunur.
+func (as *AdvancedStringType) Nullable() *AdvancedStringType {
+	as.StringType.Nullable()
+	return as
+}
+
 // Label, kullanıcıya gösterilecek alan adını özelleştirir.
 func (as *AdvancedStringType) Label(label string) *AdvancedStringType {
 	as.StringType.Label(label)
 	return as
 }
 
+// Severity, alanın kurallarının önem seviyesini belirler (error, warning, info).
+func (as *AdvancedStringType) Severity(level core.Severity) *AdvancedStringType {
+	as.StringType.Severity(level)
+	return as
+}
+
+// Describe, alan için dokümantasyon amaçlı açıklama tanımlar.
+func (as *AdvancedStringType) Describe(text string) *AdvancedStringType {
+	as.StringType.Describe(text)
+	return as
+}
+
+// Example, dokümantasyonda gösterilecek örnek bir değer ekler.
+func (as *AdvancedStringType) Example(value any) *AdvancedStringType {
+	as.StringType.Example(value)
+	return as
+}
+
+// Deprecated, alanı kullanımdan kaldırılmış olarak işaretler.
+func (as *AdvancedStringType) Deprecated(reason string) *AdvancedStringType {
+	as.StringType.Deprecated(reason)
+	return as
+}
+
+// Trim, baştaki ve sondaki boşlukları temizler. Zincirin AdvancedStringType
+// olarak devam edebilmesi için StringType.Trim üzerine yazılmıştır.
+func (as *AdvancedStringType) Trim() *AdvancedStringType {
+	as.StringType.Trim()
+	return as
+}
+
+// NoTrim, şema seviyesindeki otomatik trim işleminden bu alanı muaf tutar.
+func (as *AdvancedStringType) NoTrim() *AdvancedStringType {
+	as.StringType.NoTrim()
+	return as
+}
+
+// Sensitive, alanı hassas olarak işaretler.
+func (as *AdvancedStringType) Sensitive() *AdvancedStringType {
+	as.StringType.Sensitive()
+	return as
+}
+
+// Introspect, gelişmiş string tipinin temel string kuralları ile birlikte ek
+// kurallarını yapısal olarak döndürür.
+func (as *AdvancedStringType) Introspect() *core.TypeDescription {
+	desc := as.DescribeBase("string")
+	as.describeRules(desc)
+	if as.turkishChars != nil {
+		desc.AddRule("turkish_chars", map[string]any{"allow": *as.turkishChars})
+	}
+	if as.domainCheck != nil {
+		desc.AddRule("domain", map[string]any{"allow_subdomain": *as.domainCheck})
+	}
+	if as.charSet != nil {
+		desc.AddRule("charset", map[string]any{"value": *as.charSet})
+	}
+	as.dns.describe(desc)
+	return desc
+}
+
+// HasAsyncRules, core.AsyncOptional implementasyonu; temel string kurallarına
+// ek olarak DNS kontrolleri tanımlıysa true döner.
+func (as *AdvancedStringType) HasAsyncRules() bool {
+	return as.StringType.HasAsyncRules() || as.dns.enabled()
+}
+
+// ValidateAsync, core.AsyncValidatable implementasyonu; temel string
+// kontrollerinden sonra alan adını DNS'te denetler.
+func (as *AdvancedStringType) ValidateAsync(ctx context.Context, field string, value any, result *core.ValidationResult) {
+	as.StringType.ValidateAsync(ctx, field, value, result)
+	if str, ok := value.(string); ok && str != "" && as.dns.enabled() {
+		as.dns.validate(ctx, field, as.GetLabel(field), str, result)
+	}
+}
+
+// Immutable, alanın güncelleme sırasında değiştirilemeyeceğini belirtir.
+func (as *AdvancedStringType) Immutable() *AdvancedStringType {
+	as.StringType.Immutable()
+	return as
+}
+
 // Validate, tüm gelişmiş string kurallarını uygular ve olası hataları ValidationResult'a
 // ekler. Önce temel StringType doğrulaması yapılır, ardından gelişmiş kontroller çalışır.
 func (as *AdvancedStringType) Validate(field string, value any, result *core.ValidationResult) {
 	as.StringType.Validate(field, value, result)
-	if result.HasErrors() || value == nil {
+	if result.HasFieldErrors(field) || value == nil {
 		return
 	}
 
@@ -154,21 +280,21 @@ func (as *AdvancedStringType) Validate(field string, value any, result *core.Val
 	if as.turkishChars != nil {
 		hasTurkish := rules.HasTurkishChars(str)
 		if *as.turkishChars && !hasTurkish {
-			result.AddError(field, fmt.Sprintf("%s alanında Türkçe karakter bulunmalıdır", fieldName))
+			result.AddErrorRule(field, "turkish_chars", fmt.Sprintf("%s alanında Türkçe karakter bulunmalıdır", fieldName))
 		} else if !*as.turkishChars && hasTurkish {
-			result.AddError(field, fmt.Sprintf("%s alanında Türkçe karakter bulunmamalıdır", fieldName))
+			result.AddErrorRule(field, "turkish_chars", fmt.Sprintf("%s alanında Türkçe karakter bulunmamalıdır", fieldName))
 		}
 	}
 
 	if as.domainCheck != nil {
 		if !rules.IsValidDomain(str, *as.domainCheck) {
-			result.AddError(field, fmt.Sprintf("%s alanı geçerli bir alan adı olmalıdır", fieldName))
+			result.AddErrorRule(field, "domain", fmt.Sprintf("%s alanı geçerli bir alan adı olmalıdır", fieldName))
 		}
 	}
 
 	if as.charSet != nil {
 		if !rules.ValidateCharSet(str, *as.charSet) {
-			result.AddError(field, fmt.Sprintf("%s alanı '%s' karakter setine uymalıdır", fieldName, *as.charSet))
+			result.AddErrorRule(field, "charset", fmt.Sprintf("%s alanı '%s' karakter setine uymalıdır", fieldName, *as.charSet))
 		}
 	}
 }
@@ -0,0 +1,157 @@
+// -----------------------------------------------------------------------------
+// AnyType
+// -----------------------------------------------------------------------------
+// Değerin biçimini kısıtlamayan (passthrough) alan tipidir. Şemanın içeriğine
+// karışmadan iletmesi gereken bölümler (webhook gövdesindeki ham "data",
+// üçüncü parti servislere aktarılan metadata) için kullanılır:
+//
+//	"payload": validation.Any().Required()
+//
+// Her değer kabul edilir; Required, Default, Custom ve TransformWith diğer
+// tiplerdeki gibi çalışır. Değer ValidData'ya olduğu gibi (varsa dönüşümlerden
+// geçmiş haliyle) yazılır.
+//
+// Yazar Bilgileri:
+// @author   Ahmet ALTUN
+// @github   github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email    ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+package types
+
+import "github.com/biyonik/go-fluent-validator/core"
+
+// AnyType, herhangi bir değeri kabul eden alan tipidir.
+type AnyType struct {
+	core.BaseType
+	customValidation *core.CustomValidation
+}
+
+// Required, alanın zorunlu olduğunu belirtir; nil ve boş string reddedilir.
+func (a *AnyType) Required() *AnyType {
+	a.SetRequired()
+	return a
+}
+
+// Optional, alanın anahtarının payload'da hiç bulunmayabileceğini belirtir;
+// eksik anahtar Required olsa bile geçerlidir ve ValidData'ya yazılmaz.
+func (a *AnyType) Optional() *AnyType {
+	a.SetOptional()
+	return a
+}
+
+// Nullable, alanın açıkça null gönderilebileceğini belirtir; null değer
+// Required olsa bile geçerlidir ve ValidData'da korunur.
+func (a *AnyType) Nullable() *AnyType {
+	a.SetNullable()
+	return a
+}
+
+// Label, kullanıcıya gösterilecek alan adını özelleştirir.
+func (a *AnyType) Label(label string) *AnyType {
+	a.SetLabel(label)
+	return a
+}
+
+// Severity, alanın kurallarının önem seviyesini belirler (error, warning, info).
+// SeverityError dışındaki ihlaller şemanın politikasına göre uyarı olarak raporlanır.
+func (a *AnyType) Severity(level core.Severity) *AnyType {
+	a.SetSeverity(level)
+	return a
+}
+
+// Describe, alan için dokümantasyon amaçlı açıklama tanımlar. Doğrulamayı
+// etkilemez; Describe() çıktısında ve üretilen dokümanlarda görünür.
+func (a *AnyType) Describe(text string) *AnyType {
+	a.SetDescription(text)
+	return a
+}
+
+// Example, dokümantasyonda gösterilecek örnek bir değer ekler.
+func (a *AnyType) Example(value any) *AnyType {
+	a.AddExample(value)
+	return a
+}
+
+// Deprecated, alanı kullanımdan kaldırılmış olarak işaretler. reason, kaldırma
+// gerekçesini veya yerine kullanılacak alanı belirtir.
+func (a *AnyType) Deprecated(reason string) *AnyType {
+	a.SetDeprecated(reason)
+	return a
+}
+
+// Default, değer gönderilmediğinde kullanılacak varsayılan değeri tanımlar.
+func (a *AnyType) Default(value any) *AnyType {
+	a.SetDefault(value)
+	return a
+}
+
+// Immutable, alanın güncelleme sırasında (ValidateChanges) değiştirilemeyeceğini
+// belirtir.
+func (a *AnyType) Immutable() *AnyType {
+	a.SetImmutable()
+	return a
+}
+
+// TransformWith, doğrulamadan önce değere uygulanacak bir dönüşüm ekler.
+// Dönüşümler eklendikleri sırayla çalışır; hata dönen dönüşüm alana dönüşüm
+// hatası olarak yazılır.
+//
+// Örnek:
+//
+//	validation.Any().TransformWith(func(v any) (any, error) {
+//	    return redact(v), nil
+//	})
+func (a *AnyType) TransformWith(fn func(any) (any, error)) *AnyType {
+	a.AddTransform(fn)
+	return a
+}
+
+// Custom, değer üzerinde çalışan özel bir doğrulayıcı ekler. nil değerler
+// için çağrılmaz.
+func (a *AnyType) Custom(validator func(any) error) *AnyType {
+	if a.customValidation == nil {
+		a.customValidation = core.NewCustomValidation()
+	}
+
+	a.customValidation.AddSync(func(value any) error {
+		if value == nil {
+			return nil
+		}
+		return validator(value)
+	})
+
+	return a
+}
+
+// AddRule, özel bir doğrulama kuralı ekler.
+func (a *AnyType) AddRule(rule core.Rule) *AnyType {
+	if a.customValidation == nil {
+		a.customValidation = core.NewCustomValidation()
+	}
+	a.customValidation.AddRule(rule)
+	return a
+}
+
+// Introspect, any tipinin tanımını yapısal olarak döndürür.
+func (a *AnyType) Introspect() *core.TypeDescription {
+	desc := a.DescribeBase("any")
+	desc.CustomRules = a.customValidation.Count()
+	return desc
+}
+
+// Validate, zorunluluk kontrolünü ve varsa özel doğrulayıcıları çalıştırır.
+func (a *AnyType) Validate(field string, value any, result *core.ValidationResult) {
+	a.BaseType.Validate(field, value, result)
+	if result.HasFieldErrors(field) {
+		return
+	}
+	if value == nil {
+		return
+	}
+
+	if a.customValidation != nil && a.customValidation.HasValidators() {
+		a.customValidation.ValidateSync(field, value, result)
+	}
+}
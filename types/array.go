@@ -32,6 +32,7 @@ package types
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/biyonik/go-fluent-validator/core"
 	"github.com/biyonik/go-fluent-validator/i18n"
@@ -42,14 +43,18 @@ import (
 // özellikleri içerir.
 type ArrayType struct {
 	core.BaseType
-	minLength        *int      // Minimum eleman sayısı
-	maxLength        *int      // Maksimum eleman sayısı
-	elementSchema    core.Type // Her bir elemanın uyacağı şema
+	minLength        *int                 // Minimum eleman sayısı
+	maxLength        *int                 // Maksimum eleman sayısı
+	elementSchema    core.Type            // Her bir elemanın uyacağı şema
+	discriminator    string               // ElementsBy ile şemayı seçen eleman alanı
+	variants         map[string]core.Type // Ayırıcı değerine göre eleman şemaları
 	customValidation *core.CustomValidation
 	// New validators
 	isUnique         bool
 	containsValue    *any
 	isNotEmpty       bool
+	overlapStart     string
+	overlapEnd       string
 }
 
 // Required, alanın zorunlu olduğunu belirtir.
@@ -58,12 +63,53 @@ func (a *ArrayType) Required() *ArrayType {
 	return a
 }
 
+// Optional, alanın anahtarının payload'da hiç bulunmayabileceğini belirtir;
+// eksik anahtar Required olsa bile geçerlidir ve ValidData'ya yazılmaz.
+func (a *ArrayType) Optional() *ArrayType {
+	a.SetOptional()
+	return a
+}
+
+// Nullable, alanın açıkça null gönderilebileceğini belirtir; null değer
+// Required olsa bile geçerlidir ve ValidData'da korunur.
+func (a *ArrayType) Nullable() *ArrayType {
+	a.SetNullable()
+	return a
+}
+
 // Label, kullanıcıya gösterilecek alan adını özelleştirir.
 func (a *ArrayType) Label(label string) *ArrayType {
 	a.SetLabel(label)
 	return a
 }
 
+// Severity, alanın kurallarının önem seviyesini belirler (error, warning, info).
+// SeverityError dışındaki ihlaller şemanın politikasına göre uyarı olarak raporlanır.
+func (a *ArrayType) Severity(level core.Severity) *ArrayType {
+	a.SetSeverity(level)
+	return a
+}
+
+// Describe, alan için dokümantasyon amaçlı açıklama tanımlar. Doğrulamayı
+// etkilemez; Describe() çıktısında ve üretilen dokümanlarda görünür.
+func (a *ArrayType) Describe(text string) *ArrayType {
+	a.SetDescription(text)
+	return a
+}
+
+// Example, dokümantasyonda gösterilecek örnek bir değer ekler.
+func (a *ArrayType) Example(value any) *ArrayType {
+	a.AddExample(value)
+	return a
+}
+
+// Deprecated, alanı kullanımdan kaldırılmış olarak işaretler. reason, kaldırma
+// gerekçesini veya yerine kullanılacak alanı belirtir.
+func (a *ArrayType) Deprecated(reason string) *ArrayType {
+	a.SetDeprecated(reason)
+	return a
+}
+
 // Min, dizide bulunması gereken minimum eleman sayısını tanımlar.
 func (a *ArrayType) Min(length int) *ArrayType {
 	a.minLength = &length
@@ -83,6 +129,35 @@ func (a *ArrayType) Elements(schema core.Type) *ArrayType {
 	return a
 }
 
+// ElementsBy
+// -----------------------------------------------------------------------------
+// Dizi içinde ayrık birleşim (discriminated union) tanımlar: her eleman bir
+// nesne olmalı ve kendi discriminator alanının değerine göre variants içinden
+// seçilen şemaya göre doğrulanır. CMS blok editörleri gibi farklı türde
+// elemanlar içeren listeler için kullanılır.
+//
+// Ayırıcı alanı eksik olan elemanlar için `field[i].<discriminator>` alanına
+// zorunluluk hatası, tanımsız bir değer için izin verilen değerleri listeleyen
+// bir hata eklenir.
+//
+// Örnek:
+//
+//	validation.Array().ElementsBy("type", map[string]core.Type{
+//	    "image": validation.Object().Shape(imageShape),
+//	    "video": validation.Object().Shape(videoShape),
+//	})
+func (a *ArrayType) ElementsBy(discriminator string, variants map[string]core.Type) *ArrayType {
+	a.discriminator = discriminator
+	a.variants = variants
+	return a
+}
+
+// GetElementSchema, dizinin elemanlarına uygulanan şemayı döndürür.
+// Eleman şeması tanımlanmamışsa nil döner.
+func (a *ArrayType) GetElementSchema() core.Type {
+	return a.elementSchema
+}
+
 // Transform, dizinin kendisini ve varsa elemanlarını dönüştürür.
 // Bu aşama, veri normalize etme (ör. trim, type-cast) için kritiktir.
 func (a *ArrayType) Transform(value any) (any, error) {
@@ -99,10 +174,17 @@ func (a *ArrayType) Transform(value any) (any, error) {
 		return nil, fmt.Errorf("dizi (array) tipinde olmalıdır")
 	}
 
-	if a.elementSchema != nil {
+	if a.elementSchema != nil || a.variants != nil {
 		transformedSlice := make([]any, len(slice))
 		for i, item := range slice {
-			transformedItem, err := a.elementSchema.Transform(item)
+			schema := a.elementSchema
+			if a.variants != nil {
+				if schema, _ = a.variantOf(item); schema == nil {
+					transformedSlice[i] = item
+					continue
+				}
+			}
+			transformedItem, err := schema.Transform(item)
 			if err != nil {
 				return nil, fmt.Errorf("dizi index %d: %w", i, err)
 			}
@@ -160,11 +242,90 @@ func (a *ArrayType) NotEmpty() *ArrayType {
 	return a
 }
 
+// Introspect, dizi tipinin kurallarını ve eleman şemasını yapısal olarak döndürür.
+func (a *ArrayType) Introspect() *core.TypeDescription {
+	desc := a.DescribeBase("array")
+	if a.minLength != nil {
+		desc.AddRule("min", map[string]any{"value": *a.minLength})
+	}
+	if a.maxLength != nil {
+		desc.AddRule("max", map[string]any{"value": *a.maxLength})
+	}
+	if a.isNotEmpty {
+		desc.AddRule("not_empty", nil)
+	}
+	if a.isUnique {
+		desc.AddRule("unique", nil)
+	}
+	if a.containsValue != nil {
+		desc.AddRule("contains", map[string]any{"value": *a.containsValue})
+	}
+	if a.overlapStart != "" {
+		desc.AddRule("no_overlap", map[string]any{"start": a.overlapStart, "end": a.overlapEnd})
+	}
+	desc.CustomRules = a.customValidation.Count()
+	desc.Elements = core.DescribeType(a.elementSchema)
+	if a.variants != nil {
+		desc.Elements = &core.TypeDescription{
+			Type:          "object",
+			Discriminator: a.discriminator,
+			Variants:      make(map[string]*core.TypeDescription, len(a.variants)),
+		}
+		for kind, typ := range a.variants {
+			desc.Elements.Variants[kind] = core.DescribeType(typ)
+		}
+	}
+	return desc
+}
+
+// variantOf, elemanın ayırıcı değerine karşılık gelen şemayı ve ayırıcı
+// değerini döndürür. Eleman nesne değilse veya değer tanımsızsa şema nil olur.
+func (a *ArrayType) variantOf(item any) (core.Type, any) {
+	obj, ok := item.(map[string]any)
+	if !ok {
+		return nil, nil
+	}
+	kind := obj[a.discriminator]
+	key, ok := kind.(string)
+	if !ok {
+		return nil, kind
+	}
+	return a.variants[key], kind
+}
+
+// validateVariant, ElementsBy ile tanımlanan ayrık birleşim elemanını doğrular.
+func (a *ArrayType) validateVariant(path string, item any, result *core.ValidationResult) {
+	if _, ok := item.(map[string]any); !ok {
+		result.AddRuleError(path, i18n.KeyObject, path)
+		return
+	}
+
+	schema, kind := a.variantOf(item)
+	if schema != nil {
+		schema.Validate(path, item, result)
+		return
+	}
+
+	discriminatorPath := path + "." + a.discriminator
+	if kind == nil || kind == "" {
+		result.AddRuleError(discriminatorPath, i18n.KeyRequired, discriminatorPath)
+		return
+	}
+
+	kinds := make([]string, 0, len(a.variants))
+	for k := range a.variants {
+		kinds = append(kinds, k)
+	}
+	sort.Strings(kinds)
+	values := i18n.StringList(kinds)
+	result.AddRuleError(discriminatorPath, i18n.KeyDiscriminator, discriminatorPath, values, kind, i18n.Attrs{"values": values})
+}
+
 // Validate, dizinin uzunluk doğrulamasını ve eleman doğrulamasını yapar.
 // Hatalar, `field[0]`, `field[1]` formatında detaylı bir şekilde işlenir.
 func (a *ArrayType) Validate(field string, value any, result *core.ValidationResult) {
 	a.BaseType.Validate(field, value, result)
-	if result.HasErrors() {
+	if result.HasFieldErrors(field) {
 		return
 	}
 	if value == nil {
@@ -173,34 +334,36 @@ func (a *ArrayType) Validate(field string, value any, result *core.ValidationRes
 
 	slice, ok := value.([]any)
 	if !ok {
-		result.AddError(field, i18n.Get(i18n.KeyArray, a.GetLabel(field)))
+		result.AddRuleError(field, i18n.KeyArray, a.GetLabel(field))
 		return
 	}
 
 	fieldName := a.GetLabel(field)
 
 	if a.minLength != nil && len(slice) < *a.minLength {
-		result.AddError(field, i18n.Get(i18n.KeyMinElements, fieldName, *a.minLength))
+		result.AddRuleError(field, i18n.KeyMinElements, fieldName, *a.minLength)
 	}
 	if a.maxLength != nil && len(slice) > *a.maxLength {
-		result.AddError(field, i18n.Get(i18n.KeyMaxElements, fieldName, *a.maxLength))
+		result.AddRuleError(field, i18n.KeyMaxElements, fieldName, *a.maxLength)
 	}
 
 	// New validators
 	if a.isNotEmpty && len(slice) == 0 {
-		result.AddError(field, i18n.Get(i18n.KeyNotEmpty, fieldName))
+		result.AddRuleError(field, i18n.KeyNotEmpty, fieldName)
 	}
 
 	if a.isUnique {
 		seen := make(map[string]bool)
 		for i, item := range slice {
+			if i%core.ExpiryCheckInterval == 0 && result.Expired() {
+				break
+			}
 			key := fmt.Sprintf("%v", item)
 			if seen[key] {
-				result.AddError(field, i18n.Get(i18n.KeyUnique, fieldName))
+				result.AddRuleError(field, i18n.KeyUnique, fieldName)
 				break
 			}
 			seen[key] = true
-			_ = i // prevent unused variable warning
 		}
 	}
 
@@ -213,7 +376,7 @@ func (a *ArrayType) Validate(field string, value any, result *core.ValidationRes
 			}
 		}
 		if !found {
-			result.AddError(field, i18n.Get(i18n.KeyArrayContains, fieldName, *a.containsValue))
+			result.AddRuleError(field, i18n.KeyArrayContains, fieldName, *a.containsValue)
 		}
 	}
 
@@ -221,10 +384,29 @@ func (a *ArrayType) Validate(field string, value any, result *core.ValidationRes
 		a.customValidation.ValidateSync(field, value, result)
 	}
 
-	if a.elementSchema != nil {
+	// Her eleman kendi sonucu üzerinde doğrulanır; böylece hatalı bir eleman
+	// diğerlerinin doğrulanmasını engellemez ve indeks bazlı sonuçlar tutulur.
+	if a.elementSchema != nil || a.variants != nil {
+		elements := make([]core.ElementResult, 0, len(slice))
 		for i, item := range slice {
+			// Süre sınırı dolduysa kalan elemanlar atlanır (bkz. core.Expired).
+			if i%core.ExpiryCheckInterval == 0 && result.Expired() {
+				break
+			}
 			elementFieldPath := fmt.Sprintf("%s[%d]", field, i)
-			a.elementSchema.Validate(elementFieldPath, item, result)
+			elementResult := result.Child()
+			if a.variants != nil {
+				a.validateVariant(elementFieldPath, item, elementResult)
+			} else {
+				a.elementSchema.Validate(elementFieldPath, item, elementResult)
+			}
+			result.Merge(elementResult)
+			elements = append(elements, core.ElementResult{Index: i, Value: item, Errors: elementResult.Errors()})
 		}
+		result.SetElements(field, elements)
+	}
+
+	if a.overlapStart != "" {
+		a.validateNoOverlap(field, slice, result)
 	}
 }
@@ -0,0 +1,92 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/biyonik/go-fluent-validator/core"
+	"github.com/biyonik/go-fluent-validator/i18n"
+)
+
+//
+// -----------------------------------------------------------------------------
+// Aralık Çakışması (NoOverlap)
+// -----------------------------------------------------------------------------
+// Elemanları başlangıç/bitiş alanlarına sahip nesneler olan dizilerde
+// (vardiyalar, rezervasyonlar, fiyat kademeleri) aralıkların çakışmadığını
+// denetler. Aralıklar yarı açıktır: [başlangıç, bitiş). Bir aralığın bittiği
+// anda başlayan aralık çakışma sayılmaz (09:00–12:00 ve 12:00–15:00 geçerlidir).
+//
+// Sınırlar sayı, time.Time veya string olabilir; string'ler sözlük sırasıyla
+// karşılaştırılır (ISO 8601 tarih ve "15:04" saatleri için doğru sıradır).
+// Aralıklar başlangıca göre sıralanıp tek geçişte taranır (O(n log n)).
+// Çakışan her eleman için hata "alan[i]" yoluna, çakıştığı elemanın indeksiyle
+// yazılır. Kendi doğrulaması başarısız olan veya sınırları karşılaştırılamayan
+// elemanlar atlanır.
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// NoOverlap, dizideki nesnelerin [start, end) aralıklarının birbiriyle
+// çakışmamasını sağlar.
+//
+// Örnek:
+//
+//	"shifts": validation.Array().NoOverlap("start", "end").Elements(validation.Object().Shape(...)),
+func (a *ArrayType) NoOverlap(startKey, endKey string) *ArrayType {
+	a.overlapStart = startKey
+	a.overlapEnd = endKey
+	return a
+}
+
+// rangeItem, çakışma taramasında kullanılan tek bir aralıktır.
+type rangeItem struct {
+	index      int
+	start, end any
+}
+
+// validateNoOverlap, aralıkları başlangıca göre sıralar ve o ana kadarki en
+// geç biten aralıkla çakışan her elemana hata yazar.
+func (a *ArrayType) validateNoOverlap(field string, slice []any, result *core.ValidationResult) {
+	ranges := make([]rangeItem, 0, len(slice))
+	for i, item := range slice {
+		path := fmt.Sprintf("%s[%d]", field, i)
+		obj, ok := item.(map[string]any)
+		if !ok || result.HasFieldErrors(path) {
+			continue
+		}
+		start, end := obj[a.overlapStart], obj[a.overlapEnd]
+		if cmp, ok := core.CompareValues(start, end); !ok || cmp > 0 {
+			continue
+		}
+		ranges = append(ranges, rangeItem{index: i, start: start, end: end})
+	}
+
+	sort.SliceStable(ranges, func(i, j int) bool {
+		cmp, _ := core.CompareValues(ranges[i].start, ranges[j].start)
+		return cmp < 0
+	})
+
+	if len(ranges) < 2 {
+		return
+	}
+	fieldName := a.GetLabel(field)
+	latest := ranges[0] // o ana kadar en geç biten aralık
+	for _, current := range ranges[1:] {
+		if cmp, _ := core.CompareValues(current.start, latest.end); cmp < 0 {
+			first, second := latest.index, current.index
+			if first > second {
+				first, second = second, first
+			}
+			result.AddRuleError(fmt.Sprintf("%s[%d]", field, second), i18n.KeyNoOverlap,
+				fmt.Sprintf("%s[%d]", fieldName, second), fmt.Sprintf("%s[%d]", fieldName, first))
+		}
+		if cmp, _ := core.CompareValues(current.end, latest.end); cmp > 0 {
+			latest = current
+		}
+	}
+}
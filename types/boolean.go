@@ -52,6 +52,20 @@ func (b *BooleanType) Required() *BooleanType {
 	return b
 }
 
+// Optional, alanın anahtarının payload'da hiç bulunmayabileceğini belirtir;
+// eksik anahtar Required olsa bile geçerlidir ve ValidData'ya yazılmaz.
+func (b *BooleanType) Optional() *BooleanType {
+	b.SetOptional()
+	return b
+}
+
+// Nullable, alanın açıkça null gönderilebileceğini belirtir; null değer
+// Required olsa bile geçerlidir ve ValidData'da korunur.
+func (b *BooleanType) Nullable() *BooleanType {
+	b.SetNullable()
+	return b
+}
+
 // Label, alan için okunabilir ve anlamlı bir isim belirler.
 // Bu label, hata mesajlarında kullanıcıya daha anlaşılır geri bildirim vermek
 // için kullanılır.
@@ -60,6 +74,33 @@ func (b *BooleanType) Label(label string) *BooleanType {
 	return b
 }
 
+// Severity, alanın kurallarının önem seviyesini belirler (error, warning, info).
+// SeverityError dışındaki ihlaller şemanın politikasına göre uyarı olarak raporlanır.
+func (b *BooleanType) Severity(level core.Severity) *BooleanType {
+	b.SetSeverity(level)
+	return b
+}
+
+// Describe, alan için dokümantasyon amaçlı açıklama tanımlar. Doğrulamayı
+// etkilemez; Describe() çıktısında ve üretilen dokümanlarda görünür.
+func (b *BooleanType) Describe(text string) *BooleanType {
+	b.SetDescription(text)
+	return b
+}
+
+// Example, dokümantasyonda gösterilecek örnek bir değer ekler.
+func (b *BooleanType) Example(value any) *BooleanType {
+	b.AddExample(value)
+	return b
+}
+
+// Deprecated, alanı kullanımdan kaldırılmış olarak işaretler. reason, kaldırma
+// gerekçesini veya yerine kullanılacak alanı belirtir.
+func (b *BooleanType) Deprecated(reason string) *BooleanType {
+	b.SetDeprecated(reason)
+	return b
+}
+
 // Default, bu boolean alan için bir varsayılan değer tanımlar.
 // Veri gelmediğinde veya boş olduğunda bu değer otomatik olarak atanır.
 func (b *BooleanType) Default(value bool) *BooleanType {
@@ -67,6 +108,13 @@ func (b *BooleanType) Default(value bool) *BooleanType {
 	return b
 }
 
+// Immutable, alanın güncelleme sırasında (ValidateChanges) değiştirilemeyeceğini
+// belirtir.
+func (b *BooleanType) Immutable() *BooleanType {
+	b.SetImmutable()
+	return b
+}
+
 func (b *BooleanType) Custom(validator func(bool) error) *BooleanType {
 	if b.customValidation == nil {
 		b.customValidation = core.NewCustomValidation()
@@ -96,6 +144,13 @@ func (b *BooleanType) AddRule(rule core.Rule) *BooleanType {
 	return b
 }
 
+// Introspect, boolean tipinin tanımını yapısal olarak döndürür.
+func (b *BooleanType) Introspect() *core.TypeDescription {
+	desc := b.DescribeBase("boolean")
+	desc.CustomRules = b.customValidation.Count()
+	return desc
+}
+
 // Validate, ilgili alanın doğrulama sürecini yürütür.
 // 1. BaseType doğrulama kurallarını çalıştırır (required, default, label...).
 // 2. Gelen değer nil ise (zorunlu değilse) işlem durdurulur.
@@ -104,7 +159,7 @@ func (b *BooleanType) AddRule(rule core.Rule) *BooleanType {
 // 5. Custom validators varsa çalıştırır.
 func (b *BooleanType) Validate(field string, value any, result *core.ValidationResult) {
 	b.BaseType.Validate(field, value, result)
-	if result.HasErrors() {
+	if result.HasFieldErrors(field) {
 		return
 	}
 
@@ -114,7 +169,7 @@ func (b *BooleanType) Validate(field string, value any, result *core.ValidationR
 
 	_, ok := value.(bool)
 	if !ok {
-		result.AddError(field, i18n.Get(i18n.KeyBoolean, b.GetLabel(field)))
+		result.AddRuleError(field, i18n.KeyBoolean, b.GetLabel(field))
 		return
 	}
 
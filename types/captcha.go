@@ -0,0 +1,118 @@
+package types
+
+import (
+	"context"
+
+	"github.com/biyonik/go-fluent-validator/core"
+	"github.com/biyonik/go-fluent-validator/i18n"
+	"github.com/biyonik/go-fluent-validator/rules"
+)
+
+// CaptchaType
+//
+// Formla gönderilen captcha token'ını (reCAPTCHA, hCaptcha, Turnstile)
+// sağlayıcıyla doğrulayan tiptir. Token her zaman zorunludur; sağlayıcı
+// sorgusu şemanın dış kaynaklı (asenkron) kontrol adımında, ValidateCtx'e
+// verilen context ile yapılır. Ağ hataları ve zaman aşımları token'ın
+// reddedilmesiyle sonuçlanır (fail closed).
+//
+// Skor tabanlı sağlayıcılarda MinScore ile eşik, Action ile beklenen işlem adı
+// tanımlanabilir. Alan hassas kabul edilir; eski girdiye (OldInput) eklenmez.
+//
+// Kullanım Örneği:
+//
+//	"captcha": validation.Captcha(rules.Turnstile(secret)),
+//	"token":   validation.Captcha(rules.Recaptcha(secret)).MinScore(0.5).Action("signup"),
+//
+// Yazar Bilgileri:
+//   - @author  Ahmet Altun
+//   - @github  https://github.com/biyonik
+//   - @company Biyonik Software
+//   - @email   admin@biyonik.dev
+type CaptchaType struct {
+	core.BaseType
+	verify   rules.CaptchaVerifier
+	minScore *float64
+	action   string
+}
+
+// Verifier, token'ı doğrulayacak sağlayıcıyı belirler (rules.Recaptcha,
+// rules.HCaptcha, rules.Turnstile veya özel bir doğrulayıcı).
+func (c *CaptchaType) Verifier(verify rules.CaptchaVerifier) *CaptchaType {
+	c.verify = verify
+	return c
+}
+
+// MinScore, skor tabanlı sağlayıcılarda kabul edilecek en düşük skoru (0.0–1.0)
+// belirler. Yanıtta skor yoksa eşik uygulanmaz.
+func (c *CaptchaType) MinScore(score float64) *CaptchaType {
+	c.minScore = &score
+	return c
+}
+
+// Action, yanıttaki action değerinin eşleşmesi gereken işlem adını belirler
+// (reCAPTCHA v3'te grecaptcha.execute'a verilen action).
+func (c *CaptchaType) Action(action string) *CaptchaType {
+	c.action = action
+	return c
+}
+
+// Label, doğrulama hatalarında görünecek kullanıcı dostu adı belirler.
+func (c *CaptchaType) Label(label string) *CaptchaType {
+	c.SetLabel(label)
+	return c
+}
+
+// IsSensitive, captcha token'ları her zaman hassas kabul edildiği için true döner.
+func (c *CaptchaType) IsSensitive() bool {
+	return true
+}
+
+// Validate, token'ın gönderilmiş ve boş olmayan bir string olduğunu denetler.
+// Token'ın kendisi ValidateAsync içinde doğrulanır.
+func (c *CaptchaType) Validate(field string, value any, result *core.ValidationResult) {
+	if str, ok := value.(string); !ok || str == "" {
+		result.AddRuleError(field, i18n.KeyCaptcha, c.GetLabel(field))
+	}
+}
+
+// ValidateAsync, core.AsyncValidatable implementasyonu; token'ı sağlayıcıya
+// doğrulatır, ardından skor ve action kısıtlarını denetler.
+func (c *CaptchaType) ValidateAsync(ctx context.Context, field string, value any, result *core.ValidationResult) {
+	str, _ := value.(string)
+	if c.verify == nil {
+		result.AddRuleError(field, i18n.KeyCaptcha, c.GetLabel(field))
+		return
+	}
+
+	res, err := c.verify(ctx, str)
+	switch {
+	case err != nil, !res.Success:
+		result.AddRuleError(field, i18n.KeyCaptcha, c.GetLabel(field))
+	case c.minScore != nil && res.Score != nil && *res.Score < *c.minScore:
+		result.AddRuleError(field, i18n.KeyCaptcha, c.GetLabel(field))
+	case c.action != "" && res.Action != c.action:
+		result.AddRuleError(field, i18n.KeyCaptcha, c.GetLabel(field))
+	}
+}
+
+// Introspect, captcha alanını yapısal olarak döndürür. Sağlayıcı doğrulayıcısı
+// bir fonksiyon olduğu için özel kural olarak sayılır.
+func (c *CaptchaType) Introspect() *core.TypeDescription {
+	desc := c.DescribeBase("captcha")
+	desc.Required = true
+	desc.Sensitive = true
+	params := map[string]any{}
+	if c.minScore != nil {
+		params["min_score"] = *c.minScore
+	}
+	if c.action != "" {
+		params["action"] = c.action
+	}
+	if len(params) == 0 {
+		params = nil
+	}
+	desc.AddRule("captcha", params)
+	desc.CustomRules = 1
+	return desc
+}
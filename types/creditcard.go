@@ -1,7 +1,9 @@
 package types
 
 import (
+	"context"
 	"fmt"
+	"strings"
 
 	"github.com/biyonik/go-fluent-validator/core"
 	"github.com/biyonik/go-fluent-validator/i18n"
@@ -36,6 +38,30 @@ type CreditCardType struct {
 	core.BaseType
 	cardType         string // Örn: "visa", "mastercard", "amex" vb.
 	customValidation *core.CustomValidation
+	binLookup        func(bin string) (CardMeta, error)
+	allowedKinds     []string
+	allowedCountries []string
+}
+
+// Kart türleri (CardMeta.Kind için önerilen değerler).
+const (
+	CardKindCredit  = "credit"
+	CardKindDebit   = "debit"
+	CardKindPrepaid = "prepaid"
+)
+
+// CardMeta, BIN veritabanından dönen kart bilgisidir.
+//
+// Alanlar:
+//   - Brand   : Kart markası (örn. "visa", "mastercard")
+//   - Kind    : Kart türü (CardKindCredit, CardKindDebit, CardKindPrepaid)
+//   - Country : Kartı çıkaran ülkenin ISO 3166-1 alpha-2 kodu (örn. "TR")
+//   - Issuer  : Kartı çıkaran banka
+type CardMeta struct {
+	Brand   string
+	Kind    string
+	Country string
+	Issuer  string
 }
 
 // Required işareti, alanın boş bırakılamayacağını belirtir.
@@ -47,6 +73,20 @@ func (c *CreditCardType) Required() *CreditCardType {
 	return c
 }
 
+// Optional, alanın anahtarının payload'da hiç bulunmayabileceğini belirtir;
+// eksik anahtar Required olsa bile geçerlidir ve ValidData'ya yazılmaz.
+func (c *CreditCardType) Optional() *CreditCardType {
+	c.SetOptional()
+	return c
+}
+
+// Nullable, alanın açıkça null gönderilebileceğini belirtir; null değer
+// Required olsa bile geçerlidir ve ValidData'da korunur.
+func (c *CreditCardType) Nullable() *CreditCardType {
+	c.SetNullable()
+	return c
+}
+
 // Label, doğrulama hatalarında görünecek kullanıcı dostu adı belirler.
 //
 // Parametreler:
@@ -59,6 +99,38 @@ func (c *CreditCardType) Label(label string) *CreditCardType {
 	return c
 }
 
+// IsSensitive, kart numaraları her zaman hassas kabul edildiği için true döner.
+func (c *CreditCardType) IsSensitive() bool {
+	return true
+}
+
+// Severity, alanın kurallarının önem seviyesini belirler (error, warning, info).
+// SeverityError dışındaki ihlaller şemanın politikasına göre uyarı olarak raporlanır.
+func (c *CreditCardType) Severity(level core.Severity) *CreditCardType {
+	c.SetSeverity(level)
+	return c
+}
+
+// Describe, alan için dokümantasyon amaçlı açıklama tanımlar. Doğrulamayı
+// etkilemez; Describe() çıktısında ve üretilen dokümanlarda görünür.
+func (c *CreditCardType) Describe(text string) *CreditCardType {
+	c.SetDescription(text)
+	return c
+}
+
+// Example, dokümantasyonda gösterilecek örnek bir değer ekler.
+func (c *CreditCardType) Example(value any) *CreditCardType {
+	c.AddExample(value)
+	return c
+}
+
+// Deprecated, alanı kullanımdan kaldırılmış olarak işaretler. reason, kaldırma
+// gerekçesini veya yerine kullanılacak alanı belirtir.
+func (c *CreditCardType) Deprecated(reason string) *CreditCardType {
+	c.SetDeprecated(reason)
+	return c
+}
+
 // Type, yalnızca belirli bir kart markasına ait kredi kartı numarasının
 // kabul edilmesini sağlar.
 //
@@ -105,6 +177,67 @@ func (c *CreditCardType) AddRule(rule core.Rule) *CreditCardType {
 	return c
 }
 
+// BINLookup, kart numarası format ve Luhn kontrollerini geçtikten sonra ilk 6
+// hanesiyle (BIN) çağrılacak sorgu fonksiyonunu tanımlar. Dönen CardMeta,
+// AllowKinds ve AllowCountries kısıtlarına karşı denetlenir.
+//
+// Sorgu dış kaynağa gittiği için yalnızca şemanın Validate/ValidateCtx akışında,
+// diğer alanların sorgularıyla eşzamanlı çalışır; Session ve ValidateFields
+// gibi anlık geri bildirim akışlarında çalıştırılmaz. ValidateCtx'e verilen
+// context iptal edilirse sorgu beklenmez ve alan doğrulanamadı olarak
+// işaretlenir. Fonksiyonun döndürdüğü hata da aynı şekilde raporlanır;
+// bilinmeyen BIN'leri kabul etmek için boş CardMeta ve nil dönülebilir.
+//
+// Örnek:
+//
+//	validation.CreditCard().
+//	    BINLookup(binDB.Lookup).
+//	    AllowKinds(types.CardKindCredit).
+//	    AllowCountries("TR")
+func (c *CreditCardType) BINLookup(lookup func(bin string) (CardMeta, error)) *CreditCardType {
+	c.binLookup = lookup
+	return c
+}
+
+// AllowKinds, BIN sorgusundan dönen kart türünün verilen türlerden biri
+// olmasını zorunlu kılar (örn. yalnızca kredi kartı). BINLookup ile birlikte
+// kullanılır.
+func (c *CreditCardType) AllowKinds(kinds ...string) *CreditCardType {
+	c.allowedKinds = append(c.allowedKinds, kinds...)
+	return c
+}
+
+// AllowCountries, BIN sorgusundan dönen kartı çıkaran ülkenin verilen ISO
+// ülke kodlarından biri olmasını zorunlu kılar. BINLookup ile birlikte
+// kullanılır.
+func (c *CreditCardType) AllowCountries(codes ...string) *CreditCardType {
+	for _, code := range codes {
+		c.allowedCountries = append(c.allowedCountries, strings.ToUpper(code))
+	}
+	return c
+}
+
+// Introspect, kredi kartı tipinin kurallarını yapısal olarak döndürür.
+func (c *CreditCardType) Introspect() *core.TypeDescription {
+	desc := c.DescribeBase("credit_card")
+	desc.Sensitive = true
+	if c.cardType != "" {
+		desc.AddRule("card_type", map[string]any{"value": c.cardType})
+	}
+	if c.binLookup != nil {
+		params := map[string]any{}
+		if len(c.allowedKinds) > 0 {
+			params["kinds"] = c.allowedKinds
+		}
+		if len(c.allowedCountries) > 0 {
+			params["countries"] = c.allowedCountries
+		}
+		desc.AddRule("bin_lookup", params)
+	}
+	desc.CustomRules = c.customValidation.Count()
+	return desc
+}
+
 // Validate, kredi kartı numarasının geçerliliğini kontrol eder.
 //
 // Gerçekleştirilen kontroller:
@@ -123,7 +256,7 @@ func (c *CreditCardType) Validate(field string, value any, result *core.Validati
 	// BaseType doğrulamalarını uygula
 	c.BaseType.Validate(field, value, result)
 
-	if result.HasErrors() {
+	if result.HasFieldErrors(field) {
 		return
 	}
 	if value == nil {
@@ -133,16 +266,73 @@ func (c *CreditCardType) Validate(field string, value any, result *core.Validati
 	// Değerin string olması gerekir
 	str, ok := value.(string)
 	if !ok {
-		result.AddError(field, i18n.Get(i18n.KeyString, c.GetLabel(field)))
+		result.AddRuleError(field, i18n.KeyString, c.GetLabel(field))
 		return
 	}
 
 	// Kredi kartı doğrulaması (format + Luhn + kart markası kontrolü)
 	if !rules.IsValidCreditCard(str, c.cardType) {
-		result.AddError(field, i18n.Get(i18n.KeyCreditCard, c.GetLabel(field)))
+		result.AddRuleError(field, i18n.KeyCreditCard, c.GetLabel(field))
 	}
 
 	if c.customValidation != nil && c.customValidation.HasValidators() {
 		c.customValidation.ValidateSync(field, value, result)
 	}
 }
+
+// ValidateAsync, core.AsyncValidatable implementasyonu; BINLookup tanımlıysa
+// kartın BIN bilgisini sorgular ve tür/ülke kısıtlarını denetler. Şema bu
+// metodu yalnızca alan seviyesi kuralları geçen değerler için çağırır.
+func (c *CreditCardType) ValidateAsync(ctx context.Context, field string, value any, result *core.ValidationResult) {
+	if c.binLookup == nil {
+		return
+	}
+	str, ok := value.(string)
+	if !ok {
+		return
+	}
+	bin := rules.CardBIN(str)
+	if bin == "" {
+		return
+	}
+
+	type lookupResult struct {
+		meta CardMeta
+		err  error
+	}
+	done := make(chan lookupResult, 1)
+	go func() {
+		meta, err := c.binLookup(bin)
+		done <- lookupResult{meta: meta, err: err}
+	}()
+
+	var res lookupResult
+	select {
+	case <-ctx.Done():
+		result.AddRuleError(field, i18n.KeyCardLookup, c.GetLabel(field))
+		return
+	case res = <-done:
+	}
+	if res.err != nil {
+		result.AddRuleError(field, i18n.KeyCardLookup, c.GetLabel(field))
+		return
+	}
+
+	if len(c.allowedKinds) > 0 && !containsFold(c.allowedKinds, res.meta.Kind) {
+		result.AddRuleError(field, i18n.KeyCardKind, c.GetLabel(field), i18n.StringList(c.allowedKinds))
+	}
+	if len(c.allowedCountries) > 0 && !containsFold(c.allowedCountries, res.meta.Country) {
+		result.AddRuleError(field, i18n.KeyCardCountry, c.GetLabel(field), i18n.StringList(c.allowedCountries))
+	}
+}
+
+// containsFold, value'nun list içinde büyük/küçük harf duyarsız olarak
+// bulunup bulunmadığını döndürür.
+func containsFold(list []string, value string) bool {
+	for _, item := range list {
+		if strings.EqualFold(item, value) {
+			return true
+		}
+	}
+	return false
+}
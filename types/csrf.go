@@ -0,0 +1,88 @@
+package types
+
+import (
+	"context"
+
+	"github.com/biyonik/go-fluent-validator/core"
+	"github.com/biyonik/go-fluent-validator/i18n"
+)
+
+// CSRFVerifier, gönderilen CSRF token'ını doğrulayan fonksiyondur. ctx,
+// ValidateCtx'e verilen context'tir; oturum veya istek bilgisine (örn.
+// httpvalidate.ContextWithRequest ile eklenen *http.Request) buradan erişilir.
+// Token geçerliyse true döner.
+type CSRFVerifier func(ctx context.Context, token string) bool
+
+// CSRFTokenType
+//
+// HTML formlarıyla gönderilen CSRF token alanını doğrulayan tiptir. Token her
+// zaman zorunludur; doğrulama, form alanlarıyla aynı geçişte, şemanın
+// dış kaynaklı (asenkron) kontrol adımında CSRFVerifier ile yapılır. Böylece
+// Validate yerine ValidateCtx kullanılmalıdır; context taşımayan doğrulamalarda
+// doğrulayıcı boş context ile çağrılır ve genellikle başarısız olur.
+//
+// Alan hassas kabul edilir; eski girdiye (OldInput) eklenmez.
+//
+// Kullanım Örneği:
+//
+//	schema := validation.Make()
+//	schema.Shape(map[string]validation.Type{
+//	    "_token": validation.CSRFToken(httpvalidate.CookieCSRF("csrf_token")),
+//	    "email":  validation.String().Required().Email(),
+//	})
+//	res := schema.ValidateCtx(httpvalidate.ContextWithRequest(r.Context(), r), form)
+//
+// Yazar Bilgileri:
+//   - @author  Ahmet Altun
+//   - @github  https://github.com/biyonik
+//   - @company Biyonik Software
+//   - @email   admin@biyonik.dev
+type CSRFTokenType struct {
+	core.BaseType
+	verify CSRFVerifier
+}
+
+// Verifier, token'ı doğrulayacak fonksiyonu belirler.
+func (c *CSRFTokenType) Verifier(verify CSRFVerifier) *CSRFTokenType {
+	c.verify = verify
+	return c
+}
+
+// IsSensitive, CSRF token'ları her zaman hassas kabul edildiği için true döner.
+func (c *CSRFTokenType) IsSensitive() bool {
+	return true
+}
+
+// Label, doğrulama hatalarında görünecek kullanıcı dostu adı belirler.
+func (c *CSRFTokenType) Label(label string) *CSRFTokenType {
+	c.SetLabel(label)
+	return c
+}
+
+// Validate, token'ın gönderilmiş ve boş olmayan bir string olduğunu denetler.
+// Token'ın kendisi ValidateAsync içinde doğrulanır.
+func (c *CSRFTokenType) Validate(field string, value any, result *core.ValidationResult) {
+	if str, ok := value.(string); !ok || str == "" {
+		result.AddRuleError(field, i18n.KeyCSRF, c.GetLabel(field))
+	}
+}
+
+// ValidateAsync, core.AsyncValidatable implementasyonu; token'ı CSRFVerifier
+// ile doğrular. Doğrulayıcı tanımlı değilse token reddedilir.
+func (c *CSRFTokenType) ValidateAsync(ctx context.Context, field string, value any, result *core.ValidationResult) {
+	str, _ := value.(string)
+	if c.verify == nil || !c.verify(ctx, str) {
+		result.AddRuleError(field, i18n.KeyCSRF, c.GetLabel(field))
+	}
+}
+
+// Introspect, CSRF alanını yapısal olarak döndürür. Doğrulayıcı bir fonksiyon
+// olduğu için özel kural olarak sayılır.
+func (c *CSRFTokenType) Introspect() *core.TypeDescription {
+	desc := c.DescribeBase("csrf_token")
+	desc.Required = true
+	desc.Sensitive = true
+	desc.AddRule("csrf", nil)
+	desc.CustomRules = 1
+	return desc
+}
@@ -46,6 +46,20 @@ func (d *DateType) Required() *DateType {
 	return d
 }
 
+// Optional, alanın anahtarının payload'da hiç bulunmayabileceğini belirtir;
+// eksik anahtar Required olsa bile geçerlidir ve ValidData'ya yazılmaz.
+func (d *DateType) Optional() *DateType {
+	d.SetOptional()
+	return d
+}
+
+// Nullable, alanın açıkça null gönderilebileceğini belirtir; null değer
+// Required olsa bile geçerlidir ve ValidData'da korunur.
+func (d *DateType) Nullable() *DateType {
+	d.SetNullable()
+	return d
+}
+
 // Label, doğrulama hatalarında görünecek kullanıcı dostu alan adını belirler.
 //
 // Parametreler:
@@ -58,6 +72,33 @@ func (d *DateType) Label(label string) *DateType {
 	return d
 }
 
+// Severity, alanın kurallarının önem seviyesini belirler (error, warning, info).
+// SeverityError dışındaki ihlaller şemanın politikasına göre uyarı olarak raporlanır.
+func (d *DateType) Severity(level core.Severity) *DateType {
+	d.SetSeverity(level)
+	return d
+}
+
+// Describe, alan için dokümantasyon amaçlı açıklama tanımlar. Doğrulamayı
+// etkilemez; Describe() çıktısında ve üretilen dokümanlarda görünür.
+func (d *DateType) Describe(text string) *DateType {
+	d.SetDescription(text)
+	return d
+}
+
+// Example, dokümantasyonda gösterilecek örnek bir değer ekler.
+func (d *DateType) Example(value any) *DateType {
+	d.AddExample(value)
+	return d
+}
+
+// Deprecated, alanı kullanımdan kaldırılmış olarak işaretler. reason, kaldırma
+// gerekçesini veya yerine kullanılacak alanı belirtir.
+func (d *DateType) Deprecated(reason string) *DateType {
+	d.SetDeprecated(reason)
+	return d
+}
+
 // Default, alan için varsayılan bir değer belirler.
 //
 // Parametreler:
@@ -106,6 +147,38 @@ func (d *DateType) Max(dateStr string) *DateType {
 	return d
 }
 
+// Immutable, alanın güncelleme sırasında (ValidateChanges) değiştirilemeyeceğini belirtir.
+//
+// Döndürür:
+//   - *DateType
+func (d *DateType) Immutable() *DateType {
+	d.SetImmutable()
+	return d
+}
+
+// OnlyIncrease, tarihin güncelleme sırasında yalnızca ileri alınabileceğini belirtir.
+//
+// Döndürür:
+//   - *DateType
+func (d *DateType) OnlyIncrease() *DateType {
+	d.SetOnlyIncrease()
+	return d
+}
+
+// OnChange, ValidateChanges sırasında eski ve yeni tarihi birlikte gören bir
+// değişiklik kuralı ekler.
+//
+// Döndürür:
+//   - *DateType
+func (d *DateType) OnChange(validator func(oldValue, newValue time.Time) error) *DateType {
+	d.AddChangeValidator(func(oldValue, newValue any) error {
+		oldDate, _ := oldValue.(time.Time)
+		newDate, _ := newValue.(time.Time)
+		return validator(oldDate, newDate)
+	})
+	return d
+}
+
 // Custom adds a custom validation function
 func (d *DateType) Custom(validator func(time.Time) error) *DateType {
 	if d.customValidation == nil {
@@ -137,6 +210,27 @@ func (d *DateType) AddRule(rule core.Rule) *DateType {
 	return d
 }
 
+// Introspect, tarih tipinin formatını ve sınırlarını yapısal olarak döndürür.
+//
+// Döndürür:
+//   - *core.TypeDescription
+func (d *DateType) Introspect() *core.TypeDescription {
+	desc := d.DescribeBase("date")
+	layout := d.format
+	if layout == "" {
+		layout = "2006-01-02"
+	}
+	desc.AddRule("format", map[string]any{"layout": layout})
+	if d.minDateStr != nil {
+		desc.AddRule("min", map[string]any{"value": *d.minDateStr})
+	}
+	if d.maxDateStr != nil {
+		desc.AddRule("max", map[string]any{"value": *d.maxDateStr})
+	}
+	desc.CustomRules = d.customValidation.Count()
+	return desc
+}
+
 // Transform, gelen değeri string → time.Time formatına dönüştürür.
 //
 // Dönüşüm Süreci:
@@ -193,7 +287,7 @@ func (d *DateType) Transform(value any) (any, error) {
 //   - result (*core.ValidationResult): sonuç nesnesi
 func (d *DateType) Validate(field string, value any, result *core.ValidationResult) {
 	d.BaseType.Validate(field, value, result)
-	if result.HasErrors() {
+	if result.HasFieldErrors(field) {
 		return
 	}
 	if value == nil {
@@ -202,7 +296,7 @@ func (d *DateType) Validate(field string, value any, result *core.ValidationResu
 
 	parsedDate, ok := value.(time.Time)
 	if !ok {
-		result.AddError(field, i18n.Get(i18n.KeyDate, d.GetLabel(field)))
+		result.AddRuleError(field, i18n.KeyDate, d.GetLabel(field))
 		return
 	}
 
@@ -216,9 +310,9 @@ func (d *DateType) Validate(field string, value any, result *core.ValidationResu
 	if d.minDateStr != nil {
 		minDate, err := time.Parse(layout, *d.minDateStr)
 		if err != nil {
-			result.AddError(field, i18n.Get(i18n.KeyDateFormat, fieldName, layout))
+			result.AddRuleError(field, i18n.KeyDateFormat, fieldName, layout)
 		} else if parsedDate.Before(minDate) {
-			result.AddError(field, i18n.Get(i18n.KeyDateMin, fieldName, *d.minDateStr))
+			result.AddRuleError(field, i18n.KeyDateMin, fieldName, i18n.DateValue{Time: minDate, Raw: *d.minDateStr})
 		}
 	}
 
@@ -226,9 +320,9 @@ func (d *DateType) Validate(field string, value any, result *core.ValidationResu
 	if d.maxDateStr != nil {
 		maxDate, err := time.Parse(layout, *d.maxDateStr)
 		if err != nil {
-			result.AddError(field, i18n.Get(i18n.KeyDateFormat, fieldName, layout))
+			result.AddRuleError(field, i18n.KeyDateFormat, fieldName, layout)
 		} else if parsedDate.After(maxDate) {
-			result.AddError(field, i18n.Get(i18n.KeyDateMax, fieldName, *d.maxDateStr))
+			result.AddRuleError(field, i18n.KeyDateMax, fieldName, i18n.DateValue{Time: maxDate, Raw: *d.maxDateStr})
 		}
 	}
 
@@ -0,0 +1,51 @@
+package types
+
+import (
+	"context"
+
+	"github.com/biyonik/go-fluent-validator/core"
+	"github.com/biyonik/go-fluent-validator/i18n"
+	"github.com/biyonik/go-fluent-validator/rules"
+)
+
+// dnsChecks, alan adı üzerinde çalışan DNS kaydı kontrolleridir (bkz.
+// rules.DomainResolvable, rules.HasMX, rules.HasTXT). AdvancedStringType
+// (Domain) ve EmailType (adresin alan adı kısmı) tarafından paylaşılır.
+// Kontroller şemanın dış kaynaklı (asenkron) adımında çalışır; DNS hataları
+// alan adının reddedilmesiyle sonuçlanır.
+type dnsChecks struct {
+	resolvable bool
+	mx         bool
+	txtPrefix  *string
+}
+
+// enabled, en az bir DNS kontrolü tanımlıysa true döner.
+func (d *dnsChecks) enabled() bool {
+	return d.resolvable || d.mx || d.txtPrefix != nil
+}
+
+// validate, domain için tanımlı kontrolleri çalıştırır.
+func (d *dnsChecks) validate(ctx context.Context, field, fieldName, domain string, result *core.ValidationResult) {
+	if d.resolvable && rules.DomainResolvable(ctx, domain) != nil {
+		result.AddRuleError(field, i18n.KeyDomainResolvable, fieldName)
+	}
+	if d.mx && rules.HasMX(ctx, domain) != nil {
+		result.AddRuleError(field, i18n.KeyDomainMX, fieldName)
+	}
+	if d.txtPrefix != nil && rules.HasTXT(ctx, domain, *d.txtPrefix) != nil {
+		result.AddRuleError(field, i18n.KeyDomainTXT, fieldName)
+	}
+}
+
+// describe, DNS kontrollerini tanıma ekler.
+func (d *dnsChecks) describe(desc *core.TypeDescription) {
+	if d.resolvable {
+		desc.AddRule("domain_resolvable", nil)
+	}
+	if d.mx {
+		desc.AddRule("has_mx", nil)
+	}
+	if d.txtPrefix != nil {
+		desc.AddRule("has_txt", map[string]any{"value": *d.txtPrefix})
+	}
+}
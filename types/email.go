@@ -0,0 +1,330 @@
+package types
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/biyonik/go-fluent-validator/core"
+	"github.com/biyonik/go-fluent-validator/i18n"
+	"github.com/biyonik/go-fluent-validator/rules"
+)
+
+// EmailType
+//
+// E-posta adreslerine özel doğrulama tipidir. String().Email()'den farklı
+// olarak adresi yerel kısım ve alan adı olarak ele alır; kurumsal kayıt
+// akışlarında sık ihtiyaç duyulan izinli/yasaklı alan adı listeleri ve parça
+// bazlı özel kurallar tanımlanabilir.
+//
+// Özellikler:
+//   - Baş/son boşluklar temizlenir, alan adı küçük harfe çevrilir; ValidData
+//     normalize edilmiş adresi içerir (yerel kısım olduğu gibi kalır)
+//   - DomainIn / NotDomainIn alt alan adlarını da kapsar
+//   - Domain / LocalPart ile parçalara özel kurallar eklenebilir
+//   - RejectFreeProviders / RejectRoleAccounts ile kişisel sağlayıcılar ve
+//     rol hesapları (admin@, noreply@) reddedilebilir (bkz. rules.IsFreeEmailProvider)
+//   - DomainResolvable / HasMX / HasTXT ile alan adı DNS'te denetlenebilir
+//     (asenkron, ValidateCtx ile)
+//
+// Kullanım Örneği:
+//
+//	"email": validation.Email().Required().DomainIn("acme.com", "acme.com.tr"),
+//	"lead":  validation.Email(types.RejectFreeProviders(), types.RejectRoleAccounts()).Required(),
+//	"contact": validation.Email().NotDomainIn("gmail.com", "hotmail.com").
+//	    LocalPart(func(local string) error {
+//	        if strings.Contains(local, "+") {
+//	            return errors.New("alias adresler kabul edilmez")
+//	        }
+//	        return nil
+//	    }),
+//
+// Yazar Bilgileri:
+//   - @author  Ahmet Altun
+//   - @github  https://github.com/biyonik
+//   - @company Biyonik Software
+//   - @email   admin@biyonik.dev
+type EmailType struct {
+	core.BaseType
+	domainsIn        []string
+	domainsNotIn     []string
+	rejectFree       bool
+	rejectRole       bool
+	dns              dnsChecks
+	customValidation *core.CustomValidation
+}
+
+// EmailOption, validation.Email(...) yapıcısına verilen e-postaya özel
+// seçeneklerdir. validation.EmailOption arayüzünü ApplyEmail ile karşılar;
+// böylece validation.WithRequired() gibi ortak seçeneklerle birlikte
+// verilebilir.
+type EmailOption func(*EmailType)
+
+// ApplyEmail, seçeneği verilen EmailType'a uygular.
+func (o EmailOption) ApplyEmail(e *EmailType) { o(e) }
+
+// RejectFreeProviders, ücretsiz e-posta sağlayıcılarından gelen adresleri
+// reddeden seçenektir.
+func RejectFreeProviders() EmailOption {
+	return func(e *EmailType) {
+		e.RejectFreeProviders()
+	}
+}
+
+// RejectRoleAccounts, rol hesaplarını (admin@, info@, noreply@ ...) reddeden
+// seçenektir.
+func RejectRoleAccounts() EmailOption {
+	return func(e *EmailType) {
+		e.RejectRoleAccounts()
+	}
+}
+
+// Required, alanın boş geçilemeyeceğini belirtir.
+func (e *EmailType) Required() *EmailType {
+	e.SetRequired()
+	return e
+}
+
+// Optional, alanın anahtarının payload'da hiç bulunmayabileceğini belirtir;
+// eksik anahtar Required olsa bile geçerlidir ve ValidData'ya yazılmaz.
+func (e *EmailType) Optional() *EmailType {
+	e.SetOptional()
+	return e
+}
+
+// Nullable, alanın açıkça null gönderilebileceğini belirtir; null değer
+// Required olsa bile geçerlidir ve ValidData'da korunur.
+func (e *EmailType) Nullable() *EmailType {
+	e.SetNullable()
+	return e
+}
+
+// Label, doğrulama hatalarında gösterilecek kullanıcı dostu alan adını belirler.
+func (e *EmailType) Label(label string) *EmailType {
+	e.SetLabel(label)
+	return e
+}
+
+// Severity, alanın kurallarının önem seviyesini belirler (error, warning, info).
+func (e *EmailType) Severity(level core.Severity) *EmailType {
+	e.SetSeverity(level)
+	return e
+}
+
+// Describe, alan için dokümantasyon amaçlı açıklama tanımlar.
+func (e *EmailType) Describe(text string) *EmailType {
+	e.SetDescription(text)
+	return e
+}
+
+// Example, dokümantasyonda gösterilecek örnek bir değer ekler.
+func (e *EmailType) Example(value any) *EmailType {
+	e.AddExample(value)
+	return e
+}
+
+// Deprecated, alanı kullanımdan kaldırılmış olarak işaretler.
+func (e *EmailType) Deprecated(reason string) *EmailType {
+	e.SetDeprecated(reason)
+	return e
+}
+
+// Default, alan için varsayılan değer belirler.
+func (e *EmailType) Default(value string) *EmailType {
+	e.SetDefault(value)
+	return e
+}
+
+// Immutable, alanın güncelleme sırasında (ValidateChanges) değiştirilemeyeceğini belirtir.
+func (e *EmailType) Immutable() *EmailType {
+	e.SetImmutable()
+	return e
+}
+
+// DomainIn, adresin yalnızca verilen alan adlarından (veya alt alan
+// adlarından) biri olmasına izin verir.
+func (e *EmailType) DomainIn(domains ...string) *EmailType {
+	e.domainsIn = append(e.domainsIn, domains...)
+	return e
+}
+
+// NotDomainIn, verilen alan adlarını (ve alt alan adlarını) reddeder. Ücretsiz
+// e-posta sağlayıcılarını veya tek kullanımlık adres servislerini engellemek
+// için kullanılır.
+func (e *EmailType) NotDomainIn(domains ...string) *EmailType {
+	e.domainsNotIn = append(e.domainsNotIn, domains...)
+	return e
+}
+
+// RejectFreeProviders, ücretsiz e-posta sağlayıcılarından (gmail.com,
+// outlook.com ...) gelen adresleri reddeder. Liste rules.SetFreeEmailProviders
+// ve rules.AddFreeEmailProviders ile değiştirilebilir.
+func (e *EmailType) RejectFreeProviders() *EmailType {
+	e.rejectFree = true
+	return e
+}
+
+// RejectRoleAccounts, kişiye ait olmayan rol hesaplarını (admin@, info@,
+// noreply@ ...) reddeder. Liste rules.SetRoleAccounts ve rules.AddRoleAccounts
+// ile değiştirilebilir.
+func (e *EmailType) RejectRoleAccounts() *EmailType {
+	e.rejectRole = true
+	return e
+}
+
+// DomainResolvable, adresin alan adının DNS'te bir adrese çözülmesini
+// zorunlu kılar. Sorgu ValidateCtx'e verilen context ile yapılır.
+func (e *EmailType) DomainResolvable() *EmailType {
+	e.dns.resolvable = true
+	return e
+}
+
+// HasMX, adresin alan adının e-posta kabul eden bir MX kaydı olmasını
+// zorunlu kılar.
+func (e *EmailType) HasMX() *EmailType {
+	e.dns.mx = true
+	return e
+}
+
+// HasTXT, adresin alan adının prefix ile başlayan bir TXT kaydı yayımlamasını
+// zorunlu kılar (alan adı sahipliği doğrulaması için).
+func (e *EmailType) HasTXT(prefix string) *EmailType {
+	e.dns.txtPrefix = &prefix
+	return e
+}
+
+// Domain, adresin alan adı kısmına (küçük harfe çevrilmiş) özel bir kural
+// ekler. fn hata dönerse mesajı alanın hatası olarak eklenir.
+func (e *EmailType) Domain(fn func(domain string) error) *EmailType {
+	return e.Custom(func(address string) error {
+		_, domain, _ := rules.SplitEmail(address)
+		return fn(domain)
+	})
+}
+
+// LocalPart, adresin "@" öncesi kısmına özel bir kural ekler.
+func (e *EmailType) LocalPart(fn func(local string) error) *EmailType {
+	return e.Custom(func(address string) error {
+		local, _, _ := rules.SplitEmail(address)
+		return fn(local)
+	})
+}
+
+// Custom, normalize edilmiş adres üzerinde çalışan özel bir doğrulama ekler.
+func (e *EmailType) Custom(validator func(string) error) *EmailType {
+	if e.customValidation == nil {
+		e.customValidation = core.NewCustomValidation()
+	}
+
+	e.customValidation.AddSync(func(value any) error {
+		if value == nil {
+			return nil
+		}
+
+		strVal, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("value must be string")
+		}
+
+		return validator(strVal)
+	})
+
+	return e
+}
+
+// AddRule, yeniden kullanılabilir bir core.Rule ekler.
+func (e *EmailType) AddRule(rule core.Rule) *EmailType {
+	if e.customValidation == nil {
+		e.customValidation = core.NewCustomValidation()
+	}
+	e.customValidation.AddRule(rule)
+	return e
+}
+
+// Transform, tanımlı dönüşümleri uyguladıktan sonra adresi normalize eder
+// (bkz. rules.NormalizeEmail).
+func (e *EmailType) Transform(value any) (any, error) {
+	value, err := e.BaseType.Transform(value)
+	if err != nil {
+		return nil, err
+	}
+	if str, ok := value.(string); ok {
+		return rules.NormalizeEmail(str), nil
+	}
+	return value, nil
+}
+
+// Introspect, e-posta tipinin kurallarını yapısal olarak döndürür.
+func (e *EmailType) Introspect() *core.TypeDescription {
+	desc := e.DescribeBase("email")
+	if len(e.domainsIn) > 0 {
+		desc.AddRule("domain_in", map[string]any{"values": append([]string(nil), e.domainsIn...)})
+	}
+	if len(e.domainsNotIn) > 0 {
+		desc.AddRule("domain_not_in", map[string]any{"values": append([]string(nil), e.domainsNotIn...)})
+	}
+	if e.rejectFree {
+		desc.AddRule("reject_free_providers", nil)
+	}
+	if e.rejectRole {
+		desc.AddRule("reject_role_accounts", nil)
+	}
+	e.dns.describe(desc)
+	desc.CustomRules = e.customValidation.Count()
+	return desc
+}
+
+// Validate, adresin biçimini ve alan adı kısıtlarını kontrol eder.
+func (e *EmailType) Validate(field string, value any, result *core.ValidationResult) {
+	e.BaseType.Validate(field, value, result)
+	if result.HasFieldErrors(field) || value == nil {
+		return
+	}
+
+	fieldName := e.GetLabel(field)
+	str, ok := value.(string)
+	if !ok {
+		result.AddRuleError(field, i18n.KeyString, fieldName)
+		return
+	}
+	if str == "" {
+		return
+	}
+	if !emailRegex.MatchString(str) {
+		result.AddRuleError(field, i18n.KeyEmail, fieldName)
+		return
+	}
+
+	local, domain, _ := rules.SplitEmail(str)
+	if len(e.domainsIn) > 0 && !rules.EmailDomainMatches(domain, e.domainsIn) {
+		result.AddRuleError(field, i18n.KeyEmailDomainIn, fieldName, strings.Join(e.domainsIn, ", "))
+	}
+	if len(e.domainsNotIn) > 0 && rules.EmailDomainMatches(domain, e.domainsNotIn) {
+		result.AddRuleError(field, i18n.KeyEmailDomainNotIn, fieldName)
+	}
+	if e.rejectFree && rules.IsFreeEmailProvider(domain) {
+		result.AddRuleError(field, i18n.KeyEmailFreeProvider, fieldName)
+	}
+	if e.rejectRole && rules.IsRoleAccount(local) {
+		result.AddRuleError(field, i18n.KeyEmailRoleAccount, fieldName)
+	}
+
+	if e.customValidation != nil && e.customValidation.HasValidators() {
+		e.customValidation.ValidateSync(field, value, result)
+	}
+}
+
+// HasAsyncRules, core.AsyncOptional implementasyonu; yalnızca DNS kontrolleri
+// tanımlıysa alan dış kaynaklı kontrol adımına dahil edilir.
+func (e *EmailType) HasAsyncRules() bool {
+	return e.dns.enabled()
+}
+
+// ValidateAsync, core.AsyncValidatable implementasyonu; adresin alan adını
+// DNS'te denetler.
+func (e *EmailType) ValidateAsync(ctx context.Context, field string, value any, result *core.ValidationResult) {
+	str, _ := value.(string)
+	if _, domain, ok := rules.SplitEmail(str); ok && domain != "" {
+		e.dns.validate(ctx, field, e.GetLabel(field), domain, result)
+	}
+}
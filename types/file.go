@@ -0,0 +1,202 @@
+package types
+
+import (
+	"fmt"
+	"mime/multipart"
+	"strings"
+
+	"github.com/biyonik/go-fluent-validator/core"
+	"github.com/biyonik/go-fluent-validator/i18n"
+	"github.com/biyonik/go-fluent-validator/rules"
+)
+
+// FileType
+//
+// Multipart formlarla yüklenen dosyaları (*multipart.FileHeader) doğrulayan
+// tiptir. Boyut sınırı, uzantı listesi ve içerikten tespit edilen (istemcinin
+// gönderdiği Content-Type başlığına güvenilmeyen) MIME türü denetlenir.
+// SanitizeFilename ile dosya adı rules.SanitizeFilename kullanılarak
+// temizlenir; ValidData'da adı temizlenmiş bir kopya döner, orijinal başlık
+// değiştirilmez.
+//
+// Veri genellikle httpvalidate.FromForm ile doldurulur; birden çok dosya
+// yüklenen alanlar Array().Elements(File()) ile doğrulanır.
+//
+// Kullanım Örneği:
+//
+//	"avatar": validation.File().Required().MaxSize(2 << 20).
+//	    MIMETypes("image/png", "image/jpeg").Extensions("png", "jpg", "jpeg").
+//	    SanitizeFilename(),
+//
+// Yazar Bilgileri:
+//   - @author  Ahmet Altun
+//   - @github  https://github.com/biyonik
+//   - @company Biyonik Software
+//   - @email   admin@biyonik.dev
+type FileType struct {
+	core.BaseType
+	maxSize    *int64
+	mimeTypes  []string
+	extensions []string
+	sanitize   bool
+}
+
+// Required, dosyanın yüklenmesini zorunlu kılar.
+func (f *FileType) Required() *FileType {
+	f.SetRequired()
+	return f
+}
+
+// Optional, alanın anahtarının payload'da hiç bulunmayabileceğini belirtir;
+// eksik anahtar Required olsa bile geçerlidir ve ValidData'ya yazılmaz.
+func (f *FileType) Optional() *FileType {
+	f.SetOptional()
+	return f
+}
+
+// Nullable, alanın açıkça null gönderilebileceğini belirtir; null değer
+// Required olsa bile geçerlidir ve ValidData'da korunur.
+func (f *FileType) Nullable() *FileType {
+	f.SetNullable()
+	return f
+}
+
+// Label, doğrulama hatalarında görünecek kullanıcı dostu adı belirler.
+func (f *FileType) Label(label string) *FileType {
+	f.SetLabel(label)
+	return f
+}
+
+// Severity, alanın kurallarının önem seviyesini belirler.
+func (f *FileType) Severity(level core.Severity) *FileType {
+	f.SetSeverity(level)
+	return f
+}
+
+// Describe, alan için dokümantasyon amaçlı açıklama tanımlar.
+func (f *FileType) Describe(text string) *FileType {
+	f.SetDescription(text)
+	return f
+}
+
+// Deprecated, alanı kullanımdan kaldırılmış olarak işaretler.
+func (f *FileType) Deprecated(reason string) *FileType {
+	f.SetDeprecated(reason)
+	return f
+}
+
+// MaxSize, dosyanın en fazla bytes bayt olmasını zorunlu kılar.
+func (f *FileType) MaxSize(bytes int64) *FileType {
+	f.maxSize = &bytes
+	return f
+}
+
+// MIMETypes, dosya içeriğinden tespit edilen türün verilen türlerden biri
+// olmasını zorunlu kılar. "image/*" gibi jokerler desteklenir.
+func (f *FileType) MIMETypes(mimeTypes ...string) *FileType {
+	f.mimeTypes = append(f.mimeTypes, mimeTypes...)
+	return f
+}
+
+// Extensions, dosya adının verilen uzantılardan biriyle bitmesini zorunlu
+// kılar ("pdf" veya ".pdf").
+func (f *FileType) Extensions(extensions ...string) *FileType {
+	f.extensions = append(f.extensions, extensions...)
+	return f
+}
+
+// SanitizeFilename, dosya adını rules.SanitizeFilename ile temizler. Temizlik
+// sonrası boş kalan adlar reddedilir; uzantı kontrolü temizlenmiş ada
+// uygulanır.
+func (f *FileType) SanitizeFilename() *FileType {
+	if f.sanitize {
+		return f
+	}
+	f.sanitize = true
+	f.AddNamedTransform("sanitize_filename", func(value any) (any, error) {
+		header, ok := value.(*multipart.FileHeader)
+		if !ok || header == nil {
+			return value, nil
+		}
+		clean := *header
+		clean.Filename = rules.SanitizeFilename(header.Filename)
+		return &clean, nil
+	})
+	return f
+}
+
+// Validate, değerin bir *multipart.FileHeader olduğunu ve boyut, uzantı ve
+// MIME türü kurallarına uyduğunu denetler.
+func (f *FileType) Validate(field string, value any, result *core.ValidationResult) {
+	header, ok := value.(*multipart.FileHeader)
+	switch {
+	case ok && header == nil:
+		value = nil
+	case !ok && value != nil:
+		result.AddRuleError(field, i18n.KeyFile, f.GetLabel(field))
+		return
+	}
+	f.BaseType.Validate(field, value, result)
+	if result.HasFieldErrors(field) || header == nil {
+		return
+	}
+
+	fieldName := f.GetLabel(field)
+	if header.Filename == "" {
+		result.AddRuleError(field, i18n.KeyFile, fieldName)
+		return
+	}
+	if f.maxSize != nil && header.Size > *f.maxSize {
+		result.AddRuleError(field, i18n.KeyFileSize, fieldName, formatFileSize(*f.maxSize))
+	}
+	if len(f.extensions) > 0 && !rules.HasExtension(header.Filename, f.extensions) {
+		result.AddRuleError(field, i18n.KeyFileExtension, fieldName, strings.Join(f.extensions, ", "))
+	}
+	if len(f.mimeTypes) > 0 {
+		f.validateMIME(field, fieldName, header, result)
+	}
+}
+
+// validateMIME, dosyanın içeriğinden tespit edilen türü izin verilen
+// türlerle karşılaştırır. Dosya açılamazsa geçersiz sayılır.
+func (f *FileType) validateMIME(field, fieldName string, header *multipart.FileHeader, result *core.ValidationResult) {
+	file, err := header.Open()
+	if err != nil {
+		result.AddRuleError(field, i18n.KeyFile, fieldName)
+		return
+	}
+	defer file.Close()
+
+	mediaType, err := rules.SniffMIME(file)
+	if err != nil || !rules.MatchMIME(mediaType, f.mimeTypes) {
+		result.AddRuleError(field, i18n.KeyFileMIME, fieldName, strings.Join(f.mimeTypes, ", "))
+	}
+}
+
+// Introspect, dosya alanının kurallarını yapısal olarak döndürür.
+func (f *FileType) Introspect() *core.TypeDescription {
+	desc := f.DescribeBase("file")
+	if f.maxSize != nil {
+		desc.AddRule("max_size", map[string]any{"value": *f.maxSize})
+	}
+	if len(f.mimeTypes) > 0 {
+		desc.AddRule("mime_types", map[string]any{"values": append([]string(nil), f.mimeTypes...)})
+	}
+	if len(f.extensions) > 0 {
+		desc.AddRule("extensions", map[string]any{"values": append([]string(nil), f.extensions...)})
+	}
+	return desc
+}
+
+// formatFileSize, bayt sayısını hata mesajları için okunabilir biçime
+// çevirir (örn. 2097152 → "2 MB", 1536 → "1.5 KB").
+func formatFileSize(bytes int64) string {
+	units := []string{"B", "KB", "MB", "GB", "TB"}
+	size := float64(bytes)
+	unit := 0
+	for size >= 1024 && unit < len(units)-1 {
+		size /= 1024
+		unit++
+	}
+	return strings.TrimSuffix(strings.TrimRight(fmt.Sprintf("%.1f", size), "0"), ".") + " " + units[unit]
+}
@@ -43,6 +43,20 @@ func (i *IbanType) Required() *IbanType {
 	return i
 }
 
+// Optional, alanın anahtarının payload'da hiç bulunmayabileceğini belirtir;
+// eksik anahtar Required olsa bile geçerlidir ve ValidData'ya yazılmaz.
+func (i *IbanType) Optional() *IbanType {
+	i.SetOptional()
+	return i
+}
+
+// Nullable, alanın açıkça null gönderilebileceğini belirtir; null değer
+// Required olsa bile geçerlidir ve ValidData'da korunur.
+func (i *IbanType) Nullable() *IbanType {
+	i.SetNullable()
+	return i
+}
+
 // Label, doğrulama hatalarında gösterilecek kullanıcı dostu alan adını belirler.
 //
 // Parametreler:
@@ -55,6 +69,33 @@ func (i *IbanType) Label(label string) *IbanType {
 	return i
 }
 
+// Severity, alanın kurallarının önem seviyesini belirler (error, warning, info).
+// SeverityError dışındaki ihlaller şemanın politikasına göre uyarı olarak raporlanır.
+func (i *IbanType) Severity(level core.Severity) *IbanType {
+	i.SetSeverity(level)
+	return i
+}
+
+// Describe, alan için dokümantasyon amaçlı açıklama tanımlar. Doğrulamayı
+// etkilemez; Describe() çıktısında ve üretilen dokümanlarda görünür.
+func (i *IbanType) Describe(text string) *IbanType {
+	i.SetDescription(text)
+	return i
+}
+
+// Example, dokümantasyonda gösterilecek örnek bir değer ekler.
+func (i *IbanType) Example(value any) *IbanType {
+	i.AddExample(value)
+	return i
+}
+
+// Deprecated, alanı kullanımdan kaldırılmış olarak işaretler. reason, kaldırma
+// gerekçesini veya yerine kullanılacak alanı belirtir.
+func (i *IbanType) Deprecated(reason string) *IbanType {
+	i.SetDeprecated(reason)
+	return i
+}
+
 // Country, IBAN doğrulamasında belirli bir ülke kodu zorunluluğu ekler.
 //
 // Parametreler:
@@ -67,6 +108,15 @@ func (i *IbanType) Country(code string) *IbanType {
 	return i
 }
 
+// Immutable, alanın güncelleme sırasında (ValidateChanges) değiştirilemeyeceğini belirtir.
+//
+// Döndürür:
+//   - *IbanType
+func (i *IbanType) Immutable() *IbanType {
+	i.SetImmutable()
+	return i
+}
+
 // Custom adds a custom validation function
 func (i *IbanType) Custom(validator func(string) error) *IbanType {
 	if i.customValidation == nil {
@@ -98,6 +148,16 @@ func (i *IbanType) AddRule(rule core.Rule) *IbanType {
 	return i
 }
 
+// Introspect, IBAN tipinin kurallarını yapısal olarak döndürür.
+func (i *IbanType) Introspect() *core.TypeDescription {
+	desc := i.DescribeBase("iban")
+	if i.countryCode != "" {
+		desc.AddRule("country", map[string]any{"value": i.countryCode})
+	}
+	desc.CustomRules = i.customValidation.Count()
+	return desc
+}
+
 // Validate, IBAN alanının geçerliliğini kontrol eder.
 //
 // İşlem sırası:
@@ -111,18 +171,18 @@ func (i *IbanType) AddRule(rule core.Rule) *IbanType {
 //   - result (*core.ValidationResult): doğrulama sonucu
 func (i *IbanType) Validate(field string, value any, result *core.ValidationResult) {
 	i.BaseType.Validate(field, value, result)
-	if result.HasErrors() || value == nil {
+	if result.HasFieldErrors(field) || value == nil {
 		return
 	}
 
 	str, ok := value.(string)
 	if !ok {
-		result.AddError(field, i18n.Get(i18n.KeyString, i.GetLabel(field)))
+		result.AddRuleError(field, i18n.KeyString, i.GetLabel(field))
 		return
 	}
 
 	if !rules.IsValidIBAN(str, i.countryCode) {
-		result.AddError(field, i18n.Get(i18n.KeyIBAN, i.GetLabel(field)))
+		result.AddRuleError(field, i18n.KeyIBAN, i.GetLabel(field))
 	}
 
 	if i.customValidation != nil && i.customValidation.HasValidators() {
@@ -0,0 +1,230 @@
+package types
+
+import (
+	"fmt"
+	"mime/multipart"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/biyonik/go-fluent-validator/core"
+	"github.com/biyonik/go-fluent-validator/i18n"
+	"github.com/biyonik/go-fluent-validator/rules"
+)
+
+// ImageType
+//
+// Yüklenen görselleri doğrulayan, FileType'ın tüm kurallarını (boyut, uzantı,
+// MIME, dosya adı temizliği) devralan tiptir. Görselin biçimi ve piksel
+// boyutları yalnızca dosya başlığı okunarak (rules.DecodeImageConfig) tespit
+// edilir; piksel verisi çözülmediği için büyük veya kötü niyetli dosyalar
+// belleğe açılmadan reddedilir.
+//
+// Kullanım Örneği:
+//
+//	"cover": validation.Image().Required().MaxSize(5 << 20).
+//	    Formats("png", "jpeg", "webp").MinWidth(1200).MaxMegapixels(24).
+//	    AspectRatio(16, 9),
+//
+// Yazar Bilgileri:
+//   - @author  Ahmet Altun
+//   - @github  https://github.com/biyonik
+//   - @company Biyonik Software
+//   - @email   admin@biyonik.dev
+type ImageType struct {
+	FileType
+	formats       []string
+	minWidth      *int
+	maxWidth      *int
+	minHeight     *int
+	maxHeight     *int
+	maxMegapixels *float64
+	aspectRatio   *[2]int
+}
+
+// Required, görselin yüklenmesini zorunlu kılar.
+func (i *ImageType) Required() *ImageType {
+	i.SetRequired()
+	return i
+}
+
+// Optional, alanın anahtarının payload'da hiç bulunmayabileceğini belirtir;
+// eksik anahtar Required olsa bile geçerlidir ve ValidData'ya yazılmaz.
+func (i *ImageType) Optional() *ImageType {
+	i.SetOptional()
+	return i
+}
+
+// Nullable, alanın açıkça null gönderilebileceğini belirtir; null değer
+// Required olsa bile geçerlidir ve ValidData'da korunur.
+func (i *ImageType) Nullable() *ImageType {
+	i.SetNullable()
+	return i
+}
+
+// Label, doğrulama hatalarında görünecek kullanıcı dostu adı belirler.
+func (i *ImageType) Label(label string) *ImageType {
+	i.SetLabel(label)
+	return i
+}
+
+// Severity, alanın kurallarının önem seviyesini belirler.
+func (i *ImageType) Severity(level core.Severity) *ImageType {
+	i.SetSeverity(level)
+	return i
+}
+
+// Describe, alan için dokümantasyon amaçlı açıklama tanımlar.
+func (i *ImageType) Describe(text string) *ImageType {
+	i.SetDescription(text)
+	return i
+}
+
+// Deprecated, alanı kullanımdan kaldırılmış olarak işaretler.
+func (i *ImageType) Deprecated(reason string) *ImageType {
+	i.SetDeprecated(reason)
+	return i
+}
+
+// MaxSize, dosyanın en fazla bytes bayt olmasını zorunlu kılar.
+func (i *ImageType) MaxSize(bytes int64) *ImageType {
+	i.FileType.MaxSize(bytes)
+	return i
+}
+
+// MIMETypes, içerikten tespit edilen MIME türünü sınırlar (bkz. FileType).
+func (i *ImageType) MIMETypes(mimeTypes ...string) *ImageType {
+	i.FileType.MIMETypes(mimeTypes...)
+	return i
+}
+
+// Extensions, dosya adının uzantısını sınırlar (bkz. FileType).
+func (i *ImageType) Extensions(extensions ...string) *ImageType {
+	i.FileType.Extensions(extensions...)
+	return i
+}
+
+// SanitizeFilename, dosya adını rules.SanitizeFilename ile temizler.
+func (i *ImageType) SanitizeFilename() *ImageType {
+	i.FileType.SanitizeFilename()
+	return i
+}
+
+// Formats, görselin başlığından tespit edilen biçimin verilenlerden biri
+// olmasını zorunlu kılar (png, jpeg/jpg, gif, webp).
+func (i *ImageType) Formats(formats ...string) *ImageType {
+	for _, format := range formats {
+		i.formats = append(i.formats, rules.NormalizeImageFormat(format))
+	}
+	return i
+}
+
+// MinWidth, görselin en az px piksel genişliğinde olmasını zorunlu kılar.
+func (i *ImageType) MinWidth(px int) *ImageType {
+	i.minWidth = &px
+	return i
+}
+
+// MaxWidth, görselin en fazla px piksel genişliğinde olmasını zorunlu kılar.
+func (i *ImageType) MaxWidth(px int) *ImageType {
+	i.maxWidth = &px
+	return i
+}
+
+// MinHeight, görselin en az px piksel yüksekliğinde olmasını zorunlu kılar.
+func (i *ImageType) MinHeight(px int) *ImageType {
+	i.minHeight = &px
+	return i
+}
+
+// MaxHeight, görselin en fazla px piksel yüksekliğinde olmasını zorunlu kılar.
+func (i *ImageType) MaxHeight(px int) *ImageType {
+	i.maxHeight = &px
+	return i
+}
+
+// MaxMegapixels, görselin toplam piksel sayısını milyon cinsinden sınırlar.
+func (i *ImageType) MaxMegapixels(mp float64) *ImageType {
+	i.maxMegapixels = &mp
+	return i
+}
+
+// AspectRatio, görselin en-boy oranının width:height (örn. 16:9) olmasını
+// zorunlu kılar; rules.AspectRatioTolerance kadar sapma kabul edilir.
+func (i *ImageType) AspectRatio(width, height int) *ImageType {
+	i.aspectRatio = &[2]int{width, height}
+	return i
+}
+
+// Validate, önce FileType kurallarını uygular, ardından görsel başlığını
+// okuyarak biçim ve boyut kurallarını denetler.
+func (i *ImageType) Validate(field string, value any, result *core.ValidationResult) {
+	i.FileType.Validate(field, value, result)
+	header, _ := value.(*multipart.FileHeader)
+	if result.HasFieldErrors(field) || header == nil {
+		return
+	}
+
+	fieldName := i.GetLabel(field)
+	file, err := header.Open()
+	if err != nil {
+		result.AddRuleError(field, i18n.KeyImage, fieldName)
+		return
+	}
+	defer file.Close()
+
+	cfg, err := rules.DecodeImageConfig(file)
+	if err != nil {
+		result.AddRuleError(field, i18n.KeyImage, fieldName)
+		return
+	}
+	if len(i.formats) > 0 && !slices.Contains(i.formats, cfg.Format) {
+		result.AddRuleError(field, i18n.KeyImageFormat, fieldName, strings.Join(i.formats, ", "))
+	}
+	if i.minWidth != nil && cfg.Width < *i.minWidth {
+		result.AddRuleError(field, i18n.KeyImageMinWidth, fieldName, *i.minWidth)
+	}
+	if i.maxWidth != nil && cfg.Width > *i.maxWidth {
+		result.AddRuleError(field, i18n.KeyImageMaxWidth, fieldName, *i.maxWidth)
+	}
+	if i.minHeight != nil && cfg.Height < *i.minHeight {
+		result.AddRuleError(field, i18n.KeyImageMinHeight, fieldName, *i.minHeight)
+	}
+	if i.maxHeight != nil && cfg.Height > *i.maxHeight {
+		result.AddRuleError(field, i18n.KeyImageMaxHeight, fieldName, *i.maxHeight)
+	}
+	if i.maxMegapixels != nil && cfg.Megapixels() > *i.maxMegapixels {
+		result.AddRuleError(field, i18n.KeyImageMegapixels, fieldName, strconv.FormatFloat(*i.maxMegapixels, 'f', -1, 64))
+	}
+	if r := i.aspectRatio; r != nil && !rules.MatchAspectRatio(cfg.Width, cfg.Height, r[0], r[1]) {
+		result.AddRuleError(field, i18n.KeyImageAspectRatio, fieldName, fmt.Sprintf("%d:%d", r[0], r[1]))
+	}
+}
+
+// Introspect, görsel alanının dosya ve görsel kurallarını yapısal olarak
+// döndürür.
+func (i *ImageType) Introspect() *core.TypeDescription {
+	desc := i.FileType.Introspect()
+	desc.Type = "image"
+	if len(i.formats) > 0 {
+		desc.AddRule("formats", map[string]any{"values": append([]string(nil), i.formats...)})
+	}
+	for _, limit := range []struct {
+		name  string
+		value *int
+	}{
+		{"min_width", i.minWidth}, {"max_width", i.maxWidth},
+		{"min_height", i.minHeight}, {"max_height", i.maxHeight},
+	} {
+		if limit.value != nil {
+			desc.AddRule(limit.name, map[string]any{"value": *limit.value})
+		}
+	}
+	if i.maxMegapixels != nil {
+		desc.AddRule("max_megapixels", map[string]any{"value": *i.maxMegapixels})
+	}
+	if r := i.aspectRatio; r != nil {
+		desc.AddRule("aspect_ratio", map[string]any{"width": r[0], "height": r[1]})
+	}
+	return desc
+}
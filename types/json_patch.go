@@ -0,0 +1,336 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/biyonik/go-fluent-validator/core"
+	"github.com/biyonik/go-fluent-validator/i18n"
+	"github.com/biyonik/go-fluent-validator/rules"
+)
+
+// jsonPatchOps, RFC 6902'de tanımlı işlemlerdir.
+var jsonPatchOps = []string{"add", "remove", "replace", "move", "copy", "test"}
+
+// JSONPatchType
+//
+// RFC 6902 JSON Patch dokümanlarını (işlem nesnelerinden oluşan dizi)
+// doğrulayan tiptir. Her işlemde op değerinin tanımlı işlemlerden biri
+// olması, path (ve move/copy için from) alanlarının geçerli birer JSON Pointer
+// olması ve add/replace/test işlemlerinde value alanının bulunması denetlenir.
+//
+// Hedef şema verildiğinde path, şemanın alanları (Object alt alanları ve
+// Array elemanları dahil) üzerinde çözülür: şemada olmayan yollar reddedilir,
+// add/replace değerleri ilgili alanın tipiyle doğrulanır ve zorunlu alanların
+// remove ile silinmesine izin verilmez. Hatalar "patch[0].value" gibi işlem
+// indeksli alan adlarıyla raporlanır.
+//
+// Kullanım Örneği:
+//
+//	user := validation.Make().Shape(map[string]validation.Type{
+//	    "name": validation.String().Required().Max(50),
+//	    "tags": validation.Array().Elements(validation.String().Max(20)),
+//	})
+//	res := validation.JSONPatch(user).ValidateJSON(body)
+//
+// Yazar Bilgileri:
+//   - @author  Ahmet Altun
+//   - @github  https://github.com/biyonik
+//   - @company Biyonik Software
+//   - @email   admin@biyonik.dev
+type JSONPatchType struct {
+	core.BaseType
+	target core.Schema
+}
+
+// Target, yamaların uygulanacağı dokümanın şemasını belirler. target nil ise
+// yalnızca doküman yapısı denetlenir.
+func (p *JSONPatchType) Target(target core.Schema) *JSONPatchType {
+	p.target = target
+	return p
+}
+
+// Required, alanın zorunlu olmasını sağlar.
+func (p *JSONPatchType) Required() *JSONPatchType {
+	p.SetRequired()
+	return p
+}
+
+// Optional, alanın anahtarının payload'da hiç bulunmayabileceğini belirtir;
+// eksik anahtar Required olsa bile geçerlidir ve ValidData'ya yazılmaz.
+func (p *JSONPatchType) Optional() *JSONPatchType {
+	p.SetOptional()
+	return p
+}
+
+// Nullable, alanın açıkça null gönderilebileceğini belirtir; null değer
+// Required olsa bile geçerlidir ve ValidData'da korunur.
+func (p *JSONPatchType) Nullable() *JSONPatchType {
+	p.SetNullable()
+	return p
+}
+
+// Label, doğrulama hatalarında görünecek kullanıcı dostu adı belirler.
+func (p *JSONPatchType) Label(label string) *JSONPatchType {
+	p.SetLabel(label)
+	return p
+}
+
+// Describe, alan için dokümantasyon amaçlı açıklama tanımlar.
+func (p *JSONPatchType) Describe(text string) *JSONPatchType {
+	p.SetDescription(text)
+	return p
+}
+
+// ValidateJSON
+// -----------------------------------------------------------------------------
+// PATCH isteğinin ham gövdesini (kökü dizi olan JSON) UseNumber ile çözer ve
+// doğrular. Bozuk JSON "_payload" alanında raporlanır; işlem hataları
+// "[0].path" gibi kök indeksli alan adlarıyla döner. Hata yoksa ValidData
+// "patch" anahtarı altında çözülmüş işlemleri içerir.
+func (p *JSONPatchType) ValidateJSON(data []byte) *core.ValidationResult {
+	result := core.NewResult()
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var patch any
+	if err := dec.Decode(&patch); err != nil || dec.More() {
+		result.AddRuleError("_payload", i18n.KeyInvalidJSON)
+		return result
+	}
+	p.Validate("", patch, result)
+	if !result.HasErrors() {
+		result.SetValidData(map[string]any{"patch": patch})
+	}
+	return result
+}
+
+// Validate, değerin geçerli bir JSON Patch dizisi olduğunu ve (hedef şema
+// varsa) her işlemin şemaya uyduğunu denetler.
+func (p *JSONPatchType) Validate(field string, value any, result *core.ValidationResult) {
+	p.BaseType.Validate(field, value, result)
+	if result.HasFieldErrors(field) || value == nil {
+		return
+	}
+
+	ops, ok := value.([]any)
+	if !ok {
+		result.AddRuleError(field, i18n.KeyJSONPatch, p.GetLabel(field))
+		return
+	}
+	for i, item := range ops {
+		path := fmt.Sprintf("%s[%d]", field, i)
+		op, ok := item.(map[string]any)
+		if !ok {
+			result.AddRuleError(path, i18n.KeyJSONPatch, path)
+			continue
+		}
+		p.validateOperation(path, op, result)
+	}
+}
+
+// validateOperation, tek bir işlem nesnesini doğrular.
+func (p *JSONPatchType) validateOperation(path string, op map[string]any, result *core.ValidationResult) {
+	name, _ := op["op"].(string)
+	switch {
+	case op["op"] == nil:
+		result.AddRuleError(path+".op", i18n.KeyRequired, path+".op")
+		return
+	case !containsOp(name):
+		// İşlem listesi sabit ve kısa olduğundan kısaltılmadan yazılır.
+		result.AddRuleError(path+".op", i18n.KeyOneOf, path+".op", strings.Join(jsonPatchOps, ", "),
+			i18n.Attrs{"attribute": path + ".op", "values": i18n.StringList(jsonPatchOps)})
+		return
+	}
+
+	target, ok := p.pointerMember(path+".path", op["path"], result)
+	if !ok {
+		return
+	}
+	var from string
+	if name == "move" || name == "copy" {
+		if from, ok = p.pointerMember(path+".from", op["from"], result); !ok {
+			return
+		}
+		if name == "move" && strings.HasPrefix(target, from+"/") {
+			result.AddRuleError(path+".from", i18n.KeyJSONPatchMove, path+".from")
+			return
+		}
+	}
+	value, hasValue := op["value"]
+	if (name == "add" || name == "replace" || name == "test") && !hasValue {
+		result.AddRuleError(path+".value", i18n.KeyRequired, path+".value")
+		return
+	}
+	if p.target == nil {
+		return
+	}
+
+	switch name {
+	case "add", "replace":
+		p.validateValue(path, target, value, result)
+	case "remove":
+		p.checkRemovable(path+".path", target, result)
+	case "move":
+		if p.checkRemovable(path+".from", from, result) {
+			p.checkPath(path+".path", target, result)
+		}
+	case "copy":
+		if p.checkPath(path+".from", from, result) {
+			p.checkPath(path+".path", target, result)
+		}
+	default:
+		p.checkPath(path+".path", target, result)
+	}
+}
+
+// pointerMember, path/from üyesinin var olan geçerli bir JSON Pointer
+// olduğunu denetler.
+func (p *JSONPatchType) pointerMember(field string, value any, result *core.ValidationResult) (string, bool) {
+	pointer, ok := value.(string)
+	switch {
+	case value == nil:
+		result.AddRuleError(field, i18n.KeyRequired, field)
+	case !ok || !rules.IsValidJSONPointer(pointer):
+		result.AddRuleError(field, i18n.KeyJSONPointer, field)
+	default:
+		return pointer, true
+	}
+	return "", false
+}
+
+// checkPath, pointer'ın hedef şemada karşılığı olduğunu denetler.
+func (p *JSONPatchType) checkPath(field, pointer string, result *core.ValidationResult) bool {
+	if _, ok := p.resolve(pointer); !ok {
+		result.AddRuleError(field, i18n.KeyJSONPatchPath, field)
+		return false
+	}
+	return true
+}
+
+// checkRemovable, pointer'ın şemada var olduğunu ve zorunlu bir alanı
+// göstermediğini denetler (remove ve move kaynağı için).
+func (p *JSONPatchType) checkRemovable(field, pointer string, result *core.ValidationResult) bool {
+	typ, ok := p.resolve(pointer)
+	switch {
+	case !ok:
+		result.AddRuleError(field, i18n.KeyJSONPatchPath, field)
+	case pointer == "" || typ != nil && isRequiredType(typ):
+		result.AddRuleError(field, i18n.KeyJSONPatchRemove, field)
+	default:
+		return true
+	}
+	return false
+}
+
+// validateValue, add/replace değerini pointer'ın işaret ettiği tiple doğrular.
+// Kök ("") hedefte değer tüm şemayla doğrulanır.
+func (p *JSONPatchType) validateValue(path, pointer string, value any, result *core.ValidationResult) {
+	if pointer == "" {
+		doc, ok := value.(map[string]any)
+		if !ok {
+			result.AddRuleError(path+".value", i18n.KeyObject, path+".value")
+			return
+		}
+		result.MergePrefixed(path+".value", p.target.Validate(doc))
+		return
+	}
+
+	typ, ok := p.resolve(pointer)
+	switch {
+	case !ok:
+		result.AddRuleError(path+".path", i18n.KeyJSONPatchPath, path+".path")
+	case typ == nil, value == nil && !isRequiredType(typ):
+	default:
+		transformed, err := typ.Transform(value)
+		if err != nil {
+			result.AddErrorRule(path+".value", "transform", fmt.Sprintf("%s: %s", path+".value", err.Error()))
+			return
+		}
+		typ.Validate(path+".value", transformed, result)
+	}
+}
+
+// resolve, pointer'ı hedef şema üzerinde çözerek işaret ettiği tipi döndürür.
+// Eleman tipi tanımsız dizilerin altındaki yollar için (nil, true) döner.
+func (p *JSONPatchType) resolve(pointer string) (core.Type, bool) {
+	if pointer == "" {
+		return nil, true
+	}
+	provider, ok := p.target.(core.ShapeProvider)
+	if !ok {
+		return nil, true
+	}
+	var current core.Type
+	shape := provider.GetShape()
+	for i, token := range strings.Split(pointer[1:], "/") {
+		token = strings.NewReplacer("~1", "/", "~0", "~").Replace(token)
+		if i > 0 {
+			switch t := current.(type) {
+			case core.ElementProvider:
+				if token != "-" && !isArrayIndex(token) {
+					return nil, false
+				}
+				current = t.GetElementSchema()
+				if current == nil {
+					return nil, true
+				}
+				continue
+			case core.ShapeProvider:
+				shape = t.GetShape()
+				if len(shape) == 0 {
+					return nil, true
+				}
+			default:
+				return nil, false
+			}
+		}
+		typ, ok := shape[token]
+		if !ok {
+			return nil, false
+		}
+		current = typ
+	}
+	return current, true
+}
+
+// Introspect, yama alanını yapısal olarak döndürür. Hedef şemanın alanları
+// Fields altında yer alır.
+func (p *JSONPatchType) Introspect() *core.TypeDescription {
+	desc := p.DescribeBase("json_patch")
+	if describer, ok := p.target.(core.SchemaDescriber); ok {
+		desc.Fields = describer.Describe().Fields
+	}
+	return desc
+}
+
+// containsOp, name'in RFC 6902 işlemlerinden biri olup olmadığını döndürür.
+func containsOp(name string) bool {
+	for _, op := range jsonPatchOps {
+		if op == name {
+			return true
+		}
+	}
+	return false
+}
+
+// isArrayIndex, token'ın RFC 6901 dizi indeksi (başında sıfır olmayan rakam
+// dizisi) olup olmadığını döndürür.
+func isArrayIndex(token string) bool {
+	if token == "" || (len(token) > 1 && token[0] == '0') {
+		return false
+	}
+	for _, c := range token {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// isRequiredType, tipin zorunlu işaretli olup olmadığını döndürür.
+func isRequiredType(typ core.Type) bool {
+	desc := core.DescribeType(typ)
+	return desc != nil && desc.Required
+}
@@ -0,0 +1,117 @@
+// -----------------------------------------------------------------------------
+// LiteralType
+// -----------------------------------------------------------------------------
+// Değerin tek bir sabite eşit olmasını gerektiren alan tipidir. Zarf (envelope)
+// alanları ve sürüm işaretleri için kullanılır:
+//
+//	"version": validation.Literal(2).Required(),
+//	"kind":    validation.Literal("invoice"),
+//
+// Karşılaştırma core.ValuesEqual ile yapılır; JSON'dan gelen 2.0 ile 2 gibi
+// sayısal değerler eşit kabul edilir, "2" ise 2'ye eşit değildir.
+//
+// Yazar Bilgileri:
+// @author   Ahmet ALTUN
+// @github   github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email    ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+package types
+
+import (
+	"github.com/biyonik/go-fluent-validator/core"
+	"github.com/biyonik/go-fluent-validator/i18n"
+)
+
+// LiteralType, değeri tek bir sabitle sınırlayan alan tipidir.
+type LiteralType struct {
+	core.BaseType
+	value any
+}
+
+// Value, alanın eşit olması gereken sabiti belirler.
+func (l *LiteralType) Value(value any) *LiteralType {
+	l.value = value
+	return l
+}
+
+// Required, alanın zorunlu olduğunu belirtir.
+func (l *LiteralType) Required() *LiteralType {
+	l.SetRequired()
+	return l
+}
+
+// Optional, alanın anahtarının payload'da hiç bulunmayabileceğini belirtir;
+// eksik anahtar Required olsa bile geçerlidir ve ValidData'ya yazılmaz.
+func (l *LiteralType) Optional() *LiteralType {
+	l.SetOptional()
+	return l
+}
+
+// Nullable, alanın açıkça null gönderilebileceğini belirtir; null değer
+// Required olsa bile geçerlidir ve ValidData'da korunur.
+func (l *LiteralType) Nullable() *LiteralType {
+	l.SetNullable()
+	return l
+}
+
+// Label, kullanıcıya gösterilecek alan adını özelleştirir.
+func (l *LiteralType) Label(label string) *LiteralType {
+	l.SetLabel(label)
+	return l
+}
+
+// Severity, alanın kurallarının önem seviyesini belirler (error, warning, info).
+// SeverityError dışındaki ihlaller şemanın politikasına göre uyarı olarak raporlanır.
+func (l *LiteralType) Severity(level core.Severity) *LiteralType {
+	l.SetSeverity(level)
+	return l
+}
+
+// Describe, alan için dokümantasyon amaçlı açıklama tanımlar. Doğrulamayı
+// etkilemez; Describe() çıktısında ve üretilen dokümanlarda görünür.
+func (l *LiteralType) Describe(text string) *LiteralType {
+	l.SetDescription(text)
+	return l
+}
+
+// Deprecated, alanı kullanımdan kaldırılmış olarak işaretler. reason, kaldırma
+// gerekçesini veya yerine kullanılacak alanı belirtir.
+func (l *LiteralType) Deprecated(reason string) *LiteralType {
+	l.SetDeprecated(reason)
+	return l
+}
+
+// Default, değer gönderilmediğinde sabitin kendisini kullanır.
+func (l *LiteralType) Default() *LiteralType {
+	l.SetDefault(l.value)
+	return l
+}
+
+// GetValue, alanın eşit olması gereken sabiti döndürür.
+func (l *LiteralType) GetValue() any {
+	return l.value
+}
+
+// Introspect, literal tipinin sabitini yapısal olarak döndürür.
+func (l *LiteralType) Introspect() *core.TypeDescription {
+	desc := l.DescribeBase("literal")
+	desc.AddRule("literal", map[string]any{"value": l.value})
+	return desc
+}
+
+// Validate, değerin sabite eşit olduğunu doğrular.
+func (l *LiteralType) Validate(field string, value any, result *core.ValidationResult) {
+	l.BaseType.Validate(field, value, result)
+	if result.HasFieldErrors(field) {
+		return
+	}
+	if value == nil {
+		return
+	}
+
+	if !core.ValuesEqual(value, l.value) {
+		result.AddRuleError(field, i18n.KeyLiteral, l.GetLabel(field), i18n.Attrs{"value": l.value})
+	}
+}
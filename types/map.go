@@ -0,0 +1,283 @@
+// -----------------------------------------------------------------------------
+// MapType
+// -----------------------------------------------------------------------------
+// Anahtarları önceden bilinmeyen nesneler (feature flag'ler, dil bazlı
+// çeviriler, metadata alanları) için anahtar/değer tipidir. ObjectType'ın
+// sabit Shape'i yerine anahtarlar ve değerler ayrı şemalarla doğrulanır:
+//
+//	"translations": validation.Map().
+//	    Keys(validation.String().Regex(`^[a-z]{2}$`)).
+//	    Values(validation.String().Required().Max(200)).
+//	    MinKeys(1)
+//
+// Geçersiz anahtarlar alanın kendisine map_key hatasıyla raporlanır ve
+// değerleri ayrıca doğrulanmaz. Değer hataları, ObjectType'taki gibi
+// `field.anahtar` yolunda raporlanır. Anahtarlar deterministik hata sırası
+// için sıralı dolaşılır.
+//
+// Yazar Bilgileri:
+// @author   Ahmet ALTUN
+// @github   github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email    ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+package types
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/biyonik/go-fluent-validator/core"
+	"github.com/biyonik/go-fluent-validator/i18n"
+)
+
+// MapType, anahtar ve değer şemalarıyla doğrulanan dinamik nesne tipidir.
+type MapType struct {
+	core.BaseType
+	minKeys          *int      // Minimum anahtar sayısı
+	maxKeys          *int      // Maksimum anahtar sayısı
+	keySchema        core.Type // Her anahtarın uyacağı şema
+	valueSchema      core.Type // Her değerin uyacağı şema
+	customValidation *core.CustomValidation
+}
+
+// Required, alanın zorunlu olduğunu belirtir.
+func (m *MapType) Required() *MapType {
+	m.SetRequired()
+	return m
+}
+
+// Optional, alanın anahtarının payload'da hiç bulunmayabileceğini belirtir;
+// eksik anahtar Required olsa bile geçerlidir ve ValidData'ya yazılmaz.
+func (m *MapType) Optional() *MapType {
+	m.SetOptional()
+	return m
+}
+
+// Nullable, alanın açıkça null gönderilebileceğini belirtir; null değer
+// Required olsa bile geçerlidir ve ValidData'da korunur.
+func (m *MapType) Nullable() *MapType {
+	m.SetNullable()
+	return m
+}
+
+// Label, kullanıcıya gösterilecek alan adını özelleştirir.
+func (m *MapType) Label(label string) *MapType {
+	m.SetLabel(label)
+	return m
+}
+
+// Severity, alanın kurallarının önem seviyesini belirler (error, warning, info).
+// SeverityError dışındaki ihlaller şemanın politikasına göre uyarı olarak raporlanır.
+func (m *MapType) Severity(level core.Severity) *MapType {
+	m.SetSeverity(level)
+	return m
+}
+
+// Describe, alan için dokümantasyon amaçlı açıklama tanımlar. Doğrulamayı
+// etkilemez; Describe() çıktısında ve üretilen dokümanlarda görünür.
+func (m *MapType) Describe(text string) *MapType {
+	m.SetDescription(text)
+	return m
+}
+
+// Example, dokümantasyonda gösterilecek örnek bir değer ekler.
+func (m *MapType) Example(value any) *MapType {
+	m.AddExample(value)
+	return m
+}
+
+// Deprecated, alanı kullanımdan kaldırılmış olarak işaretler. reason, kaldırma
+// gerekçesini veya yerine kullanılacak alanı belirtir.
+func (m *MapType) Deprecated(reason string) *MapType {
+	m.SetDeprecated(reason)
+	return m
+}
+
+// Keys, her anahtarın (string olarak) uyması gereken şemayı tanımlar.
+// Örneğin: validation.Map().Keys(validation.String().Regex(`^[a-z_]+$`))
+func (m *MapType) Keys(schema core.Type) *MapType {
+	m.keySchema = schema
+	return m
+}
+
+// Values, her değerin uyması gereken şemayı tanımlar.
+// Örneğin: validation.Map().Values(validation.Boolean())
+func (m *MapType) Values(schema core.Type) *MapType {
+	m.valueSchema = schema
+	return m
+}
+
+// MinKeys, nesnede bulunması gereken minimum anahtar sayısını tanımlar.
+func (m *MapType) MinKeys(count int) *MapType {
+	m.minKeys = &count
+	return m
+}
+
+// MaxKeys, nesnede bulunmasına izin verilen maksimum anahtar sayısını tanımlar.
+func (m *MapType) MaxKeys(count int) *MapType {
+	m.maxKeys = &count
+	return m
+}
+
+// GetKeySchema, anahtarlara uygulanan şemayı döndürür; tanımlanmamışsa nil döner.
+func (m *MapType) GetKeySchema() core.Type {
+	return m.keySchema
+}
+
+// GetValueSchema, değerlere uygulanan şemayı döndürür; tanımlanmamışsa nil döner.
+func (m *MapType) GetValueSchema() core.Type {
+	return m.valueSchema
+}
+
+// Custom, nesnenin tamamı üzerinde çalışan özel bir doğrulayıcı ekler.
+func (m *MapType) Custom(validator func(map[string]any) error) *MapType {
+	if m.customValidation == nil {
+		m.customValidation = core.NewCustomValidation()
+	}
+
+	m.customValidation.AddSync(func(value any) error {
+		if value == nil {
+			return nil
+		}
+
+		data, ok := value.(map[string]any)
+		if !ok {
+			return fmt.Errorf("value must be object (map[string]any)")
+		}
+
+		return validator(data)
+	})
+
+	return m
+}
+
+// AddRule, özel bir doğrulama kuralı ekler.
+func (m *MapType) AddRule(rule core.Rule) *MapType {
+	if m.customValidation == nil {
+		m.customValidation = core.NewCustomValidation()
+	}
+	m.customValidation.AddRule(rule)
+	return m
+}
+
+// Transform, anahtarları ve değerleri kendi şemalarının dönüşümleriyle
+// dönüştürür. Anahtar dönüşümü string dışında bir değer üretirse anahtar
+// olduğu gibi bırakılır.
+func (m *MapType) Transform(value any) (any, error) {
+	value, err := m.BaseType.Transform(value)
+	if err != nil {
+		return nil, err
+	}
+	if value == nil {
+		return nil, nil
+	}
+
+	data, ok := value.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("nesne (object) tipinde olmalıdır")
+	}
+	if m.keySchema == nil && m.valueSchema == nil {
+		return data, nil
+	}
+
+	transformed := make(map[string]any, len(data))
+	for _, key := range sortedMapKeys(data) {
+		item := data[key]
+		if m.keySchema != nil {
+			k, err := m.keySchema.Transform(key)
+			if err != nil {
+				return nil, fmt.Errorf("anahtar '%s': %w", key, err)
+			}
+			if s, ok := k.(string); ok {
+				key = s
+			}
+		}
+		if m.valueSchema != nil && (item != nil || !core.NullableOf(m.valueSchema)) {
+			if item, err = m.valueSchema.Transform(item); err != nil {
+				return nil, fmt.Errorf("alan '%s': %w", key, err)
+			}
+		}
+		transformed[key] = item
+	}
+	return transformed, nil
+}
+
+// Introspect, map tipinin kurallarını, anahtar ve değer şemalarını yapısal
+// olarak döndürür.
+func (m *MapType) Introspect() *core.TypeDescription {
+	desc := m.DescribeBase("map")
+	if m.minKeys != nil {
+		desc.AddRule("min_keys", map[string]any{"value": *m.minKeys})
+	}
+	if m.maxKeys != nil {
+		desc.AddRule("max_keys", map[string]any{"value": *m.maxKeys})
+	}
+	desc.CustomRules = m.customValidation.Count()
+	desc.Keys = core.DescribeType(m.keySchema)
+	desc.Values = core.DescribeType(m.valueSchema)
+	return desc
+}
+
+// Validate, anahtar sayısını, anahtarları ve değerleri doğrular.
+func (m *MapType) Validate(field string, value any, result *core.ValidationResult) {
+	m.BaseType.Validate(field, value, result)
+	if result.HasFieldErrors(field) {
+		return
+	}
+	if value == nil {
+		return
+	}
+
+	data, ok := value.(map[string]any)
+	if !ok {
+		result.AddRuleError(field, i18n.KeyObject, m.GetLabel(field))
+		return
+	}
+
+	fieldName := m.GetLabel(field)
+
+	if m.minKeys != nil && len(data) < *m.minKeys {
+		result.AddRuleError(field, i18n.KeyMinKeys, fieldName, *m.minKeys)
+	}
+	if m.maxKeys != nil && len(data) > *m.maxKeys {
+		result.AddRuleError(field, i18n.KeyMaxKeys, fieldName, *m.maxKeys)
+	}
+
+	if m.customValidation != nil && m.customValidation.HasValidators() {
+		m.customValidation.ValidateSync(field, value, result)
+	}
+
+	if m.keySchema == nil && m.valueSchema == nil {
+		return
+	}
+	for i, key := range sortedMapKeys(data) {
+		// Süre sınırı dolduysa kalan anahtarlar atlanır (bkz. core.Expired).
+		if i%core.ExpiryCheckInterval == 0 && result.Expired() {
+			break
+		}
+		path := field + "." + key
+		if m.keySchema != nil {
+			keyResult := result.Child()
+			m.keySchema.Validate(path, key, keyResult)
+			if keyResult.HasErrors() {
+				result.AddRuleError(field, i18n.KeyMapKey, fieldName, key, i18n.Attrs{"key": key})
+				continue
+			}
+		}
+		if m.valueSchema != nil && !core.SkipValue(m.valueSchema, data, key) {
+			m.valueSchema.Validate(path, data[key], result)
+		}
+	}
+}
+
+// sortedMapKeys, nesnenin anahtarlarını sıralı döndürür.
+func sortedMapKeys(data map[string]any) []string {
+	keys := make([]string, 0, len(data))
+	for key := range data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
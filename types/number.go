@@ -1,8 +1,11 @@
 package types
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"math"
+	"strconv"
 
 	"github.com/biyonik/go-fluent-validator/core"
 	"github.com/biyonik/go-fluent-validator/i18n"
@@ -52,6 +55,20 @@ func (n *NumberType) Required() *NumberType {
 	return n
 }
 
+// Optional, alanın anahtarının payload'da hiç bulunmayabileceğini belirtir;
+// eksik anahtar Required olsa bile geçerlidir ve ValidData'ya yazılmaz.
+func (n *NumberType) Optional() *NumberType {
+	n.SetOptional()
+	return n
+}
+
+// Nullable, alanın açıkça null gönderilebileceğini belirtir; null değer
+// Required olsa bile geçerlidir ve ValidData'da korunur.
+func (n *NumberType) Nullable() *NumberType {
+	n.SetNullable()
+	return n
+}
+
 // Label, doğrulama hatalarında gösterilecek kullanıcı dostu alan adını belirler.
 //
 // Parametreler:
@@ -64,6 +81,33 @@ func (n *NumberType) Label(label string) *NumberType {
 	return n
 }
 
+// Severity, alanın kurallarının önem seviyesini belirler (error, warning, info).
+// SeverityError dışındaki ihlaller şemanın politikasına göre uyarı olarak raporlanır.
+func (n *NumberType) Severity(level core.Severity) *NumberType {
+	n.SetSeverity(level)
+	return n
+}
+
+// Describe, alan için dokümantasyon amaçlı açıklama tanımlar. Doğrulamayı
+// etkilemez; Describe() çıktısında ve üretilen dokümanlarda görünür.
+func (n *NumberType) Describe(text string) *NumberType {
+	n.SetDescription(text)
+	return n
+}
+
+// Example, dokümantasyonda gösterilecek örnek bir değer ekler.
+func (n *NumberType) Example(value any) *NumberType {
+	n.AddExample(value)
+	return n
+}
+
+// Deprecated, alanı kullanımdan kaldırılmış olarak işaretler. reason, kaldırma
+// gerekçesini veya yerine kullanılacak alanı belirtir.
+func (n *NumberType) Deprecated(reason string) *NumberType {
+	n.SetDeprecated(reason)
+	return n
+}
+
 // Default, alanın varsayılan değerini belirler.
 //
 // Parametreler:
@@ -144,6 +188,12 @@ func (n *NumberType) Custom(validator func(float64) error) *NumberType {
 			num = float64(v)
 		case float64:
 			num = v
+		case json.Number:
+			f, err := v.Float64()
+			if err != nil {
+				return fmt.Errorf("value must be number")
+			}
+			num = f
 		default:
 			return fmt.Errorf("value must be number")
 		}
@@ -162,6 +212,51 @@ func (n *NumberType) AddRule(rule core.Rule) *NumberType {
 	return n
 }
 
+// Immutable, alanın güncelleme sırasında (ValidateChanges) değiştirilemeyeceğini belirtir.
+//
+// Döndürür:
+//   - *NumberType
+func (n *NumberType) Immutable() *NumberType {
+	n.SetImmutable()
+	return n
+}
+
+// OnlyIncrease, alanın güncelleme sırasında yalnızca artabileceğini belirtir.
+// Örn: sürüm numarası, sayaç, stok girişi.
+//
+// Döndürür:
+//   - *NumberType
+func (n *NumberType) OnlyIncrease() *NumberType {
+	n.SetOnlyIncrease()
+	return n
+}
+
+// OnlyDecrease, alanın güncelleme sırasında yalnızca azalabileceğini belirtir.
+//
+// Döndürür:
+//   - *NumberType
+func (n *NumberType) OnlyDecrease() *NumberType {
+	n.SetOnlyDecrease()
+	return n
+}
+
+// OnChange, ValidateChanges sırasında eski ve yeni sayıyı birlikte gören bir
+// değişiklik kuralı ekler.
+//
+// Parametreler:
+//   - validator (func(oldValue, newValue float64) error): değişiklik kuralı
+//
+// Döndürür:
+//   - *NumberType
+func (n *NumberType) OnChange(validator func(oldValue, newValue float64) error) *NumberType {
+	n.AddChangeValidator(func(oldValue, newValue any) error {
+		oldNum, _ := core.ToFloat64(oldValue)
+		newNum, _ := core.ToFloat64(newValue)
+		return validator(oldNum, newNum)
+	})
+	return n
+}
+
 // Positive ensures the number is greater than zero
 func (n *NumberType) Positive() *NumberType {
 	n.isPositive = true
@@ -187,6 +282,59 @@ func (n *NumberType) Between(min, max float64) *NumberType {
 	return n
 }
 
+// Transform, json.Decoder.UseNumber ile çözülmüş json.Number değerlerini
+// sayıya çevirir (tamsayılar int64, diğerleri float64) ve ardından tanımlı
+// dönüşümleri çalıştırır. Geçersiz json.Number değerleri olduğu gibi bırakılır
+// ve Validate'de sayısal değil hatası üretir. Integer() alanlarda int64'e
+// sığmayan tamsayılar da float64'e düşürülmeden bırakılır; Validate bunları
+// tamsayı aralığı dışında hatasıyla raporlar.
+func (n *NumberType) Transform(value any) (any, error) {
+	if num, ok := value.(json.Number); ok {
+		switch i, err := num.Int64(); {
+		case err == nil:
+			value = i
+		case n.isInteger && errors.Is(err, strconv.ErrRange):
+			// Olduğu gibi bırakılır; Validate aralık dışı hatası üretir.
+		default:
+			if f, err := num.Float64(); err == nil {
+				value = f
+			}
+		}
+	}
+	return n.BaseType.Transform(value)
+}
+
+// Introspect, sayı tipinin kurallarını ve parametrelerini yapısal olarak döndürür.
+//
+// Döndürür:
+//   - *core.TypeDescription
+func (n *NumberType) Introspect() *core.TypeDescription {
+	desc := n.DescribeBase("number")
+	if n.isInteger {
+		desc.AddRule("integer", nil)
+	}
+	if n.min != nil {
+		desc.AddRule("min", map[string]any{"value": *n.min})
+	}
+	if n.max != nil {
+		desc.AddRule("max", map[string]any{"value": *n.max})
+	}
+	if n.isPositive {
+		desc.AddRule("positive", nil)
+	}
+	if n.isNegative {
+		desc.AddRule("negative", nil)
+	}
+	if n.multipleOf != nil {
+		desc.AddRule("multiple_of", map[string]any{"value": *n.multipleOf})
+	}
+	if n.betweenMin != nil && n.betweenMax != nil {
+		desc.AddRule("between", map[string]any{"min": *n.betweenMin, "max": *n.betweenMax})
+	}
+	desc.CustomRules = n.customValidation.Count()
+	return desc
+}
+
 // Validate, alanın sayısal geçerliliğini kontrol eder.
 //
 // İşlem sırası:
@@ -201,7 +349,7 @@ func (n *NumberType) Between(min, max float64) *NumberType {
 //   - result (*core.ValidationResult): doğrulama sonucu
 func (n *NumberType) Validate(field string, value any, result *core.ValidationResult) {
 	n.BaseType.Validate(field, value, result)
-	if result.HasErrors() {
+	if result.HasFieldErrors(field) {
 		return
 	}
 	if value == nil {
@@ -233,6 +381,15 @@ func (n *NumberType) Validate(field string, value any, result *core.ValidationRe
 	case float32:
 		num = float64(v)
 		ok = true
+	case json.Number:
+		if n.isInteger {
+			if _, err := v.Int64(); errors.Is(err, strconv.ErrRange) {
+				result.AddRuleError(field, i18n.KeyIntegerRange, n.GetLabel(field))
+				return
+			}
+		}
+		f, err := v.Float64()
+		num, ok = f, err == nil
 	default:
 		ok = false
 	}
@@ -240,40 +397,40 @@ func (n *NumberType) Validate(field string, value any, result *core.ValidationRe
 	fieldName := n.GetLabel(field)
 
 	if !ok {
-		result.AddError(field, i18n.Get(i18n.KeyNumeric, fieldName))
+		result.AddRuleError(field, i18n.KeyNumeric, fieldName)
 		return
 	}
 
 	if n.isInteger && num != float64(int64(num)) {
-		result.AddError(field, i18n.Get(i18n.KeyInteger, fieldName))
+		result.AddRuleError(field, i18n.KeyInteger, fieldName)
 	}
 	if n.min != nil && num < *n.min {
-		result.AddError(field, i18n.Get(i18n.KeyMin, fieldName, *n.min))
+		result.AddRuleError(field, i18n.KeyMin, fieldName, *n.min)
 	}
 	if n.max != nil && num > *n.max {
-		result.AddError(field, i18n.Get(i18n.KeyMax, fieldName, *n.max))
+		result.AddRuleError(field, i18n.KeyMax, fieldName, *n.max)
 	}
 
 	// New validators
 	if n.isPositive && num <= 0 {
-		result.AddError(field, i18n.Get(i18n.KeyPositive, fieldName))
+		result.AddRuleError(field, i18n.KeyPositive, fieldName)
 	}
 
 	if n.isNegative && num >= 0 {
-		result.AddError(field, i18n.Get(i18n.KeyNegative, fieldName))
+		result.AddRuleError(field, i18n.KeyNegative, fieldName)
 	}
 
 	if n.multipleOf != nil {
 		// Check if num is a multiple of multipleOf using modulo with floating point precision
 		remainder := math.Mod(num, *n.multipleOf)
 		if math.Abs(remainder) > 1e-9 { // Use small epsilon for floating point comparison
-			result.AddError(field, i18n.Get(i18n.KeyMultipleOf, fieldName, *n.multipleOf))
+			result.AddRuleError(field, i18n.KeyMultipleOf, fieldName, *n.multipleOf)
 		}
 	}
 
 	if n.betweenMin != nil && n.betweenMax != nil {
 		if num < *n.betweenMin || num > *n.betweenMax {
-			result.AddError(field, i18n.Get(i18n.KeyBetween, fieldName, *n.betweenMin, *n.betweenMax))
+			result.AddRuleError(field, i18n.KeyBetween, fieldName, *n.betweenMin, *n.betweenMax)
 		}
 	}
 
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/biyonik/go-fluent-validator/core"
 	"github.com/biyonik/go-fluent-validator/i18n"
@@ -41,6 +42,20 @@ func (o *ObjectType) Required() *ObjectType {
 	return o
 }
 
+// Optional, alanın anahtarının payload'da hiç bulunmayabileceğini belirtir;
+// eksik anahtar Required olsa bile geçerlidir ve ValidData'ya yazılmaz.
+func (o *ObjectType) Optional() *ObjectType {
+	o.SetOptional()
+	return o
+}
+
+// Nullable, alanın açıkça null gönderilebileceğini belirtir; null değer
+// Required olsa bile geçerlidir ve ValidData'da korunur.
+func (o *ObjectType) Nullable() *ObjectType {
+	o.SetNullable()
+	return o
+}
+
 // Label, doğrulama hatalarında gösterilecek kullanıcı dostu alan adını belirler.
 //
 // Parametreler:
@@ -53,6 +68,33 @@ func (o *ObjectType) Label(label string) *ObjectType {
 	return o
 }
 
+// Severity, alanın kurallarının önem seviyesini belirler (error, warning, info).
+// SeverityError dışındaki ihlaller şemanın politikasına göre uyarı olarak raporlanır.
+func (o *ObjectType) Severity(level core.Severity) *ObjectType {
+	o.SetSeverity(level)
+	return o
+}
+
+// Describe, alan için dokümantasyon amaçlı açıklama tanımlar. Doğrulamayı
+// etkilemez; Describe() çıktısında ve üretilen dokümanlarda görünür.
+func (o *ObjectType) Describe(text string) *ObjectType {
+	o.SetDescription(text)
+	return o
+}
+
+// Example, dokümantasyonda gösterilecek örnek bir değer ekler.
+func (o *ObjectType) Example(value any) *ObjectType {
+	o.AddExample(value)
+	return o
+}
+
+// Deprecated, alanı kullanımdan kaldırılmış olarak işaretler. reason, kaldırma
+// gerekçesini veya yerine kullanılacak alanı belirtir.
+func (o *ObjectType) Deprecated(reason string) *ObjectType {
+	o.SetDeprecated(reason)
+	return o
+}
+
 // Shape, nesnenin alt alanlarını ve bu alanların tiplerini tanımlar.
 //
 // Parametreler:
@@ -65,6 +107,14 @@ func (o *ObjectType) Shape(shape map[string]core.Type) *ObjectType {
 	return o
 }
 
+// GetShape, nesnenin alt alan–tip eşlemesini döndürür.
+//
+// Döndürür:
+//   - map[string]core.Type
+func (o *ObjectType) GetShape() map[string]core.Type {
+	return o.shape
+}
+
 // Custom adds a custom validation function
 func (o *ObjectType) Custom(validator func(map[string]any) error) *ObjectType {
 	if o.customValidation == nil {
@@ -119,12 +169,20 @@ func (o *ObjectType) Transform(value any) (any, error) {
 	}
 
 	transformedData := make(map[string]any)
-	for field, typ := range o.shape {
-		subValue := data[field]
+	for _, field := range o.fieldNames() {
+		typ := o.shape[field]
+		subValue, sent := data[field]
+		if sent && subValue == nil && core.NullableOf(typ) {
+			transformedData[field] = nil
+			continue
+		}
 		transformedSubValue, err := typ.Transform(subValue)
 		if err != nil {
 			return nil, fmt.Errorf("alan '%s': %w", field, err)
 		}
+		if core.OmitValue(typ, data, field, transformedSubValue) {
+			continue
+		}
 		transformedData[field] = transformedSubValue
 	}
 	for k, v := range data {
@@ -135,6 +193,31 @@ func (o *ObjectType) Transform(value any) (any, error) {
 	return transformedData, nil
 }
 
+// fieldNames, alt alan adlarını sıralı döndürür; dönüşüm ve doğrulama
+// hatalarının sırası map dolaşım sırasından bağımsız olur.
+func (o *ObjectType) fieldNames() []string {
+	names := make([]string, 0, len(o.shape))
+	for name := range o.shape {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Introspect, nesne tipinin alt alanlarını yapısal olarak döndürür.
+//
+// Döndürür:
+//   - *core.TypeDescription
+func (o *ObjectType) Introspect() *core.TypeDescription {
+	desc := o.DescribeBase("object")
+	desc.Fields = make(map[string]*core.TypeDescription, len(o.shape))
+	for name, typ := range o.shape {
+		desc.Fields[name] = core.DescribeType(typ)
+	}
+	desc.CustomRules = o.customValidation.Count()
+	return desc
+}
+
 // Validate, nesne ve alt alanlarının doğrulamasını gerçekleştirir.
 //
 // Parametreler:
@@ -143,7 +226,7 @@ func (o *ObjectType) Transform(value any) (any, error) {
 //   - result (*core.ValidationResult): doğrulama sonucu
 func (o *ObjectType) Validate(field string, value any, result *core.ValidationResult) {
 	o.BaseType.Validate(field, value, result)
-	if result.HasErrors() {
+	if result.HasFieldErrors(field) {
 		return
 	}
 	if value == nil {
@@ -152,11 +235,15 @@ func (o *ObjectType) Validate(field string, value any, result *core.ValidationRe
 
 	data, ok := value.(map[string]any)
 	if !ok {
-		result.AddError(field, i18n.Get(i18n.KeyObject, o.GetLabel(field)))
+		result.AddRuleError(field, i18n.KeyObject, o.GetLabel(field))
 		return
 	}
 
-	for subField, subSchema := range o.shape {
+	for _, subField := range o.fieldNames() {
+		subSchema := o.shape[subField]
+		if core.SkipValue(subSchema, data, subField) {
+			continue
+		}
 		subValue := data[subField]
 		fullFieldPath := fmt.Sprintf("%s.%s", field, subField)
 		subSchema.Validate(fullFieldPath, subValue, result)
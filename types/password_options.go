@@ -25,7 +25,35 @@
 
 package types
 
-import "github.com/biyonik/go-fluent-validator/rules"
+import (
+	"sync"
+
+	"github.com/biyonik/go-fluent-validator/rules"
+)
+
+var (
+	// defaultPasswordRules, Password() kurallarının başladığı kurum geneli
+	// politikadır.
+	defaultPasswordRules   = rules.DefaultPasswordRules()
+	defaultPasswordRulesMu sync.RWMutex
+)
+
+// SetDefaultPasswordRules, bundan sonra oluşturulan Password() alanlarının
+// başlangıç politikasını belirler. Daha önce tanımlanmış alanlar etkilenmez;
+// bu yüzden uygulama başlangıcında, şemalar oluşturulmadan önce çağrılmalıdır.
+func SetDefaultPasswordRules(policy rules.PasswordRules) {
+	defaultPasswordRulesMu.Lock()
+	defer defaultPasswordRulesMu.Unlock()
+	defaultPasswordRules = policy
+}
+
+// DefaultPasswordRules, geçerli varsayılan şifre politikasının bir kopyasını
+// döndürür.
+func DefaultPasswordRules() rules.PasswordRules {
+	defaultPasswordRulesMu.RLock()
+	defer defaultPasswordRulesMu.RUnlock()
+	return defaultPasswordRules
+}
 
 // PasswordOption, PasswordRules üzerinde bir ayarı uygulamak için kullanılan fonksiyon tipidir.
 type PasswordOption func(*rules.PasswordRules)
@@ -44,6 +72,15 @@ func WithMaxLength(length int) PasswordOption {
 	}
 }
 
+// WithMaxBytes, şifrenin UTF-8 bayt uzunluğu için üst sınır koyar. bcrypt ile
+// saklanan şifrelerde rules.BcryptMaxBytes (72) verilmelidir; aksi halde uzun
+// ve çok baytlı karakter içeren parolaların sonu sessizce kesilir.
+func WithMaxBytes(n int) PasswordOption {
+	return func(r *rules.PasswordRules) {
+		r.MaxBytes = n
+	}
+}
+
 // WithRequireUppercase, şifrede büyük harf gerekip gerekmediğini ayarlar.
 func WithRequireUppercase(required bool) PasswordOption {
 	return func(r *rules.PasswordRules) {
@@ -85,3 +122,53 @@ func WithMinUniqueChars(count int) PasswordOption {
 		r.MinUniqueChars = count
 	}
 }
+
+// WithRequireLetter, şifrede en az bir harf (herhangi bir alfabeden) bulunmasını zorunlu kılar.
+func WithRequireLetter(required bool) PasswordOption {
+	return func(r *rules.PasswordRules) {
+		r.RequireLetter = required
+	}
+}
+
+// WithPasswordRules, tüm kuralları verilen politikayla değiştirir. Hazır
+// ayarları (rules.PasswordNIST, rules.PasswordOWASP, rules.PasswordPCI) tek bir
+// alana uygulamak için kullanılır; sonrasında verilen seçenekler bunun üzerine
+// uygulanır.
+//
+// Örnek:
+//
+//	String().Password(types.WithPasswordRules(rules.PasswordOWASP()), types.WithMinLength(16))
+func WithPasswordRules(policy rules.PasswordRules) PasswordOption {
+	return func(r *rules.PasswordRules) {
+		*r = policy
+	}
+}
+
+// WithNotSimilarTo, şifrenin verilen kardeş alanların değerlerine (kullanıcı
+// adı, e-posta, ad vb.) benzememesini zorunlu kılar (bkz.
+// rules.IsSimilarPassword). Kontrol ValidateCtx'in dış kaynaklı kontrol
+// adımında yapılır; alan adları şemadaki nokta yoluyla verilebilir.
+//
+// Örnek:
+//
+//	"password": validation.String().Password(types.WithNotSimilarTo("username", "email")),
+func WithNotSimilarTo(fields ...string) PasswordOption {
+	return func(r *rules.PasswordRules) {
+		r.NotSimilarTo = append([]string(nil), fields...)
+	}
+}
+
+// WithHistoryChecker, şifrenin kullanıcının önceki şifrelerinden biri olmamasını
+// zorunlu kılar. fn, ValidateCtx'e verilen context ile çağrılır; true veya
+// hata dönerse şifre reddedilir.
+//
+// Örnek:
+//
+//	types.WithHistoryChecker(func(ctx context.Context, pw string) (bool, error) {
+//	    return users.PasswordUsedBefore(ctx, session.UserID(ctx), pw)
+//	})
+func WithHistoryChecker(fn rules.PasswordHistoryChecker) PasswordOption {
+	return func(r *rules.PasswordRules) {
+		r.HistoryChecker = fn
+	}
+}
@@ -0,0 +1,56 @@
+// -----------------------------------------------------------------------------
+// PEMOption Yardımcı Fonksiyonları
+// -----------------------------------------------------------------------------
+// String().PEM(...) kuralının ayarlarını (anahtar tipleri, en küçük anahtar
+// boyutu, sertifika geçerlilik süresi) rules.PEMRules üzerinde değiştiren
+// seçenekleri içerir.
+//
+// Örnek kullanım:
+//   validation.String().PEM(rules.PEMCertificate,
+//       types.PEMKeyTypes("rsa", "ecdsa"), types.PEMNotExpired(30*24*time.Hour))
+//
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+package types
+
+import (
+	"strings"
+	"time"
+
+	"github.com/biyonik/go-fluent-validator/rules"
+)
+
+// PEMOption, PEMRules üzerinde bir ayarı uygulamak için kullanılan fonksiyon tipidir.
+type PEMOption func(*rules.PEMRules)
+
+// PEMKeyTypes, izin verilen anahtar tiplerini belirler (rules.KeyTypeRSA,
+// rules.KeyTypeECDSA, rules.KeyTypeEd25519).
+func PEMKeyTypes(keyTypes ...string) PEMOption {
+	return func(r *rules.PEMRules) {
+		r.KeyTypes = r.KeyTypes[:0]
+		for _, t := range keyTypes {
+			r.KeyTypes = append(r.KeyTypes, strings.ToLower(t))
+		}
+	}
+}
+
+// PEMMinKeySize, RSA ve EC anahtarları için en küçük boyutu (bit) belirler.
+func PEMMinKeySize(rsaBits, ecBits int) PEMOption {
+	return func(r *rules.PEMRules) {
+		r.MinRSABits = rsaBits
+		r.MinECBits = ecBits
+	}
+}
+
+// PEMNotExpired, sertifikaların şu anda geçerli olmasını ve en az margin
+// süresi daha geçerli kalmasını zorunlu kılar.
+func PEMNotExpired(margin time.Duration) PEMOption {
+	return func(r *rules.PEMRules) {
+		r.CheckExpiry = true
+		r.ExpiryMargin = margin
+	}
+}
@@ -21,14 +21,22 @@
 package types
 
 import (
+	"context"
 	"encoding/base64"
+	"errors"
 	"fmt"
+	"net/netip"
 	"regexp"
+	"slices"
+	"strconv"
 	"strings"
+	"time"
+	"unicode/utf8"
 
 	"github.com/biyonik/go-fluent-validator/core"
 	"github.com/biyonik/go-fluent-validator/i18n"
 	"github.com/biyonik/go-fluent-validator/rules"
+	"github.com/biyonik/go-fluent-validator/rules/nationalid"
 )
 
 var (
@@ -49,37 +57,127 @@ type StringType struct {
 	maxLength        *int
 	emailRegex       *regexp.Regexp
 	urlRegex         *regexp.Regexp
+	urlNoPrivate     bool
+	urlResolver      rules.HostResolver
+	urlResolverFunc  bool
+	urlSchemes       []string
+	urlPorts         []int
+	callbackVerifier rules.CallbackVerifier
 	allowedValues    []string
 	passwordRules    *rules.PasswordRules
+	pemRules         *rules.PEMRules
+	sshKeyTypes      []string
+	isSSHPublicKey   bool
+	isJSONPointer    bool
+	isJSONPath       bool
 	ipVersion        *int
+	ipNotPrivate     bool
+	ipNotLoopback    bool
+	ipPublicOnly     bool
+	ipPolicies       []rules.IPPolicy
 	phoneCountry     *string
 	customValidation *core.CustomValidation
 	// New validators
 	isAlpha          bool
 	isAlphanumeric   bool
+	alphaUnicode     bool
+	alnumUnicode     bool
+	alphaSpace       bool
+	personName       bool
 	isNumeric        bool
 	startsWith       *string
 	endsWith         *string
 	contains         *string
 	customRegex      *regexp.Regexp
 	regexError       error
+	regexOptions     *rules.RegexOptions
 	isMAC            bool
 	isHex            bool
 	isBase64         bool
+	isRRule          bool
+	isE164           bool
+	isDID            bool
+	isURN            bool
+	checksumName     string
+	checksumFn       rules.ChecksumFunc
+	checksumError    error
+	nationalID       string
+	nationalIDSpec   nationalid.Spec
+	nationalIDError  error
+	licensePlate     string
+	licensePlateFn   func(string) bool
+	licensePlateErr  error
+	isMERSIS         bool
+	isTaxOfficeCode  bool
+	isSGKNumber      bool
+	otpLength        *int
+	headerValue      bool
+	headerFilename   bool
+	idempotencyKey   bool
+	idempotencyCheck rules.IdempotencyChecker
+	honeypot         bool
+	totpSecret       TOTPSecretProvider
+	totpSkew         int
+	csvElement       core.Type
 }
 
+// TOTPSecretProvider, doğrulanan kullanıcının Base32 TOTP gizli anahtarını
+// döndüren fonksiyondur. Kullanıcı genellikle ctx içindeki oturumdan bulunur.
+type TOTPSecretProvider func(ctx context.Context) (string, error)
+
 // Required, alanın zorunlu olmasını sağlar.
 func (s *StringType) Required() *StringType {
 	s.SetRequired()
 	return s
 }
 
+// Optional, alanın anahtarının payload'da hiç bulunmayabileceğini belirtir;
+// eksik anahtar Required olsa bile geçerlidir ve ValidData'ya yazılmaz.
+func (s *StringType) Optional() *StringType {
+	s.SetOptional()
+	return s
+}
+
+// Nullable, alanın açıkça null gönderilebileceğini belirtir; null değer
+// Required olsa bile geçerlidir ve ValidData'da korunur.
+func (s *StringType) Nullable() *StringType {
+	s.SetNullable()
+	return s
+}
+
 // Label, alan için okunabilir bir isim tanımlar.
 func (s *StringType) Label(label string) *StringType {
 	s.SetLabel(label)
 	return s
 }
 
+// Severity, alanın kurallarının önem seviyesini belirler (error, warning, info).
+// SeverityError dışındaki ihlaller şemanın politikasına göre uyarı olarak raporlanır.
+func (s *StringType) Severity(level core.Severity) *StringType {
+	s.SetSeverity(level)
+	return s
+}
+
+// Describe, alan için dokümantasyon amaçlı açıklama tanımlar. Doğrulamayı
+// etkilemez; Describe() çıktısında ve üretilen dokümanlarda görünür.
+func (s *StringType) Describe(text string) *StringType {
+	s.SetDescription(text)
+	return s
+}
+
+// Example, dokümantasyonda gösterilecek örnek bir değer ekler.
+func (s *StringType) Example(value any) *StringType {
+	s.AddExample(value)
+	return s
+}
+
+// Deprecated, alanı kullanımdan kaldırılmış olarak işaretler. reason, kaldırma
+// gerekçesini veya yerine kullanılacak alanı belirtir.
+func (s *StringType) Deprecated(reason string) *StringType {
+	s.SetDeprecated(reason)
+	return s
+}
+
 // Default, alan için varsayılan değer belirler.
 func (s *StringType) Default(value string) *StringType {
 	s.SetDefault(value)
@@ -110,6 +208,66 @@ func (s *StringType) URL() *StringType {
 	return s
 }
 
+// NoPrivateHosts, URL'nin özel, loopback, link-local veya bulut metadata
+// adreslerini (ve localhost, *.internal gibi host adlarını) hedeflemesini
+// engeller. Webhook/geri çağırma adresi kaydeden uç noktalarda SSRF'e karşı
+// kullanılır. Host adlarının DNS ile çözülüp denetlenmesi için ResolveHosts
+// eklenmelidir.
+//
+// Örnek:
+//
+//	"webhook_url": validation.String().Required().URL().NoPrivateHosts().
+//	    ResolveHosts().Schemes("https").Ports(443, 8443),
+func (s *StringType) NoPrivateHosts() *StringType {
+	s.urlNoPrivate = true
+	return s
+}
+
+// ResolveHosts, NoPrivateHosts kontrolünü DNS çözümlemesiyle genişletir:
+// host adının çözüldüğü adreslerin tamamı genel olmalıdır. Çözümleme şemanın
+// dış kaynaklı (asenkron) adımında ValidateCtx'e verilen context ile yapılır;
+// hatalar adresin reddedilmesiyle sonuçlanır. resolver verilmezse
+// rules.LookupHost kullanılır.
+func (s *StringType) ResolveHosts(resolver ...rules.HostResolver) *StringType {
+	s.urlNoPrivate = true
+	s.urlResolver = rules.LookupHost
+	s.urlResolverFunc = false
+	if len(resolver) > 0 && resolver[0] != nil {
+		s.urlResolver = resolver[0]
+		s.urlResolverFunc = true
+	}
+	return s
+}
+
+// Schemes, URL şemasının verilen şemalardan biri olmasını zorunlu kılar
+// (örn. yalnızca "https").
+func (s *StringType) Schemes(schemes ...string) *StringType {
+	s.urlSchemes = schemes
+	return s
+}
+
+// Ports, URL portunun verilen portlardan biri olmasını zorunlu kılar. Port
+// yazılmamışsa şemanın varsayılan portu (http 80, https 443) denetlenir.
+func (s *StringType) Ports(ports ...int) *StringType {
+	s.urlPorts = ports
+	return s
+}
+
+// VerifyCallback, URL'nin sahipliğini verify ile (örn.
+// rules.ChallengeCallback) doğrular. Doğrulama şemanın dış kaynaklı
+// (asenkron) adımında ValidateCtx'e verilen context ile yapılır; alan
+// seviyesindeki kurallar veya ResolveHosts başarısız olursa adrese istek
+// yapılmaz.
+//
+// Örnek:
+//
+//	"callback_url": validation.String().Required().URL().ResolveHosts().
+//	    VerifyCallback(rules.ChallengeCallback()),
+func (s *StringType) VerifyCallback(verify rules.CallbackVerifier) *StringType {
+	s.callbackVerifier = verify
+	return s
+}
+
 // OneOf, alanın belirli bir değer listesi içinde olmasını sağlar.
 func (s *StringType) OneOf(values []string) *StringType {
 	s.allowedValues = values
@@ -126,6 +284,48 @@ func (s *StringType) IP(version ...int) *StringType {
 	return s
 }
 
+// NotPrivate, IP adresinin özel ağ bloklarından (10/8, 172.16/12,
+// 192.168/16, fc00::/7) olmamasını zorunlu kılar. IP() ile birlikte kullanılır.
+func (s *StringType) NotPrivate() *StringType {
+	s.ipNotPrivate = true
+	return s
+}
+
+// NotLoopback, IP adresinin loopback (127/8, ::1) olmamasını zorunlu kılar.
+func (s *StringType) NotLoopback() *StringType {
+	s.ipNotLoopback = true
+	return s
+}
+
+// PublicOnly, IP adresinin genel internette yönlendirilebilir olmasını
+// zorunlu kılar; özel, loopback, link-local (bulut metadata adresi dahil),
+// multicast ve özel amaçlı bloklar reddedilir.
+//
+// Örnek:
+//
+//	"server_ip": validation.String().Required().IP().PublicOnly(),
+func (s *StringType) PublicOnly() *StringType {
+	s.ipPublicOnly = true
+	return s
+}
+
+// Policy, IP adresine uygulanacak özel bir politika ekler (örn. ülke veya ASN
+// kısıtı). Politika hata dönerse hata mesajı alana eklenir. IP() ile birlikte
+// kullanılır; geçersiz IP'lerde politikalar çalıştırılmaz.
+//
+// Örnek:
+//
+//	validation.String().IP().Policy(func(ip netip.Addr) error {
+//	    if geo.Country(ip) != "TR" {
+//	        return errors.New("yalnızca Türkiye IP adresleri kabul edilir")
+//	    }
+//	    return nil
+//	})
+func (s *StringType) Policy(policy rules.IPPolicy) *StringType {
+	s.ipPolicies = append(s.ipPolicies, policy)
+	return s
+}
+
 // Phone, alanın belirli ülkeye ait telefon numarası formatında olmasını sağlar.
 func (s *StringType) Phone(countryCode string) *StringType {
 	s.phoneCountry = &countryCode
@@ -134,7 +334,7 @@ func (s *StringType) Phone(countryCode string) *StringType {
 
 // Trim, string değerlerin başındaki ve sonundaki boşlukları temizler.
 func (s *StringType) Trim() *StringType {
-	s.AddTransform(func(value any) (any, error) {
+	s.AddNamedTransform("trim", func(value any) (any, error) {
 		str, ok := value.(string)
 		if !ok {
 			return nil, fmt.Errorf("Trim sadece string değerler için uygulanabilir")
@@ -144,9 +344,37 @@ func (s *StringType) Trim() *StringType {
 	return s
 }
 
+// NoTrim, şema seviyesinde otomatik trim (WithAutoTrim) açık olsa bile bu alanın
+// değerinin olduğu gibi bırakılmasını sağlar.
+func (s *StringType) NoTrim() *StringType {
+	s.SetNoTrim()
+	return s
+}
+
+// Sensitive, alanı hassas olarak işaretler; değeri başarısız doğrulamadan sonra
+// formu yeniden doldurmak için saklanan eski girdiye (OldInput) eklenmez.
+// Password() kuralı alanı otomatik olarak hassas işaretler.
+func (s *StringType) Sensitive() *StringType {
+	s.SetSensitive()
+	return s
+}
+
+// Honeypot, alanı botlara karşı tuzak alan olarak işaretler: insanlara gizlenen
+// bu alan doldurulmuşsa (boş olmayan herhangi bir değer) doğrulama başarısız
+// olur. Alan hassas sayılır; eski girdiye (OldInput) eklenmez.
+//
+// Örnek:
+//
+//	"website": validation.String().Honeypot(),
+func (s *StringType) Honeypot() *StringType {
+	s.honeypot = true
+	s.SetSensitive()
+	return s
+}
+
 // StripTags, HTML etiketlerini temizler, istenen etiketleri bırakabilir.
 func (s *StringType) StripTags(allowedTags ...string) *StringType {
-	s.AddTransform(func(value any) (any, error) {
+	s.AddNamedTransform("strip_tags", func(value any) (any, error) {
 		str, ok := value.(string)
 		if !ok {
 			return nil, fmt.Errorf("StripTags sadece string değerler için uygulanabilir")
@@ -156,26 +384,16 @@ func (s *StringType) StripTags(allowedTags ...string) *StringType {
 	return s
 }
 
-// Password, alanın şifre doğrulama kurallarına uymasını sağlar.
+// Password, alanın şifre doğrulama kurallarına uymasını sağlar. Kurallar
+// varsayılan politikadan (SetDefaultPasswordRules) başlar; options ile
+// alan bazında değiştirilir.
 func (s *StringType) Password(options ...PasswordOption) *StringType {
-	defaults := &rules.PasswordRules{
-		MinLength:         8,
-		MaxLength:         72,
-		RequireUppercase:  true,
-		RequireLowercase:  true,
-		RequireNumeric:    true,
-		RequireSpecial:    true,
-		SpecialChars:      `!@#$%^&*(),.?":{}|<>+-`,
-		MinUniqueChars:    6,
-		MaxRepeatingChars: 3,
-		DisallowCommon:    true,
-		DisallowKeyboard:  true,
-		MinEntropy:        50.0,
-	}
+	defaults := DefaultPasswordRules()
 	for _, option := range options {
-		option(defaults)
+		option(&defaults)
 	}
-	s.passwordRules = defaults
+	s.passwordRules = &defaults
+	s.SetSensitive()
 	return s
 }
 
@@ -196,6 +414,48 @@ func (s *StringType) Custom(validator func(string) error) *StringType {
 	return s
 }
 
+// Immutable, alanın güncelleme sırasında (ValidateChanges) değiştirilemeyeceğini belirtir.
+func (s *StringType) Immutable() *StringType {
+	s.SetImmutable()
+	return s
+}
+
+// OnChange, ValidateChanges sırasında eski ve yeni değeri birlikte gören bir
+// değişiklik kuralı ekler. Örn: "status sadece draft→published olabilir".
+func (s *StringType) OnChange(validator func(oldValue, newValue string) error) *StringType {
+	s.AddChangeValidator(func(oldValue, newValue any) error {
+		oldStr, _ := oldValue.(string)
+		newStr, _ := newValue.(string)
+		return validator(oldStr, newStr)
+	})
+	return s
+}
+
+// Transition, alanın güncelleme sırasında (ValidateChanges) yalnızca izin verilen
+// durumlar arasında geçiş yapabilmesini sağlar.
+//
+// Örnek:
+//
+//	validation.String().Transition(map[string][]string{
+//	    "draft":     {"published"},
+//	    "published": {"archived"},
+//	})
+func (s *StringType) Transition(transitions map[string][]string) *StringType {
+	s.AddNamedChangeValidator("transition", map[string]any{"transitions": transitions},
+		func(fieldName string, oldValue, newValue any) error {
+			from, okOld := oldValue.(string)
+			to, okNew := newValue.(string)
+			if !okOld || !okNew {
+				return nil
+			}
+			if !rules.IsAllowedTransition(transitions, from, to) {
+				return core.NewMessageError(i18n.KeyTransition, fieldName, from, to)
+			}
+			return nil
+		})
+	return s
+}
+
 // Alpha ensures the string contains only alphabetic characters
 func (s *StringType) Alpha() *StringType {
 	s.isAlpha = true
@@ -208,6 +468,65 @@ func (s *StringType) Alphanumeric() *StringType {
 	return s
 }
 
+// AlphaUnicode, alanın yalnızca harflerden oluşmasını sağlar. Alpha()'dan
+// farklı olarak tüm alfabelerdeki harfleri (\p{L}) ve aksan işaretlerini kabul
+// eder: "Müller", "Çağla", "Ålesund" geçerlidir.
+func (s *StringType) AlphaUnicode() *StringType {
+	s.alphaUnicode = true
+	return s
+}
+
+// AlphaNumericUnicode, alanın yalnızca harf (\p{L}) ve rakamlardan (\p{N})
+// oluşmasını sağlar. Arapça-Hint veya tam genişlikli rakamlar da kabul edilir.
+func (s *StringType) AlphaNumericUnicode() *StringType {
+	s.alnumUnicode = true
+	return s
+}
+
+// AlphaSpace, alanın harflerden ve kelimeleri ayıran boşluklardan oluşmasını
+// sağlar (örn: "Ana María"). Baştaki ve sondaki boşluklar kabul edilmez;
+// gerekirse Trim() ile birlikte kullanılmalıdır.
+func (s *StringType) AlphaSpace() *StringType {
+	s.alphaSpace = true
+	return s
+}
+
+// PersonName
+// -----------------------------------------------------------------------------
+// Alanın bir kişi adı (ad, soyad, tam ad) olmasını sağlar. Tüm alfabelerdeki
+// harfler, kesme işareti, kısa çizgi, kelime arası boşluklar ve unvan/baş harf
+// noktaları (Dr., J.R.R.) kabul edilir. Doğrulamadan önce baştaki/sondaki
+// boşluklar kaldırılır ve ardışık boşluklar teke indirilir. Max() tanımlı
+// değilse rules.PersonNameMaxLength karakter sınırı uygulanır.
+//
+// Örnek:
+//
+//	validation.String().PersonName().TitleCase().Required()
+func (s *StringType) PersonName() *StringType {
+	s.personName = true
+	s.AddNamedTransform("collapse_whitespace", func(value any) (any, error) {
+		str, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("PersonName sadece string değerler için uygulanabilir")
+		}
+		return rules.CollapseWhitespace(str), nil
+	})
+	return s
+}
+
+// TitleCase, her kelimenin ilk harfini büyütür ("jean-luc o'neil" →
+// "Jean-Luc O'Neil"). Diğer harflere dokunulmaz.
+func (s *StringType) TitleCase() *StringType {
+	s.AddNamedTransform("title_case", func(value any) (any, error) {
+		str, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("TitleCase sadece string değerler için uygulanabilir")
+		}
+		return rules.TitleCaseName(str), nil
+	})
+	return s
+}
+
 // Numeric ensures the string contains only numeric characters
 func (s *StringType) Numeric() *StringType {
 	s.isNumeric = true
@@ -242,6 +561,15 @@ func (s *StringType) Regex(pattern string) *StringType {
 	return s
 }
 
+// RegexOptions, Regex() deseni için girdi uzunluğu sınırını ve eşleştirme
+// zaman aşımını bu alana özel olarak belirler. Tanımlanmazsa
+// rules.SetRegexOptions ile ayarlanan varsayılanlar (başlangıçta sınırsız)
+// kullanılır.
+func (s *StringType) RegexOptions(opts rules.RegexOptions) *StringType {
+	s.regexOptions = &opts
+	return s
+}
+
 // MAC ensures the string is a valid MAC address
 func (s *StringType) MAC() *StringType {
 	s.isMAC = true
@@ -260,35 +588,639 @@ func (s *StringType) Base64() *StringType {
 	return s
 }
 
+// RRule, alanın RFC 5545 tekrarlama kuralı (örn. "FREQ=WEEKLY;BYDAY=MO,WE")
+// olmasını zorunlu kılar. FREQ zorunluluğu, UNTIL/COUNT birlikteliği, BYDAY
+// ve diğer BYxxx parçalarının değer aralıkları denetlenir.
+func (s *StringType) RRule() *StringType {
+	s.isRRule = true
+	return s
+}
+
+// E164, alanın E.164 biçiminde uluslararası bir telefon numarası
+// (örn. "+905551112233") olmasını zorunlu kılar.
+func (s *StringType) E164() *StringType {
+	s.isE164 = true
+	return s
+}
+
+// DID, alanın W3C DID söz dizimine uygun bir merkeziyetsiz tanımlayıcı
+// (örn. "did:web:example.com") olmasını zorunlu kılar.
+func (s *StringType) DID() *StringType {
+	s.isDID = true
+	return s
+}
+
+// URN, alanın RFC 8141'e uygun bir URN (örn. "urn:isbn:0451450523") olmasını
+// zorunlu kılar.
+func (s *StringType) URN() *StringType {
+	s.isURN = true
+	return s
+}
+
+// Checksum, alanın adı verilen algoritmaya göre (luhn, verhoeff, damm, mod97
+// veya rules.RegisterChecksum ile eklenen) geçerli bir kontrol basamağı
+// taşımasını zorunlu kılar. Bilinmeyen bir algoritma adı doğrulama sırasında
+// yapılandırma hatası olarak raporlanır.
+//
+// Örnek:
+//
+//	"aadhaar": validation.String().Required().Checksum("verhoeff"),
+func (s *StringType) Checksum(algorithm string) *StringType {
+	s.checksumName = algorithm
+	s.checksumFn, s.checksumError = rules.Checksum(algorithm)
+	return s
+}
+
+// NationalID, alanın country (ISO 3166-1 alpha-2, örn. "TR", "ES") için
+// geçerli bir ulusal kimlik numarası olmasını zorunlu kılar. Hangi ülkelerde
+// checksum, hangilerinde yalnızca biçim kontrolü yapıldığı nationalid paket
+// belgesinde listelenmiştir. Desteklenmeyen ülke kodu doğrulama sırasında
+// yapılandırma hatası olarak raporlanır.
+//
+// Örnek:
+//
+//	"dni": validation.String().Required().NationalID("ES"),
+func (s *StringType) NationalID(country string) *StringType {
+	s.nationalID = country
+	s.nationalIDSpec, s.nationalIDError = nationalid.Lookup(country)
+	return s
+}
+
+// LicensePlate, alanın country için geçerli bir araç plakası biçiminde
+// olmasını zorunlu kılar. Boşluk ve tireler yok sayılır; ülke tabloları
+// rules.RegisterLicensePlate ile genişletilebilir.
+//
+// Örnek:
+//
+//	"plate": validation.String().Required().LicensePlate("TR"),
+func (s *StringType) LicensePlate(country string) *StringType {
+	s.licensePlate = country
+	s.licensePlateFn, s.licensePlateErr = rules.LicensePlate(country)
+	return s
+}
+
+// MERSIS, alanın 16 haneli geçerli bir MERSİS numarası olmasını zorunlu
+// kılar; numaranın içerdiği VKN'nin kontrol basamağı da denetlenir.
+func (s *StringType) MERSIS() *StringType {
+	s.isMERSIS = true
+	return s
+}
+
+// TaxOfficeCode, alanın 6 haneli bir vergi dairesi kodu olmasını zorunlu
+// kılar. rules.SetTaxOfficeCodes ile liste yüklenmişse kod listede aranır.
+func (s *StringType) TaxOfficeCode() *StringType {
+	s.isTaxOfficeCode = true
+	return s
+}
+
+// SGKNumber, alanın 26 haneli SGK işyeri sicil numarası biçiminde olmasını
+// zorunlu kılar.
+func (s *StringType) SGKNumber() *StringType {
+	s.isSGKNumber = true
+	return s
+}
+
+// PEM, alanın blockType tipinde (rules.PEMCertificate, rules.PEMPrivateKey,
+// rules.PEMPublicKey) geçerli PEM blok(lar)ı içermesini zorunlu kılar.
+// Varsayılan olarak RSA anahtarlar en az 2048, EC anahtarlar en az 256 bit
+// olmalıdır; sertifika süresi PEMNotExpired ile denetlenir. Özel anahtar
+// alanları otomatik olarak hassas işaretlenir.
+//
+// Örnek:
+//
+//	"certificate": validation.String().Required().PEM(rules.PEMCertificate,
+//	    types.PEMKeyTypes("rsa", "ecdsa"), types.PEMNotExpired(7*24*time.Hour)),
+//	"private_key": validation.String().Required().PEM(rules.PEMPrivateKey),
+func (s *StringType) PEM(blockType string, options ...PEMOption) *StringType {
+	r := rules.DefaultPEMRules(blockType)
+	for _, option := range options {
+		option(&r)
+	}
+	s.pemRules = &r
+	if r.BlockType == rules.PEMPrivateKey {
+		s.SetSensitive()
+	}
+	return s
+}
+
+// SSHPublicKey, alanın OpenSSH authorized_keys biçiminde tek satırlık bir
+// açık anahtar olmasını zorunlu kılar. allowedTypes tam tip adları
+// (rules.SSHKeyEd25519...) veya "rsa", "ecdsa", "ed25519", "dsa" kısa
+// adlarıdır; boşsa rules.DefaultSSHKeyTypes (DSA hariç) kabul edilir. RSA
+// anahtarlar en az rules.MinSSHRSABits bit olmalıdır.
+//
+// Örnek:
+//
+//	"deploy_key": validation.String().Required().SSHPublicKey("ed25519", "ecdsa"),
+func (s *StringType) SSHPublicKey(allowedTypes ...string) *StringType {
+	s.isSSHPublicKey = true
+	s.sshKeyTypes = allowedTypes
+	return s
+}
+
+// JSONPointer, alanın RFC 6901 JSON Pointer (örn. "/items/0/name") olmasını
+// zorunlu kılar. Boş string tüm dokümanı gösterdiği için geçerlidir; boş
+// değerleri reddetmek için Required kullanılmalıdır.
+func (s *StringType) JSONPointer() *StringType {
+	s.isJSONPointer = true
+	return s
+}
+
+// JSONPath, alanın RFC 9535 sözdizimine uygun bir JSONPath sorgusu (örn.
+// "$.items[?@.price < 10].id") olmasını zorunlu kılar. Yalnızca sözdizimi
+// denetlenir.
+//
+// Örnek:
+//
+//	"filter": validation.String().Required().JSONPath(),
+func (s *StringType) JSONPath() *StringType {
+	s.isJSONPath = true
+	return s
+}
+
+// OTPCode, alanın tam olarak length haneden oluşan sayısal bir tek kullanımlık
+// kod (örn. 6 haneli SMS/TOTP kodu) olmasını zorunlu kılar.
+func (s *StringType) OTPCode(length int) *StringType {
+	s.otpLength = &length
+	return s
+}
+
+// OTPFormat, OTPCode ile aynıdır: alanın tam olarak length haneli sayısal bir
+// kod olmasını zorunlu kılar. Yalnızca biçim denetlenir; kodun doğruluğu için
+// TOTP kullanılır.
+func (s *StringType) OTPFormat(length int) *StringType {
+	return s.OTPCode(length)
+}
+
+// TOTP, alanın kullanıcının kimlik doğrulayıcı uygulamasında üretilen 6 veya
+// 8 haneli zaman tabanlı kod (RFC 6238) olmasını zorunlu kılar. Gizli anahtar
+// secret ile ValidateCtx'e verilen context'ten alınır ve kod şemanın dış
+// kaynaklı (asenkron) kontrol adımında doğrulanır. skew, saat kaymasına karşı
+// kabul edilen önceki/sonraki periyot sayısıdır (genellikle 1).
+//
+// Örnek:
+//
+//	"code": validation.String().Required().TOTP(func(ctx context.Context) (string, error) {
+//	    return users.TOTPSecret(ctx, session.UserID(ctx))
+//	}, 1),
+func (s *StringType) TOTP(secret TOTPSecretProvider, skew int) *StringType {
+	s.totpSecret = secret
+	s.totpSkew = skew
+	return s
+}
+
+// IdempotencyKey, alanın Idempotency-Key başlığında kullanılabilecek bir
+// UUID veya ULID olmasını ve rules.IdempotencyKeyMaxLength karakteri (Max ile
+// daha küçük bir sınır verilebilir) aşmamasını zorunlu kılar. checker
+// verilirse anahtarın daha önce kullanılmadığı şemanın dış kaynaklı
+// (asenkron) kontrol adımında denetlenir; checker hata dönerse anahtar
+// reddedilir.
+//
+// Örnek:
+//
+//	headers := validation.Make().Shape(map[string]validation.Type{
+//	    "Idempotency-Key": validation.String().Required().IdempotencyKey(func(ctx context.Context, key string) (bool, error) {
+//	        return store.Used(ctx, key)
+//	    }),
+//	})
+//	res := httpvalidate.ValidateRequest(r, bodySchema, nil, headers)
+func (s *StringType) IdempotencyKey(checker ...rules.IdempotencyChecker) *StringType {
+	s.idempotencyKey = true
+	if len(checker) > 0 {
+		s.idempotencyCheck = checker[0]
+	}
+	return s
+}
+
+// HasAsyncRules, core.AsyncOptional implementasyonu; yalnızca TOTP, şifre
+// geçmişi/benzerlik, DNS, geri çağırma veya idempotency anahtarı kontrolü
+// tanımlıysa alan dış kaynaklı kontrol adımına dahil edilir.
+func (s *StringType) HasAsyncRules() bool {
+	if s.totpSecret != nil || s.urlResolver != nil || s.callbackVerifier != nil || s.idempotencyCheck != nil {
+		return true
+	}
+	r := s.passwordRules
+	return r != nil && (len(r.NotSimilarTo) > 0 || r.HistoryChecker != nil)
+}
+
+// ValidateAsync, core.AsyncValidatable implementasyonu; TOTP kodunu
+// kullanıcının gizli anahtarıyla doğrular, şifreyi kardeş alanlara benzerlik
+// ve şifre geçmişine karşı denetler, URL host'unu DNS ile çözüp denetler ve
+// geri çağırma adresinin sahipliğini ve idempotency anahtarının daha önce
+// kullanılmadığını doğrular.
+func (s *StringType) ValidateAsync(ctx context.Context, field string, value any, result *core.ValidationResult) {
+	str, ok := value.(string)
+	if !ok || str == "" {
+		return
+	}
+	if s.totpSecret != nil {
+		secret, err := s.totpSecret(ctx)
+		if err != nil || !rules.VerifyTOTP(secret, str, time.Now(), s.totpSkew) {
+			result.AddRuleError(field, i18n.KeyTOTP, s.GetLabel(field))
+		}
+	}
+	if s.passwordRules != nil {
+		s.validatePasswordAsync(ctx, field, str, result)
+	}
+	if s.urlResolver != nil {
+		_, host, _, err := rules.URLHost(str)
+		if err != nil || rules.CheckHostResolvesPublic(ctx, s.urlResolver, host) != nil {
+			result.AddRuleError(field, i18n.KeyURLPrivateHost, s.GetLabel(field))
+			return
+		}
+	}
+	if s.callbackVerifier != nil && s.callbackVerifier(ctx, str) != nil {
+		result.AddRuleError(field, i18n.KeyCallbackChallenge, s.GetLabel(field))
+	}
+	if s.idempotencyCheck != nil && rules.IsValidIdempotencyKey(str, s.idempotencyMaxLength()) {
+		if used, err := s.idempotencyCheck(ctx, str); err != nil || used {
+			result.AddRuleError(field, i18n.KeyIdempotencyReplay, s.GetLabel(field))
+		}
+	}
+}
+
+// idempotencyMaxLength, idempotency anahtarı için geçerli uzunluk sınırını
+// döndürür; Max verilmişse ve daha küçükse o kullanılır.
+func (s *StringType) idempotencyMaxLength() int {
+	if s.maxLength != nil && *s.maxLength < rules.IdempotencyKeyMaxLength {
+		return *s.maxLength
+	}
+	return rules.IdempotencyKeyMaxLength
+}
+
+// validatePasswordAsync, şifrenin kardeş alanlara benzememesini ve daha önce
+// kullanılmamış olmasını denetler. Geçmiş sorgusu hata verirse şifre reddedilir.
+func (s *StringType) validatePasswordAsync(ctx context.Context, field, password string, result *core.ValidationResult) {
+	r := s.passwordRules
+	if len(r.NotSimilarTo) > 0 {
+		data, _ := core.PayloadFromContext(ctx)
+		for _, other := range r.NotSimilarTo {
+			v, _ := core.PayloadValue(data, other)
+			if sibling, ok := v.(string); ok && rules.IsSimilarPassword(password, sibling) {
+				result.AddRuleError(field, i18n.KeyPasswordSimilar, s.GetLabel(field), other)
+				break
+			}
+		}
+	}
+	if r.HistoryChecker != nil {
+		if reused, err := r.HistoryChecker(ctx, password); err != nil || reused {
+			result.AddRuleError(field, i18n.KeyPasswordReused, s.GetLabel(field))
+		}
+	}
+}
+
+// HeaderValue, alanın bir HTTP yanıt başlığına güvenle kopyalanabilmesi için
+// yalnızca görünür ASCII karakterler, boşluk ve sekme içermesini zorunlu kılar.
+// CR/LF içeren değerler (header injection) reddedilir.
+func (s *StringType) HeaderValue() *StringType {
+	s.headerValue = true
+	return s
+}
+
+// ContentDispositionFilename, alanın Content-Disposition başlığında
+// filename="..." olarak kullanılabilecek güvenli bir dosya adı olmasını
+// zorunlu kılar (bkz. rules.IsSafeHeaderFilename).
+func (s *StringType) ContentDispositionFilename() *StringType {
+	s.headerFilename = true
+	return s
+}
+
+// Introspect, string tipinin kurallarını ve parametrelerini yapısal olarak döndürür.
+func (s *StringType) Introspect() *core.TypeDescription {
+	desc := s.DescribeBase("string")
+	s.describeRules(desc)
+	return desc
+}
+
+// describeRules, StringType kurallarını verilen tanıma ekler. AdvancedStringType
+// gibi StringType'ı embed eden tipler de bu metodu kullanır.
+func (s *StringType) describeRules(desc *core.TypeDescription) {
+	if s.minLength != nil {
+		desc.AddRule("min", map[string]any{"value": *s.minLength})
+	}
+	if s.maxLength != nil {
+		desc.AddRule("max", map[string]any{"value": *s.maxLength})
+	}
+	if s.emailRegex != nil {
+		desc.AddRule("email", nil)
+	}
+	if s.urlRegex != nil {
+		desc.AddRule("url", nil)
+	}
+	if s.urlNoPrivate {
+		desc.AddRule("no_private_hosts", nil)
+	}
+	if s.urlResolver != nil {
+		desc.AddRule("resolve_hosts", nil)
+	}
+	if s.callbackVerifier != nil {
+		desc.AddRule("callback_challenge", nil)
+	}
+	if len(s.urlSchemes) > 0 {
+		desc.AddRule("url_schemes", map[string]any{"values": s.urlSchemes})
+	}
+	if len(s.urlPorts) > 0 {
+		desc.AddRule("url_ports", map[string]any{"values": s.urlPorts})
+	}
+	if len(s.allowedValues) > 0 {
+		desc.AddRule("one_of", map[string]any{"values": append([]string(nil), s.allowedValues...)})
+	}
+	if s.passwordRules != nil {
+		r := s.passwordRules
+		params := map[string]any{
+			"min_length":          r.MinLength,
+			"max_length":          r.MaxLength,
+			"max_bytes":           r.MaxBytes,
+			"require_uppercase":   r.RequireUppercase,
+			"require_lowercase":   r.RequireLowercase,
+			"require_numeric":     r.RequireNumeric,
+			"require_letter":      r.RequireLetter,
+			"require_special":     r.RequireSpecial,
+			"special_chars":       r.SpecialChars,
+			"min_unique_chars":    r.MinUniqueChars,
+			"max_repeating_chars": r.MaxRepeatingChars,
+			"disallow_common":     r.DisallowCommon,
+			"disallow_keyboard":   r.DisallowKeyboard,
+			"min_entropy":         r.MinEntropy,
+		}
+		if len(r.NotSimilarTo) > 0 {
+			params["not_similar_to"] = append([]string(nil), r.NotSimilarTo...)
+		}
+		desc.AddRule("password", params)
+	}
+	if s.ipVersion != nil {
+		desc.AddRule("ip", map[string]any{"version": *s.ipVersion})
+	}
+	if s.ipNotPrivate {
+		desc.AddRule("ip_not_private", nil)
+	}
+	if s.ipNotLoopback {
+		desc.AddRule("ip_not_loopback", nil)
+	}
+	if s.ipPublicOnly {
+		desc.AddRule("ip_public_only", nil)
+	}
+	if s.phoneCountry != nil {
+		desc.AddRule("phone", map[string]any{"country": *s.phoneCountry})
+	}
+	if s.isAlpha {
+		desc.AddRule("alpha", nil)
+	}
+	if s.isAlphanumeric {
+		desc.AddRule("alphanumeric", nil)
+	}
+	if s.alphaUnicode {
+		desc.AddRule("alpha_unicode", nil)
+	}
+	if s.alnumUnicode {
+		desc.AddRule("alphanumeric_unicode", nil)
+	}
+	if s.alphaSpace {
+		desc.AddRule("alpha_space", nil)
+	}
+	if s.personName {
+		desc.AddRule("person_name", nil)
+	}
+	if s.isNumeric {
+		desc.AddRule("numeric", nil)
+	}
+	if s.startsWith != nil {
+		desc.AddRule("starts_with", map[string]any{"value": *s.startsWith})
+	}
+	if s.endsWith != nil {
+		desc.AddRule("ends_with", map[string]any{"value": *s.endsWith})
+	}
+	if s.contains != nil {
+		desc.AddRule("contains", map[string]any{"value": *s.contains})
+	}
+	if s.customRegex != nil {
+		desc.AddRule("regex", map[string]any{"pattern": s.customRegex.String()})
+	}
+	if s.isMAC {
+		desc.AddRule("mac", nil)
+	}
+	if s.isHex {
+		desc.AddRule("hex", nil)
+	}
+	if s.isBase64 {
+		desc.AddRule("base64", nil)
+	}
+	if s.isRRule {
+		desc.AddRule("rrule", nil)
+	}
+	if s.honeypot {
+		desc.AddRule("honeypot", nil)
+	}
+	if s.isE164 {
+		desc.AddRule("e164", nil)
+	}
+	if s.isDID {
+		desc.AddRule("did", nil)
+	}
+	if s.isURN {
+		desc.AddRule("urn", nil)
+	}
+	if s.checksumName != "" {
+		desc.AddRule("checksum", map[string]any{"algorithm": s.checksumName})
+	}
+	if s.nationalID != "" {
+		desc.AddRule("national_id", map[string]any{"country": s.nationalID})
+	}
+	if s.licensePlate != "" {
+		desc.AddRule("license_plate", map[string]any{"country": s.licensePlate})
+	}
+	if s.isMERSIS {
+		desc.AddRule("mersis", nil)
+	}
+	if s.isTaxOfficeCode {
+		desc.AddRule("tax_office", nil)
+	}
+	if s.isSGKNumber {
+		desc.AddRule("sgk_number", nil)
+	}
+	if s.pemRules != nil {
+		r := s.pemRules
+		params := map[string]any{
+			"block_type":   r.BlockType,
+			"min_rsa_bits": r.MinRSABits,
+			"min_ec_bits":  r.MinECBits,
+		}
+		if len(r.KeyTypes) > 0 {
+			params["key_types"] = append([]string(nil), r.KeyTypes...)
+		}
+		if r.CheckExpiry {
+			params["check_expiry"] = true
+			params["expiry_margin"] = r.ExpiryMargin.String()
+		}
+		desc.AddRule("pem", params)
+	}
+	if s.isSSHPublicKey {
+		var params map[string]any
+		if len(s.sshKeyTypes) > 0 {
+			params = map[string]any{"types": append([]string(nil), s.sshKeyTypes...)}
+		}
+		desc.AddRule("ssh_public_key", params)
+	}
+	if s.isJSONPointer {
+		desc.AddRule("json_pointer", nil)
+	}
+	if s.isJSONPath {
+		desc.AddRule("json_path", nil)
+	}
+	if s.otpLength != nil {
+		desc.AddRule("otp_code", map[string]any{"length": *s.otpLength})
+	}
+	if s.totpSecret != nil {
+		desc.AddRule("totp", map[string]any{"skew": s.totpSkew})
+	}
+	if s.headerValue {
+		desc.AddRule("header_value", nil)
+	}
+	if s.headerFilename {
+		desc.AddRule("header_filename", nil)
+	}
+	if s.idempotencyKey {
+		desc.AddRule("idempotency_key", nil)
+	}
+	if s.csvElement != nil {
+		desc.AddRule("csv_of", nil)
+		desc.Elements = core.DescribeType(s.csvElement)
+	}
+	desc.CustomRules = s.customValidation.Count()
+	if s.totpSecret != nil {
+		desc.CustomRules++
+	}
+	if s.passwordRules != nil && s.passwordRules.HistoryChecker != nil {
+		desc.CustomRules++
+	}
+	desc.CustomRules += len(s.ipPolicies)
+	if s.urlResolverFunc {
+		desc.CustomRules++
+	}
+	if s.callbackVerifier != nil {
+		desc.CustomRules++
+	}
+	if s.idempotencyCheck != nil {
+		desc.CustomRules++
+	}
+}
+
+// validateSSHPublicKey, SSH anahtarı kuralının hatasını ilgili mesaja çevirir.
+func (s *StringType) validateSSHPublicKey(field, fieldName, str string, result *core.ValidationResult) {
+	_, err := rules.ValidateSSHPublicKey(str, s.sshKeyTypes)
+	switch {
+	case err == nil:
+	case errors.Is(err, rules.ErrSSHKeyType):
+		allowed := s.sshKeyTypes
+		if len(allowed) == 0 {
+			allowed = rules.DefaultSSHKeyTypes
+		}
+		result.AddRuleError(field, i18n.KeySSHKeyType, fieldName, strings.Join(allowed, ", "))
+	case errors.Is(err, rules.ErrSSHKeySize):
+		result.AddRuleError(field, i18n.KeySSHKeySize, fieldName, rules.MinSSHRSABits)
+	default:
+		result.AddRuleError(field, i18n.KeySSHPublicKey, fieldName)
+	}
+}
+
+// validatePEM, PEM kuralının hatasını ilgili mesaja çevirir.
+func (s *StringType) validatePEM(field, fieldName, str string, result *core.ValidationResult) {
+	err := rules.ValidatePEM(str, *s.pemRules, time.Now())
+	switch {
+	case err == nil:
+	case errors.Is(err, rules.ErrPEMKeyType) && len(s.pemRules.KeyTypes) > 0:
+		result.AddRuleError(field, i18n.KeyPEMKeyType, fieldName, strings.Join(s.pemRules.KeyTypes, ", "))
+	case errors.Is(err, rules.ErrPEMKeySize):
+		result.AddRuleError(field, i18n.KeyPEMKeySize, fieldName)
+	case errors.Is(err, rules.ErrCertificateExpired):
+		result.AddRuleError(field, i18n.KeyPEMExpired, fieldName)
+	default:
+		result.AddRuleError(field, i18n.KeyPEM, fieldName, strings.ToLower(s.pemRules.BlockType))
+	}
+}
+
+// validateIPPolicies, geçerli bir IP adresine yerleşik ve özel politikaları
+// uygular.
+func (s *StringType) validateIPPolicies(field, fieldName string, ip netip.Addr, result *core.ValidationResult) {
+	if s.ipNotPrivate && rules.NotPrivateIP(ip) != nil {
+		result.AddRuleError(field, i18n.KeyIPNotPrivate, fieldName)
+	}
+	if s.ipNotLoopback && rules.NotLoopbackIP(ip) != nil {
+		result.AddRuleError(field, i18n.KeyIPNotLoopback, fieldName)
+	}
+	if s.ipPublicOnly && rules.PublicIPOnly(ip) != nil {
+		result.AddRuleError(field, i18n.KeyIPPublicOnly, fieldName)
+	}
+	for _, policy := range s.ipPolicies {
+		if err := policy(ip); err != nil {
+			result.AddErrorRule(field, "ip_policy", err.Error())
+		}
+	}
+}
+
+// validateURLGuard, URL'nin host, şema ve port kısıtlarını denetler.
+func (s *StringType) validateURLGuard(field, fieldName, str string, result *core.ValidationResult) {
+	scheme, host, port, err := rules.URLHost(str)
+	if err != nil {
+		result.AddRuleError(field, i18n.KeyURL, fieldName)
+		return
+	}
+	if s.urlNoPrivate && rules.IsInternalHost(host) {
+		result.AddRuleError(field, i18n.KeyURLPrivateHost, fieldName)
+	}
+	if len(s.urlSchemes) > 0 && !containsFold(s.urlSchemes, scheme) {
+		result.AddRuleError(field, i18n.KeyURLScheme, fieldName, strings.Join(s.urlSchemes, ", "))
+	}
+	if len(s.urlPorts) > 0 && !slices.Contains(s.urlPorts, port) {
+		ports := make([]string, len(s.urlPorts))
+		for i, p := range s.urlPorts {
+			ports[i] = strconv.Itoa(p)
+		}
+		result.AddRuleError(field, i18n.KeyURLPort, fieldName, strings.Join(ports, ", "))
+	}
+}
+
 // Validate, string değer üzerinde tüm kuralları uygular ve hata durumlarını result'a ekler.
 func (s *StringType) Validate(field string, value any, result *core.ValidationResult) {
 	s.BaseType.Validate(field, value, result)
-	if result.HasErrors() {
+	if result.HasFieldErrors(field) {
 		return
 	}
 	if value == nil {
 		return
 	}
 
+	if s.honeypot {
+		if str, ok := value.(string); !ok || str != "" {
+			result.AddRuleError(field, i18n.KeyHoneypot, s.GetLabel(field))
+		}
+		return
+	}
+
+	if s.csvElement != nil {
+		s.validateCSV(field, value, result)
+		return
+	}
+
 	str, ok := value.(string)
 	if !ok {
-		result.AddError(field, i18n.Get(i18n.KeyString, s.GetLabel(field)))
+		result.AddRuleError(field, i18n.KeyString, s.GetLabel(field))
 		return
 	}
 
 	fieldName := s.GetLabel(field)
 
 	if s.minLength != nil && len(str) < *s.minLength {
-		result.AddError(field, i18n.Get(i18n.KeyMinLength, fieldName, *s.minLength))
+		result.AddRuleError(field, i18n.KeyMinLength, fieldName, *s.minLength)
 	}
 
 	if s.maxLength != nil && len(str) > *s.maxLength {
-		result.AddError(field, i18n.Get(i18n.KeyMaxLength, fieldName, *s.maxLength))
+		result.AddRuleError(field, i18n.KeyMaxLength, fieldName, *s.maxLength)
 	}
 
 	if s.emailRegex != nil {
 		if strings.Contains(str, "..") {
-			result.AddError(field, i18n.Get(i18n.KeyEmail, fieldName))
+			result.AddRuleError(field, i18n.KeyEmail, fieldName)
 			return
 		}
 
@@ -298,39 +1230,43 @@ func (s *StringType) Validate(field string, value any, result *core.ValidationRe
 			if len(domainParts) > 0 {
 				tld := domainParts[len(domainParts)-1]
 				if len(tld) < 2 {
-					result.AddError(field, i18n.Get(i18n.KeyEmail, fieldName))
+					result.AddRuleError(field, i18n.KeyEmail, fieldName)
 					return
 				}
 			}
 		}
 
 		if !s.emailRegex.MatchString(str) {
-			result.AddError(field, i18n.Get(i18n.KeyEmail, fieldName))
+			result.AddRuleError(field, i18n.KeyEmail, fieldName)
 		}
 	}
 
 	if s.urlRegex != nil {
 		if strings.Contains(str, " ") {
-			result.AddError(field, i18n.Get(i18n.KeyURL, fieldName))
+			result.AddRuleError(field, i18n.KeyURL, fieldName)
 			return
 		}
 
 		if !strings.HasPrefix(str, "http://") && !strings.HasPrefix(str, "https://") {
-			result.AddError(field, i18n.Get(i18n.KeyURL, fieldName))
+			result.AddRuleError(field, i18n.KeyURL, fieldName)
 			return
 		}
 
 		withoutProtocol := strings.TrimPrefix(strings.TrimPrefix(str, "https://"), "http://")
 		if len(withoutProtocol) == 0 {
-			result.AddError(field, i18n.Get(i18n.KeyURL, fieldName))
+			result.AddRuleError(field, i18n.KeyURL, fieldName)
 			return
 		}
 
 		if !s.urlRegex.MatchString(str) {
-			result.AddError(field, i18n.Get(i18n.KeyURL, fieldName))
+			result.AddRuleError(field, i18n.KeyURL, fieldName)
 		}
 	}
 
+	if s.urlNoPrivate || len(s.urlSchemes) > 0 || len(s.urlPorts) > 0 {
+		s.validateURLGuard(field, fieldName, str, result)
+	}
+
 	if len(s.allowedValues) > 0 {
 		found := false
 		for _, allowed := range s.allowedValues {
@@ -340,76 +1276,196 @@ func (s *StringType) Validate(field string, value any, result *core.ValidationRe
 			}
 		}
 		if !found {
-			result.AddError(field, i18n.Get(i18n.KeyOneOf, fieldName, fmt.Sprintf("%v", s.allowedValues)))
+			values := i18n.StringList(s.allowedValues)
+			result.AddRuleError(field, i18n.KeyOneOf, fieldName, values, i18n.Attrs{"attribute": fieldName, "values": values})
 		}
 	}
 
 	if s.passwordRules != nil && str != "" {
 		passwordErrors := rules.ValidatePassword(str, s.passwordRules)
 		for _, err := range passwordErrors {
-			result.AddError(field, fmt.Sprintf("%s %s", fieldName, err))
+			result.AddErrorRule(field, "password", fmt.Sprintf("%s %s", fieldName, err))
+		}
+		if s.passwordRules.MaxBytes == 0 && len(str) > rules.BcryptMaxBytes {
+			result.AddWarning(field, i18n.Get(i18n.KeyPasswordTruncated, fieldName, rules.BcryptMaxBytes))
 		}
 	}
 	if s.ipVersion != nil {
 		if !rules.IsValidIP(str, *s.ipVersion) {
-			result.AddError(field, i18n.Get(i18n.KeyIP, fieldName))
+			result.AddRuleError(field, i18n.KeyIP, fieldName)
+		} else if ip, ok := rules.ParseIPAddr(str); ok {
+			s.validateIPPolicies(field, fieldName, ip, result)
 		}
 	}
 	if s.phoneCountry != nil {
 		if !rules.IsValidPhoneNumber(str, *s.phoneCountry) {
-			result.AddError(field, i18n.Get(i18n.KeyPhone, fieldName, *s.phoneCountry))
+			result.AddRuleError(field, i18n.KeyPhone, fieldName, *s.phoneCountry)
 		}
 	}
 
 	// New validators
 	if s.isAlpha && !alphaRegex.MatchString(str) {
-		result.AddError(field, i18n.Get(i18n.KeyAlpha, fieldName))
+		result.AddRuleError(field, i18n.KeyAlpha, fieldName)
 	}
 
 	if s.isAlphanumeric && !alphanumericRegex.MatchString(str) {
-		result.AddError(field, i18n.Get(i18n.KeyAlphanumeric, fieldName))
+		result.AddRuleError(field, i18n.KeyAlphanumeric, fieldName)
+	}
+
+	if s.alphaUnicode && !rules.ValidateCharSet(str, "unicode_alpha") {
+		result.AddRuleError(field, i18n.KeyAlphaUnicode, fieldName)
+	}
+
+	if s.alnumUnicode && !rules.ValidateCharSet(str, "unicode_alphanumeric") {
+		result.AddRuleError(field, i18n.KeyAlphaNumericUnicode, fieldName)
+	}
+
+	if s.alphaSpace && !rules.ValidateCharSet(str, "alpha_space") {
+		result.AddRuleError(field, i18n.KeyAlphaSpace, fieldName)
+	}
+
+	if s.personName && str != "" {
+		if !rules.IsValidPersonName(str) {
+			result.AddRuleError(field, i18n.KeyPersonName, fieldName)
+		} else if s.maxLength == nil && utf8.RuneCountInString(str) > rules.PersonNameMaxLength {
+			result.AddRuleError(field, i18n.KeyMaxLength, fieldName, rules.PersonNameMaxLength)
+		}
 	}
 
 	if s.isNumeric && !numericRegex.MatchString(str) {
-		result.AddError(field, i18n.Get(i18n.KeyNumericString, fieldName))
+		result.AddRuleError(field, i18n.KeyNumericString, fieldName)
 	}
 
 	if s.startsWith != nil && !strings.HasPrefix(str, *s.startsWith) {
-		result.AddError(field, i18n.Get(i18n.KeyStartsWith, fieldName, *s.startsWith))
+		result.AddRuleError(field, i18n.KeyStartsWith, fieldName, *s.startsWith)
 	}
 
 	if s.endsWith != nil && !strings.HasSuffix(str, *s.endsWith) {
-		result.AddError(field, i18n.Get(i18n.KeyEndsWith, fieldName, *s.endsWith))
+		result.AddRuleError(field, i18n.KeyEndsWith, fieldName, *s.endsWith)
 	}
 
 	if s.contains != nil && !strings.Contains(str, *s.contains) {
-		result.AddError(field, i18n.Get(i18n.KeyContains, fieldName, *s.contains))
+		result.AddRuleError(field, i18n.KeyContains, fieldName, *s.contains)
 	}
 
 	if s.regexError != nil {
-		result.AddError(field, fmt.Sprintf("%s: %s", fieldName, s.regexError.Error()))
+		result.AddErrorRule(field, "regex", fmt.Sprintf("%s: %s", fieldName, s.regexError.Error()))
 		return
 	}
 
-	if s.customRegex != nil && !s.customRegex.MatchString(str) {
-		result.AddError(field, i18n.Get(i18n.KeyRegex, fieldName))
+	if s.customRegex != nil {
+		opts := rules.GetRegexOptions()
+		if s.regexOptions != nil {
+			opts = *s.regexOptions
+		}
+		matched, err := rules.MatchRegex(s.customRegex, str, opts)
+		switch {
+		case errors.Is(err, rules.ErrRegexInputTooLong):
+			result.AddRuleError(field, i18n.KeyMaxLength, fieldName, opts.MaxInputLength)
+		case errors.Is(err, rules.ErrRegexTimeout):
+			result.AddRuleError(field, i18n.KeyRegexTimeout, fieldName)
+		case !matched:
+			result.AddRuleError(field, i18n.KeyRegex, fieldName)
+		}
 	}
 
 	if s.isMAC && !macRegex.MatchString(str) {
-		result.AddError(field, i18n.Get(i18n.KeyMAC, fieldName))
+		result.AddRuleError(field, i18n.KeyMAC, fieldName)
 	}
 
 	if s.isHex && !hexRegex.MatchString(str) {
-		result.AddError(field, i18n.Get(i18n.KeyHex, fieldName))
+		result.AddRuleError(field, i18n.KeyHex, fieldName)
 	}
 
 	if s.isBase64 {
 		// Check if it's valid base64 by trying to decode it
 		if _, err := base64.StdEncoding.DecodeString(str); err != nil {
-			result.AddError(field, i18n.Get(i18n.KeyBase64, fieldName))
+			result.AddRuleError(field, i18n.KeyBase64, fieldName)
 		}
 	}
 
+	if s.isRRule && !rules.IsValidRRule(str) {
+		result.AddRuleError(field, i18n.KeyRRule, fieldName)
+	}
+
+	if s.isE164 && !rules.IsValidE164(str) {
+		result.AddRuleError(field, i18n.KeyE164, fieldName)
+	}
+
+	if s.isDID && !rules.IsValidDID(str) {
+		result.AddRuleError(field, i18n.KeyDID, fieldName)
+	}
+
+	if s.isURN && !rules.IsValidURN(str) {
+		result.AddRuleError(field, i18n.KeyURN, fieldName)
+	}
+
+	if s.checksumError != nil {
+		result.AddErrorRule(field, "checksum", fmt.Sprintf("%s: %s", fieldName, s.checksumError.Error()))
+	} else if s.checksumFn != nil && !s.checksumFn(str) {
+		result.AddRuleError(field, i18n.KeyChecksum, fieldName)
+	}
+
+	if s.nationalIDError != nil {
+		result.AddErrorRule(field, "national_id", fmt.Sprintf("%s: %s", fieldName, s.nationalIDError.Error()))
+	} else if s.nationalID != "" && !s.nationalIDSpec.Validate(str) {
+		result.AddRuleError(field, i18n.KeyNationalID, fieldName)
+	}
+
+	if s.licensePlateErr != nil {
+		result.AddErrorRule(field, "license_plate", fmt.Sprintf("%s: %s", fieldName, s.licensePlateErr.Error()))
+	} else if s.licensePlateFn != nil && !s.licensePlateFn(str) {
+		result.AddRuleError(field, i18n.KeyLicensePlate, fieldName)
+	}
+
+	if s.isMERSIS && !rules.IsValidMERSIS(str) {
+		result.AddRuleError(field, i18n.KeyMERSIS, fieldName)
+	}
+
+	if s.isTaxOfficeCode && !rules.IsValidTaxOfficeCode(str) {
+		result.AddRuleError(field, i18n.KeyTaxOfficeCode, fieldName)
+	}
+
+	if s.isSGKNumber && !rules.IsValidSGKNumber(str) {
+		result.AddRuleError(field, i18n.KeySGKNumber, fieldName)
+	}
+
+	if s.pemRules != nil {
+		s.validatePEM(field, fieldName, str, result)
+	}
+
+	if s.isSSHPublicKey {
+		s.validateSSHPublicKey(field, fieldName, str, result)
+	}
+
+	if s.isJSONPointer && !rules.IsValidJSONPointer(str) {
+		result.AddRuleError(field, i18n.KeyJSONPointer, fieldName)
+	}
+
+	if s.isJSONPath && !rules.IsValidJSONPath(str) {
+		result.AddRuleError(field, i18n.KeyJSONPath, fieldName)
+	}
+
+	if s.otpLength != nil && !rules.IsValidOTPCode(str, *s.otpLength) {
+		result.AddRuleError(field, i18n.KeyOTPCode, fieldName, *s.otpLength)
+	}
+
+	if s.totpSecret != nil && !rules.IsValidOTPCode(str, 6) && !rules.IsValidOTPCode(str, 8) {
+		result.AddRuleError(field, i18n.KeyTOTP, fieldName)
+	}
+
+	if s.headerValue && !rules.IsSafeHeaderValue(str) {
+		result.AddRuleError(field, i18n.KeyHeaderValue, fieldName)
+	}
+
+	if s.headerFilename && !rules.IsSafeHeaderFilename(str) {
+		result.AddRuleError(field, i18n.KeyHeaderFilename, fieldName)
+	}
+
+	if s.idempotencyKey && !rules.IsValidIdempotencyKey(str, s.idempotencyMaxLength()) {
+		result.AddRuleError(field, i18n.KeyIdempotencyKey, fieldName, s.idempotencyMaxLength())
+	}
+
 	if s.customValidation != nil && s.customValidation.HasValidators() {
 		s.customValidation.ValidateSync(field, value, result)
 	}
@@ -0,0 +1,118 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/biyonik/go-fluent-validator/core"
+	"github.com/biyonik/go-fluent-validator/i18n"
+)
+
+//
+// -----------------------------------------------------------------------------
+// Virgülle Ayrılmış Değer Listeleri (CSVOf)
+// -----------------------------------------------------------------------------
+// Sorgu parametreleri gibi tek bir string içinde gelen listeleri ("?ids=1,2,3")
+// ayrıştırır. Değer virgüllerden bölünür, her parça kırpılır, eleman tipine
+// göre dönüştürülür (Number → float64, Boolean → bool) ve eleman tipiyle
+// doğrulanır. ValidData'da alan, ayrıştırılmış değerleri içeren []any olur;
+// böylece Array().Elements(...) ile gelen JSON dizileriyle aynı biçimi taşır.
+//
+// CSV modunda string kuralları (Email, Regex vb.) uygulanmaz; Min ve Max eleman
+// sayısını sınırlar. Eleman hataları Array ile aynı şekilde "alan[i]" yoluna
+// yazılır ve result.Elements(alan) ile indeks bazlı okunabilir.
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// CSVOf, alanın virgülle ayrılmış ve her elemanı elem tipine uyan bir liste
+// olmasını sağlar.
+//
+// Örnek:
+//
+//	"ids": validation.String().Required().Max(100).CSVOf(validation.Number().Integer().Positive()),
+//	// "4, 8,15" → ValidData["ids"] = []any{4.0, 8.0, 15.0}
+func (s *StringType) CSVOf(elem core.Type) *StringType {
+	s.csvElement = elem
+	return s
+}
+
+// Transform, tanımlı dönüşümleri uygular; CSVOf tanımlıysa değeri ayrıştırılmış
+// eleman listesine çevirir. Boş string olduğu gibi bırakılır.
+func (s *StringType) Transform(value any) (any, error) {
+	value, err := s.BaseType.Transform(value)
+	if err != nil || s.csvElement == nil {
+		return value, err
+	}
+	str, ok := value.(string)
+	if !ok || strings.TrimSpace(str) == "" {
+		return value, nil
+	}
+
+	parts := strings.Split(str, ",")
+	items := make([]any, len(parts))
+	for i, part := range parts {
+		item, err := s.csvElement.Transform(parseCSVItem(strings.TrimSpace(part), s.csvElement))
+		if err != nil {
+			return nil, err
+		}
+		items[i] = item
+	}
+	return items, nil
+}
+
+// parseCSVItem, parçayı eleman tipinin beklediği Go değerine çevirir.
+// Ayrıştırılamayan parçalar string kalır ve eleman doğrulamasında reddedilir.
+func parseCSVItem(part string, elem core.Type) any {
+	switch elem.(type) {
+	case *NumberType:
+		if f, err := strconv.ParseFloat(part, 64); err == nil {
+			return f
+		}
+	case *BooleanType:
+		if b, err := strconv.ParseBool(part); err == nil {
+			return b
+		}
+	}
+	return part
+}
+
+// validateCSV, ayrıştırılmış listenin eleman sayısını ve her elemanı doğrular.
+func (s *StringType) validateCSV(field string, value any, result *core.ValidationResult) {
+	fieldName := s.GetLabel(field)
+	items, ok := value.([]any)
+	if !ok {
+		if _, isString := value.(string); !isString {
+			result.AddRuleError(field, i18n.KeyString, fieldName)
+		}
+		return
+	}
+
+	if s.minLength != nil && len(items) < *s.minLength {
+		result.AddRuleError(field, i18n.KeyMinElements, fieldName, *s.minLength)
+	}
+	if s.maxLength != nil && len(items) > *s.maxLength {
+		result.AddRuleError(field, i18n.KeyMaxElements, fieldName, *s.maxLength)
+	}
+
+	elements := make([]core.ElementResult, 0, len(items))
+	for i, item := range items {
+		if i%core.ExpiryCheckInterval == 0 && result.Expired() {
+			break
+		}
+		elementResult := result.Child()
+		s.csvElement.Validate(fmt.Sprintf("%s[%d]", field, i), item, elementResult)
+		result.Merge(elementResult)
+		elements = append(elements, core.ElementResult{Index: i, Value: item, Errors: elementResult.Errors()})
+	}
+	result.SetElements(field, elements)
+
+	if s.customValidation != nil && s.customValidation.HasValidators() {
+		s.customValidation.ValidateSync(field, value, result)
+	}
+}
@@ -40,12 +40,53 @@ func (u *UuidType) Required() *UuidType {
 	return u
 }
 
+// Optional, alanın anahtarının payload'da hiç bulunmayabileceğini belirtir;
+// eksik anahtar Required olsa bile geçerlidir ve ValidData'ya yazılmaz.
+func (u *UuidType) Optional() *UuidType {
+	u.SetOptional()
+	return u
+}
+
+// Nullable, alanın açıkça null gönderilebileceğini belirtir; null değer
+// Required olsa bile geçerlidir ve ValidData'da korunur.
+func (u *UuidType) Nullable() *UuidType {
+	u.SetNullable()
+	return u
+}
+
 // Label, alan için okunabilir bir isim tanımlar.
 func (u *UuidType) Label(label string) *UuidType {
 	u.SetLabel(label)
 	return u
 }
 
+// Severity, alanın kurallarının önem seviyesini belirler (error, warning, info).
+// SeverityError dışındaki ihlaller şemanın politikasına göre uyarı olarak raporlanır.
+func (u *UuidType) Severity(level core.Severity) *UuidType {
+	u.SetSeverity(level)
+	return u
+}
+
+// Describe, alan için dokümantasyon amaçlı açıklama tanımlar. Doğrulamayı
+// etkilemez; Describe() çıktısında ve üretilen dokümanlarda görünür.
+func (u *UuidType) Describe(text string) *UuidType {
+	u.SetDescription(text)
+	return u
+}
+
+// Example, dokümantasyonda gösterilecek örnek bir değer ekler.
+func (u *UuidType) Example(value any) *UuidType {
+	u.AddExample(value)
+	return u
+}
+
+// Deprecated, alanı kullanımdan kaldırılmış olarak işaretler. reason, kaldırma
+// gerekçesini veya yerine kullanılacak alanı belirtir.
+func (u *UuidType) Deprecated(reason string) *UuidType {
+	u.SetDeprecated(reason)
+	return u
+}
+
 // Version, doğrulama için kullanılacak UUID sürümünü belirler (0-5 arası).
 func (u *UuidType) Version(v int) *UuidType {
 	if v >= 0 && v <= 5 {
@@ -54,6 +95,12 @@ func (u *UuidType) Version(v int) *UuidType {
 	return u
 }
 
+// Immutable, alanın güncelleme sırasında (ValidateChanges) değiştirilemeyeceğini belirtir.
+func (u *UuidType) Immutable() *UuidType {
+	u.SetImmutable()
+	return u
+}
+
 // Custom adds a custom validation function
 func (u *UuidType) Custom(validator func(string) error) *UuidType {
 	if u.customValidation == nil {
@@ -85,10 +132,20 @@ func (u *UuidType) AddRule(rule core.Rule) *UuidType {
 	return u
 }
 
+// Introspect, UUID tipinin kurallarını yapısal olarak döndürür.
+func (u *UuidType) Introspect() *core.TypeDescription {
+	desc := u.DescribeBase("uuid")
+	if u.version != 0 {
+		desc.AddRule("version", map[string]any{"value": u.version})
+	}
+	desc.CustomRules = u.customValidation.Count()
+	return desc
+}
+
 // Validate, UUID değerini doğrular ve hataları result'a ekler.
 func (u *UuidType) Validate(field string, value any, result *core.ValidationResult) {
 	u.BaseType.Validate(field, value, result)
-	if result.HasErrors() {
+	if result.HasFieldErrors(field) {
 		return
 	}
 	if value == nil {
@@ -97,13 +154,13 @@ func (u *UuidType) Validate(field string, value any, result *core.ValidationResu
 
 	str, ok := value.(string)
 	if !ok {
-		result.AddError(field, i18n.Get(i18n.KeyString, u.GetLabel(field)))
+		result.AddRuleError(field, i18n.KeyString, u.GetLabel(field))
 		return
 	}
 
 	fieldName := u.GetLabel(field)
 	if !rules.IsValidUUID(str, u.version) {
-		result.AddError(field, i18n.Get(i18n.KeyUUID, fieldName))
+		result.AddRuleError(field, i18n.KeyUUID, fieldName)
 	}
 
 	if u.customValidation != nil && u.customValidation.HasValidators() {
@@ -0,0 +1,154 @@
+package validation
+
+import (
+	"fmt"
+
+	"github.com/biyonik/go-fluent-validator/core"
+	"github.com/biyonik/go-fluent-validator/i18n"
+)
+
+//
+// -----------------------------------------------------------------------------
+// Birleşim Tipleri (Union)
+// -----------------------------------------------------------------------------
+// Bir alanın birden fazla biçimde gelebildiği durumlar ("string veya sayı",
+// "tek e-posta veya e-posta listesi") için alan seviyesinde birleşim tipi:
+//
+//	"id": validation.Union(
+//	    validation.Number().Integer().Positive(),
+//	    validation.Uuid(),
+//	).Required()
+//
+// Alternatifler tanımlandıkları sırayla denenir; değeri hatasız dönüştürüp
+// doğrulayan ilk alternatif kazanır ve ValidData'ya onun dönüştürdüğü değer
+// yazılır. Hiçbiri uymazsa alana union hatası ve en az hata üreten (en yakın)
+// alternatifin hataları eklenir; eşitlikte önce tanımlanan, değeri hiç
+// dönüştüremeyen (tipi tutmayan) alternatifler ise en son seçilir.
+//
+// Payload seviyesinde, bir ayırıcı alana göre şema seçimi için
+// DiscriminatedUnion; alternatif şemalar için AnyOf/OneOf kullanılır.
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// Union
+// -----------------------------------------------------------------------------
+// Değerin types içindeki tiplerden en az birine uymasını gerektiren bir alan
+// tipi oluşturur.
+//
+// Örnek:
+//
+//	"price": validation.Union(validation.Number().Min(0), validation.String().Regex(`^\d+(\.\d+)?$`))
+func Union(types ...core.Type) *UnionType {
+	return &UnionType{types: types}
+}
+
+// UnionType, alternatif tiplerden birine uyan değerleri kabul eden alan tipidir.
+type UnionType struct {
+	core.BaseType
+	types []core.Type
+}
+
+// Required, alanın boş geçilemeyeceğini belirtir.
+func (u *UnionType) Required() *UnionType {
+	u.SetRequired()
+	return u
+}
+
+// Optional, alanın anahtarının payload'da hiç bulunmayabileceğini belirtir;
+// eksik anahtar Required olsa bile geçerlidir ve ValidData'ya yazılmaz.
+func (u *UnionType) Optional() *UnionType {
+	u.SetOptional()
+	return u
+}
+
+// Nullable, alanın açıkça null gönderilebileceğini belirtir; null değer
+// Required olsa bile geçerlidir ve ValidData'da korunur.
+func (u *UnionType) Nullable() *UnionType {
+	u.SetNullable()
+	return u
+}
+
+// Label, doğrulama hatalarında gösterilecek kullanıcı dostu alan adını belirler.
+func (u *UnionType) Label(label string) *UnionType {
+	u.SetLabel(label)
+	return u
+}
+
+// Describe, alan için dokümantasyon amaçlı açıklama tanımlar.
+func (u *UnionType) Describe(text string) *UnionType {
+	u.SetDescription(text)
+	return u
+}
+
+// Types, alternatif tipleri tanımlandıkları sırayla döndürür.
+func (u *UnionType) Types() []core.Type {
+	return u.types
+}
+
+// match, value'yu alternatiflerle sırayla dener. Uyan ilk alternatifin
+// dönüştürdüğü değeri döndürür; hiçbiri uymazsa ok false olur ve closest en
+// az hata üreten alternatifin sonucudur. Değeri dönüştüremeyen alternatifler
+// yalnızca başka aday yoksa closest olarak seçilir.
+func (u *UnionType) match(field string, value any, result *core.ValidationResult) (matched any, ok bool, closest *core.ValidationResult) {
+	closestTransformed := false
+	for _, typ := range u.types {
+		branch := result.Child()
+		transformed, err := typ.Transform(value)
+		if err != nil {
+			if closest == nil {
+				branch.AddError(field, fmt.Sprintf("Dönüşüm hatası: %s", err.Error()))
+				closest = branch
+			}
+			continue
+		}
+		typ.Validate(field, transformed, branch)
+		if !branch.HasErrors() {
+			return transformed, true, nil
+		}
+		if !closestTransformed || len(branch.Failures()) < len(closest.Failures()) {
+			closest, closestTransformed = branch, true
+		}
+	}
+	return nil, false, closest
+}
+
+// Transform, ortak dönüşümlerden sonra değeri uyan ilk alternatifin
+// dönüşümüyle döndürür. Hiçbir alternatif uymazsa değer olduğu gibi kalır.
+func (u *UnionType) Transform(value any) (any, error) {
+	value, err := u.BaseType.Transform(value)
+	if err != nil || value == nil {
+		return value, err
+	}
+	if matched, ok, _ := u.match("", value, core.NewResult()); ok {
+		return matched, nil
+	}
+	return value, nil
+}
+
+// Validate, değerin alternatiflerden birine uyduğunu doğrular.
+func (u *UnionType) Validate(field string, value any, result *core.ValidationResult) {
+	u.BaseType.Validate(field, value, result)
+	if result.HasFieldErrors(field) || value == nil {
+		return
+	}
+	if _, ok, closest := u.match(field, value, result); !ok {
+		result.AddRuleError(field, i18n.KeyUnion, u.GetLabel(field), len(u.types))
+		if closest != nil {
+			result.Merge(closest)
+		}
+	}
+}
+
+// Introspect, birleşimi alternatiflerinin tanımlarıyla birlikte döndürür.
+func (u *UnionType) Introspect() *core.TypeDescription {
+	desc := u.DescribeBase("union")
+	for _, typ := range u.types {
+		desc.Union = append(desc.Union, core.DescribeType(typ))
+	}
+	return desc
+}
@@ -2,9 +2,17 @@
 package validation
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/biyonik/go-fluent-validator/core"
+	"github.com/biyonik/go-fluent-validator/i18n"
 )
 
 //
@@ -29,6 +37,14 @@ import (
 type ValidationResult = core.ValidationResult
 type Type = core.Type
 type Schema = core.Schema
+type Severity = core.Severity
+
+// Önem seviyeleri (kullanıcı dostu API için)
+const (
+	SeverityError   = core.SeverityError
+	SeverityWarning = core.SeverityWarning
+	SeverityInfo    = core.SeverityInfo
+)
 
 // conditionalRule
 // -----------------------------------------------------------------------------
@@ -40,14 +56,108 @@ type conditionalRule struct {
 	callback      func() core.Schema // Çalıştırılacak alt şema
 }
 
+// crossValidator
+// -----------------------------------------------------------------------------
+// CrossValidate / CrossValidateFields ile eklenen çok alanlı doğrulayıcıyı
+// temsil eder. fields boşsa doğrulayıcı her zaman çalışır ve hatası
+// _cross_validation alanına eklenir.
+type crossValidator struct {
+	fields []string                        // Okunan alanlar (opsiyonel)
+	rule   string                          // Hata kaydındaki kural adı (opsiyonel)
+	fn     func(data map[string]any) error // Doğrulama fonksiyonu
+}
+
+// run
+// -----------------------------------------------------------------------------
+// Doğrulayıcıyı çalıştırır ve hatayı uygun alana ekler. Bildirilen
+// alanlardan biri zaten hatalıysa doğrulayıcı atlanır.
+func (cv crossValidator) run(data map[string]any, result *core.ValidationResult) {
+	if cv.blocked(result.Errors()) {
+		return
+	}
+	for _, fe := range cv.evaluate(data) {
+		fe.addTo(result, cv.rule)
+	}
+}
+
+// blocked
+// -----------------------------------------------------------------------------
+// Bildirilen alanlardan biri errs içinde hata almışsa true döner.
+func (cv crossValidator) blocked(errs map[string][]string) bool {
+	for _, field := range cv.fields {
+		if len(errs[field]) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// evaluate
+// -----------------------------------------------------------------------------
+// Doğrulama fonksiyonunu çalıştırır; hata varsa hataların yazılacağı alanları
+// ve mesajları döndürür. FieldErrors dönen doğrulayıcılar birden fazla alana
+// hata yazabilir.
+func (cv crossValidator) evaluate(data map[string]any) []*FieldError {
+	err := cv.fn(data)
+	if err == nil {
+		return nil
+	}
+
+	var fieldErrs FieldErrors
+	if len(cv.fields) > 0 && errors.As(err, &fieldErrs) && len(fieldErrs) > 0 {
+		return fieldErrs
+	}
+	var fieldErr *FieldError
+	if len(cv.fields) > 0 && errors.As(err, &fieldErr) {
+		return []*FieldError{fieldErr}
+	}
+
+	field := "_cross_validation"
+	if len(cv.fields) > 0 {
+		field = cv.fields[0]
+	}
+	var msg *core.MessageError
+	if errors.As(err, &msg) {
+		return []*FieldError{newKeyedFieldError(field, msg.Key, msg.Args...)}
+	}
+	return []*FieldError{{Field: field, Message: err.Error()}}
+}
+
+// dependsOn
+// -----------------------------------------------------------------------------
+// Doğrulayıcının field alanındaki bir değişiklikten etkilenip etkilenmediğini
+// döndürür. Alan bildirmeyen doğrulayıcılar her alana bağımlı kabul edilir.
+func (cv crossValidator) dependsOn(field string) bool {
+	if len(cv.fields) == 0 {
+		return true
+	}
+	for _, f := range cv.fields {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
+
 // ValidationSchema
 // -----------------------------------------------------------------------------
 // Bir validasyon şemasını temsil eder.
 //
 // Alanlar:
 //   - shape: Her field için Type karşılığı
-//   - crossValidators: Çok alanlı doğrulama fonksiyonları
+//   - crossValidators: Çok alanlı doğrulama fonksiyonları (opsiyonel alan bildirimiyle)
 //   - conditionalRules: When(...) ile eklenen koşullu doğrulama kuralları
+//   - transitionRules: TransitionRule(...) ile eklenen durum geçişi kuralları
+//   - featureRules: WithFeature(...) ile eklenen feature flag'e bağlı kural grupları
+//   - compositions: AllOf(...) / AnyOf(...) / Not(...) ile kurulan şema birleşimleri
+//   - autoTrim: WithAutoTrim() ile açılan otomatik trim davranışı
+//   - severityPolicy: WithSeverityPolicy() ile belirlenen önem seviyesi politikası
+//   - stats, failureHooks: WithStats() / WithFailureHook() ile eklenen hata kayıtları
+//   - discriminator: Discriminated(...) şemalarında varyantı seçen alan
+//   - globalStringMax, maxTotalBytes: WithGlobalStringMax() / WithMaxTotalBytes() sınırları
+//   - budget: WithBudget() ile belirlenen doğrulama süre sınırı
+//   - strict, coerce, locale, maxErrors: WithStrict() / WithCoerce() /
+//     WithLocale() / WithMaxErrors() modları (bkz. Config)
 //
 // Örnek:
 //
@@ -59,13 +169,33 @@ type conditionalRule struct {
 // -----------------------------------------------------------------------------
 type ValidationSchema struct {
 	shape            map[string]core.Type
-	crossValidators  []func(data map[string]any) error
+	crossValidators  []crossValidator
 	conditionalRules []conditionalRule
+	transitionRules  []transitionRule
+	featureRules     []featureRule
+	compositions     []composition
+	autoTrim         bool
+	severityPolicy   core.SeverityPolicy
+	stats            *StatsCollector
+	failureHooks     []func(ctx context.Context, failure core.Failure)
+	discriminator    string
+	globalStringMax  int
+	maxTotalBytes    int
+	budget           time.Duration
+	sensitiveFields  map[string]bool
+	strict           bool
+	coerce           bool
+	locale           string
+	maxErrors        int
 }
 
 // Make
 // -----------------------------------------------------------------------------
-// Yeni bir ValidationSchema oluşturur.
+// Genel yapılandırmayla (bkz. SetDefaultConfig) yeni bir ValidationSchema
+// oluşturur.
+//
+// Parametreler:
+//   - opts: Şema davranışını değiştiren opsiyonel seçenekler (WithAutoTrim vb.)
 //
 // Dönüş:
 //   - *ValidationSchema
@@ -73,11 +203,17 @@ type ValidationSchema struct {
 // Örnek:
 //
 //	schema := validation.Make()
-func Make() *ValidationSchema {
-	return &ValidationSchema{
+//	trimmed := validation.Make(validation.WithAutoTrim())
+func Make(opts ...SchemaOption) *ValidationSchema {
+	vs := &ValidationSchema{
 		shape:            make(map[string]core.Type),
 		conditionalRules: make([]conditionalRule, 0),
 	}
+	WithConfig(DefaultConfig())(vs)
+	for _, opt := range opts {
+		opt(vs)
+	}
+	return vs
 }
 
 // Shape
@@ -97,10 +233,28 @@ func Make() *ValidationSchema {
 //	    "age":   validation.Number().Min(18),
 //	})
 func (vs *ValidationSchema) Shape(shape map[string]core.Type) core.Schema {
+	// Discriminated şemalarda ayırıcı alan, ortak alanlar eklenirken korunur.
+	if vs.discriminator != "" {
+		if _, ok := shape[vs.discriminator]; !ok {
+			merged := make(map[string]core.Type, len(shape)+1)
+			for field, typ := range shape {
+				merged[field] = typ
+			}
+			merged[vs.discriminator] = vs.shape[vs.discriminator]
+			shape = merged
+		}
+	}
 	vs.shape = shape
 	return vs
 }
 
+// GetShape
+// -----------------------------------------------------------------------------
+// Şemanın alan–type eşlemesini döndürür (core.ShapeProvider).
+func (vs *ValidationSchema) GetShape() map[string]core.Type {
+	return vs.shape
+}
+
 // CrossValidate
 // -----------------------------------------------------------------------------
 // Çok alanlı validasyon ekler. Örneğin password == password_confirm gibi.
@@ -119,10 +273,87 @@ func (vs *ValidationSchema) Shape(shape map[string]core.Type) core.Schema {
 //	    return nil
 //	})
 func (vs *ValidationSchema) CrossValidate(fn func(data map[string]any) error) core.Schema {
-	vs.crossValidators = append(vs.crossValidators, fn)
+	vs.crossValidators = append(vs.crossValidators, crossValidator{fn: fn})
 	return vs
 }
 
+// CrossValidateFields
+// -----------------------------------------------------------------------------
+// Okuduğu alanları bildiren çok alanlı validasyon ekler. CrossValidate'ten
+// farklı olarak:
+//   - Bildirilen alanlardan herhangi biri alan seviyesinde hata aldıysa
+//     doğrulayıcı hiç çalıştırılmaz (geçersiz veri üzerinde anlamsız
+//     karşılaştırma yapılmaz).
+//   - Dönen hata _cross_validation yerine ilgili alana eklenir. Hata bir
+//     *FieldError ise kendi Field değerine, değilse ilk bildirilen alana yazılır.
+//
+// Parametreler:
+//   - fields: Doğrulayıcının okuduğu alanlar
+//   - fn: func(data map[string]any) error
+//
+// Örnek:
+//
+//	schema.CrossValidateFields([]string{"price", "discount"}, func(data map[string]any) error {
+//	    if data["discount"].(float64) > data["price"].(float64) {
+//	        return validation.NewFieldError("discount", "İndirim fiyattan büyük olamaz")
+//	    }
+//	    return nil
+//	})
+func (vs *ValidationSchema) CrossValidateFields(fields []string, fn func(data map[string]any) error) *ValidationSchema {
+	vs.crossValidators = append(vs.crossValidators, crossValidator{fields: fields, fn: fn})
+	return vs
+}
+
+// Same
+// -----------------------------------------------------------------------------
+// field alanının other alanıyla aynı değere sahip olmasını zorunlu kılar
+// (örn: password / password_confirmation). Hata field alanına yazılır; mesaj
+// {other} yer tutucusu ile karşılaştırılan alanın etiketini içerir. Alanlardan
+// biri boşsa veya alan seviyesinde hatalıysa kural atlanır.
+//
+// Örnek:
+//
+//	schema.Same("password_confirmation", "password")
+func (vs *ValidationSchema) Same(field, other string) *ValidationSchema {
+	return vs.compareFields("same", i18n.KeySame, field, other, true)
+}
+
+// Different
+// -----------------------------------------------------------------------------
+// field alanının other alanından farklı bir değere sahip olmasını zorunlu
+// kılar (örn: new_password / current_password).
+func (vs *ValidationSchema) Different(field, other string) *ValidationSchema {
+	return vs.compareFields("different", i18n.KeyDifferent, field, other, false)
+}
+
+// compareFields, Same ve Different için alan karşılaştırma doğrulayıcısını ekler.
+func (vs *ValidationSchema) compareFields(rule string, key i18n.MessageKey, field, other string, equal bool) *ValidationSchema {
+	vs.crossValidators = append(vs.crossValidators, crossValidator{
+		fields: []string{field, other},
+		rule:   rule,
+		fn: func(data map[string]any) error {
+			a, b := data[field], data[other]
+			if a == nil || b == nil || core.ValuesEqual(a, b) == equal {
+				return nil
+			}
+			label := vs.labelOf(field)
+			return newKeyedFieldError(field, key, label, i18n.Attrs{
+				"attribute": label,
+				"other":     vs.labelOf(other),
+			})
+		},
+	})
+	return vs
+}
+
+// labelOf, alanın şemadaki tipine tanımlı etiketi, yoksa alan adını döndürür.
+func (vs *ValidationSchema) labelOf(field string) string {
+	if labeled, ok := vs.shape[field].(interface{ GetLabel(string) string }); ok {
+		return labeled.GetLabel(field)
+	}
+	return field
+}
+
 // When
 // -----------------------------------------------------------------------------
 // Koşullu doğrulama ekler. Belli bir alan belirlenen değere eşitse
@@ -166,59 +397,586 @@ func (vs *ValidationSchema) When(field string, expectedValue any, callback func(
 // Dönüş:
 //   - *core.ValidationResult
 func (vs *ValidationSchema) Validate(data map[string]any) *core.ValidationResult {
+	result, transformedData := vs.validate(context.Background(), data)
+
+	// 5) Valid data set
+	if !result.HasErrors() {
+		result.SetValidData(transformedData)
+	}
+
+	return result
+}
+
+// validate
+// -----------------------------------------------------------------------------
+// Validate'in 1–4 numaralı adımlarını çalıştırır ve sonucu, dönüştürülmüş
+// veri ile birlikte döndürür. ValidData ataması çağırana bırakılır; böylece
+// ValidateChanges gibi ek adım çalıştıran modlar aynı akışı yeniden kullanabilir.
+func (vs *ValidationSchema) validate(ctx context.Context, data map[string]any) (*core.ValidationResult, map[string]any) {
+	if vs.budget > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, vs.budget)
+		defer cancel()
+	}
 	result := core.NewResult()
-	transformedData := make(map[string]any)
+	result.BindContext(ctx)
+	raw := data
+
+	// 0) Payload sınırları (alan kurallarından önce)
+	data, blocked, ok := vs.applyGuards(data, result)
+	if !ok {
+		result.SetOldInput(vs.oldInput(raw))
+		vs.finalize(ctx, result)
+		return result, map[string]any{}
+	}
+	if vs.strict && !isNested(ctx) {
+		vs.checkStrict(data, result)
+	}
+	if vs.coerce {
+		data = vs.coerceData(data)
+	}
 
 	// 1) Transform aşaması
-	for field, typ := range vs.shape {
-		value := data[field]
-		transformedValue, err := typ.Transform(value)
-		if err != nil {
-			result.AddError(field, fmt.Sprintf("Dönüşüm hatası: %s", err.Error()))
+	transformedData := vs.transform(data, result)
+
+	// 2-4) Kurallar; süre sınırı dolarsa kalan adımlar atlanır
+	vs.validateRules(ctx, data, transformedData, blocked, result)
+	if result.Interrupted() && !slices.ContainsFunc(result.Failures(), isDeadline) {
+		result.AddRuleError(payloadField, i18n.KeyDeadline)
+	}
+	if !isNested(ctx) {
+		result.LimitErrors(vs.maxErrors)
+	}
+
+	result.SetTransformedData(transformedData)
+	if result.HasErrors() {
+		result.SetOldInput(vs.oldInput(raw))
+	}
+	vs.finalize(ctx, result)
+
+	return result, transformedData
+}
+
+// validateRules
+// -----------------------------------------------------------------------------
+// Alan, dış kaynaklı, koşullu, feature, birleşim, durum geçişi ve çapraz alan
+// kurallarını sırayla çalıştırır. Her adımdan (ve her alandan) önce süre
+// sınırı kontrol edilir; süre dolduysa kalan adımlar atlanır ve sonuç
+// kesilmiş olarak işaretlenir (core.ValidationResult.Interrupted).
+func (vs *ValidationSchema) validateRules(ctx context.Context, data, transformedData map[string]any, blocked map[string]bool, result *core.ValidationResult) {
+	// 2) Field-level validation (deterministik hata sırası için alan adına göre)
+	for _, field := range sortedKeys(vs.shape) {
+		typ := vs.shape[field]
+		if blocked[field] {
+			continue
+		}
+		if result.Expired() {
+			return
+		}
+		if core.SkipValue(typ, transformedData, field) {
 			continue
 		}
-		transformedData[field] = transformedValue
+		vs.validateValue(field, typ, transformedData[field], result)
 	}
 
-	// 2) Field-level validation
-	for field, typ := range vs.shape {
-		typ.Validate(field, transformedData[field], result)
-	}
-
-	if len(vs.conditionalRules) > 0 {
-		for _, rule := range vs.conditionalRules {
-			val, exists := transformedData[rule.field]
-			if exists && val == rule.expectedValue {
-				subSchema := rule.callback()
-				subResult := subSchema.Validate(data)
-				if subResult.HasErrors() {
-					for f, msgs := range subResult.Errors() {
-						for _, msg := range msgs {
-							result.AddError(f, msg)
-						}
-					}
-				} else {
-					for k, v := range subResult.ValidData() {
-						transformedData[k] = v
-					}
-				}
+	// 3) Dış kaynaklı (asenkron) alan kontrolleri; context'i kendileri izler
+	vs.validateAsync(ctx, transformedData, blocked, result)
+
+	// Alt şemalar üst şemanın verisinin tamamını görür; Strict ve MaxErrors
+	// yalnızca en dıştaki şemada uygulanır.
+	sub := nested(ctx)
+
+	for _, rule := range vs.conditionalRules {
+		val, exists := transformedData[rule.field]
+		if !exists || val != rule.expectedValue {
+			continue
+		}
+		if result.Expired() {
+			return
+		}
+		subResult := core.ValidateContext(sub, rule.callback(), data)
+		if subResult.HasErrors() {
+			result.Merge(subResult)
+		} else {
+			for k, v := range subResult.ValidData() {
+				transformedData[k] = v
 			}
 		}
 	}
 
+	// Feature flag'e bağlı kural grupları
+	if len(vs.featureRules) > 0 && result.Expired() {
+		return
+	}
+	vs.validateFeatures(sub, data, transformedData, result)
+
+	// AllOf / AnyOf / Not birleşimleri
+	if len(vs.compositions) > 0 && result.Expired() {
+		return
+	}
+	vs.validateCompositions(sub, data, transformedData, result)
+
+	// Durum geçişi kuralları (mevcut durum sağlayıcısı olanlar)
+	if len(vs.transitionRules) > 0 && result.Expired() {
+		return
+	}
+	vs.validateTransitions(transformedData, result)
+
 	// 4) Cross-field validation
 	// Run cross-validation regardless of field-level errors
 	// This ensures important cross-field checks (like password confirmation) always run
-	for _, fn := range vs.crossValidators {
-		if err := fn(transformedData); err != nil {
-			result.AddError("_cross_validation", err.Error())
+	if len(vs.crossValidators) > 0 && result.Expired() {
+		return
+	}
+	for _, cv := range vs.crossValidators {
+		cv.run(transformedData, result)
+	}
+}
+
+// isDeadline, f'nin süre sınırı hatası olup olmadığını bildirir; iç içe
+// şemalardan birleştirilen hatayla aynısının tekrar eklenmemesi için kullanılır.
+func isDeadline(f core.Failure) bool {
+	return f.Field == payloadField && f.Rule == "deadline"
+}
+
+// finalize, her doğrulama yolunun sonunda çalışan ortak adımlardır: istek
+// kimliğini ekler, mesajları yerelleştirir ve sonucu kaydeder.
+func (vs *ValidationSchema) finalize(ctx context.Context, result *core.ValidationResult) {
+	result.SetRequestID(core.RequestIDFromContext(ctx))
+	vs.localize(ctx, result)
+	vs.record(ctx, result)
+}
+
+// localize, context'te i18n.ContextWithLocale ile bir dil taşınıyorsa hata
+// mesajlarını o dilde, yoksa (varsa) şemanın diliyle (WithLocale) yeniden
+// üretir.
+func (vs *ValidationSchema) localize(ctx context.Context, result *core.ValidationResult) {
+	if locale, ok := i18n.LocaleFromContext(ctx); ok {
+		result.Localize(locale)
+	} else if vs.locale != "" {
+		result.Localize(vs.locale)
+	}
+}
+
+// ValidateChanges
+// -----------------------------------------------------------------------------
+// Güncelleme uç noktaları (PUT/PATCH) için diff-aware doğrulama yapar.
+// Önce newData normal Validate akışından geçirilir; ardından hem oldData'da
+// hem newData'da bulunan her alan için, tipe eklenmiş değişiklik kuralları
+// (Immutable, OnlyIncrease, OnChange vb.) eski ve yeni değerle çalıştırılır.
+//
+// Notlar:
+//   - Eski değer de karşılaştırmadan önce alanın Transform zincirinden geçirilir.
+//   - newData'da gönderilmeyen alanlar "değişmedi" kabul edilir ve kontrol edilmez.
+//   - Yeni değeri zaten geçersiz olan alanlar için değişiklik kuralları çalışmaz.
+//
+// Parametreler:
+//   - oldData: Kayıtlı (mevcut) veri
+//   - newData: Gönderilen yeni veri
+//
+// Dönüş:
+//   - *core.ValidationResult
+//
+// Örnek:
+//
+//	schema := validation.Make()
+//	schema.Shape(map[string]validation.Type{
+//	    "id":      validation.Uuid().Immutable(),
+//	    "version": validation.Number().Integer().OnlyIncrease(),
+//	})
+//	result := schema.ValidateChanges(stored, payload)
+func (vs *ValidationSchema) ValidateChanges(oldData, newData map[string]any) *core.ValidationResult {
+	result, transformedData := vs.validate(context.Background(), newData)
+
+	for _, field := range sortedKeys(vs.shape) {
+		changeAware, ok := vs.shape[field].(core.ChangeAware)
+		if !ok {
+			continue
 		}
+		oldRaw, inOld := oldData[field]
+		if _, inNew := newData[field]; !inOld || !inNew {
+			continue
+		}
+		if len(result.Errors()[field]) > 0 {
+			continue
+		}
+		oldValue, err := vs.transformValue(vs.shape[field], oldRaw)
+		if err != nil {
+			continue
+		}
+		changeAware.ValidateChange(field, oldValue, transformedData[field], result)
 	}
 
-	// 5) Valid data set
+	vs.validateStoredTransitions(oldData, newData, transformedData, result)
+
 	if !result.HasErrors() {
 		result.SetValidData(transformedData)
 	}
 
 	return result
 }
+
+// Sanitize
+// -----------------------------------------------------------------------------
+// Veriyi doğrulama kurallarını çalıştırmadan yalnızca dönüşüm zincirinden
+// (otomatik trim, Trim, StripTags, varsayılan değerler, tip dönüşümleri)
+// geçirir. Normalizasyonu kural denetiminden ayrı yürüten pipeline'lar için
+// kullanılır.
+//
+// Koşulu sağlanan When(...) alt şemalarının ve AllOf(...) dallarının
+// temizlediği alanlar da sonuca eklenir.
+//
+// Parametre:
+//   - data: map[string]any
+//
+// Dönüş:
+//   - map[string]any: Temizlenmiş veri (yalnızca şemada tanımlı alanlar)
+//   - *core.ValidationResult: Sadece dönüşüm hatalarını içeren sonuç
+//
+// Örnek:
+//
+//	clean, res := schema.Sanitize(input)
+//	if res.HasErrors() {
+//	    log.Println(res.Errors())
+//	}
+func (vs *ValidationSchema) Sanitize(data map[string]any) (map[string]any, *core.ValidationResult) {
+	result := core.NewResult()
+	cleaned := vs.transform(data, result)
+
+	for _, rule := range vs.conditionalRules {
+		val, exists := cleaned[rule.field]
+		if !exists || val != rule.expectedValue {
+			continue
+		}
+		mergeSanitized(rule.callback(), data, cleaned, result)
+	}
+	for _, c := range vs.compositions {
+		if c.kind != compositionAllOf {
+			continue
+		}
+		for _, schema := range c.schemas {
+			mergeSanitized(schema, data, cleaned, result)
+		}
+	}
+
+	return cleaned, result
+}
+
+// mergeSanitized, alt şemanın temizlediği alanları ve dönüşüm hatalarını
+// cleaned ve result'a ekler.
+func mergeSanitized(schema core.Schema, data, cleaned map[string]any, result *core.ValidationResult) {
+	sanitizer, ok := schema.(core.Sanitizer)
+	if !ok {
+		return
+	}
+	subCleaned, subResult := sanitizer.Sanitize(data)
+	for f, msgs := range subResult.Errors() {
+		for _, msg := range msgs {
+			result.AddError(f, msg)
+		}
+	}
+	for k, v := range subCleaned {
+		cleaned[k] = v
+	}
+}
+
+// ValidateLenient
+// -----------------------------------------------------------------------------
+// Validate ile aynı kuralları çalıştırır; ancak hata olsa bile ValidData'yı
+// tek başına geçerli olan alanlarla doldurur. Hatalı alanlar (ve iç içe
+// hataları olan alanlar, örn. "address.city" veya "items[2]") ValidData'ya
+// eklenmez. "Geçerli olanları taslak olarak kaydet" akışları için kullanılır.
+//
+// Parametre:
+//   - data: map[string]any
+//
+// Dönüş:
+//   - *core.ValidationResult: Hatalar + kısmi ValidData
+//
+// Örnek:
+//
+//	res := schema.ValidateLenient(input)
+//	saveDraft(res.ValidData())
+//	return res.Errors()
+func (vs *ValidationSchema) ValidateLenient(data map[string]any) *core.ValidationResult {
+	result, transformedData := vs.validate(context.Background(), data)
+
+	validData := make(map[string]any, len(transformedData))
+	for field, value := range transformedData {
+		if !hasErrorsUnder(result.Errors(), field) {
+			validData[field] = value
+		}
+	}
+	result.SetValidData(validData)
+
+	return result
+}
+
+// hasErrorsUnder
+// -----------------------------------------------------------------------------
+// field alanının kendisinde veya altındaki bir yolda ("field.x", "field[0]")
+// hata olup olmadığını döndürür.
+func hasErrorsUnder(errs map[string][]string, field string) bool {
+	for key := range errs {
+		if key == field || strings.HasPrefix(key, field+".") || strings.HasPrefix(key, field+"[") {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateField
+// -----------------------------------------------------------------------------
+// Şemadaki tek bir alanı, şemanın geri kalanını ve çapraz doğrulayıcıları
+// çalıştırmadan doğrular. "Yazarken doğrulama" yapan uç noktalar için
+// kullanılır.
+//
+// Parametreler:
+//   - field: Alan adı
+//   - value: Alanın ham değeri
+//
+// Dönüş:
+//   - *core.ValidationResult: Yalnızca bu alana ait hataları içerir
+//
+// Örnek:
+//
+//	res := schema.ValidateField("email", "user@")
+//	if res.HasErrors() {
+//	    return res.Errors()["email"]
+//	}
+func (vs *ValidationSchema) ValidateField(field string, value any) *core.ValidationResult {
+	return vs.ValidateFields(map[string]any{field: value}, field)
+}
+
+// ValidateFields
+// -----------------------------------------------------------------------------
+// data içinden yalnızca belirtilen alanları doğrular. Şemada tanımlı olmayan
+// alan adları yok sayılır; When(...) dalları ve çapraz doğrulayıcılar
+// çalıştırılmaz.
+//
+// Her alan ayrı bir sonuç üzerinde doğrulanıp birleştirilir; böylece bir
+// alanın hatası diğer alanların kontrolünü engellemez.
+//
+// Parametreler:
+//   - data: Ham veri
+//   - fields: Doğrulanacak alan adları
+//
+// Dönüş:
+//   - *core.ValidationResult: Hata yoksa ValidData yalnızca bu alanları içerir
+//
+// Örnek:
+//
+//	res := schema.ValidateFields(input, "email", "username")
+func (vs *ValidationSchema) ValidateFields(data map[string]any, fields ...string) *core.ValidationResult {
+	result := core.NewResult()
+	transformedData := make(map[string]any, len(fields))
+
+	for _, field := range fields {
+		typ, ok := vs.shape[field]
+		if !ok {
+			continue
+		}
+
+		if core.SkipValue(typ, data, field) {
+			if value, sent := data[field]; sent {
+				transformedData[field] = value
+			}
+			continue
+		}
+		value, err := vs.transformValue(typ, data[field])
+		if err != nil {
+			result.AddError(field, fmt.Sprintf("Dönüşüm hatası: %s", err.Error()))
+			continue
+		}
+
+		fieldResult := core.NewResult()
+		vs.validateValue(field, typ, value, fieldResult)
+		result.Merge(fieldResult)
+		transformedData[field] = value
+	}
+
+	result.SetTransformedData(transformedData)
+	vs.record(context.Background(), result)
+	if !result.HasErrors() {
+		result.SetValidData(transformedData)
+	}
+
+	return result
+}
+
+// transform
+// -----------------------------------------------------------------------------
+// Şemadaki her alan için (gerekirse otomatik trim uygulayarak) Transform
+// zincirini çalıştırır. Dönüşüm hatası alan alanlar sonuç verisine eklenmez,
+// hata result'a yazılır. Nullable alanlara gönderilen null korunur, Optional
+// ve Nullable alanların eksik anahtarları eklenmez (bkz. core.OmitValue).
+// Validate ve Sanitize tarafından ortak kullanılır.
+func (vs *ValidationSchema) transform(data map[string]any, result *core.ValidationResult) map[string]any {
+	transformedData := make(map[string]any)
+	for _, field := range sortedKeys(vs.shape) {
+		value, keep, err := vs.transformField(field, data)
+		if err != nil {
+			result.AddError(field, fmt.Sprintf("Dönüşüm hatası: %s", err.Error()))
+			continue
+		}
+		if keep {
+			transformedData[field] = value
+		}
+	}
+	return transformedData
+}
+
+// transformField
+// -----------------------------------------------------------------------------
+// Tek bir alanı varlık kurallarına göre dönüştürür: Nullable alana gönderilen
+// null dönüşümsüz korunur; Optional ve Nullable alanların eksik anahtarları
+// için keep false döner (bkz. core.OmitValue). transform ve ValidationSession
+// tarafından ortak kullanılır.
+func (vs *ValidationSchema) transformField(field string, data map[string]any) (value any, keep bool, err error) {
+	typ := vs.shape[field]
+	value, sent := data[field]
+	if sent && value == nil && core.NullableOf(typ) {
+		return nil, true, nil
+	}
+	value, err = vs.transformValue(typ, value)
+	if err != nil {
+		return nil, false, err
+	}
+	return value, !core.OmitValue(typ, data, field, value), nil
+}
+
+// validateValue
+// -----------------------------------------------------------------------------
+// Tek bir alanı doğrular. Alanın önem seviyesi "error" değilse doğrulama ayrı
+// bir sonuç üzerinde çalıştırılır ve mesajlar şemanın SeverityPolicy'sine göre
+// hata, uyarı veya bilgi olarak result'a aktarılır.
+func (vs *ValidationSchema) validateValue(field string, typ core.Type, value any, result *core.ValidationResult) {
+	severity := core.SeverityOf(typ)
+	if severity == core.SeverityError {
+		typ.Validate(field, value, result)
+		return
+	}
+
+	fieldResult := result.Child()
+	typ.Validate(field, value, fieldResult)
+	vs.mergeBySeverity(severity, fieldResult, result)
+}
+
+// mergeBySeverity, bir alanın ayrı toplanmış sonucunu seviyesine göre ana
+// sonuca aktarır: politikanın engelleyici saydığı seviyeler hata olarak
+// birleştirilir, diğerleri uyarı/bilgi olarak eklenir.
+func (vs *ValidationSchema) mergeBySeverity(severity core.Severity, fieldResult, result *core.ValidationResult) {
+	policy := vs.severityPolicy
+	if policy == nil {
+		policy = core.DefaultSeverityPolicy
+	}
+	if severity == core.SeverityError || policy(severity) {
+		result.Merge(fieldResult)
+		return
+	}
+	for f, msgs := range fieldResult.Errors() {
+		for _, msg := range msgs {
+			result.AddIssue(severity, f, msg)
+		}
+	}
+}
+
+// validateAsync
+// -----------------------------------------------------------------------------
+// core.AsyncValidatable uygulayan alanların dış kaynaklı kontrollerini
+// (örn. CreditCard().BINLookup) eşzamanlı çalıştırır. Alan seviyesi
+// kurallarda zaten hata almış veya payload sınırlarına takılmış alanlar için
+// dış kaynağa gidilmez. Kardeş alanlara bakan kurallar için dönüştürülmüş veri
+// context'e eklenir (core.PayloadFromContext). Sonuçlar deterministik olması
+// için alan adına göre sıralı birleştirilir.
+func (vs *ValidationSchema) validateAsync(ctx context.Context, data map[string]any, blocked map[string]bool, result *core.ValidationResult) {
+	var fields []string
+	for field, typ := range vs.shape {
+		if _, ok := typ.(core.AsyncValidatable); !ok || blocked[field] {
+			continue
+		}
+		if opt, ok := typ.(core.AsyncOptional); ok && !opt.HasAsyncRules() {
+			continue
+		}
+		if result.HasFieldErrors(field) {
+			continue
+		}
+		fields = append(fields, field)
+	}
+	if len(fields) == 0 {
+		return
+	}
+	sort.Strings(fields)
+	ctx = core.ContextWithPayload(ctx, data)
+
+	results := make([]*core.ValidationResult, len(fields))
+	var wg sync.WaitGroup
+	for i, field := range fields {
+		wg.Add(1)
+		go func(i int, field string) {
+			defer wg.Done()
+			results[i] = core.NewResult()
+			vs.shape[field].(core.AsyncValidatable).ValidateAsync(ctx, field, data[field], results[i])
+		}(i, field)
+	}
+	wg.Wait()
+
+	for i, field := range fields {
+		vs.mergeBySeverity(core.SeverityOf(vs.shape[field]), results[i], result)
+	}
+}
+
+// transformValue
+// -----------------------------------------------------------------------------
+// Tek bir ham değeri (gerekirse otomatik trim uygulayarak) tipin Transform
+// zincirinden geçirir.
+func (vs *ValidationSchema) transformValue(typ core.Type, value any) (any, error) {
+	if vs.autoTrim {
+		value = autoTrimValue(typ, value)
+	}
+	return typ.Transform(value)
+}
+
+// autoTrimValue
+// -----------------------------------------------------------------------------
+// WithAutoTrim açık olduğunda ham değeri tipin yapısına göre dolaşır ve string
+// değerlerin baş/son boşluklarını temizler. NoTrim() ile işaretlenen tipler ve
+// bunların altındaki değerler olduğu gibi bırakılır. Çağıranın verisini
+// değiştirmemek için map ve slice değerlerinin kopyası üzerinde çalışır.
+func autoTrimValue(typ core.Type, value any) any {
+	if opt, ok := typ.(core.TrimOptOut); ok && opt.IsTrimDisabled() {
+		return value
+	}
+
+	switch v := value.(type) {
+	case string:
+		return strings.TrimSpace(v)
+	case map[string]any:
+		provider, ok := typ.(core.ShapeProvider)
+		if !ok {
+			return v
+		}
+		shape := provider.GetShape()
+		trimmed := make(map[string]any, len(v))
+		for key, item := range v {
+			if subType, exists := shape[key]; exists {
+				trimmed[key] = autoTrimValue(subType, item)
+			} else {
+				trimmed[key] = item
+			}
+		}
+		return trimmed
+	case []any:
+		provider, ok := typ.(core.ElementProvider)
+		if !ok || provider.GetElementSchema() == nil {
+			return v
+		}
+		elementType := provider.GetElementSchema()
+		trimmed := make([]any, len(v))
+		for i, item := range v {
+			trimmed[i] = autoTrimValue(elementType, item)
+		}
+		return trimmed
+	}
+	return value
+}
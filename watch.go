@@ -0,0 +1,227 @@
+package validation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+//
+// -----------------------------------------------------------------------------
+// Şemaların Çalışırken Yeniden Yüklenmesi (Hot Reload)
+// -----------------------------------------------------------------------------
+// Bildirimsel şema dosyalarını (MarshalJSON ile üretilmiş anlık görüntüler)
+// bir dizinden veya bir konfigürasyon servisinden periyodik olarak okuyup
+// Registry'deki şemaları atomik olarak değiştiren yardımcıları içerir. Böylece
+// doğrulama kuralları servis yeniden dağıtılmadan ayarlanabilir.
+//
+// Kütüphane harici bağımlılık kullanmadığı için dosya değişiklikleri fsnotify
+// yerine yoklama (polling) ile tespit edilir.
+//
+// Yeniden yükleme kuralları:
+//   - Bir turda değişen tüm şemalar birlikte derlenir; biri bile hatalıysa
+//     hiçbiri uygulanmaz ve eski şemalar kullanılmaya devam eder.
+//   - Hata düzeltilene kadar her turda yeniden denenir ve hata işleyicisine
+//     raporlanır.
+//   - Kaynaktan kaldırılan şemalar kayıt defterinden de çıkarılır.
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// DefaultWatchInterval, yoklama aralığı belirtilmediğinde kullanılan süredir.
+const DefaultWatchInterval = 2 * time.Second
+
+// SchemaLoader, şema belgelerini isim → içerik (anlık görüntü JSON'u) olarak
+// getiren fonksiyondur. Konfigürasyon servisleri için kullanıcı tarafından
+// yazılır; dizinler için DirLoader kullanılabilir.
+type SchemaLoader func(ctx context.Context) (map[string][]byte, error)
+
+// WatchOption, WatchDir ve WatchSource davranışını değiştiren seçeneklerdir.
+type WatchOption func(*watchConfig)
+
+// watchConfig, izleme seçeneklerinin toplandığı yapıdır.
+type watchConfig struct {
+	interval   time.Duration
+	onError    func(error)
+	onReload   func(names []string)
+	schemaOpts []SchemaOption
+}
+
+// WithPollInterval, kaynağın hangi aralıkla yoklanacağını belirler.
+func WithPollInterval(d time.Duration) WatchOption {
+	return func(c *watchConfig) {
+		c.interval = d
+	}
+}
+
+// WithReloadErrorHandler, arka planda oluşan yükleme/derleme hatalarını alır.
+// Tanımlanmazsa hatalar yok sayılır ve eski şemalar kullanılmaya devam eder.
+func WithReloadErrorHandler(fn func(error)) WatchOption {
+	return func(c *watchConfig) {
+		c.onError = fn
+	}
+}
+
+// WithReloadHook, her başarılı yeniden yüklemeden sonra değişen (eklenen,
+// güncellenen veya kaldırılan) şema isimleriyle çağrılır.
+func WithReloadHook(fn func(names []string)) WatchOption {
+	return func(c *watchConfig) {
+		c.onReload = fn
+	}
+}
+
+// WithLoadedSchemaOptions, yüklenen her şemaya uygulanacak şema seçeneklerini
+// (WithStats, WithSeverityPolicy vb.) belirler.
+func WithLoadedSchemaOptions(opts ...SchemaOption) WatchOption {
+	return func(c *watchConfig) {
+		c.schemaOpts = append(c.schemaOpts, opts...)
+	}
+}
+
+// DirLoader
+// -----------------------------------------------------------------------------
+// dir dizinindeki *.json dosyalarını okuyan bir SchemaLoader döndürür. Şema
+// ismi, dosya adının uzantısız halidir ("user.json" → "user"). Alt dizinler
+// okunmaz.
+func DirLoader(dir string) SchemaLoader {
+	return func(ctx context.Context) (map[string][]byte, error) {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			return nil, err
+		}
+		docs := make(map[string][]byte)
+		for _, entry := range entries {
+			if entry.IsDir() || filepath.Ext(entry.Name()) != ".json" {
+				continue
+			}
+			data, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+			if err != nil {
+				return nil, err
+			}
+			docs[strings.TrimSuffix(entry.Name(), ".json")] = data
+		}
+		return docs, nil
+	}
+}
+
+// WatchDir
+// -----------------------------------------------------------------------------
+// dir dizinindeki şema dosyalarını yükler ve ctx iptal edilene kadar
+// değişiklikleri izler. İlk yükleme senkron yapılır; hata varsa döndürülür ve
+// izleme başlatılmaz.
+//
+// Örnek:
+//
+//	registry := validation.NewRegistry()
+//	err := registry.WatchDir(ctx, "/etc/app/schemas",
+//	    validation.WithPollInterval(5*time.Second),
+//	    validation.WithReloadErrorHandler(func(err error) { log.Println(err) }),
+//	)
+//	...
+//	res, ok := registry.Validate("user", payload)
+func (r *Registry) WatchDir(ctx context.Context, dir string, opts ...WatchOption) error {
+	return r.WatchSource(ctx, DirLoader(dir), opts...)
+}
+
+// WatchSource
+// -----------------------------------------------------------------------------
+// load ile getirilen şemaları yükler ve ctx iptal edilene kadar periyodik
+// olarak yoklar. Konfigürasyon servisleri (Consul, etcd, HTTP uç noktası vb.)
+// için kullanılır. İlk yükleme senkron yapılır; hata varsa döndürülür.
+func (r *Registry) WatchSource(ctx context.Context, load SchemaLoader, opts ...WatchOption) error {
+	cfg := watchConfig{interval: DefaultWatchInterval}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	w := &schemaWatcher{registry: r, load: load, cfg: cfg, seen: make(map[string]string)}
+	if err := w.reload(ctx); err != nil {
+		return err
+	}
+
+	go func() {
+		ticker := time.NewTicker(cfg.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := w.reload(ctx); err != nil && cfg.onError != nil {
+					cfg.onError(err)
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+// schemaWatcher, bir kaynağın son görülen içeriğini takip eder.
+type schemaWatcher struct {
+	registry *Registry
+	load     SchemaLoader
+	cfg      watchConfig
+	seen     map[string]string
+}
+
+// reload, kaynağı bir kez okur ve değişen şemaları atomik olarak uygular.
+func (w *schemaWatcher) reload(ctx context.Context) error {
+	docs, err := w.load(ctx)
+	if err != nil {
+		return fmt.Errorf("şemalar yüklenemedi: %w", err)
+	}
+
+	var (
+		changed = make(map[string]*ValidationSchema)
+		removed []string
+		errs    []error
+	)
+	for name, doc := range docs {
+		if prev, ok := w.seen[name]; ok && prev == string(doc) {
+			continue
+		}
+		schema := Make(w.cfg.schemaOpts...)
+		if err := schema.UnmarshalJSON(doc); err != nil {
+			errs = append(errs, fmt.Errorf("şema %q: %w", name, err))
+			continue
+		}
+		changed[name] = schema
+	}
+	for name := range w.seen {
+		if _, ok := docs[name]; !ok {
+			removed = append(removed, name)
+		}
+	}
+
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+	if len(changed) == 0 && len(removed) == 0 {
+		return nil
+	}
+
+	w.seen = make(map[string]string, len(docs))
+	for name, doc := range docs {
+		w.seen[name] = string(doc)
+	}
+
+	w.registry.swap(changed, removed)
+	if w.cfg.onReload != nil {
+		names := removed
+		for name := range changed {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		w.cfg.onReload(names)
+	}
+	return nil
+}
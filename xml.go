@@ -0,0 +1,306 @@
+package validation
+
+import (
+	"encoding/xml"
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/biyonik/go-fluent-validator/core"
+	"github.com/biyonik/go-fluent-validator/i18n"
+)
+
+//
+// -----------------------------------------------------------------------------
+// XML Doğrulama
+// -----------------------------------------------------------------------------
+// SOAP ve eski (legacy) entegrasyonlardan gelen XML gövdelerini, JSON için
+// tanımlanan şemaların aynısıyla doğrular. Doküman önce bir eleman ağacına
+// çözülür, ardından veri map'ine çevrilir:
+//
+//   - mapping boşsa genel kurallar uygulanır: kök elemanın nitelikleri ve alt
+//     elemanları yerel adlarıyla anahtar olur, tekrarlanan elemanlar []any,
+//     alt elemanı veya niteliği olan elemanlar map, diğerleri metin olur.
+//     Hem niteliği hem metni olan elemanlarda metin "#text" anahtarına yazılır.
+//   - mapping verilmişse yalnızca eşlenen alanlar okunur (bkz. core.XMLMapping).
+//
+// Her iki durumda da ad alanı önekleri yok sayılır ve SOAP zarfı
+// (Envelope/Body) açılır; yollar gövdedeki ilk elemana göredir. Metin
+// değerleri şema tanımına göre çevrilir (bkz. core.CoerceStrings); dizi
+// alanlarına tek eleman gelirse tek elemanlı dizi yapılır ve
+// <tags><tag>a</tag><tag>b</tag></tags> gibi sarmalayıcı elemanlar açılır.
+//
+// Bozuk XML veya kökten sonra gelen ek eleman "_payload" alanında
+// "invalid_xml" kuralıyla raporlanır.
+//
+// Metadata:
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// xmlTextKey, hem niteliği hem metni olan elemanlarda metnin yazıldığı anahtardır.
+const xmlTextKey = "#text"
+
+// ValidateXML
+// -----------------------------------------------------------------------------
+// r'den bir XML dokümanı okur, mapping'e göre veriye çevirir ve şemayla
+// doğrular. mapping nil ise genel eleman→anahtar kuralları kullanılır.
+//
+// Örnek:
+//
+//	// <CreateUser id="42"><Contact><Email>ada@example.com</Email></Contact></CreateUser>
+//	res := schema.ValidateXML(r.Body, core.XMLMapping{
+//	    "id":    "@id",
+//	    "email": "Contact/Email",
+//	})
+func (vs *ValidationSchema) ValidateXML(r io.Reader, mapping core.XMLMapping) *core.ValidationResult {
+	root, err := decodeXMLDocument(r)
+	if err != nil {
+		result := core.NewResult()
+		result.AddRuleError(payloadField, i18n.KeyInvalidXML)
+		return result
+	}
+	root = unwrapSOAP(root)
+
+	var data map[string]any
+	if len(mapping) == 0 {
+		data, _ = root.value().(map[string]any)
+		if data == nil {
+			data = map[string]any{}
+		}
+	} else {
+		data = make(map[string]any, len(mapping))
+		for field, path := range mapping {
+			if value, ok := root.lookup(path); ok {
+				setXMLField(data, field, value)
+			}
+		}
+	}
+
+	desc := vs.Describe()
+	for name, value := range data {
+		data[name] = coerceXML(value, desc.Fields[name])
+	}
+	return vs.Validate(data)
+}
+
+// xmlNode, çözülmüş bir XML elemanıdır.
+type xmlNode struct {
+	space    string
+	name     string
+	attrs    []xml.Attr
+	children []*xmlNode
+	text     strings.Builder
+}
+
+// errTrailingXML, kök elemandan sonra ek eleman bulunduğunu belirtir.
+var errTrailingXML = errors.New("kök elemandan sonra beklenmeyen içerik")
+
+// decodeXMLDocument, r'den tek kök elemanlı bir XML dokümanını ağaç olarak
+// çözer.
+func decodeXMLDocument(r io.Reader) (*xmlNode, error) {
+	dec := xml.NewDecoder(r)
+	var root *xmlNode
+	var stack []*xmlNode
+
+	for {
+		tok, err := dec.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			node := &xmlNode{space: t.Name.Space, name: t.Name.Local, attrs: t.Attr}
+			if len(stack) > 0 {
+				parent := stack[len(stack)-1]
+				parent.children = append(parent.children, node)
+			} else if root != nil {
+				return nil, errTrailingXML
+			} else {
+				root = node
+			}
+			stack = append(stack, node)
+		case xml.EndElement:
+			stack = stack[:len(stack)-1]
+		case xml.CharData:
+			if len(stack) > 0 {
+				stack[len(stack)-1].text.Write(t)
+			}
+		}
+	}
+
+	if root == nil {
+		return nil, errors.New("XML dokümanında kök eleman yok")
+	}
+	return root, nil
+}
+
+// soapNamespaces, SOAP 1.1 ve 1.2 zarf ad alanlarıdır.
+var soapNamespaces = map[string]bool{
+	"http://schemas.xmlsoap.org/soap/envelope/": true,
+	"http://www.w3.org/2003/05/soap-envelope":   true,
+}
+
+// unwrapSOAP, kök bir SOAP zarfıysa Body içindeki ilk elemanı döndürür.
+func unwrapSOAP(root *xmlNode) *xmlNode {
+	if root.name != "Envelope" || !soapNamespaces[root.space] {
+		return root
+	}
+	for _, child := range root.children {
+		if child.name == "Body" && len(child.children) > 0 {
+			return child.children[0]
+		}
+	}
+	return root
+}
+
+// value, elemanı genel kurallara göre map'e veya metne çevirir.
+func (n *xmlNode) value() any {
+	text := strings.TrimSpace(n.text.String())
+	attrs := 0
+	for _, attr := range n.attrs {
+		if attr.Name.Space != "xmlns" && attr.Name.Local != "xmlns" {
+			attrs++
+		}
+	}
+	if len(n.children) == 0 && attrs == 0 {
+		return text
+	}
+
+	m := make(map[string]any, attrs+len(n.children))
+	for _, attr := range n.attrs {
+		if attr.Name.Space == "xmlns" || attr.Name.Local == "xmlns" {
+			continue
+		}
+		m[attr.Name.Local] = attr.Value
+	}
+	for _, child := range n.children {
+		value := child.value()
+		switch existing := m[child.name].(type) {
+		case nil:
+			m[child.name] = value
+		case []any:
+			m[child.name] = append(existing, value)
+		default:
+			m[child.name] = []any{existing, value}
+		}
+	}
+	if len(n.children) == 0 && text != "" {
+		m[xmlTextKey] = text
+	}
+	return m
+}
+
+// lookup, "A/B/@id" biçimindeki yolu n'e göre çözer. Birden fazla eleman
+// eşleşirse değerler []any olarak döner.
+func (n *xmlNode) lookup(path string) (any, bool) {
+	nodes := []*xmlNode{n}
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	var values []any
+
+	for i, segment := range segments {
+		if attr, ok := strings.CutPrefix(segment, "@"); ok && i == len(segments)-1 {
+			for _, node := range nodes {
+				for _, a := range node.attrs {
+					if a.Name.Local == attr && a.Name.Space != "xmlns" {
+						values = append(values, a.Value)
+					}
+				}
+			}
+			nodes = nil
+			break
+		}
+		if segment == "" || segment == "." {
+			continue
+		}
+		var next []*xmlNode
+		for _, node := range nodes {
+			for _, child := range node.children {
+				if child.name == segment {
+					next = append(next, child)
+				}
+			}
+		}
+		nodes = next
+	}
+
+	for _, node := range nodes {
+		values = append(values, node.value())
+	}
+	switch len(values) {
+	case 0:
+		return nil, false
+	case 1:
+		return values[0], true
+	}
+	return values, true
+}
+
+// setXMLField, "customer.name" biçimindeki alan yoluna değeri iç içe map'ler
+// oluşturarak yazar.
+func setXMLField(data map[string]any, field string, value any) {
+	parts := strings.Split(field, ".")
+	for _, part := range parts[:len(parts)-1] {
+		next, ok := data[part].(map[string]any)
+		if !ok {
+			next = map[string]any{}
+			data[part] = next
+		}
+		data = next
+	}
+	data[parts[len(parts)-1]] = value
+}
+
+// coerceXML, XML'den okunan değeri şema tanımına göre çevirir.
+func coerceXML(value any, desc *core.TypeDescription) any {
+	if desc == nil {
+		return value
+	}
+
+	switch desc.Type {
+	case "object":
+		m, ok := value.(map[string]any)
+		if !ok {
+			if s, isText := value.(string); isText && s == "" {
+				return map[string]any{}
+			}
+			return value
+		}
+		for name, field := range m {
+			m[name] = coerceXML(field, desc.Fields[name])
+		}
+		return m
+	case "array":
+		items, ok := value.([]any)
+		if !ok {
+			if s, isText := value.(string); isText && s == "" {
+				return []any{}
+			}
+			if m, isMap := value.(map[string]any); isMap && len(m) == 1 {
+				for name, inner := range m {
+					if desc.Elements == nil || desc.Elements.Fields[name] == nil {
+						value = inner
+					}
+				}
+			}
+			if items, ok = value.([]any); !ok {
+				items = []any{value}
+			}
+		}
+		for i, item := range items {
+			items[i] = coerceXML(item, desc.Elements)
+		}
+		return items
+	}
+
+	if s, ok := value.(string); ok {
+		return core.CoerceStrings([]string{s}, desc)
+	}
+	return value
+}